@@ -78,6 +78,7 @@ func (s *Service) HandleCallback(code, state string) (*CallbackResult, error) {
 			Email:       optionalString(claims.Email),
 			Avatar:      optionalString(claims.Avatar),
 			Id:          optionalString(claims.Id),
+			Roles:       roleNames(claims.Roles),
 		},
 	}, nil
 }
@@ -131,6 +132,7 @@ type CallbackUser struct {
 	Email       *string
 	Avatar      *string
 	Id          *string
+	Roles       []string
 }
 
 func normalizeTokenType(tokenType string) string {
@@ -174,6 +176,16 @@ func extractExpiresInSeconds(token *oauth2.Token) int {
 	return int(remaining)
 }
 
+func roleNames(roles []*casdoorsdk.Role) []string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if role != nil && role.Name != "" {
+			names = append(names, role.Name)
+		}
+	}
+	return names
+}
+
 func optionalString(value string) *string {
 	if value == "" {
 		return nil
@@ -48,6 +48,7 @@ func TestServiceHandleCallback(t *testing.T) {
 			Email:       "john@example.com",
 			Avatar:      "http://avatar",
 			Id:          "user-id",
+			Roles:       []*casdoorsdk.Role{{Name: "trainer"}},
 		},
 	}
 
@@ -69,6 +70,7 @@ func TestServiceHandleCallback(t *testing.T) {
 	require.Equal(t, "john@example.com", *resp.User.Email)
 	require.Equal(t, "http://avatar", *resp.User.Avatar)
 	require.Equal(t, "user-id", *resp.User.Id)
+	require.Equal(t, []string{"trainer"}, resp.User.Roles)
 }
 
 func TestServiceHandleCallbackErrors(t *testing.T) {
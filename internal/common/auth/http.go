@@ -15,6 +15,11 @@ type User struct {
 	Role  string
 
 	DisplayName string
+
+	// SessionID identifies the session the user's token was issued for.
+	SessionID string
+	// AuthMethod is how the user signed in, it's empty when the token doesn't tell.
+	AuthMethod string
 }
 
 type ctxKey int
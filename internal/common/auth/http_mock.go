@@ -2,6 +2,8 @@ package auth
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"net/http"
 
 	"github.com/dgrijalva/jwt-go"
@@ -31,14 +33,31 @@ func HttpMockMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
+		authMethod, _ := claims["signinMethod"].(string)
+
 		ctx := context.WithValue(r.Context(), userContextKey, User{
 			UUID:        claims["user_uuid"].(string),
 			Email:       claims["email"].(string),
 			Role:        claims["role"].(string),
 			DisplayName: claims["name"].(string),
+			SessionID:   sessionID(claims, token.Raw),
+			AuthMethod:  authMethod,
 		})
 		r = r.WithContext(ctx)
 
 		next.ServeHTTP(w, r)
 	})
 }
+
+// sessionID takes the session from the sid or jti claim.
+// Tokens without them are sessions on their own, identified by the token's hash.
+func sessionID(claims jwt.MapClaims, rawToken string) string {
+	for _, claim := range []string{"sid", "jti"} {
+		if id, ok := claims[claim].(string); ok && id != "" {
+			return id
+		}
+	}
+
+	hash := sha256.Sum256([]byte(rawToken))
+	return hex.EncodeToString(hash[:])
+}
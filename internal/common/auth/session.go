@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	commonerrors "github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/common/server/httperr"
+)
+
+var (
+	// ErrSessionRevoked is returned by SessionChecker for sessions revoked by the user.
+	ErrSessionRevoked = commonerrors.NewAuthorizationError("session was revoked", "session-revoked")
+	// ErrUserDeactivated is returned by SessionChecker for users whose account was deactivated.
+	ErrUserDeactivated = commonerrors.NewForbiddenError("user account is deactivated", "user-deactivated")
+)
+
+// SessionActivity is a request made in the session of the authenticated user.
+type SessionActivity struct {
+	User      User
+	IP        string
+	UserAgent string
+}
+
+// SessionChecker records the activity of user sessions and tells whether the session can still be used.
+type SessionChecker interface {
+	// CheckSession returns ErrSessionRevoked or ErrUserDeactivated when the request should be rejected.
+	// Otherwise, it returns the user of the session with the role kept by the users service.
+	CheckSession(ctx context.Context, activity SessionActivity) (User, error)
+}
+
+// SessionMiddleware rejects requests made in revoked sessions. It has to run after the authentication middleware,
+// requests without authenticated user are passed through.
+// The user of the request is replaced by the checked one, so role changes apply without waiting for a new token.
+func SessionMiddleware(checker SessionChecker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user, err := UserFromCtx(r.Context())
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ip, _, err := net.SplitHostPort(r.RemoteAddr)
+			if err != nil {
+				ip = r.RemoteAddr
+			}
+
+			checkedUser, err := checker.CheckSession(r.Context(), SessionActivity{
+				User:      user,
+				IP:        ip,
+				UserAgent: r.UserAgent(),
+			})
+			if errors.Is(err, ErrSessionRevoked) || errors.Is(err, ErrUserDeactivated) {
+				httperr.RespondWithSlugError(err, w, r)
+				return
+			}
+			if err != nil {
+				httperr.InternalError("cannot-check-session", err, w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), userContextKey, checkedUser)))
+		})
+	}
+}
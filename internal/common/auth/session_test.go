@@ -0,0 +1,80 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+)
+
+func TestSessionMiddleware_replaces_role(t *testing.T) {
+	t.Parallel()
+
+	// the user was demoted after the token was issued
+	checker := sessionCheckerMock{role: "attendee"}
+
+	var handledUser auth.User
+	handler := auth.HttpMockMiddleware(auth.SessionMiddleware(checker)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, err := auth.UserFromCtx(r.Context())
+		require.NoError(t, err)
+		handledUser = user
+	})))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest(t, "admin"))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "attendee", handledUser.Role)
+	assert.NotEmpty(t, handledUser.UUID)
+}
+
+func TestSessionMiddleware_revoked(t *testing.T) {
+	t.Parallel()
+
+	checker := sessionCheckerMock{err: auth.ErrSessionRevoked}
+
+	handler := auth.HttpMockMiddleware(auth.SessionMiddleware(checker)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request in revoked session must not be handled")
+	})))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest(t, "attendee"))
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+type sessionCheckerMock struct {
+	role string
+	err  error
+}
+
+func (m sessionCheckerMock) CheckSession(ctx context.Context, activity auth.SessionActivity) (auth.User, error) {
+	if m.err != nil {
+		return auth.User{}, m.err
+	}
+
+	user := activity.User
+	user.Role = m.role
+	return user, nil
+}
+
+func newRequest(t *testing.T, role string) *http.Request {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_uuid": uuid.New().String(),
+		"email":     "user@example.com",
+		"role":      role,
+		"name":      "User",
+	})
+	signed, err := token.SignedString([]byte("mock_secret"))
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+signed)
+	return req
+}
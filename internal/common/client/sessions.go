@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/genproto/users"
+)
+
+// UsersSessionChecker checks sessions of authenticated users in the users service,
+// so services can reject requests made in revoked sessions.
+type UsersSessionChecker struct {
+	client users.UsersServiceClient
+}
+
+func NewUsersSessionChecker(client users.UsersServiceClient) UsersSessionChecker {
+	if client == nil {
+		panic("missing users client")
+	}
+
+	return UsersSessionChecker{client: client}
+}
+
+func (c UsersSessionChecker) CheckSession(ctx context.Context, activity auth.SessionActivity) (auth.User, error) {
+	resp, err := c.client.CheckSession(ctx, &users.CheckSessionRequest{
+		UserId:      activity.User.UUID,
+		SessionId:   activity.User.SessionID,
+		Role:        activity.User.Role,
+		DisplayName: activity.User.DisplayName,
+		Email:       activity.User.Email,
+		AuthMethod:  activity.User.AuthMethod,
+		Ip:          activity.IP,
+		UserAgent:   activity.UserAgent,
+	})
+	if err != nil {
+		return auth.User{}, fmt.Errorf("check session: %w", err)
+	}
+
+	if resp.UserDeactivated {
+		return auth.User{}, auth.ErrUserDeactivated
+	}
+	if resp.Revoked {
+		return auth.User{}, auth.ErrSessionRevoked
+	}
+
+	user := activity.User
+	if resp.Role != "" {
+		user.Role = resp.Role
+	}
+
+	return user, nil
+}
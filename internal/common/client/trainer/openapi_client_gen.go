@@ -358,10 +358,10 @@ type ClientWithResponsesInterface interface {
 }
 
 type GetTrainerAvailableHoursResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Date
-	JSONDefault  *Error
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *[]Date
+	ApplicationproblemJSONDefault *Error
 }
 
 // Status returns HTTPResponse.Status
@@ -381,10 +381,10 @@ func (r GetTrainerAvailableHoursResponse) StatusCode() int {
 }
 
 type MakeHourAvailableResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON204      *[]Date
-	JSONDefault  *Error
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON204                       *[]Date
+	ApplicationproblemJSONDefault *Error
 }
 
 // Status returns HTTPResponse.Status
@@ -404,10 +404,10 @@ func (r MakeHourAvailableResponse) StatusCode() int {
 }
 
 type MakeHourUnavailableResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON204      *[]Date
-	JSONDefault  *Error
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON204                       *[]Date
+	ApplicationproblemJSONDefault *Error
 }
 
 // Status returns HTTPResponse.Status
@@ -495,7 +495,7 @@ func ParseGetTrainerAvailableHoursResponse(rsp *http.Response) (*GetTrainerAvail
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSONDefault = &dest
+		response.ApplicationproblemJSONDefault = &dest
 
 	}
 
@@ -528,7 +528,7 @@ func ParseMakeHourAvailableResponse(rsp *http.Response) (*MakeHourAvailableRespo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSONDefault = &dest
+		response.ApplicationproblemJSONDefault = &dest
 
 	}
 
@@ -561,7 +561,7 @@ func ParseMakeHourUnavailableResponse(rsp *http.Response) (*MakeHourUnavailableR
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSONDefault = &dest
+		response.ApplicationproblemJSONDefault = &dest
 
 	}
 
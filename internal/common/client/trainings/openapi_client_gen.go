@@ -98,24 +98,122 @@ type ClientInterface interface {
 
 	CreateTraining(ctx context.Context, body CreateTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateBulkCancellationWithBody request with any body
+	CreateBulkCancellationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBulkCancellation(ctx context.Context, body CreateBulkCancellationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBulkCancellation request
+	GetBulkCancellation(ctx context.Context, bulkCancellationUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResumeBulkCancellation request
+	ResumeBulkCancellation(ctx context.Context, bulkCancellationUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClient request
+	GetClient(ctx context.Context, clientUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportTrainingHistory request
+	ExportTrainingHistory(ctx context.Context, params *ExportTrainingHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTrainerRating request
+	GetTrainerRating(ctx context.Context, params *GetTrainerRatingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCancellationReport request
+	GetCancellationReport(ctx context.Context, params *GetCancellationReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUtilizationReport request
+	GetUtilizationReport(ctx context.Context, params *GetUtilizationReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTrainingSeriesWithBody request with any body
+	CreateTrainingSeriesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTrainingSeries(ctx context.Context, body CreateTrainingSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelTrainingSeries request
+	CancelTrainingSeries(ctx context.Context, seriesUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTrainingSeries request
+	GetTrainingSeries(ctx context.Context, seriesUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSessionTypes request
+	GetSessionTypes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSessionTypeWithBody request with any body
+	CreateSessionTypeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSessionType(ctx context.Context, body CreateSessionTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSessionTypeWithBody request with any body
+	UpdateSessionTypeWithBody(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSessionType(ctx context.Context, code string, body UpdateSessionTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportUserData request
+	ExportUserData(ctx context.Context, userUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EraseUserData request
+	EraseUserData(ctx context.Context, userUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWaitlist request
+	GetWaitlist(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// JoinWaitlistWithBody request with any body
+	JoinWaitlistWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	JoinWaitlist(ctx context.Context, body JoinWaitlistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LeaveWaitlist request
+	LeaveWaitlist(ctx context.Context, entryUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ClaimWaitlistOfferWithBody request with any body
+	ClaimWaitlistOfferWithBody(ctx context.Context, entryUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ClaimWaitlistOffer(ctx context.Context, entryUUID openapi_types.UUID, body ClaimWaitlistOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CancelTraining request
-	CancelTraining(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CancelTraining(ctx context.Context, trainingUUID openapi_types.UUID, params *CancelTrainingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTraining request
+	GetTraining(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ApproveRescheduleTraining request
-	ApproveRescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ApproveRescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, params *ApproveRescheduleTrainingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RecordTrainingAttendanceWithBody request with any body
+	RecordTrainingAttendanceWithBody(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RecordTrainingAttendance(ctx context.Context, trainingUUID openapi_types.UUID, body RecordTrainingAttendanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RateTrainingWithBody request with any body
+	RateTrainingWithBody(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RateTraining(ctx context.Context, trainingUUID openapi_types.UUID, body RateTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReplyToTrainingFeedbackWithBody request with any body
+	ReplyToTrainingFeedbackWithBody(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReplyToTrainingFeedback(ctx context.Context, trainingUUID openapi_types.UUID, body ReplyToTrainingFeedbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTrainingNotesWithBody request with any body
+	UpdateTrainingNotesWithBody(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTrainingNotes(ctx context.Context, trainingUUID openapi_types.UUID, body UpdateTrainingNotesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTrainingNotesHistory request
+	GetTrainingNotesHistory(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// RejectRescheduleTraining request
-	RejectRescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	RejectRescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, params *RejectRescheduleTrainingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// RequestRescheduleTrainingWithBody request with any body
-	RequestRescheduleTrainingWithBody(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	RequestRescheduleTrainingWithBody(ctx context.Context, trainingUUID openapi_types.UUID, params *RequestRescheduleTrainingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	RequestRescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, body RequestRescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	RequestRescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, params *RequestRescheduleTrainingParams, body RequestRescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// RescheduleTrainingWithBody request with any body
-	RescheduleTrainingWithBody(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	RescheduleTrainingWithBody(ctx context.Context, trainingUUID openapi_types.UUID, params *RescheduleTrainingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	RescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, body RescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	RescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, params *RescheduleTrainingParams, body RescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetTrainings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -154,8 +252,8 @@ func (c *Client) CreateTraining(ctx context.Context, body CreateTrainingJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) CancelTraining(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCancelTrainingRequest(c.Server, trainingUUID)
+func (c *Client) CreateBulkCancellationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBulkCancellationRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -166,8 +264,8 @@ func (c *Client) CancelTraining(ctx context.Context, trainingUUID openapi_types.
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApproveRescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApproveRescheduleTrainingRequest(c.Server, trainingUUID)
+func (c *Client) CreateBulkCancellation(ctx context.Context, body CreateBulkCancellationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBulkCancellationRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -178,8 +276,8 @@ func (c *Client) ApproveRescheduleTraining(ctx context.Context, trainingUUID ope
 	return c.Client.Do(req)
 }
 
-func (c *Client) RejectRescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRejectRescheduleTrainingRequest(c.Server, trainingUUID)
+func (c *Client) GetBulkCancellation(ctx context.Context, bulkCancellationUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBulkCancellationRequest(c.Server, bulkCancellationUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -190,8 +288,8 @@ func (c *Client) RejectRescheduleTraining(ctx context.Context, trainingUUID open
 	return c.Client.Do(req)
 }
 
-func (c *Client) RequestRescheduleTrainingWithBody(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRequestRescheduleTrainingRequestWithBody(c.Server, trainingUUID, contentType, body)
+func (c *Client) ResumeBulkCancellation(ctx context.Context, bulkCancellationUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResumeBulkCancellationRequest(c.Server, bulkCancellationUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -202,8 +300,8 @@ func (c *Client) RequestRescheduleTrainingWithBody(ctx context.Context, training
 	return c.Client.Do(req)
 }
 
-func (c *Client) RequestRescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, body RequestRescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRequestRescheduleTrainingRequest(c.Server, trainingUUID, body)
+func (c *Client) GetClient(ctx context.Context, clientUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClientRequest(c.Server, clientUUID)
 	if err != nil {
 		return nil, err
 	}
@@ -214,8 +312,8 @@ func (c *Client) RequestRescheduleTraining(ctx context.Context, trainingUUID ope
 	return c.Client.Do(req)
 }
 
-func (c *Client) RescheduleTrainingWithBody(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRescheduleTrainingRequestWithBody(c.Server, trainingUUID, contentType, body)
+func (c *Client) ExportTrainingHistory(ctx context.Context, params *ExportTrainingHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportTrainingHistoryRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -226,8 +324,8 @@ func (c *Client) RescheduleTrainingWithBody(ctx context.Context, trainingUUID op
 	return c.Client.Do(req)
 }
 
-func (c *Client) RescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, body RescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRescheduleTrainingRequest(c.Server, trainingUUID, body)
+func (c *Client) GetTrainerRating(ctx context.Context, params *GetTrainerRatingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTrainerRatingRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -238,624 +336,4071 @@ func (c *Client) RescheduleTraining(ctx context.Context, trainingUUID openapi_ty
 	return c.Client.Do(req)
 }
 
-// NewGetTrainingsRequest generates requests for GetTrainings
-func NewGetTrainingsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetCancellationReport(ctx context.Context, params *GetCancellationReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCancellationReportRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/trainings")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetUtilizationReport(ctx context.Context, params *GetUtilizationReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUtilizationReportRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateTrainingRequest calls the generic CreateTraining builder with application/json body
-func NewCreateTrainingRequest(server string, body CreateTrainingJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateTrainingSeriesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTrainingSeriesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateTrainingRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateTrainingRequestWithBody generates requests for CreateTraining with any type of body
-func NewCreateTrainingRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateTrainingSeries(ctx context.Context, body CreateTrainingSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTrainingSeriesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/trainings")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CancelTrainingSeries(ctx context.Context, seriesUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelTrainingSeriesRequest(c.Server, seriesUUID)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCancelTrainingRequest generates requests for CancelTraining
-func NewCancelTrainingRequest(server string, trainingUUID openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+func (c *Client) GetTrainingSeries(ctx context.Context, seriesUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTrainingSeriesRequest(c.Server, seriesUUID)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetSessionTypes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSessionTypesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/trainings/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateSessionTypeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSessionTypeRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewApproveRescheduleTrainingRequest generates requests for ApproveRescheduleTraining
-func NewApproveRescheduleTrainingRequest(server string, trainingUUID openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+func (c *Client) CreateSessionType(ctx context.Context, body CreateSessionTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSessionTypeRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateSessionTypeWithBody(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSessionTypeRequestWithBody(c.Server, code, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/trainings/%s/approve-reschedule", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateSessionType(ctx context.Context, code string, body UpdateSessionTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSessionTypeRequest(c.Server, code, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewRejectRescheduleTrainingRequest generates requests for RejectRescheduleTraining
-func NewRejectRescheduleTrainingRequest(server string, trainingUUID openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+func (c *Client) ExportUserData(ctx context.Context, userUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportUserDataRequest(c.Server, userUUID)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) EraseUserData(ctx context.Context, userUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEraseUserDataRequest(c.Server, userUUID)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/trainings/%s/reject-reschedule", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetWaitlist(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWaitlistRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewRequestRescheduleTrainingRequest calls the generic RequestRescheduleTraining builder with application/json body
-func NewRequestRescheduleTrainingRequest(server string, trainingUUID openapi_types.UUID, body RequestRescheduleTrainingJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) JoinWaitlistWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewJoinWaitlistRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewRequestRescheduleTrainingRequestWithBody(server, trainingUUID, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewRequestRescheduleTrainingRequestWithBody generates requests for RequestRescheduleTraining with any type of body
-func NewRequestRescheduleTrainingRequestWithBody(server string, trainingUUID openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+func (c *Client) JoinWaitlist(ctx context.Context, body JoinWaitlistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewJoinWaitlistRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) LeaveWaitlist(ctx context.Context, entryUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLeaveWaitlistRequest(c.Server, entryUUID)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/trainings/%s/request-reschedule", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ClaimWaitlistOfferWithBody(ctx context.Context, entryUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClaimWaitlistOfferRequestWithBody(c.Server, entryUUID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewRescheduleTrainingRequest calls the generic RescheduleTraining builder with application/json body
-func NewRescheduleTrainingRequest(server string, trainingUUID openapi_types.UUID, body RescheduleTrainingJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ClaimWaitlistOffer(ctx context.Context, entryUUID openapi_types.UUID, body ClaimWaitlistOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClaimWaitlistOfferRequest(c.Server, entryUUID, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewRescheduleTrainingRequestWithBody(server, trainingUUID, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewRescheduleTrainingRequestWithBody generates requests for RescheduleTraining with any type of body
-func NewRescheduleTrainingRequestWithBody(server string, trainingUUID openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+func (c *Client) CancelTraining(ctx context.Context, trainingUUID openapi_types.UUID, params *CancelTrainingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelTrainingRequest(c.Server, trainingUUID, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetTraining(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTrainingRequest(c.Server, trainingUUID)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/trainings/%s/reschedule", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ApproveRescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, params *ApproveRescheduleTrainingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveRescheduleTrainingRequest(c.Server, trainingUUID, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) RecordTrainingAttendanceWithBody(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordTrainingAttendanceRequestWithBody(c.Server, trainingUUID, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) RecordTrainingAttendance(ctx context.Context, trainingUUID openapi_types.UUID, body RecordTrainingAttendanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordTrainingAttendanceRequest(c.Server, trainingUUID, body)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) RateTrainingWithBody(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRateTrainingRequestWithBody(c.Server, trainingUUID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) RateTraining(ctx context.Context, trainingUUID openapi_types.UUID, body RateTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRateTrainingRequest(c.Server, trainingUUID, body)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) ReplyToTrainingFeedbackWithBody(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplyToTrainingFeedbackRequestWithBody(c.Server, trainingUUID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetTrainingsWithResponse request
-	GetTrainingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTrainingsResponse, error)
-
-	// CreateTrainingWithBodyWithResponse request with any body
-	CreateTrainingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTrainingResponse, error)
-
-	CreateTrainingWithResponse(ctx context.Context, body CreateTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTrainingResponse, error)
-
-	// CancelTrainingWithResponse request
-	CancelTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelTrainingResponse, error)
-
-	// ApproveRescheduleTrainingWithResponse request
-	ApproveRescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApproveRescheduleTrainingResponse, error)
-
-	// RejectRescheduleTrainingWithResponse request
-	RejectRescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*RejectRescheduleTrainingResponse, error)
-
-	// RequestRescheduleTrainingWithBodyWithResponse request with any body
-	RequestRescheduleTrainingWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestRescheduleTrainingResponse, error)
-
-	RequestRescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, body RequestRescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestRescheduleTrainingResponse, error)
-
-	// RescheduleTrainingWithBodyWithResponse request with any body
-	RescheduleTrainingWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RescheduleTrainingResponse, error)
-
-	RescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, body RescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*RescheduleTrainingResponse, error)
+func (c *Client) ReplyToTrainingFeedback(ctx context.Context, trainingUUID openapi_types.UUID, body ReplyToTrainingFeedbackJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReplyToTrainingFeedbackRequest(c.Server, trainingUUID, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type GetTrainingsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Trainings
-	JSONDefault  *Error
+func (c *Client) UpdateTrainingNotesWithBody(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTrainingNotesRequestWithBody(c.Server, trainingUUID, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTrainingsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) UpdateTrainingNotes(ctx context.Context, trainingUUID openapi_types.UUID, body UpdateTrainingNotesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTrainingNotesRequest(c.Server, trainingUUID, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTrainingsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) GetTrainingNotesHistory(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTrainingNotesHistoryRequest(c.Server, trainingUUID)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type CreateTrainingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+func (c *Client) RejectRescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, params *RejectRescheduleTrainingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRejectRescheduleTrainingRequest(c.Server, trainingUUID, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateTrainingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) RequestRescheduleTrainingWithBody(ctx context.Context, trainingUUID openapi_types.UUID, params *RequestRescheduleTrainingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestRescheduleTrainingRequestWithBody(c.Server, trainingUUID, params, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTrainingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) RequestRescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, params *RequestRescheduleTrainingParams, body RequestRescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestRescheduleTrainingRequest(c.Server, trainingUUID, params, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type CancelTrainingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+func (c *Client) RescheduleTrainingWithBody(ctx context.Context, trainingUUID openapi_types.UUID, params *RescheduleTrainingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRescheduleTrainingRequestWithBody(c.Server, trainingUUID, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r CancelTrainingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) RescheduleTraining(ctx context.Context, trainingUUID openapi_types.UUID, params *RescheduleTrainingParams, body RescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRescheduleTrainingRequest(c.Server, trainingUUID, params, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CancelTrainingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetTrainingsRequest generates requests for GetTrainings
+func NewGetTrainingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ApproveRescheduleTrainingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+	operationPath := fmt.Sprintf("/trainings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ApproveRescheduleTrainingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApproveRescheduleTrainingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type RejectRescheduleTrainingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r RejectRescheduleTrainingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCreateTrainingRequest calls the generic CreateTraining builder with application/json body
+func NewCreateTrainingRequest(server string, body CreateTrainingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTrainingRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RejectRescheduleTrainingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCreateTrainingRequestWithBody generates requests for CreateTraining with any type of body
+func NewCreateTrainingRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateBulkCancellationRequest calls the generic CreateBulkCancellation builder with application/json body
+func NewCreateBulkCancellationRequest(server string, body CreateBulkCancellationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBulkCancellationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateBulkCancellationRequestWithBody generates requests for CreateBulkCancellation with any type of body
+func NewCreateBulkCancellationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/bulk-cancellations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBulkCancellationRequest generates requests for GetBulkCancellation
+func NewGetBulkCancellationRequest(server string, bulkCancellationUUID openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bulkCancellationUUID", runtime.ParamLocationPath, bulkCancellationUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/bulk-cancellations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewResumeBulkCancellationRequest generates requests for ResumeBulkCancellation
+func NewResumeBulkCancellationRequest(server string, bulkCancellationUUID openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bulkCancellationUUID", runtime.ParamLocationPath, bulkCancellationUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/bulk-cancellations/%s/resume", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetClientRequest generates requests for GetClient
+func NewGetClientRequest(server string, clientUUID openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clientUUID", runtime.ParamLocationPath, clientUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/clients/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportTrainingHistoryRequest generates requests for ExportTrainingHistory
+func NewExportTrainingHistoryRequest(server string, params *ExportTrainingHistoryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Timezone != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "timezone", runtime.ParamLocationQuery, *params.Timezone); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTrainerRatingRequest generates requests for GetTrainerRating
+func NewGetTrainerRatingRequest(server string, params *GetTrainerRatingParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/feedback")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCancellationReportRequest generates requests for GetCancellationReport
+func NewGetCancellationReportRequest(server string, params *GetCancellationReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/reports/cancellations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dateFrom", runtime.ParamLocationQuery, params.DateFrom); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dateTo", runtime.ParamLocationQuery, params.DateTo); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, params.Period); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUtilizationReportRequest generates requests for GetUtilizationReport
+func NewGetUtilizationReportRequest(server string, params *GetUtilizationReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/reports/utilization")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dateFrom", runtime.ParamLocationQuery, params.DateFrom); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dateTo", runtime.ParamLocationQuery, params.DateTo); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, params.Period); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTrainingSeriesRequest calls the generic CreateTrainingSeries builder with application/json body
+func NewCreateTrainingSeriesRequest(server string, body CreateTrainingSeriesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTrainingSeriesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTrainingSeriesRequestWithBody generates requests for CreateTrainingSeries with any type of body
+func NewCreateTrainingSeriesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/series")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelTrainingSeriesRequest generates requests for CancelTrainingSeries
+func NewCancelTrainingSeriesRequest(server string, seriesUUID openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "seriesUUID", runtime.ParamLocationPath, seriesUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/series/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTrainingSeriesRequest generates requests for GetTrainingSeries
+func NewGetTrainingSeriesRequest(server string, seriesUUID openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "seriesUUID", runtime.ParamLocationPath, seriesUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/series/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSessionTypesRequest generates requests for GetSessionTypes
+func NewGetSessionTypesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/session-types")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSessionTypeRequest calls the generic CreateSessionType builder with application/json body
+func NewCreateSessionTypeRequest(server string, body CreateSessionTypeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSessionTypeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSessionTypeRequestWithBody generates requests for CreateSessionType with any type of body
+func NewCreateSessionTypeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/session-types")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateSessionTypeRequest calls the generic UpdateSessionType builder with application/json body
+func NewUpdateSessionTypeRequest(server string, code string, body UpdateSessionTypeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSessionTypeRequestWithBody(server, code, "application/json", bodyReader)
+}
+
+// NewUpdateSessionTypeRequestWithBody generates requests for UpdateSessionType with any type of body
+func NewUpdateSessionTypeRequestWithBody(server string, code string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/session-types/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewExportUserDataRequest generates requests for ExportUserData
+func NewExportUserDataRequest(server string, userUUID openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/users/%s/data-export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEraseUserDataRequest generates requests for EraseUserData
+func NewEraseUserDataRequest(server string, userUUID openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/users/%s/erasure", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWaitlistRequest generates requests for GetWaitlist
+func NewGetWaitlistRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/waitlist")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewJoinWaitlistRequest calls the generic JoinWaitlist builder with application/json body
+func NewJoinWaitlistRequest(server string, body JoinWaitlistJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewJoinWaitlistRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewJoinWaitlistRequestWithBody generates requests for JoinWaitlist with any type of body
+func NewJoinWaitlistRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/waitlist")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLeaveWaitlistRequest generates requests for LeaveWaitlist
+func NewLeaveWaitlistRequest(server string, entryUUID openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "entryUUID", runtime.ParamLocationPath, entryUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/waitlist/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewClaimWaitlistOfferRequest calls the generic ClaimWaitlistOffer builder with application/json body
+func NewClaimWaitlistOfferRequest(server string, entryUUID openapi_types.UUID, body ClaimWaitlistOfferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewClaimWaitlistOfferRequestWithBody(server, entryUUID, "application/json", bodyReader)
+}
+
+// NewClaimWaitlistOfferRequestWithBody generates requests for ClaimWaitlistOffer with any type of body
+func NewClaimWaitlistOfferRequestWithBody(server string, entryUUID openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "entryUUID", runtime.ParamLocationPath, entryUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/waitlist/%s/claim", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelTrainingRequest generates requests for CancelTraining
+func NewCancelTrainingRequest(server string, trainingUUID openapi_types.UUID, params *CancelTrainingParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTrainingRequest generates requests for GetTraining
+func NewGetTrainingRequest(server string, trainingUUID openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApproveRescheduleTrainingRequest generates requests for ApproveRescheduleTraining
+func NewApproveRescheduleTrainingRequest(server string, trainingUUID openapi_types.UUID, params *ApproveRescheduleTrainingParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/%s/approve-reschedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewRecordTrainingAttendanceRequest calls the generic RecordTrainingAttendance builder with application/json body
+func NewRecordTrainingAttendanceRequest(server string, trainingUUID openapi_types.UUID, body RecordTrainingAttendanceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRecordTrainingAttendanceRequestWithBody(server, trainingUUID, "application/json", bodyReader)
+}
+
+// NewRecordTrainingAttendanceRequestWithBody generates requests for RecordTrainingAttendance with any type of body
+func NewRecordTrainingAttendanceRequestWithBody(server string, trainingUUID openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/%s/attendance", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRateTrainingRequest calls the generic RateTraining builder with application/json body
+func NewRateTrainingRequest(server string, trainingUUID openapi_types.UUID, body RateTrainingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRateTrainingRequestWithBody(server, trainingUUID, "application/json", bodyReader)
+}
+
+// NewRateTrainingRequestWithBody generates requests for RateTraining with any type of body
+func NewRateTrainingRequestWithBody(server string, trainingUUID openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/%s/feedback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReplyToTrainingFeedbackRequest calls the generic ReplyToTrainingFeedback builder with application/json body
+func NewReplyToTrainingFeedbackRequest(server string, trainingUUID openapi_types.UUID, body ReplyToTrainingFeedbackJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReplyToTrainingFeedbackRequestWithBody(server, trainingUUID, "application/json", bodyReader)
+}
+
+// NewReplyToTrainingFeedbackRequestWithBody generates requests for ReplyToTrainingFeedback with any type of body
+func NewReplyToTrainingFeedbackRequestWithBody(server string, trainingUUID openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/%s/feedback/reply", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateTrainingNotesRequest calls the generic UpdateTrainingNotes builder with application/json body
+func NewUpdateTrainingNotesRequest(server string, trainingUUID openapi_types.UUID, body UpdateTrainingNotesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTrainingNotesRequestWithBody(server, trainingUUID, "application/json", bodyReader)
+}
+
+// NewUpdateTrainingNotesRequestWithBody generates requests for UpdateTrainingNotes with any type of body
+func NewUpdateTrainingNotesRequestWithBody(server string, trainingUUID openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/%s/notes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTrainingNotesHistoryRequest generates requests for GetTrainingNotesHistory
+func NewGetTrainingNotesHistoryRequest(server string, trainingUUID openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/%s/notes/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRejectRescheduleTrainingRequest generates requests for RejectRescheduleTraining
+func NewRejectRescheduleTrainingRequest(server string, trainingUUID openapi_types.UUID, params *RejectRescheduleTrainingParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/%s/reject-reschedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewRequestRescheduleTrainingRequest calls the generic RequestRescheduleTraining builder with application/json body
+func NewRequestRescheduleTrainingRequest(server string, trainingUUID openapi_types.UUID, params *RequestRescheduleTrainingParams, body RequestRescheduleTrainingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRequestRescheduleTrainingRequestWithBody(server, trainingUUID, params, "application/json", bodyReader)
+}
+
+// NewRequestRescheduleTrainingRequestWithBody generates requests for RequestRescheduleTraining with any type of body
+func NewRequestRescheduleTrainingRequestWithBody(server string, trainingUUID openapi_types.UUID, params *RequestRescheduleTrainingParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/%s/request-reschedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewRescheduleTrainingRequest calls the generic RescheduleTraining builder with application/json body
+func NewRescheduleTrainingRequest(server string, trainingUUID openapi_types.UUID, params *RescheduleTrainingParams, body RescheduleTrainingJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRescheduleTrainingRequestWithBody(server, trainingUUID, params, "application/json", bodyReader)
+}
+
+// NewRescheduleTrainingRequestWithBody generates requests for RescheduleTraining with any type of body
+func NewRescheduleTrainingRequestWithBody(server string, trainingUUID openapi_types.UUID, params *RescheduleTrainingParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, trainingUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trainings/%s/reschedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetTrainingsWithResponse request
+	GetTrainingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTrainingsResponse, error)
+
+	// CreateTrainingWithBodyWithResponse request with any body
+	CreateTrainingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTrainingResponse, error)
+
+	CreateTrainingWithResponse(ctx context.Context, body CreateTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTrainingResponse, error)
+
+	// CreateBulkCancellationWithBodyWithResponse request with any body
+	CreateBulkCancellationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBulkCancellationResponse, error)
+
+	CreateBulkCancellationWithResponse(ctx context.Context, body CreateBulkCancellationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBulkCancellationResponse, error)
+
+	// GetBulkCancellationWithResponse request
+	GetBulkCancellationWithResponse(ctx context.Context, bulkCancellationUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBulkCancellationResponse, error)
+
+	// ResumeBulkCancellationWithResponse request
+	ResumeBulkCancellationWithResponse(ctx context.Context, bulkCancellationUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*ResumeBulkCancellationResponse, error)
+
+	// GetClientWithResponse request
+	GetClientWithResponse(ctx context.Context, clientUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetClientResponse, error)
+
+	// ExportTrainingHistoryWithResponse request
+	ExportTrainingHistoryWithResponse(ctx context.Context, params *ExportTrainingHistoryParams, reqEditors ...RequestEditorFn) (*ExportTrainingHistoryResponse, error)
+
+	// GetTrainerRatingWithResponse request
+	GetTrainerRatingWithResponse(ctx context.Context, params *GetTrainerRatingParams, reqEditors ...RequestEditorFn) (*GetTrainerRatingResponse, error)
+
+	// GetCancellationReportWithResponse request
+	GetCancellationReportWithResponse(ctx context.Context, params *GetCancellationReportParams, reqEditors ...RequestEditorFn) (*GetCancellationReportResponse, error)
+
+	// GetUtilizationReportWithResponse request
+	GetUtilizationReportWithResponse(ctx context.Context, params *GetUtilizationReportParams, reqEditors ...RequestEditorFn) (*GetUtilizationReportResponse, error)
+
+	// CreateTrainingSeriesWithBodyWithResponse request with any body
+	CreateTrainingSeriesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTrainingSeriesResponse, error)
+
+	CreateTrainingSeriesWithResponse(ctx context.Context, body CreateTrainingSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTrainingSeriesResponse, error)
+
+	// CancelTrainingSeriesWithResponse request
+	CancelTrainingSeriesWithResponse(ctx context.Context, seriesUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelTrainingSeriesResponse, error)
+
+	// GetTrainingSeriesWithResponse request
+	GetTrainingSeriesWithResponse(ctx context.Context, seriesUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTrainingSeriesResponse, error)
+
+	// GetSessionTypesWithResponse request
+	GetSessionTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSessionTypesResponse, error)
+
+	// CreateSessionTypeWithBodyWithResponse request with any body
+	CreateSessionTypeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionTypeResponse, error)
+
+	CreateSessionTypeWithResponse(ctx context.Context, body CreateSessionTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionTypeResponse, error)
+
+	// UpdateSessionTypeWithBodyWithResponse request with any body
+	UpdateSessionTypeWithBodyWithResponse(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSessionTypeResponse, error)
+
+	UpdateSessionTypeWithResponse(ctx context.Context, code string, body UpdateSessionTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSessionTypeResponse, error)
+
+	// ExportUserDataWithResponse request
+	ExportUserDataWithResponse(ctx context.Context, userUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*ExportUserDataResponse, error)
+
+	// EraseUserDataWithResponse request
+	EraseUserDataWithResponse(ctx context.Context, userUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*EraseUserDataResponse, error)
+
+	// GetWaitlistWithResponse request
+	GetWaitlistWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWaitlistResponse, error)
+
+	// JoinWaitlistWithBodyWithResponse request with any body
+	JoinWaitlistWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*JoinWaitlistResponse, error)
+
+	JoinWaitlistWithResponse(ctx context.Context, body JoinWaitlistJSONRequestBody, reqEditors ...RequestEditorFn) (*JoinWaitlistResponse, error)
+
+	// LeaveWaitlistWithResponse request
+	LeaveWaitlistWithResponse(ctx context.Context, entryUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*LeaveWaitlistResponse, error)
+
+	// ClaimWaitlistOfferWithBodyWithResponse request with any body
+	ClaimWaitlistOfferWithBodyWithResponse(ctx context.Context, entryUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClaimWaitlistOfferResponse, error)
+
+	ClaimWaitlistOfferWithResponse(ctx context.Context, entryUUID openapi_types.UUID, body ClaimWaitlistOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*ClaimWaitlistOfferResponse, error)
+
+	// CancelTrainingWithResponse request
+	CancelTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, params *CancelTrainingParams, reqEditors ...RequestEditorFn) (*CancelTrainingResponse, error)
+
+	// GetTrainingWithResponse request
+	GetTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTrainingResponse, error)
+
+	// ApproveRescheduleTrainingWithResponse request
+	ApproveRescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, params *ApproveRescheduleTrainingParams, reqEditors ...RequestEditorFn) (*ApproveRescheduleTrainingResponse, error)
+
+	// RecordTrainingAttendanceWithBodyWithResponse request with any body
+	RecordTrainingAttendanceWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordTrainingAttendanceResponse, error)
+
+	RecordTrainingAttendanceWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, body RecordTrainingAttendanceJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordTrainingAttendanceResponse, error)
+
+	// RateTrainingWithBodyWithResponse request with any body
+	RateTrainingWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RateTrainingResponse, error)
+
+	RateTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, body RateTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*RateTrainingResponse, error)
+
+	// ReplyToTrainingFeedbackWithBodyWithResponse request with any body
+	ReplyToTrainingFeedbackWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplyToTrainingFeedbackResponse, error)
+
+	ReplyToTrainingFeedbackWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, body ReplyToTrainingFeedbackJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplyToTrainingFeedbackResponse, error)
+
+	// UpdateTrainingNotesWithBodyWithResponse request with any body
+	UpdateTrainingNotesWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTrainingNotesResponse, error)
+
+	UpdateTrainingNotesWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, body UpdateTrainingNotesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTrainingNotesResponse, error)
+
+	// GetTrainingNotesHistoryWithResponse request
+	GetTrainingNotesHistoryWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTrainingNotesHistoryResponse, error)
+
+	// RejectRescheduleTrainingWithResponse request
+	RejectRescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, params *RejectRescheduleTrainingParams, reqEditors ...RequestEditorFn) (*RejectRescheduleTrainingResponse, error)
+
+	// RequestRescheduleTrainingWithBodyWithResponse request with any body
+	RequestRescheduleTrainingWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, params *RequestRescheduleTrainingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestRescheduleTrainingResponse, error)
+
+	RequestRescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, params *RequestRescheduleTrainingParams, body RequestRescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestRescheduleTrainingResponse, error)
+
+	// RescheduleTrainingWithBodyWithResponse request with any body
+	RescheduleTrainingWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, params *RescheduleTrainingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RescheduleTrainingResponse, error)
+
+	RescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, params *RescheduleTrainingParams, body RescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*RescheduleTrainingResponse, error)
+}
+
+type GetTrainingsResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Trainings
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrainingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrainingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTrainingResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTrainingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTrainingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBulkCancellationResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *BulkCancellation
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBulkCancellationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBulkCancellationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBulkCancellationResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *BulkCancellation
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBulkCancellationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBulkCancellationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResumeBulkCancellationResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *BulkCancellation
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ResumeBulkCancellationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResumeBulkCancellationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClientResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *ClientDetails
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportTrainingHistoryResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TrainingHistory
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportTrainingHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportTrainingHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTrainerRatingResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TrainerRating
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrainerRatingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrainerRatingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCancellationReportResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *CancellationReport
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCancellationReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCancellationReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUtilizationReportResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *UtilizationReport
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUtilizationReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUtilizationReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTrainingSeriesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON201                       *TrainingSeries
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTrainingSeriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTrainingSeriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelTrainingSeriesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelTrainingSeriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelTrainingSeriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTrainingSeriesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TrainingSeries
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrainingSeriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrainingSeriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSessionTypesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *SessionTypes
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSessionTypesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSessionTypesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSessionTypeResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSessionTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSessionTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSessionTypeResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSessionTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSessionTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportUserDataResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *UserDataArchive
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportUserDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportUserDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EraseUserDataResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r EraseUserDataResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EraseUserDataResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWaitlistResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Waitlist
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWaitlistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWaitlistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type JoinWaitlistResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r JoinWaitlistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r JoinWaitlistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LeaveWaitlistResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r LeaveWaitlistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LeaveWaitlistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ClaimWaitlistOfferResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ClaimWaitlistOfferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ClaimWaitlistOfferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelTrainingResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON412     *Error
+	ApplicationproblemJSON428     *Error
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelTrainingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelTrainingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTrainingResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *Training
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrainingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrainingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveRescheduleTrainingResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON412     *Error
+	ApplicationproblemJSON428     *Error
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveRescheduleTrainingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveRescheduleTrainingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RecordTrainingAttendanceResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RecordTrainingAttendanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RecordTrainingAttendanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RateTrainingResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RateTrainingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RateTrainingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReplyToTrainingFeedbackResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ReplyToTrainingFeedbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReplyToTrainingFeedbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTrainingNotesResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTrainingNotesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTrainingNotesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTrainingNotesHistoryResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	JSON200                       *TrainingNotesHistory
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrainingNotesHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrainingNotesHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RejectRescheduleTrainingResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON412     *Error
+	ApplicationproblemJSON428     *Error
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RejectRescheduleTrainingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RejectRescheduleTrainingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestRescheduleTrainingResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON412     *Error
+	ApplicationproblemJSON428     *Error
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestRescheduleTrainingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestRescheduleTrainingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RescheduleTrainingResponse struct {
+	Body                          []byte
+	HTTPResponse                  *http.Response
+	ApplicationproblemJSON412     *Error
+	ApplicationproblemJSON428     *Error
+	ApplicationproblemJSONDefault *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RescheduleTrainingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RescheduleTrainingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetTrainingsWithResponse request returning *GetTrainingsResponse
+func (c *ClientWithResponses) GetTrainingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTrainingsResponse, error) {
+	rsp, err := c.GetTrainings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrainingsResponse(rsp)
+}
+
+// CreateTrainingWithBodyWithResponse request with arbitrary body returning *CreateTrainingResponse
+func (c *ClientWithResponses) CreateTrainingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTrainingResponse, error) {
+	rsp, err := c.CreateTrainingWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTrainingResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTrainingWithResponse(ctx context.Context, body CreateTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTrainingResponse, error) {
+	rsp, err := c.CreateTraining(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTrainingResponse(rsp)
+}
+
+// CreateBulkCancellationWithBodyWithResponse request with arbitrary body returning *CreateBulkCancellationResponse
+func (c *ClientWithResponses) CreateBulkCancellationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBulkCancellationResponse, error) {
+	rsp, err := c.CreateBulkCancellationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBulkCancellationResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBulkCancellationWithResponse(ctx context.Context, body CreateBulkCancellationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBulkCancellationResponse, error) {
+	rsp, err := c.CreateBulkCancellation(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBulkCancellationResponse(rsp)
+}
+
+// GetBulkCancellationWithResponse request returning *GetBulkCancellationResponse
+func (c *ClientWithResponses) GetBulkCancellationWithResponse(ctx context.Context, bulkCancellationUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBulkCancellationResponse, error) {
+	rsp, err := c.GetBulkCancellation(ctx, bulkCancellationUUID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBulkCancellationResponse(rsp)
+}
+
+// ResumeBulkCancellationWithResponse request returning *ResumeBulkCancellationResponse
+func (c *ClientWithResponses) ResumeBulkCancellationWithResponse(ctx context.Context, bulkCancellationUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*ResumeBulkCancellationResponse, error) {
+	rsp, err := c.ResumeBulkCancellation(ctx, bulkCancellationUUID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResumeBulkCancellationResponse(rsp)
+}
+
+// GetClientWithResponse request returning *GetClientResponse
+func (c *ClientWithResponses) GetClientWithResponse(ctx context.Context, clientUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetClientResponse, error) {
+	rsp, err := c.GetClient(ctx, clientUUID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClientResponse(rsp)
+}
+
+// ExportTrainingHistoryWithResponse request returning *ExportTrainingHistoryResponse
+func (c *ClientWithResponses) ExportTrainingHistoryWithResponse(ctx context.Context, params *ExportTrainingHistoryParams, reqEditors ...RequestEditorFn) (*ExportTrainingHistoryResponse, error) {
+	rsp, err := c.ExportTrainingHistory(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportTrainingHistoryResponse(rsp)
+}
+
+// GetTrainerRatingWithResponse request returning *GetTrainerRatingResponse
+func (c *ClientWithResponses) GetTrainerRatingWithResponse(ctx context.Context, params *GetTrainerRatingParams, reqEditors ...RequestEditorFn) (*GetTrainerRatingResponse, error) {
+	rsp, err := c.GetTrainerRating(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrainerRatingResponse(rsp)
+}
+
+// GetCancellationReportWithResponse request returning *GetCancellationReportResponse
+func (c *ClientWithResponses) GetCancellationReportWithResponse(ctx context.Context, params *GetCancellationReportParams, reqEditors ...RequestEditorFn) (*GetCancellationReportResponse, error) {
+	rsp, err := c.GetCancellationReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCancellationReportResponse(rsp)
+}
+
+// GetUtilizationReportWithResponse request returning *GetUtilizationReportResponse
+func (c *ClientWithResponses) GetUtilizationReportWithResponse(ctx context.Context, params *GetUtilizationReportParams, reqEditors ...RequestEditorFn) (*GetUtilizationReportResponse, error) {
+	rsp, err := c.GetUtilizationReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUtilizationReportResponse(rsp)
+}
+
+// CreateTrainingSeriesWithBodyWithResponse request with arbitrary body returning *CreateTrainingSeriesResponse
+func (c *ClientWithResponses) CreateTrainingSeriesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTrainingSeriesResponse, error) {
+	rsp, err := c.CreateTrainingSeriesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTrainingSeriesResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTrainingSeriesWithResponse(ctx context.Context, body CreateTrainingSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTrainingSeriesResponse, error) {
+	rsp, err := c.CreateTrainingSeries(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTrainingSeriesResponse(rsp)
+}
+
+// CancelTrainingSeriesWithResponse request returning *CancelTrainingSeriesResponse
+func (c *ClientWithResponses) CancelTrainingSeriesWithResponse(ctx context.Context, seriesUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelTrainingSeriesResponse, error) {
+	rsp, err := c.CancelTrainingSeries(ctx, seriesUUID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelTrainingSeriesResponse(rsp)
+}
+
+// GetTrainingSeriesWithResponse request returning *GetTrainingSeriesResponse
+func (c *ClientWithResponses) GetTrainingSeriesWithResponse(ctx context.Context, seriesUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTrainingSeriesResponse, error) {
+	rsp, err := c.GetTrainingSeries(ctx, seriesUUID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrainingSeriesResponse(rsp)
+}
+
+// GetSessionTypesWithResponse request returning *GetSessionTypesResponse
+func (c *ClientWithResponses) GetSessionTypesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSessionTypesResponse, error) {
+	rsp, err := c.GetSessionTypes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSessionTypesResponse(rsp)
+}
+
+// CreateSessionTypeWithBodyWithResponse request with arbitrary body returning *CreateSessionTypeResponse
+func (c *ClientWithResponses) CreateSessionTypeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSessionTypeResponse, error) {
+	rsp, err := c.CreateSessionTypeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSessionTypeResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSessionTypeWithResponse(ctx context.Context, body CreateSessionTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSessionTypeResponse, error) {
+	rsp, err := c.CreateSessionType(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSessionTypeResponse(rsp)
+}
+
+// UpdateSessionTypeWithBodyWithResponse request with arbitrary body returning *UpdateSessionTypeResponse
+func (c *ClientWithResponses) UpdateSessionTypeWithBodyWithResponse(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSessionTypeResponse, error) {
+	rsp, err := c.UpdateSessionTypeWithBody(ctx, code, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSessionTypeResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSessionTypeWithResponse(ctx context.Context, code string, body UpdateSessionTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSessionTypeResponse, error) {
+	rsp, err := c.UpdateSessionType(ctx, code, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSessionTypeResponse(rsp)
+}
+
+// ExportUserDataWithResponse request returning *ExportUserDataResponse
+func (c *ClientWithResponses) ExportUserDataWithResponse(ctx context.Context, userUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*ExportUserDataResponse, error) {
+	rsp, err := c.ExportUserData(ctx, userUUID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportUserDataResponse(rsp)
+}
+
+// EraseUserDataWithResponse request returning *EraseUserDataResponse
+func (c *ClientWithResponses) EraseUserDataWithResponse(ctx context.Context, userUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*EraseUserDataResponse, error) {
+	rsp, err := c.EraseUserData(ctx, userUUID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEraseUserDataResponse(rsp)
+}
+
+// GetWaitlistWithResponse request returning *GetWaitlistResponse
+func (c *ClientWithResponses) GetWaitlistWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWaitlistResponse, error) {
+	rsp, err := c.GetWaitlist(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWaitlistResponse(rsp)
+}
+
+// JoinWaitlistWithBodyWithResponse request with arbitrary body returning *JoinWaitlistResponse
+func (c *ClientWithResponses) JoinWaitlistWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*JoinWaitlistResponse, error) {
+	rsp, err := c.JoinWaitlistWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJoinWaitlistResponse(rsp)
+}
+
+func (c *ClientWithResponses) JoinWaitlistWithResponse(ctx context.Context, body JoinWaitlistJSONRequestBody, reqEditors ...RequestEditorFn) (*JoinWaitlistResponse, error) {
+	rsp, err := c.JoinWaitlist(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJoinWaitlistResponse(rsp)
+}
+
+// LeaveWaitlistWithResponse request returning *LeaveWaitlistResponse
+func (c *ClientWithResponses) LeaveWaitlistWithResponse(ctx context.Context, entryUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*LeaveWaitlistResponse, error) {
+	rsp, err := c.LeaveWaitlist(ctx, entryUUID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLeaveWaitlistResponse(rsp)
+}
+
+// ClaimWaitlistOfferWithBodyWithResponse request with arbitrary body returning *ClaimWaitlistOfferResponse
+func (c *ClientWithResponses) ClaimWaitlistOfferWithBodyWithResponse(ctx context.Context, entryUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ClaimWaitlistOfferResponse, error) {
+	rsp, err := c.ClaimWaitlistOfferWithBody(ctx, entryUUID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClaimWaitlistOfferResponse(rsp)
+}
+
+func (c *ClientWithResponses) ClaimWaitlistOfferWithResponse(ctx context.Context, entryUUID openapi_types.UUID, body ClaimWaitlistOfferJSONRequestBody, reqEditors ...RequestEditorFn) (*ClaimWaitlistOfferResponse, error) {
+	rsp, err := c.ClaimWaitlistOffer(ctx, entryUUID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClaimWaitlistOfferResponse(rsp)
+}
+
+// CancelTrainingWithResponse request returning *CancelTrainingResponse
+func (c *ClientWithResponses) CancelTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, params *CancelTrainingParams, reqEditors ...RequestEditorFn) (*CancelTrainingResponse, error) {
+	rsp, err := c.CancelTraining(ctx, trainingUUID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelTrainingResponse(rsp)
+}
+
+// GetTrainingWithResponse request returning *GetTrainingResponse
+func (c *ClientWithResponses) GetTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTrainingResponse, error) {
+	rsp, err := c.GetTraining(ctx, trainingUUID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrainingResponse(rsp)
+}
+
+// ApproveRescheduleTrainingWithResponse request returning *ApproveRescheduleTrainingResponse
+func (c *ClientWithResponses) ApproveRescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, params *ApproveRescheduleTrainingParams, reqEditors ...RequestEditorFn) (*ApproveRescheduleTrainingResponse, error) {
+	rsp, err := c.ApproveRescheduleTraining(ctx, trainingUUID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveRescheduleTrainingResponse(rsp)
+}
+
+// RecordTrainingAttendanceWithBodyWithResponse request with arbitrary body returning *RecordTrainingAttendanceResponse
+func (c *ClientWithResponses) RecordTrainingAttendanceWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordTrainingAttendanceResponse, error) {
+	rsp, err := c.RecordTrainingAttendanceWithBody(ctx, trainingUUID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecordTrainingAttendanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) RecordTrainingAttendanceWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, body RecordTrainingAttendanceJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordTrainingAttendanceResponse, error) {
+	rsp, err := c.RecordTrainingAttendance(ctx, trainingUUID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecordTrainingAttendanceResponse(rsp)
+}
+
+// RateTrainingWithBodyWithResponse request with arbitrary body returning *RateTrainingResponse
+func (c *ClientWithResponses) RateTrainingWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RateTrainingResponse, error) {
+	rsp, err := c.RateTrainingWithBody(ctx, trainingUUID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRateTrainingResponse(rsp)
+}
+
+func (c *ClientWithResponses) RateTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, body RateTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*RateTrainingResponse, error) {
+	rsp, err := c.RateTraining(ctx, trainingUUID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRateTrainingResponse(rsp)
+}
+
+// ReplyToTrainingFeedbackWithBodyWithResponse request with arbitrary body returning *ReplyToTrainingFeedbackResponse
+func (c *ClientWithResponses) ReplyToTrainingFeedbackWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReplyToTrainingFeedbackResponse, error) {
+	rsp, err := c.ReplyToTrainingFeedbackWithBody(ctx, trainingUUID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplyToTrainingFeedbackResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReplyToTrainingFeedbackWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, body ReplyToTrainingFeedbackJSONRequestBody, reqEditors ...RequestEditorFn) (*ReplyToTrainingFeedbackResponse, error) {
+	rsp, err := c.ReplyToTrainingFeedback(ctx, trainingUUID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReplyToTrainingFeedbackResponse(rsp)
+}
+
+// UpdateTrainingNotesWithBodyWithResponse request with arbitrary body returning *UpdateTrainingNotesResponse
+func (c *ClientWithResponses) UpdateTrainingNotesWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTrainingNotesResponse, error) {
+	rsp, err := c.UpdateTrainingNotesWithBody(ctx, trainingUUID, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTrainingNotesResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTrainingNotesWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, body UpdateTrainingNotesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTrainingNotesResponse, error) {
+	rsp, err := c.UpdateTrainingNotes(ctx, trainingUUID, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTrainingNotesResponse(rsp)
+}
+
+// GetTrainingNotesHistoryWithResponse request returning *GetTrainingNotesHistoryResponse
+func (c *ClientWithResponses) GetTrainingNotesHistoryWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTrainingNotesHistoryResponse, error) {
+	rsp, err := c.GetTrainingNotesHistory(ctx, trainingUUID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrainingNotesHistoryResponse(rsp)
+}
+
+// RejectRescheduleTrainingWithResponse request returning *RejectRescheduleTrainingResponse
+func (c *ClientWithResponses) RejectRescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, params *RejectRescheduleTrainingParams, reqEditors ...RequestEditorFn) (*RejectRescheduleTrainingResponse, error) {
+	rsp, err := c.RejectRescheduleTraining(ctx, trainingUUID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRejectRescheduleTrainingResponse(rsp)
+}
+
+// RequestRescheduleTrainingWithBodyWithResponse request with arbitrary body returning *RequestRescheduleTrainingResponse
+func (c *ClientWithResponses) RequestRescheduleTrainingWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, params *RequestRescheduleTrainingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestRescheduleTrainingResponse, error) {
+	rsp, err := c.RequestRescheduleTrainingWithBody(ctx, trainingUUID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestRescheduleTrainingResponse(rsp)
+}
+
+func (c *ClientWithResponses) RequestRescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, params *RequestRescheduleTrainingParams, body RequestRescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestRescheduleTrainingResponse, error) {
+	rsp, err := c.RequestRescheduleTraining(ctx, trainingUUID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestRescheduleTrainingResponse(rsp)
+}
+
+// RescheduleTrainingWithBodyWithResponse request with arbitrary body returning *RescheduleTrainingResponse
+func (c *ClientWithResponses) RescheduleTrainingWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, params *RescheduleTrainingParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RescheduleTrainingResponse, error) {
+	rsp, err := c.RescheduleTrainingWithBody(ctx, trainingUUID, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRescheduleTrainingResponse(rsp)
+}
+
+func (c *ClientWithResponses) RescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, params *RescheduleTrainingParams, body RescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*RescheduleTrainingResponse, error) {
+	rsp, err := c.RescheduleTraining(ctx, trainingUUID, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRescheduleTrainingResponse(rsp)
+}
+
+// ParseGetTrainingsResponse parses an HTTP response from a GetTrainingsWithResponse call
+func ParseGetTrainingsResponse(rsp *http.Response) (*GetTrainingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTrainingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Trainings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTrainingResponse parses an HTTP response from a CreateTrainingWithResponse call
+func ParseCreateTrainingResponse(rsp *http.Response) (*CreateTrainingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTrainingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBulkCancellationResponse parses an HTTP response from a CreateBulkCancellationWithResponse call
+func ParseCreateBulkCancellationResponse(rsp *http.Response) (*CreateBulkCancellationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBulkCancellationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BulkCancellation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBulkCancellationResponse parses an HTTP response from a GetBulkCancellationWithResponse call
+func ParseGetBulkCancellationResponse(rsp *http.Response) (*GetBulkCancellationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBulkCancellationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkCancellation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseResumeBulkCancellationResponse parses an HTTP response from a ResumeBulkCancellationWithResponse call
+func ParseResumeBulkCancellationResponse(rsp *http.Response) (*ResumeBulkCancellationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ResumeBulkCancellationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BulkCancellation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClientResponse parses an HTTP response from a GetClientWithResponse call
+func ParseGetClientResponse(rsp *http.Response) (*GetClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportTrainingHistoryResponse parses an HTTP response from a ExportTrainingHistoryWithResponse call
+func ParseExportTrainingHistoryResponse(rsp *http.Response) (*ExportTrainingHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportTrainingHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrainingHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTrainerRatingResponse parses an HTTP response from a GetTrainerRatingWithResponse call
+func ParseGetTrainerRatingResponse(rsp *http.Response) (*GetTrainerRatingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTrainerRatingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrainerRating
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCancellationReportResponse parses an HTTP response from a GetCancellationReportWithResponse call
+func ParseGetCancellationReportResponse(rsp *http.Response) (*GetCancellationReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCancellationReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CancellationReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUtilizationReportResponse parses an HTTP response from a GetUtilizationReportWithResponse call
+func ParseGetUtilizationReportResponse(rsp *http.Response) (*GetUtilizationReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUtilizationReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UtilizationReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTrainingSeriesResponse parses an HTTP response from a CreateTrainingSeriesWithResponse call
+func ParseCreateTrainingSeriesResponse(rsp *http.Response) (*CreateTrainingSeriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTrainingSeriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TrainingSeries
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelTrainingSeriesResponse parses an HTTP response from a CancelTrainingSeriesWithResponse call
+func ParseCancelTrainingSeriesResponse(rsp *http.Response) (*CancelTrainingSeriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelTrainingSeriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTrainingSeriesResponse parses an HTTP response from a GetTrainingSeriesWithResponse call
+func ParseGetTrainingSeriesResponse(rsp *http.Response) (*GetTrainingSeriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTrainingSeriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrainingSeries
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSessionTypesResponse parses an HTTP response from a GetSessionTypesWithResponse call
+func ParseGetSessionTypesResponse(rsp *http.Response) (*GetSessionTypesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSessionTypesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SessionTypes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSessionTypeResponse parses an HTTP response from a CreateSessionTypeWithResponse call
+func ParseCreateSessionTypeResponse(rsp *http.Response) (*CreateSessionTypeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSessionTypeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSessionTypeResponse parses an HTTP response from a UpdateSessionTypeWithResponse call
+func ParseUpdateSessionTypeResponse(rsp *http.Response) (*UpdateSessionTypeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSessionTypeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportUserDataResponse parses an HTTP response from a ExportUserDataWithResponse call
+func ParseExportUserDataResponse(rsp *http.Response) (*ExportUserDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportUserDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserDataArchive
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEraseUserDataResponse parses an HTTP response from a EraseUserDataWithResponse call
+func ParseEraseUserDataResponse(rsp *http.Response) (*EraseUserDataResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EraseUserDataResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWaitlistResponse parses an HTTP response from a GetWaitlistWithResponse call
+func ParseGetWaitlistResponse(rsp *http.Response) (*GetWaitlistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWaitlistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Waitlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return 0
-}
 
-type RequestRescheduleTrainingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r RequestRescheduleTrainingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseJoinWaitlistResponse parses an HTTP response from a JoinWaitlistWithResponse call
+func ParseJoinWaitlistResponse(rsp *http.Response) (*JoinWaitlistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RequestRescheduleTrainingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &JoinWaitlistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type RescheduleTrainingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSONDefault  *Error
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
 
-// Status returns HTTPResponse.Status
-func (r RescheduleTrainingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RescheduleTrainingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// GetTrainingsWithResponse request returning *GetTrainingsResponse
-func (c *ClientWithResponses) GetTrainingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTrainingsResponse, error) {
-	rsp, err := c.GetTrainings(ctx, reqEditors...)
+// ParseLeaveWaitlistResponse parses an HTTP response from a LeaveWaitlistWithResponse call
+func ParseLeaveWaitlistResponse(rsp *http.Response) (*LeaveWaitlistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTrainingsResponse(rsp)
-}
 
-// CreateTrainingWithBodyWithResponse request with arbitrary body returning *CreateTrainingResponse
-func (c *ClientWithResponses) CreateTrainingWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTrainingResponse, error) {
-	rsp, err := c.CreateTrainingWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &LeaveWaitlistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateTrainingResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateTrainingWithResponse(ctx context.Context, body CreateTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTrainingResponse, error) {
-	rsp, err := c.CreateTraining(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseCreateTrainingResponse(rsp)
+
+	return response, nil
 }
 
-// CancelTrainingWithResponse request returning *CancelTrainingResponse
-func (c *ClientWithResponses) CancelTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelTrainingResponse, error) {
-	rsp, err := c.CancelTraining(ctx, trainingUUID, reqEditors...)
+// ParseClaimWaitlistOfferResponse parses an HTTP response from a ClaimWaitlistOfferWithResponse call
+func ParseClaimWaitlistOfferResponse(rsp *http.Response) (*ClaimWaitlistOfferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCancelTrainingResponse(rsp)
-}
 
-// ApproveRescheduleTrainingWithResponse request returning *ApproveRescheduleTrainingResponse
-func (c *ClientWithResponses) ApproveRescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*ApproveRescheduleTrainingResponse, error) {
-	rsp, err := c.ApproveRescheduleTraining(ctx, trainingUUID, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ClaimWaitlistOfferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseApproveRescheduleTrainingResponse(rsp)
-}
 
-// RejectRescheduleTrainingWithResponse request returning *RejectRescheduleTrainingResponse
-func (c *ClientWithResponses) RejectRescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, reqEditors ...RequestEditorFn) (*RejectRescheduleTrainingResponse, error) {
-	rsp, err := c.RejectRescheduleTraining(ctx, trainingUUID, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
 	}
-	return ParseRejectRescheduleTrainingResponse(rsp)
+
+	return response, nil
 }
 
-// RequestRescheduleTrainingWithBodyWithResponse request with arbitrary body returning *RequestRescheduleTrainingResponse
-func (c *ClientWithResponses) RequestRescheduleTrainingWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RequestRescheduleTrainingResponse, error) {
-	rsp, err := c.RequestRescheduleTrainingWithBody(ctx, trainingUUID, contentType, body, reqEditors...)
+// ParseCancelTrainingResponse parses an HTTP response from a CancelTrainingWithResponse call
+func ParseCancelTrainingResponse(rsp *http.Response) (*CancelTrainingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRequestRescheduleTrainingResponse(rsp)
-}
 
-func (c *ClientWithResponses) RequestRescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, body RequestRescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*RequestRescheduleTrainingResponse, error) {
-	rsp, err := c.RequestRescheduleTraining(ctx, trainingUUID, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CancelTrainingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseRequestRescheduleTrainingResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON428 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// RescheduleTrainingWithBodyWithResponse request with arbitrary body returning *RescheduleTrainingResponse
-func (c *ClientWithResponses) RescheduleTrainingWithBodyWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RescheduleTrainingResponse, error) {
-	rsp, err := c.RescheduleTrainingWithBody(ctx, trainingUUID, contentType, body, reqEditors...)
+// ParseGetTrainingResponse parses an HTTP response from a GetTrainingWithResponse call
+func ParseGetTrainingResponse(rsp *http.Response) (*GetTrainingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRescheduleTrainingResponse(rsp)
+
+	response := &GetTrainingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Training
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) RescheduleTrainingWithResponse(ctx context.Context, trainingUUID openapi_types.UUID, body RescheduleTrainingJSONRequestBody, reqEditors ...RequestEditorFn) (*RescheduleTrainingResponse, error) {
-	rsp, err := c.RescheduleTraining(ctx, trainingUUID, body, reqEditors...)
+// ParseApproveRescheduleTrainingResponse parses an HTTP response from a ApproveRescheduleTrainingWithResponse call
+func ParseApproveRescheduleTrainingResponse(rsp *http.Response) (*ApproveRescheduleTrainingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRescheduleTrainingResponse(rsp)
+
+	response := &ApproveRescheduleTrainingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON428 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseGetTrainingsResponse parses an HTTP response from a GetTrainingsWithResponse call
-func ParseGetTrainingsResponse(rsp *http.Response) (*GetTrainingsResponse, error) {
+// ParseRecordTrainingAttendanceResponse parses an HTTP response from a RecordTrainingAttendanceWithResponse call
+func ParseRecordTrainingAttendanceResponse(rsp *http.Response) (*RecordTrainingAttendanceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTrainingsResponse{
+	response := &RecordTrainingAttendanceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Trainings
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.ApplicationproblemJSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRateTrainingResponse parses an HTTP response from a RateTrainingWithResponse call
+func ParseRateTrainingResponse(rsp *http.Response) (*RateTrainingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RateTrainingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
+	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSONDefault = &dest
+		response.ApplicationproblemJSONDefault = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCreateTrainingResponse parses an HTTP response from a CreateTrainingWithResponse call
-func ParseCreateTrainingResponse(rsp *http.Response) (*CreateTrainingResponse, error) {
+// ParseReplyToTrainingFeedbackResponse parses an HTTP response from a ReplyToTrainingFeedbackWithResponse call
+func ParseReplyToTrainingFeedbackResponse(rsp *http.Response) (*ReplyToTrainingFeedbackResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateTrainingResponse{
+	response := &ReplyToTrainingFeedbackResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -866,22 +4411,22 @@ func ParseCreateTrainingResponse(rsp *http.Response) (*CreateTrainingResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSONDefault = &dest
+		response.ApplicationproblemJSONDefault = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCancelTrainingResponse parses an HTTP response from a CancelTrainingWithResponse call
-func ParseCancelTrainingResponse(rsp *http.Response) (*CancelTrainingResponse, error) {
+// ParseUpdateTrainingNotesResponse parses an HTTP response from a UpdateTrainingNotesWithResponse call
+func ParseUpdateTrainingNotesResponse(rsp *http.Response) (*UpdateTrainingNotesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CancelTrainingResponse{
+	response := &UpdateTrainingNotesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -892,33 +4437,40 @@ func ParseCancelTrainingResponse(rsp *http.Response) (*CancelTrainingResponse, e
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSONDefault = &dest
+		response.ApplicationproblemJSONDefault = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseApproveRescheduleTrainingResponse parses an HTTP response from a ApproveRescheduleTrainingWithResponse call
-func ParseApproveRescheduleTrainingResponse(rsp *http.Response) (*ApproveRescheduleTrainingResponse, error) {
+// ParseGetTrainingNotesHistoryResponse parses an HTTP response from a GetTrainingNotesHistoryWithResponse call
+func ParseGetTrainingNotesHistoryResponse(rsp *http.Response) (*GetTrainingNotesHistoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ApproveRescheduleTrainingResponse{
+	response := &GetTrainingNotesHistoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrainingNotesHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSONDefault = &dest
+		response.ApplicationproblemJSONDefault = &dest
 
 	}
 
@@ -939,12 +4491,26 @@ func ParseRejectRescheduleTrainingResponse(rsp *http.Response) (*RejectReschedul
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON428 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSONDefault = &dest
+		response.ApplicationproblemJSONDefault = &dest
 
 	}
 
@@ -965,12 +4531,26 @@ func ParseRequestRescheduleTrainingResponse(rsp *http.Response) (*RequestResched
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON428 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSONDefault = &dest
+		response.ApplicationproblemJSONDefault = &dest
 
 	}
 
@@ -991,12 +4571,26 @@ func ParseRescheduleTrainingResponse(rsp *http.Response) (*RescheduleTrainingRes
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON428 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSONDefault = &dest
+		response.ApplicationproblemJSONDefault = &dest
 
 	}
 
@@ -13,39 +13,634 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
-// Error defines model for Error.
+// Defines values for BulkCanceledTrainingStatus.
+const (
+	BulkCanceledTrainingStatusAlreadyCanceled BulkCanceledTrainingStatus = "already-canceled"
+	BulkCanceledTrainingStatusCanceled        BulkCanceledTrainingStatus = "canceled"
+	BulkCanceledTrainingStatusPending         BulkCanceledTrainingStatus = "pending"
+)
+
+// Defines values for CancellationReportPeriodCanceledBy.
+const (
+	CancellationReportPeriodCanceledByAttendee CancellationReportPeriodCanceledBy = "attendee"
+	CancellationReportPeriodCanceledBySystem   CancellationReportPeriodCanceledBy = "system"
+	CancellationReportPeriodCanceledByTrainer  CancellationReportPeriodCanceledBy = "trainer"
+	CancellationReportPeriodCanceledByUnknown  CancellationReportPeriodCanceledBy = "unknown"
+)
+
+// Defines values for PostAttendanceAttendance.
+const (
+	PostAttendanceAttendanceAttended PostAttendanceAttendance = "attended"
+	PostAttendanceAttendanceNoShow   PostAttendanceAttendance = "no_show"
+)
+
+// Defines values for ReportFormat.
+const (
+	Csv  ReportFormat = "csv"
+	Json ReportFormat = "json"
+)
+
+// Defines values for ReportPeriod.
+const (
+	Month ReportPeriod = "month"
+	Week  ReportPeriod = "week"
+)
+
+// Defines values for TrainingAttendance.
+const (
+	TrainingAttendanceAttended  TrainingAttendance = "attended"
+	TrainingAttendanceCompleted TrainingAttendance = "completed"
+	TrainingAttendanceNoShow    TrainingAttendance = "no_show"
+)
+
+// Defines values for TrainingHistoryEntryAttendance.
+const (
+	TrainingHistoryEntryAttendanceAttended  TrainingHistoryEntryAttendance = "attended"
+	TrainingHistoryEntryAttendanceCompleted TrainingHistoryEntryAttendance = "completed"
+	TrainingHistoryEntryAttendanceNoShow    TrainingHistoryEntryAttendance = "no_show"
+)
+
+// Defines values for TrainingNotesRevisionEditedByRole.
+const (
+	TrainingNotesRevisionEditedByRoleAttendee TrainingNotesRevisionEditedByRole = "attendee"
+	TrainingNotesRevisionEditedByRoleTrainer  TrainingNotesRevisionEditedByRole = "trainer"
+)
+
+// Defines values for TrainingNotesRevisionKind.
+const (
+	Shared  TrainingNotesRevisionKind = "shared"
+	Trainer TrainingNotesRevisionKind = "trainer"
+)
+
+// Defines values for TrainingSeriesOccurrenceStatus.
+const (
+	TrainingSeriesOccurrenceStatusCanceled  TrainingSeriesOccurrenceStatus = "canceled"
+	TrainingSeriesOccurrenceStatusFailed    TrainingSeriesOccurrenceStatus = "failed"
+	TrainingSeriesOccurrenceStatusPending   TrainingSeriesOccurrenceStatus = "pending"
+	TrainingSeriesOccurrenceStatusScheduled TrainingSeriesOccurrenceStatus = "scheduled"
+	TrainingSeriesOccurrenceStatusSkipped   TrainingSeriesOccurrenceStatus = "skipped"
+)
+
+// Defines values for UserDataTrainingAttendance.
+const (
+	UserDataTrainingAttendanceAttended  UserDataTrainingAttendance = "attended"
+	UserDataTrainingAttendanceCompleted UserDataTrainingAttendance = "completed"
+	UserDataTrainingAttendanceNoShow    UserDataTrainingAttendance = "no_show"
+)
+
+// Defines values for UserDataWaitlistEntryStatus.
+const (
+	UserDataWaitlistEntryStatusBooked  UserDataWaitlistEntryStatus = "booked"
+	UserDataWaitlistEntryStatusExpired UserDataWaitlistEntryStatus = "expired"
+	UserDataWaitlistEntryStatusLeft    UserDataWaitlistEntryStatus = "left"
+	UserDataWaitlistEntryStatusOffered UserDataWaitlistEntryStatus = "offered"
+	UserDataWaitlistEntryStatusWaiting UserDataWaitlistEntryStatus = "waiting"
+)
+
+// Defines values for WaitlistEntryStatus.
+const (
+	WaitlistEntryStatusOffered WaitlistEntryStatus = "offered"
+	WaitlistEntryStatusWaiting WaitlistEntryStatus = "waiting"
+)
+
+// BulkCanceledTraining defines model for BulkCanceledTraining.
+type BulkCanceledTraining struct {
+	AttendeeUuid *openapi_types.UUID `json:"attendeeUuid,omitempty"`
+
+	// BalanceDelta Credits returned to the attendee
+	BalanceDelta    int                        `json:"balanceDelta"`
+	HourUnavailable bool                       `json:"hourUnavailable"`
+	Status          BulkCanceledTrainingStatus `json:"status"`
+	Time            *time.Time                 `json:"time,omitempty"`
+	TrainingUuid    openapi_types.UUID         `json:"trainingUuid"`
+}
+
+// BulkCanceledTrainingStatus defines model for BulkCanceledTraining.Status.
+type BulkCanceledTrainingStatus string
+
+// BulkCancellation defines model for BulkCancellation.
+type BulkCancellation struct {
+	// AlreadyCanceledCount Trainings canceled before the bulk cancellation got to them, for example by the attendee
+	AlreadyCanceledCount int       `json:"alreadyCanceledCount"`
+	CanceledCount        int       `json:"canceledCount"`
+	Completed            bool      `json:"completed"`
+	From                 time.Time `json:"from"`
+	PendingCount         int       `json:"pendingCount"`
+
+	// RefundedCredits Sum of credits returned to attendees of the canceled trainings
+	RefundedCredits int                    `json:"refundedCredits"`
+	To              time.Time              `json:"to"`
+	Trainings       []BulkCanceledTraining `json:"trainings"`
+	Uuid            openapi_types.UUID     `json:"uuid"`
+}
+
+// CancellationReport defines model for CancellationReport.
+type CancellationReport struct {
+	Periods []CancellationReportPeriod `json:"periods"`
+}
+
+// CancellationReportPeriod defines model for CancellationReportPeriod.
+type CancellationReportPeriod struct {
+	// AverageNoticeMinutes Negative when trainings are canceled after they started on average
+	AverageNoticeMinutes int `json:"averageNoticeMinutes"`
+
+	// CanceledBy unknown for trainings canceled before it was recorded
+	CanceledBy    CancellationReportPeriodCanceledBy `json:"canceledBy"`
+	Cancellations int                                `json:"cancellations"`
+
+	// LateCancellations Canceled with less than 24 hours notice
+	LateCancellations int       `json:"lateCancellations"`
+	PeriodStart       time.Time `json:"periodStart"`
+}
+
+// CancellationReportPeriodCanceledBy unknown for trainings canceled before it was recorded
+type CancellationReportPeriodCanceledBy string
+
+// CancellationTier defines model for CancellationTier.
+type CancellationTier struct {
+	AttendeeRefundPercent int `json:"attendeeRefundPercent"`
+	MinNoticeMinutes      int `json:"minNoticeMinutes"`
+	TrainerRefundPercent  int `json:"trainerRefundPercent"`
+}
+
+// ClientDetails defines model for ClientDetails.
+type ClientDetails struct {
+	Client ClientProfile `json:"client"`
+
+	// Trainings Not canceled trainings of the client, past and upcoming.
+	Trainings []Training `json:"trainings"`
+}
+
+// ClientProfile defines model for ClientProfile.
+type ClientProfile struct {
+	AvatarUrl *string `json:"avatarUrl,omitempty"`
+
+	// Balance Trainings balance of the client.
+	Balance     int                `json:"balance"`
+	Deactivated bool               `json:"deactivated"`
+	Email       *string            `json:"email,omitempty"`
+	Name        string             `json:"name"`
+	Uuid        openapi_types.UUID `json:"uuid"`
+}
+
+// Error Problem details (RFC 7807) extended with the error slug.
 type Error struct {
-	Message string `json:"message"`
-	Slug    string `json:"slug"`
+	Detail   *string                 `json:"detail,omitempty"`
+	Details  *map[string]interface{} `json:"details,omitempty"`
+	Instance *string                 `json:"instance,omitempty"`
+	Message  string                  `json:"message"`
+	Slug     string                  `json:"slug"`
+	Status   int                     `json:"status"`
+	Title    string                  `json:"title"`
+	Type     string                  `json:"type"`
+}
+
+// Feedback defines model for Feedback.
+type Feedback struct {
+	Comment      string             `json:"comment"`
+	CreatedAt    time.Time          `json:"createdAt"`
+	Rating       int                `json:"rating"`
+	RepliedAt    *time.Time         `json:"repliedAt,omitempty"`
+	Reply        *string            `json:"reply,omitempty"`
+	TrainingTime time.Time          `json:"trainingTime"`
+	TrainingUuid openapi_types.UUID `json:"trainingUuid"`
+	User         string             `json:"user"`
+	UserUuid     openapi_types.UUID `json:"userUuid"`
+}
+
+// PostAttendance defines model for PostAttendance.
+type PostAttendance struct {
+	Attendance PostAttendanceAttendance `json:"attendance"`
+}
+
+// PostAttendanceAttendance defines model for PostAttendance.Attendance.
+type PostAttendanceAttendance string
+
+// PostBulkCancellation defines model for PostBulkCancellation.
+type PostBulkCancellation struct {
+	From time.Time `json:"from"`
+
+	// To End of the range (exclusive), the range can't be longer than 31 days
+	To time.Time `json:"to"`
+}
+
+// PostFeedback defines model for PostFeedback.
+type PostFeedback struct {
+	Comment string `json:"comment"`
+	Rating  int    `json:"rating"`
+}
+
+// PostFeedbackReply defines model for PostFeedbackReply.
+type PostFeedbackReply struct {
+	Reply string `json:"reply"`
+}
+
+// PostSessionType defines model for PostSessionType.
+type PostSessionType struct {
+	// CancellationTiers Cancellation policy of the session type, one tier needs zero notice. The current cancellation policy applies when omitted. Booked trainings keep the tiers they were booked with
+	CancellationTiers *[]CancellationTier `json:"cancellationTiers,omitempty"`
+
+	// Code Lowercase letters, digits and dashes
+	Code            string  `json:"code"`
+	CreditPrice     int     `json:"creditPrice"`
+	Description     *string `json:"description,omitempty"`
+	DurationMinutes int     `json:"durationMinutes"`
+	Name            string  `json:"name"`
 }
 
 // PostTraining defines model for PostTraining.
 type PostTraining struct {
-	Notes string    `json:"notes"`
-	Time  time.Time `json:"time"`
+	Notes string `json:"notes"`
+
+	// SessionType Code of the booked session type, the default one when omitted. Ignored when rescheduling
+	SessionType *string   `json:"sessionType,omitempty"`
+	Time        time.Time `json:"time"`
+}
+
+// PostTrainingSeries defines model for PostTrainingSeries.
+type PostTrainingSeries struct {
+	FirstTime time.Time `json:"firstTime"`
+	Notes     string    `json:"notes"`
+
+	// Occurrences Number of weeks, including the skipped ones
+	Occurrences int `json:"occurrences"`
+
+	// SessionType Code of the session type booked every week, the default one when omitted
+	SessionType *string `json:"sessionType,omitempty"`
+
+	// Skip Occurrences which shouldn't be booked, for example holidays
+	Skip *[]time.Time `json:"skip,omitempty"`
+}
+
+// PostWaitlistClaim defines model for PostWaitlistClaim.
+type PostWaitlistClaim struct {
+	Notes string `json:"notes"`
+}
+
+// PostWaitlistEntry defines model for PostWaitlistEntry.
+type PostWaitlistEntry struct {
+	// AutoBook Books the freed hour right away when the trainings balance allows it, instead of offering it
+	AutoBook *bool     `json:"autoBook,omitempty"`
+	Time     time.Time `json:"time"`
+}
+
+// PutSessionType defines model for PutSessionType.
+type PutSessionType struct {
+	Archived bool `json:"archived"`
+
+	// CancellationTiers Cancellation policy of the session type, one tier needs zero notice. The current cancellation policy applies when omitted. Booked trainings keep the tiers they were booked with
+	CancellationTiers *[]CancellationTier `json:"cancellationTiers,omitempty"`
+	CreditPrice       int                 `json:"creditPrice"`
+	Description       *string             `json:"description,omitempty"`
+	DurationMinutes   int                 `json:"durationMinutes"`
+	Name              string              `json:"name"`
+}
+
+// PutTrainingNotes defines model for PutTrainingNotes.
+type PutTrainingNotes struct {
+	// Notes Notes shared with the attendee
+	Notes *string `json:"notes,omitempty"`
+
+	// TrainerNotes Private notes of the trainer
+	TrainerNotes *string `json:"trainerNotes,omitempty"`
+}
+
+// ReportFormat defines model for ReportFormat.
+type ReportFormat string
+
+// ReportPeriod Weeks start on Monday, both weeks and months in UTC
+type ReportPeriod string
+
+// SessionType defines model for SessionType.
+type SessionType struct {
+	Archived bool `json:"archived"`
+
+	// CancellationTiers Refunds of the session type's own cancellation policy, or of the current cancellation policy when it has none, from the longest notice
+	CancellationTiers []CancellationTier `json:"cancellationTiers"`
+	Code              string             `json:"code"`
+	CreditPrice       int                `json:"creditPrice"`
+	Description       string             `json:"description"`
+	DurationMinutes   int                `json:"durationMinutes"`
+	Name              string             `json:"name"`
+}
+
+// SessionTypes defines model for SessionTypes.
+type SessionTypes struct {
+	SessionTypes []SessionType `json:"sessionTypes"`
+}
+
+// TrainerRating defines model for TrainerRating.
+type TrainerRating struct {
+	AverageRating  float64    `json:"averageRating"`
+	RatingsCount   int        `json:"ratingsCount"`
+	RecentFeedback []Feedback `json:"recentFeedback"`
 }
 
 // Training defines model for Training.
 type Training struct {
-	CanBeCancelled     bool               `json:"canBeCancelled"`
-	MoveProposedBy     *string            `json:"moveProposedBy,omitempty"`
-	MoveRequiresAccept bool               `json:"moveRequiresAccept"`
-	Notes              string             `json:"notes"`
-	ProposedTime       *time.Time         `json:"proposedTime,omitempty"`
-	Time               time.Time          `json:"time"`
-	User               string             `json:"user"`
-	UserUuid           openapi_types.UUID `json:"userUuid"`
-	Uuid               openapi_types.UUID `json:"uuid"`
+	Attendance         *TrainingAttendance `json:"attendance,omitempty"`
+	CanBeCancelled     bool                `json:"canBeCancelled"`
+	MoveProposedBy     *string             `json:"moveProposedBy,omitempty"`
+	MoveRequiresAccept bool                `json:"moveRequiresAccept"`
+	Notes              string              `json:"notes"`
+
+	// Price Credits paid for the training
+	Price int `json:"price"`
+
+	// ProposalExpiresAt Deadline for answering the reschedule proposal, it's rejected automatically afterwards
+	ProposalExpiresAt *time.Time `json:"proposalExpiresAt,omitempty"`
+	ProposedTime      *time.Time `json:"proposedTime,omitempty"`
+
+	// SeriesUuid Series the training was booked in
+	SeriesUuid  *openapi_types.UUID `json:"seriesUuid,omitempty"`
+	SessionType string              `json:"sessionType"`
+	Time        time.Time           `json:"time"`
+
+	// TrainerNotes Private notes of the trainer, returned only to trainers
+	TrainerNotes *string            `json:"trainerNotes,omitempty"`
+	User         string             `json:"user"`
+	UserUuid     openapi_types.UUID `json:"userUuid"`
+	Uuid         openapi_types.UUID `json:"uuid"`
+}
+
+// TrainingAttendance defines model for Training.Attendance.
+type TrainingAttendance string
+
+// TrainingHistory defines model for TrainingHistory.
+type TrainingHistory struct {
+	Trainings []TrainingHistoryEntry `json:"trainings"`
+}
+
+// TrainingHistoryEntry defines model for TrainingHistoryEntry.
+type TrainingHistoryEntry struct {
+	Attendance  *TrainingHistoryEntryAttendance `json:"attendance,omitempty"`
+	Canceled    bool                            `json:"canceled"`
+	Notes       string                          `json:"notes"`
+	Price       int                             `json:"price"`
+	SessionType string                          `json:"sessionType"`
+
+	// Time In the requested timezone
+	Time time.Time          `json:"time"`
+	Uuid openapi_types.UUID `json:"uuid"`
+}
+
+// TrainingHistoryEntryAttendance defines model for TrainingHistoryEntry.Attendance.
+type TrainingHistoryEntryAttendance string
+
+// TrainingNotesHistory defines model for TrainingNotesHistory.
+type TrainingNotesHistory struct {
+	Revisions []TrainingNotesRevision `json:"revisions"`
 }
 
+// TrainingNotesRevision defines model for TrainingNotesRevision.
+type TrainingNotesRevision struct {
+	EditedAt     time.Time                         `json:"editedAt"`
+	EditedBy     openapi_types.UUID                `json:"editedBy"`
+	EditedByRole TrainingNotesRevisionEditedByRole `json:"editedByRole"`
+	Kind         TrainingNotesRevisionKind         `json:"kind"`
+
+	// Notes Notes after the edit
+	Notes string `json:"notes"`
+}
+
+// TrainingNotesRevisionEditedByRole defines model for TrainingNotesRevision.EditedByRole.
+type TrainingNotesRevisionEditedByRole string
+
+// TrainingNotesRevisionKind defines model for TrainingNotesRevision.Kind.
+type TrainingNotesRevisionKind string
+
+// TrainingSeries defines model for TrainingSeries.
+type TrainingSeries struct {
+	Canceled    bool                       `json:"canceled"`
+	Occurrences []TrainingSeriesOccurrence `json:"occurrences"`
+	User        string                     `json:"user"`
+	UserUuid    openapi_types.UUID         `json:"userUuid"`
+	Uuid        openapi_types.UUID         `json:"uuid"`
+}
+
+// TrainingSeriesOccurrence defines model for TrainingSeriesOccurrence.
+type TrainingSeriesOccurrence struct {
+	// FailureReason Error slug explaining why the occurrence couldn't be booked
+	FailureReason *string                        `json:"failureReason,omitempty"`
+	Status        TrainingSeriesOccurrenceStatus `json:"status"`
+	Time          time.Time                      `json:"time"`
+	TrainingUuid  *openapi_types.UUID            `json:"trainingUuid,omitempty"`
+}
+
+// TrainingSeriesOccurrenceStatus defines model for TrainingSeriesOccurrence.Status.
+type TrainingSeriesOccurrenceStatus string
+
 // Trainings defines model for Trainings.
 type Trainings struct {
 	Trainings []Training `json:"trainings"`
 }
 
+// UserDataArchive defines model for UserDataArchive.
+type UserDataArchive struct {
+	ExportedAt time.Time          `json:"exportedAt"`
+	Trainings  UserTrainingsData  `json:"trainings"`
+	UserUuid   openapi_types.UUID `json:"userUuid"`
+
+	// Users Profile, balance ledger, credit orders and sessions of the user, as exported by the users service
+	Users map[string]interface{} `json:"users"`
+}
+
+// UserDataSeries defines model for UserDataSeries.
+type UserDataSeries struct {
+	Canceled  bool               `json:"canceled"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Uuid      openapi_types.UUID `json:"uuid"`
+}
+
+// UserDataTraining defines model for UserDataTraining.
+type UserDataTraining struct {
+	Attendance      *UserDataTrainingAttendance `json:"attendance,omitempty"`
+	Canceled        bool                        `json:"canceled"`
+	CanceledAt      *time.Time                  `json:"canceledAt,omitempty"`
+	CreatedAt       time.Time                   `json:"createdAt"`
+	FeedbackComment *string                     `json:"feedbackComment,omitempty"`
+	FeedbackReply   *string                     `json:"feedbackReply,omitempty"`
+	Notes           string                      `json:"notes"`
+
+	// NotesRevisions Edits of the notes shared with the attendee, private notes of the trainer are not exported
+	NotesRevisions []TrainingNotesRevision `json:"notesRevisions"`
+	Price          int                     `json:"price"`
+	Rating         *int                    `json:"rating,omitempty"`
+	SessionType    string                  `json:"sessionType"`
+	Time           time.Time               `json:"time"`
+	Uuid           openapi_types.UUID      `json:"uuid"`
+}
+
+// UserDataTrainingAttendance defines model for UserDataTraining.Attendance.
+type UserDataTrainingAttendance string
+
+// UserDataWaitlistEntry defines model for UserDataWaitlistEntry.
+type UserDataWaitlistEntry struct {
+	AutoBook  bool                        `json:"autoBook"`
+	CreatedAt time.Time                   `json:"createdAt"`
+	Status    UserDataWaitlistEntryStatus `json:"status"`
+	Time      time.Time                   `json:"time"`
+	Uuid      openapi_types.UUID          `json:"uuid"`
+}
+
+// UserDataWaitlistEntryStatus defines model for UserDataWaitlistEntry.Status.
+type UserDataWaitlistEntryStatus string
+
+// UserTrainingsData defines model for UserTrainingsData.
+type UserTrainingsData struct {
+	Series          []UserDataSeries        `json:"series"`
+	Trainings       []UserDataTraining      `json:"trainings"`
+	WaitlistEntries []UserDataWaitlistEntry `json:"waitlistEntries"`
+}
+
+// UtilizationReport defines model for UtilizationReport.
+type UtilizationReport struct {
+	Periods []UtilizationReportPeriod `json:"periods"`
+}
+
+// UtilizationReportPeriod defines model for UtilizationReportPeriod.
+type UtilizationReportPeriod struct {
+	Attended      int `json:"attended"`
+	BookedHours   int `json:"bookedHours"`
+	Cancellations int `json:"cancellations"`
+	NoShows       int `json:"noShows"`
+
+	// OfferedHours Hours made available by the trainer, including the booked ones
+	OfferedHours int       `json:"offeredHours"`
+	PeriodStart  time.Time `json:"periodStart"`
+	Reschedules  int       `json:"reschedules"`
+
+	// Trainings Not canceled trainings
+	Trainings int `json:"trainings"`
+
+	// UtilizationRate Share of offered hours which are booked, 0 when no hours were offered
+	UtilizationRate float64 `json:"utilizationRate"`
+}
+
+// Waitlist defines model for Waitlist.
+type Waitlist struct {
+	Entries []WaitlistEntry `json:"entries"`
+}
+
+// WaitlistEntry defines model for WaitlistEntry.
+type WaitlistEntry struct {
+	AutoBook bool `json:"autoBook"`
+
+	// OfferExpiresAt Deadline for claiming the offered hour, it's offered to the next attendee afterwards
+	OfferExpiresAt *time.Time          `json:"offerExpiresAt,omitempty"`
+	Status         WaitlistEntryStatus `json:"status"`
+	Time           time.Time           `json:"time"`
+	Uuid           openapi_types.UUID  `json:"uuid"`
+}
+
+// WaitlistEntryStatus defines model for WaitlistEntry.Status.
+type WaitlistEntryStatus string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// ExportTrainingHistoryParams defines parameters for ExportTrainingHistory.
+type ExportTrainingHistoryParams struct {
+	// Format json by default
+	Format *ReportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Timezone IANA timezone of the returned times, the timezone from the user's profile or UTC by default
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+}
+
+// GetTrainerRatingParams defines parameters for GetTrainerRating.
+type GetTrainerRatingParams struct {
+	// Limit Max number of recent feedback entries, 10 by default
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetCancellationReportParams defines parameters for GetCancellationReport.
+type GetCancellationReportParams struct {
+	DateFrom time.Time `form:"dateFrom" json:"dateFrom"`
+
+	// DateTo Exclusive, the range can't be longer than a year
+	DateTo time.Time    `form:"dateTo" json:"dateTo"`
+	Period ReportPeriod `form:"period" json:"period"`
+
+	// Format json by default
+	Format *ReportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetUtilizationReportParams defines parameters for GetUtilizationReport.
+type GetUtilizationReportParams struct {
+	DateFrom time.Time `form:"dateFrom" json:"dateFrom"`
+
+	// DateTo Exclusive, the range can't be longer than a year
+	DateTo time.Time    `form:"dateTo" json:"dateTo"`
+	Period ReportPeriod `form:"period" json:"period"`
+
+	// Format json by default
+	Format *ReportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// CancelTrainingParams defines parameters for CancelTraining.
+type CancelTrainingParams struct {
+	// IfMatch ETag of the training the change is based on, the change is rejected with 412 when the training was modified since. It's required, changes without it are rejected with 428. Weak ETags are accepted, "*" matches any version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ApproveRescheduleTrainingParams defines parameters for ApproveRescheduleTraining.
+type ApproveRescheduleTrainingParams struct {
+	// IfMatch ETag of the training the change is based on, the change is rejected with 412 when the training was modified since. It's required, changes without it are rejected with 428. Weak ETags are accepted, "*" matches any version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// RejectRescheduleTrainingParams defines parameters for RejectRescheduleTraining.
+type RejectRescheduleTrainingParams struct {
+	// IfMatch ETag of the training the change is based on, the change is rejected with 412 when the training was modified since. It's required, changes without it are rejected with 428. Weak ETags are accepted, "*" matches any version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// RequestRescheduleTrainingParams defines parameters for RequestRescheduleTraining.
+type RequestRescheduleTrainingParams struct {
+	// IfMatch ETag of the training the change is based on, the change is rejected with 412 when the training was modified since. It's required, changes without it are rejected with 428. Weak ETags are accepted, "*" matches any version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// RescheduleTrainingParams defines parameters for RescheduleTraining.
+type RescheduleTrainingParams struct {
+	// IfMatch ETag of the training the change is based on, the change is rejected with 412 when the training was modified since. It's required, changes without it are rejected with 428. Weak ETags are accepted, "*" matches any version.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // CreateTrainingJSONRequestBody defines body for CreateTraining for application/json ContentType.
 type CreateTrainingJSONRequestBody = PostTraining
 
+// CreateBulkCancellationJSONRequestBody defines body for CreateBulkCancellation for application/json ContentType.
+type CreateBulkCancellationJSONRequestBody = PostBulkCancellation
+
+// CreateTrainingSeriesJSONRequestBody defines body for CreateTrainingSeries for application/json ContentType.
+type CreateTrainingSeriesJSONRequestBody = PostTrainingSeries
+
+// CreateSessionTypeJSONRequestBody defines body for CreateSessionType for application/json ContentType.
+type CreateSessionTypeJSONRequestBody = PostSessionType
+
+// UpdateSessionTypeJSONRequestBody defines body for UpdateSessionType for application/json ContentType.
+type UpdateSessionTypeJSONRequestBody = PutSessionType
+
+// JoinWaitlistJSONRequestBody defines body for JoinWaitlist for application/json ContentType.
+type JoinWaitlistJSONRequestBody = PostWaitlistEntry
+
+// ClaimWaitlistOfferJSONRequestBody defines body for ClaimWaitlistOffer for application/json ContentType.
+type ClaimWaitlistOfferJSONRequestBody = PostWaitlistClaim
+
+// RecordTrainingAttendanceJSONRequestBody defines body for RecordTrainingAttendance for application/json ContentType.
+type RecordTrainingAttendanceJSONRequestBody = PostAttendance
+
+// RateTrainingJSONRequestBody defines body for RateTraining for application/json ContentType.
+type RateTrainingJSONRequestBody = PostFeedback
+
+// ReplyToTrainingFeedbackJSONRequestBody defines body for ReplyToTrainingFeedback for application/json ContentType.
+type ReplyToTrainingFeedbackJSONRequestBody = PostFeedbackReply
+
+// UpdateTrainingNotesJSONRequestBody defines body for UpdateTrainingNotes for application/json ContentType.
+type UpdateTrainingNotesJSONRequestBody = PutTrainingNotes
+
 // RequestRescheduleTrainingJSONRequestBody defines body for RequestRescheduleTraining for application/json ContentType.
 type RequestRescheduleTrainingJSONRequestBody = PostTraining
 
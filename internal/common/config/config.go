@@ -76,9 +76,9 @@ type CasdoorConfig struct {
 
 // ContextOverrides bundles per-context feature flags and observability hints.
 type ContextOverrides struct {
-	Trainings ContextConfig `mapstructure:"trainings"`
-	Users     ContextConfig `mapstructure:"users"`
-	Trainer   ContextConfig `mapstructure:"trainer"`
+	Trainings TrainingsConfig `mapstructure:"trainings"`
+	Users     UsersConfig     `mapstructure:"users"`
+	Trainer   ContextConfig   `mapstructure:"trainer"`
 }
 
 // ContextConfig defines knobs available to each bounded context.
@@ -87,6 +87,100 @@ type ContextConfig struct {
 	MetricsNamespace string          `mapstructure:"metrics_namespace"`
 }
 
+// UsersConfig extends ContextConfig with users-specific settings.
+type UsersConfig struct {
+	ContextConfig `mapstructure:",squash"`
+	Payments      PaymentsConfig `mapstructure:"payments"`
+	Credits       CreditsConfig  `mapstructure:"credits"`
+}
+
+// CreditsConfig controls expiry of bought credits and credits granted to new users.
+type CreditsConfig struct {
+	// ExpiryJobInterval is how often remaining credits of expired lots are taken away.
+	ExpiryJobInterval time.Duration `mapstructure:"expiry_job_interval"`
+	// StarterCredits are granted to users provisioned on their first login, none when zero.
+	StarterCredits int `mapstructure:"starter_credits"`
+	// StarterCreditsValidity is how long starter credits can be spent, they never expire when zero.
+	StarterCreditsValidity time.Duration `mapstructure:"starter_credits_validity"`
+}
+
+// PaymentsConfig configures the payment provider credit packages are bought through.
+type PaymentsConfig struct {
+	// Provider is the payment provider implementation, only "fake" is available for now.
+	// Credit packages can't be bought when it's empty.
+	Provider string `mapstructure:"provider"`
+	// WebhookSecret signs payment webhooks, so nobody else can confirm payments.
+	WebhookSecret string `mapstructure:"webhook_secret"`
+	// CheckoutURL is where users are sent to complete the payment.
+	CheckoutURL string `mapstructure:"checkout_url"`
+}
+
+// TrainingsConfig extends ContextConfig with trainings-specific business rules.
+type TrainingsConfig struct {
+	ContextConfig `mapstructure:",squash"`
+	Attendance    AttendanceConfig   `mapstructure:"attendance"`
+	Cancellation  CancellationConfig `mapstructure:"cancellation"`
+	Reschedule    RescheduleConfig   `mapstructure:"reschedule"`
+	Waitlist      WaitlistConfig     `mapstructure:"waitlist"`
+}
+
+// WaitlistConfig controls offering freed hours to waitlisted attendees.
+type WaitlistConfig struct {
+	// ClaimTTL is how long the waitlisted attendee has to claim the offered hour before it's offered to the next one.
+	ClaimTTL time.Duration `mapstructure:"claim_ttl"`
+	// JobInterval is how often expired offers are passed to the next waitlisted attendee.
+	JobInterval time.Duration `mapstructure:"job_interval"`
+}
+
+// RescheduleConfig controls reschedule proposals.
+type RescheduleConfig struct {
+	// ProposalTTL is how long the other side has to answer a reschedule proposal before it's rejected.
+	ProposalTTL time.Duration `mapstructure:"proposal_ttl"`
+	// JobInterval is how often expired reschedule proposals are rejected.
+	JobInterval time.Duration `mapstructure:"job_interval"`
+	// HoldProposedHour reserves the proposed hour in the trainer's calendar until the proposal is answered.
+	HoldProposedHour bool `mapstructure:"hold_proposed_hour"`
+}
+
+// AttendanceConfig controls how training attendance is recorded and settled.
+type AttendanceConfig struct {
+	// GracePeriod is how long after a training ends the trainer can still record attendance,
+	// before the training is automatically marked as completed.
+	GracePeriod time.Duration `mapstructure:"grace_period"`
+	// NoShowPenalty is the number of credits deducted from the attendee when marked as no-show.
+	NoShowPenalty int `mapstructure:"no_show_penalty"`
+	// AttendedBonus is the number of credits given back to the attendee when marked as attended.
+	AttendedBonus int `mapstructure:"attended_bonus"`
+	// JobInterval is how often trainings without recorded attendance are checked.
+	JobInterval time.Duration `mapstructure:"job_interval"`
+}
+
+// CancellationConfig lists versioned cancellation policies. New trainings are booked under CurrentVersion,
+// older versions have to be kept as long as there are trainings booked under them.
+type CancellationConfig struct {
+	CurrentVersion string                     `mapstructure:"current_version"`
+	Policies       []CancellationPolicyConfig `mapstructure:"policies"`
+}
+
+// CancellationPolicyConfig describes refund tiers of a single policy version.
+type CancellationPolicyConfig struct {
+	Version string                   `mapstructure:"version"`
+	Tiers   []CancellationTierConfig `mapstructure:"tiers"`
+	// SessionTypeOverrides replaces Tiers for trainings of the given session type code,
+	// unless the session type has cancellation tiers of its own in the catalog.
+	SessionTypeOverrides map[string][]CancellationTierConfig `mapstructure:"session_type_overrides"`
+}
+
+// CancellationTierConfig applies when the training is canceled at least MinNotice before it starts.
+type CancellationTierConfig struct {
+	MinNotice time.Duration `mapstructure:"min_notice"`
+	// AttendeeRefundPercent is the part of the training price returned when the attendee cancels.
+	AttendeeRefundPercent int `mapstructure:"attendee_refund_percent"`
+	// TrainerRefundPercent is the part of the training price returned when the trainer cancels,
+	// values above 100 compensate the attendee for a late cancellation.
+	TrainerRefundPercent int `mapstructure:"trainer_refund_percent"`
+}
+
 // DefaultConfig returns baseline values that can be overridden via config files or env vars.
 func DefaultConfig() Config {
 	return Config{
@@ -123,11 +217,43 @@ func DefaultConfig() Config {
 			},
 		},
 		Contexts: ContextOverrides{
-			Trainings: ContextConfig{
-				FeatureFlags: map[string]bool{},
+			Trainings: TrainingsConfig{
+				ContextConfig: ContextConfig{
+					FeatureFlags: map[string]bool{},
+				},
+				Attendance: AttendanceConfig{
+					GracePeriod:   24 * time.Hour,
+					NoShowPenalty: 1,
+					JobInterval:   15 * time.Minute,
+				},
+				Cancellation: CancellationConfig{
+					CurrentVersion: "v1",
+					Policies: []CancellationPolicyConfig{
+						{
+							Version: "v1",
+							Tiers: []CancellationTierConfig{
+								{MinNotice: 24 * time.Hour, AttendeeRefundPercent: 100, TrainerRefundPercent: 100},
+								{MinNotice: 0, AttendeeRefundPercent: 0, TrainerRefundPercent: 200},
+							},
+						},
+					},
+				},
+				Reschedule: RescheduleConfig{
+					ProposalTTL: 48 * time.Hour,
+					JobInterval: 5 * time.Minute,
+				},
+				Waitlist: WaitlistConfig{
+					ClaimTTL:    2 * time.Hour,
+					JobInterval: 5 * time.Minute,
+				},
 			},
-			Users: ContextConfig{
-				FeatureFlags: map[string]bool{},
+			Users: UsersConfig{
+				ContextConfig: ContextConfig{
+					FeatureFlags: map[string]bool{},
+				},
+				Credits: CreditsConfig{
+					ExpiryJobInterval: time.Hour,
+				},
 			},
 			Trainer: ContextConfig{
 				FeatureFlags: map[string]bool{},
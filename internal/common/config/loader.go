@@ -54,8 +54,8 @@ func WithEnvFile(path string) Option {
 	}
 }
 
-// Load builds a Config instance by applying defaults, optional config readers,
-// .env files, and finally environment variables (highest priority).
+// Load builds a Config instance by applying defaults, the YAML file pointed by CONFIG_FILE,
+// optional config readers, .env files, and finally environment variables (highest priority).
 func Load(ctx context.Context, optFns ...Option) (Config, error) {
 	if ctx == nil {
 		ctx = context.Background()
@@ -83,6 +83,12 @@ func Load(ctx context.Context, optFns ...Option) (Config, error) {
 	bindLegacyEnvVars(v)
 	setDefaults(v, opts.defaults)
 
+	if path := os.Getenv("CONFIG_FILE"); path != "" {
+		if err := mergeConfigFile(v, path); err != nil {
+			return Config{}, err
+		}
+	}
+
 	for _, reader := range opts.readers {
 		if err := v.MergeConfig(reader); err != nil {
 			return Config{}, fmt.Errorf("merge config reader: %w", err)
@@ -103,6 +109,20 @@ func Load(ctx context.Context, optFns ...Option) (Config, error) {
 	return cfg, nil
 }
 
+func mergeConfigFile(v *viper.Viper, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open config file: %w", err)
+	}
+	defer f.Close()
+
+	if err := v.MergeConfig(f); err != nil {
+		return fmt.Errorf("merge config file %s: %w", path, err)
+	}
+
+	return nil
+}
+
 // MustLoad is a convenience that panics when configuration cannot be loaded or validated.
 func MustLoad(ctx context.Context, optFns ...Option) Config {
 	cfg, err := Load(ctx, optFns...)
@@ -150,8 +170,25 @@ func setDefaults(v *viper.Viper, cfg Config) {
 
 	v.SetDefault("contexts.trainings.feature_flags", cfg.Contexts.Trainings.FeatureFlags)
 	v.SetDefault("contexts.trainings.metrics_namespace", cfg.Contexts.Trainings.MetricsNamespace)
+	v.SetDefault("contexts.trainings.attendance.grace_period", cfg.Contexts.Trainings.Attendance.GracePeriod)
+	v.SetDefault("contexts.trainings.attendance.no_show_penalty", cfg.Contexts.Trainings.Attendance.NoShowPenalty)
+	v.SetDefault("contexts.trainings.attendance.attended_bonus", cfg.Contexts.Trainings.Attendance.AttendedBonus)
+	v.SetDefault("contexts.trainings.attendance.job_interval", cfg.Contexts.Trainings.Attendance.JobInterval)
+	v.SetDefault("contexts.trainings.cancellation.current_version", cfg.Contexts.Trainings.Cancellation.CurrentVersion)
+	v.SetDefault("contexts.trainings.cancellation.policies", cfg.Contexts.Trainings.Cancellation.Policies)
+	v.SetDefault("contexts.trainings.reschedule.proposal_ttl", cfg.Contexts.Trainings.Reschedule.ProposalTTL)
+	v.SetDefault("contexts.trainings.reschedule.job_interval", cfg.Contexts.Trainings.Reschedule.JobInterval)
+	v.SetDefault("contexts.trainings.reschedule.hold_proposed_hour", cfg.Contexts.Trainings.Reschedule.HoldProposedHour)
+	v.SetDefault("contexts.trainings.waitlist.claim_ttl", cfg.Contexts.Trainings.Waitlist.ClaimTTL)
+	v.SetDefault("contexts.trainings.waitlist.job_interval", cfg.Contexts.Trainings.Waitlist.JobInterval)
 	v.SetDefault("contexts.users.feature_flags", cfg.Contexts.Users.FeatureFlags)
 	v.SetDefault("contexts.users.metrics_namespace", cfg.Contexts.Users.MetricsNamespace)
+	v.SetDefault("contexts.users.payments.provider", cfg.Contexts.Users.Payments.Provider)
+	v.SetDefault("contexts.users.payments.webhook_secret", cfg.Contexts.Users.Payments.WebhookSecret)
+	v.SetDefault("contexts.users.payments.checkout_url", cfg.Contexts.Users.Payments.CheckoutURL)
+	v.SetDefault("contexts.users.credits.expiry_job_interval", cfg.Contexts.Users.Credits.ExpiryJobInterval)
+	v.SetDefault("contexts.users.credits.starter_credits", cfg.Contexts.Users.Credits.StarterCredits)
+	v.SetDefault("contexts.users.credits.starter_credits_validity", cfg.Contexts.Users.Credits.StarterCreditsValidity)
 	v.SetDefault("contexts.trainer.feature_flags", cfg.Contexts.Trainer.FeatureFlags)
 	v.SetDefault("contexts.trainer.metrics_namespace", cfg.Contexts.Trainer.MetricsNamespace)
 }
@@ -182,6 +219,25 @@ func bindLegacyEnvVars(v *viper.Viper) {
 	_ = v.BindEnv("grpc.ca_file", "GRPC_CA_FILE")
 	_ = v.BindEnv("grpc.dial_timeout", "GRPC_DIAL_TIMEOUT")
 
+	_ = v.BindEnv("contexts.trainings.attendance.grace_period", "ATTENDANCE_GRACE_PERIOD")
+	_ = v.BindEnv("contexts.trainings.attendance.no_show_penalty", "ATTENDANCE_NO_SHOW_PENALTY")
+	_ = v.BindEnv("contexts.trainings.attendance.attended_bonus", "ATTENDANCE_ATTENDED_BONUS")
+	_ = v.BindEnv("contexts.trainings.attendance.job_interval", "ATTENDANCE_JOB_INTERVAL")
+
+	_ = v.BindEnv("contexts.trainings.cancellation.current_version", "CANCELLATION_POLICY_VERSION")
+	_ = v.BindEnv("contexts.trainings.reschedule.proposal_ttl", "RESCHEDULE_PROPOSAL_TTL")
+	_ = v.BindEnv("contexts.trainings.reschedule.job_interval", "RESCHEDULE_JOB_INTERVAL")
+	_ = v.BindEnv("contexts.trainings.reschedule.hold_proposed_hour", "RESCHEDULE_HOLD_PROPOSED_HOUR")
+	_ = v.BindEnv("contexts.trainings.waitlist.claim_ttl", "WAITLIST_CLAIM_TTL")
+	_ = v.BindEnv("contexts.trainings.waitlist.job_interval", "WAITLIST_JOB_INTERVAL")
+
+	_ = v.BindEnv("contexts.users.payments.provider", "PAYMENTS_PROVIDER")
+	_ = v.BindEnv("contexts.users.payments.webhook_secret", "PAYMENTS_WEBHOOK_SECRET")
+	_ = v.BindEnv("contexts.users.payments.checkout_url", "PAYMENTS_CHECKOUT_URL")
+	_ = v.BindEnv("contexts.users.credits.expiry_job_interval", "CREDITS_EXPIRY_JOB_INTERVAL")
+	_ = v.BindEnv("contexts.users.credits.starter_credits", "CREDITS_STARTER_CREDITS")
+	_ = v.BindEnv("contexts.users.credits.starter_credits_validity", "CREDITS_STARTER_CREDITS_VALIDITY")
+
 	_ = v.BindEnv("auth.mock", "AUTH_MOCK", "MOCK_AUTH")
 	_ = v.BindEnv("auth.casdoor.enabled", "CASDOOR_ENABLED")
 	_ = v.BindEnv("auth.casdoor.endpoint", "CASDOOR_ENDPOINT")
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/vaintrub/go-ddd-template/internal/common/config"
@@ -31,3 +32,104 @@ database:
 		config.MustLoad(ctx, config.WithDefaults(defaults), config.WithReader(reader))
 	})
 }
+
+func TestLoadCancellationPolicies(t *testing.T) {
+	t.Setenv("APP_ENV", "development")
+	t.Setenv("DATABASE_URL", "postgres://example.com/db")
+	t.Setenv("TRAINER_GRPC_ADDR", "trainer:3000")
+	t.Setenv("USERS_GRPC_ADDR", "users:3000")
+
+	ctx := context.Background()
+	reader := strings.NewReader(`
+contexts:
+  trainings:
+    cancellation:
+      current_version: v2
+      policies:
+        - version: v1
+          tiers:
+            - min_notice: 24h
+              attendee_refund_percent: 100
+              trainer_refund_percent: 100
+            - min_notice: 0s
+              attendee_refund_percent: 0
+              trainer_refund_percent: 200
+        - version: v2
+          tiers:
+            - min_notice: 48h
+              attendee_refund_percent: 100
+              trainer_refund_percent: 100
+            - min_notice: 24h
+              attendee_refund_percent: 50
+              trainer_refund_percent: 150
+            - min_notice: 0s
+              attendee_refund_percent: 0
+              trainer_refund_percent: 200
+          session_type_overrides:
+            group:
+              - min_notice: 12h
+                attendee_refund_percent: 100
+                trainer_refund_percent: 100
+              - min_notice: 0s
+                attendee_refund_percent: 0
+                trainer_refund_percent: 100
+`)
+
+	cfg, err := config.Load(ctx, config.WithReader(reader))
+	require.NoError(t, err)
+
+	cancellation := cfg.Contexts.Trainings.Cancellation
+	require.Equal(t, "v2", cancellation.CurrentVersion)
+	require.Len(t, cancellation.Policies, 2)
+	require.Equal(t, 48*time.Hour, cancellation.Policies[1].Tiers[0].MinNotice)
+	require.Equal(t, 50, cancellation.Policies[1].Tiers[1].AttendeeRefundPercent)
+	require.Equal(t, 12*time.Hour, cancellation.Policies[1].SessionTypeOverrides["group"][0].MinNotice)
+}
+
+func TestLoadDefaultCancellationPolicy(t *testing.T) {
+	t.Setenv("APP_ENV", "development")
+	t.Setenv("DATABASE_URL", "postgres://example.com/db")
+	t.Setenv("TRAINER_GRPC_ADDR", "trainer:3000")
+	t.Setenv("USERS_GRPC_ADDR", "users:3000")
+
+	cfg, err := config.Load(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, config.DefaultConfig().Contexts.Trainings.Cancellation, cfg.Contexts.Trainings.Cancellation)
+}
+
+func TestLoadPaymentsRequireWebhookSecret(t *testing.T) {
+	t.Setenv("APP_ENV", "development")
+	t.Setenv("DATABASE_URL", "postgres://example.com/db")
+	t.Setenv("TRAINER_GRPC_ADDR", "trainer:3000")
+	t.Setenv("USERS_GRPC_ADDR", "users:3000")
+	t.Setenv("PAYMENTS_PROVIDER", "fake")
+
+	_, err := config.Load(context.Background())
+	require.EqualError(t, err, "config validation failed: contexts.users.payments.webhook_secret is required when payments are enabled")
+
+	t.Setenv("PAYMENTS_WEBHOOK_SECRET", "secret")
+
+	cfg, err := config.Load(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "fake", cfg.Contexts.Users.Payments.Provider)
+}
+
+func TestLoadStarterCredits(t *testing.T) {
+	t.Setenv("APP_ENV", "development")
+	t.Setenv("DATABASE_URL", "postgres://example.com/db")
+	t.Setenv("TRAINER_GRPC_ADDR", "trainer:3000")
+	t.Setenv("USERS_GRPC_ADDR", "users:3000")
+	t.Setenv("CREDITS_STARTER_CREDITS", "-1")
+
+	_, err := config.Load(context.Background())
+	require.EqualError(t, err, "config validation failed: contexts.users.credits.starter_credits must not be negative")
+
+	t.Setenv("CREDITS_STARTER_CREDITS", "2")
+	t.Setenv("CREDITS_STARTER_CREDITS_VALIDITY", "720h")
+
+	cfg, err := config.Load(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, cfg.Contexts.Users.Credits.StarterCredits)
+	require.Equal(t, 720*time.Hour, cfg.Contexts.Users.Credits.StarterCreditsValidity)
+}
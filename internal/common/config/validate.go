@@ -81,6 +81,29 @@ func Validate(cfg Config) error {
 	}
 
 	errs = append(errs, validateCasdoor(cfg)...)
+	errs = append(errs, validateTrainings(cfg.Contexts.Trainings)...)
+	errs = append(errs, validatePayments(cfg)...)
+
+	if cfg.Contexts.Users.Credits.ExpiryJobInterval <= 0 {
+		errs = append(errs, ValidationError{
+			Field:   "contexts.users.credits.expiry_job_interval",
+			Message: "must be positive",
+		})
+	}
+
+	if cfg.Contexts.Users.Credits.StarterCredits < 0 {
+		errs = append(errs, ValidationError{
+			Field:   "contexts.users.credits.starter_credits",
+			Message: "must not be negative",
+		})
+	}
+
+	if cfg.Contexts.Users.Credits.StarterCreditsValidity < 0 {
+		errs = append(errs, ValidationError{
+			Field:   "contexts.users.credits.starter_credits_validity",
+			Message: "must not be negative",
+		})
+	}
 
 	if level := strings.TrimSpace(cfg.Logging.Level); level != "" {
 		var parsed slog.Level
@@ -138,3 +161,106 @@ func validateCasdoor(cfg Config) []ValidationError {
 
 	return errs
 }
+
+func validatePayments(cfg Config) []ValidationError {
+	payments := cfg.Contexts.Users.Payments
+	if payments.Provider == "" {
+		return nil
+	}
+
+	var errs []ValidationError
+
+	if payments.Provider != "fake" {
+		errs = append(errs, ValidationError{
+			Field:   "contexts.users.payments.provider",
+			Message: "must be fake",
+		})
+	}
+	if payments.Provider == "fake" && strings.EqualFold(cfg.Env.Name, "production") {
+		errs = append(errs, ValidationError{
+			Field:   "contexts.users.payments.provider",
+			Message: "fake provider can't be used in production",
+		})
+	}
+	if payments.WebhookSecret == "" {
+		errs = append(errs, ValidationError{
+			Field:   "contexts.users.payments.webhook_secret",
+			Message: "is required when payments are enabled",
+		})
+	}
+
+	return errs
+}
+
+func validateTrainings(cfg TrainingsConfig) []ValidationError {
+	var errs []ValidationError
+
+	if cfg.Attendance.GracePeriod < 0 {
+		errs = append(errs, ValidationError{
+			Field:   "contexts.trainings.attendance.grace_period",
+			Message: "must not be negative",
+		})
+	}
+	if cfg.Attendance.NoShowPenalty < 0 {
+		errs = append(errs, ValidationError{
+			Field:   "contexts.trainings.attendance.no_show_penalty",
+			Message: "must not be negative",
+		})
+	}
+	if cfg.Attendance.AttendedBonus < 0 {
+		errs = append(errs, ValidationError{
+			Field:   "contexts.trainings.attendance.attended_bonus",
+			Message: "must not be negative",
+		})
+	}
+	if cfg.Attendance.JobInterval <= 0 {
+		errs = append(errs, ValidationError{
+			Field:   "contexts.trainings.attendance.job_interval",
+			Message: "must be positive",
+		})
+	}
+	if cfg.Reschedule.ProposalTTL <= 0 {
+		errs = append(errs, ValidationError{
+			Field:   "contexts.trainings.reschedule.proposal_ttl",
+			Message: "must be positive",
+		})
+	}
+	if cfg.Reschedule.JobInterval <= 0 {
+		errs = append(errs, ValidationError{
+			Field:   "contexts.trainings.reschedule.job_interval",
+			Message: "must be positive",
+		})
+	}
+	if cfg.Waitlist.ClaimTTL <= 0 {
+		errs = append(errs, ValidationError{
+			Field:   "contexts.trainings.waitlist.claim_ttl",
+			Message: "must be positive",
+		})
+	}
+	if cfg.Waitlist.JobInterval <= 0 {
+		errs = append(errs, ValidationError{
+			Field:   "contexts.trainings.waitlist.job_interval",
+			Message: "must be positive",
+		})
+	}
+
+	// tiers are checked by the trainings service, which builds the cancellation policies from them
+	versions := make(map[string]bool, len(cfg.Cancellation.Policies))
+	for _, policy := range cfg.Cancellation.Policies {
+		if versions[policy.Version] {
+			errs = append(errs, ValidationError{
+				Field:   "contexts.trainings.cancellation.policies",
+				Message: fmt.Sprintf("version %q is defined more than once", policy.Version),
+			})
+		}
+		versions[policy.Version] = true
+	}
+	if !versions[cfg.Cancellation.CurrentVersion] {
+		errs = append(errs, ValidationError{
+			Field:   "contexts.trainings.cancellation.current_version",
+			Message: "must be one of the defined policy versions",
+		})
+	}
+
+	return errs
+}
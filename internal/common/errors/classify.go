@@ -0,0 +1,55 @@
+package errors
+
+import (
+	stderrors "errors"
+)
+
+// Domain errors don't depend on this package, they declare their category by implementing one of these methods.
+// They can also implement Slug() string to be reported with a more specific slug than the category name.
+type (
+	notFoundError           interface{ NotFound() bool }
+	forbiddenError          interface{ Forbidden() bool }
+	conflictError           interface{ Conflict() bool }
+	preconditionFailedError interface{ PreconditionFailed() bool }
+	sluggedError            interface{ Slug() string }
+)
+
+// Classify returns the SlugError describing err: the first SlugError in its chain or, when there is none,
+// a SlugError typed by the first categorized domain error in the chain, which wraps err.
+// It returns false for errors which can't be classified, they should be treated as internal errors.
+func Classify(err error) (SlugError, bool) {
+	var slugError SlugError
+	if stderrors.As(err, &slugError) {
+		return slugError, true
+	}
+
+	var notFound notFoundError
+	if stderrors.As(err, &notFound) && notFound.NotFound() {
+		return NewNotFoundError(err.Error(), slugOf(notFound, ErrorTypeNotFound)).WithCause(err), true
+	}
+
+	var forbidden forbiddenError
+	if stderrors.As(err, &forbidden) && forbidden.Forbidden() {
+		return NewForbiddenError(err.Error(), slugOf(forbidden, ErrorTypeForbidden)).WithCause(err), true
+	}
+
+	var conflict conflictError
+	if stderrors.As(err, &conflict) && conflict.Conflict() {
+		return NewConflictError(err.Error(), slugOf(conflict, ErrorTypeConflict)).WithCause(err), true
+	}
+
+	var preconditionFailed preconditionFailedError
+	if stderrors.As(err, &preconditionFailed) && preconditionFailed.PreconditionFailed() {
+		return NewPreconditionFailedError(err.Error(), slugOf(preconditionFailed, ErrorTypePreconditionFailed)).WithCause(err), true
+	}
+
+	return SlugError{}, false
+}
+
+func slugOf(err any, errorType ErrorType) string {
+	if slugged, ok := err.(sluggedError); ok && slugged.Slug() != "" {
+		return slugged.Slug()
+	}
+
+	return errorType.String()
+}
@@ -0,0 +1,101 @@
+package errors_test
+
+import (
+	stderrors "errors"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+)
+
+type notFoundError struct{}
+
+func (notFoundError) Error() string  { return "thing not found" }
+func (notFoundError) NotFound() bool { return true }
+func (notFoundError) Slug() string   { return "thing-not-found" }
+
+type forbiddenError struct{}
+
+func (forbiddenError) Error() string   { return "can't see the thing" }
+func (forbiddenError) Forbidden() bool { return true }
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+
+	slugError := errors.NewIncorrectInputError("invalid thing", "invalid-thing")
+
+	testCases := []struct {
+		Name              string
+		Err               error
+		ExpectedErrorType errors.ErrorType
+		ExpectedSlug      string
+	}{
+		{
+			Name:              "slug_error",
+			Err:               slugError,
+			ExpectedErrorType: errors.ErrorTypeIncorrectInput,
+			ExpectedSlug:      "invalid-thing",
+		},
+		{
+			Name:              "wrapped_slug_error",
+			Err:               pkgerrors.Wrap(slugError, "unable to update thing"),
+			ExpectedErrorType: errors.ErrorTypeIncorrectInput,
+			ExpectedSlug:      "invalid-thing",
+		},
+		{
+			Name:              "domain_error_with_slug",
+			Err:               pkgerrors.Wrap(notFoundError{}, "unable to get thing"),
+			ExpectedErrorType: errors.ErrorTypeNotFound,
+			ExpectedSlug:      "thing-not-found",
+		},
+		{
+			Name:              "domain_error_without_slug",
+			Err:               forbiddenError{},
+			ExpectedErrorType: errors.ErrorTypeForbidden,
+			ExpectedSlug:      "forbidden",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			classified, ok := errors.Classify(tc.Err)
+			require.True(t, ok)
+
+			assert.Equal(t, tc.ExpectedErrorType, classified.ErrorType())
+			assert.Equal(t, tc.ExpectedSlug, classified.Slug())
+		})
+	}
+}
+
+func TestClassify_unknown_error(t *testing.T) {
+	t.Parallel()
+
+	_, ok := errors.Classify(stderrors.New("connection refused"))
+	assert.False(t, ok)
+}
+
+func TestClassify_keeps_cause(t *testing.T) {
+	t.Parallel()
+
+	classified, ok := errors.Classify(pkgerrors.Wrap(notFoundError{}, "unable to get thing"))
+	require.True(t, ok)
+
+	var notFound notFoundError
+	assert.True(t, stderrors.As(classified, &notFound))
+}
+
+func TestSlugError_WithDetail(t *testing.T) {
+	t.Parallel()
+
+	err := errors.NewPreconditionFailedError("thing was modified", "thing-modified")
+	withDetails := err.WithDetail("currentVersion", 3).WithDetail("expectedVersion", 2)
+
+	assert.Equal(t, map[string]any{"currentVersion": 3, "expectedVersion": 2}, withDetails.Details())
+	assert.Empty(t, err.Details(), "original error should not be changed")
+	assert.ErrorIs(t, err, err, "slug error should stay comparable")
+}
@@ -4,16 +4,29 @@ type ErrorType struct {
 	t string
 }
 
+func (e ErrorType) String() string {
+	return e.t
+}
+
 var (
 	ErrorTypeUnknown        = ErrorType{"unknown"}
 	ErrorTypeAuthorization  = ErrorType{"authorization"}
 	ErrorTypeIncorrectInput = ErrorType{"incorrect-input"}
+	ErrorTypeNotFound       = ErrorType{"not-found"}
+	ErrorTypeForbidden      = ErrorType{"forbidden"}
+	ErrorTypeConflict       = ErrorType{"conflict"}
+	// ErrorTypePreconditionFailed is used when the resource was changed since the version the client based the change on.
+	ErrorTypePreconditionFailed = ErrorType{"precondition-failed"}
 )
 
 type SlugError struct {
 	error     string
 	slug      string
 	errorType ErrorType
+
+	// details is a pointer, so SlugError stays comparable
+	details *map[string]any
+	cause   error
 }
 
 func (s SlugError) Error() string {
@@ -28,6 +41,39 @@ func (s SlugError) ErrorType() ErrorType {
 	return s.errorType
 }
 
+// Unwrap returns the error which caused the SlugError, if there was any.
+func (s SlugError) Unwrap() error {
+	return s.cause
+}
+
+// Details are additional facts about the error which are returned to the client, like the current version
+// of a resource changed in the meantime.
+func (s SlugError) Details() map[string]any {
+	if s.details == nil {
+		return nil
+	}
+
+	return *s.details
+}
+
+// WithDetail returns a copy of the error with the detail added.
+func (s SlugError) WithDetail(key string, value any) SlugError {
+	details := make(map[string]any, len(s.Details())+1)
+	for k, v := range s.Details() {
+		details[k] = v
+	}
+	details[key] = value
+
+	s.details = &details
+	return s
+}
+
+// WithCause returns a copy of the error wrapping cause, so it still can be matched with errors.Is and errors.As.
+func (s SlugError) WithCause(cause error) SlugError {
+	s.cause = cause
+	return s
+}
+
 func NewSlugError(error string, slug string) SlugError {
 	return SlugError{
 		error:     error,
@@ -51,3 +97,36 @@ func NewIncorrectInputError(error string, slug string) SlugError {
 		errorType: ErrorTypeIncorrectInput,
 	}
 }
+
+func NewNotFoundError(error string, slug string) SlugError {
+	return SlugError{
+		error:     error,
+		slug:      slug,
+		errorType: ErrorTypeNotFound,
+	}
+}
+
+// NewForbiddenError is used when the user is known, but isn't allowed to access the resource.
+func NewForbiddenError(error string, slug string) SlugError {
+	return SlugError{
+		error:     error,
+		slug:      slug,
+		errorType: ErrorTypeForbidden,
+	}
+}
+
+func NewConflictError(error string, slug string) SlugError {
+	return SlugError{
+		error:     error,
+		slug:      slug,
+		errorType: ErrorTypeConflict,
+	}
+}
+
+func NewPreconditionFailedError(error string, slug string) SlugError {
+	return SlugError{
+		error:     error,
+		slug:      slug,
+		errorType: ErrorTypePreconditionFailed,
+	}
+}
@@ -113,10 +113,15 @@ func (x *IsHourAvailableResponse) GetIsAvailable() bool {
 }
 
 type UpdateHourRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Time  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// attendee_id is the attendee the hour is booked or held for, used only by ScheduleTraining
+	AttendeeId string `protobuf:"bytes,2,opt,name=attendee_id,json=attendeeId,proto3" json:"attendee_id,omitempty"`
+	// training_duration_minutes is the length of the session booked into the hour, used only by ScheduleTraining.
+	// The whole hour is reserved when it's not set.
+	TrainingDurationMinutes int32 `protobuf:"varint,3,opt,name=training_duration_minutes,json=trainingDurationMinutes,proto3" json:"training_duration_minutes,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *UpdateHourRequest) Reset() {
@@ -156,6 +161,20 @@ func (x *UpdateHourRequest) GetTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UpdateHourRequest) GetAttendeeId() string {
+	if x != nil {
+		return x.AttendeeId
+	}
+	return ""
+}
+
+func (x *UpdateHourRequest) GetTrainingDurationMinutes() int32 {
+	if x != nil {
+		return x.TrainingDurationMinutes
+	}
+	return 0
+}
+
 var File_trainer_proto protoreflect.FileDescriptor
 
 const file_trainer_proto_rawDesc = "" +
@@ -164,14 +183,18 @@ const file_trainer_proto_rawDesc = "" +
 	"\x16IsHourAvailableRequest\x12.\n" +
 	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"<\n" +
 	"\x17IsHourAvailableResponse\x12!\n" +
-	"\fis_available\x18\x01 \x01(\bR\visAvailable\"C\n" +
+	"\fis_available\x18\x01 \x01(\bR\visAvailable\"\xa0\x01\n" +
 	"\x11UpdateHourRequest\x12.\n" +
-	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time2\xc5\x02\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1f\n" +
+	"\vattendee_id\x18\x02 \x01(\tR\n" +
+	"attendeeId\x12:\n" +
+	"\x19training_duration_minutes\x18\x03 \x01(\x05R\x17trainingDurationMinutes2\x92\x03\n" +
 	"\x0eTrainerService\x12V\n" +
 	"\x0fIsHourAvailable\x12\x1f.trainer.IsHourAvailableRequest\x1a .trainer.IsHourAvailableResponse\"\x00\x12H\n" +
 	"\x10ScheduleTraining\x12\x1a.trainer.UpdateHourRequest\x1a\x16.google.protobuf.Empty\"\x00\x12F\n" +
 	"\x0eCancelTraining\x12\x1a.trainer.UpdateHourRequest\x1a\x16.google.protobuf.Empty\"\x00\x12I\n" +
-	"\x11MakeHourAvailable\x12\x1a.trainer.UpdateHourRequest\x1a\x16.google.protobuf.Empty\"\x00BFZDgithub.com/vaintrub/go-ddd-template/internal/common/genproto/trainerb\x06proto3"
+	"\x11MakeHourAvailable\x12\x1a.trainer.UpdateHourRequest\x1a\x16.google.protobuf.Empty\"\x00\x12K\n" +
+	"\x13MakeHourUnavailable\x12\x1a.trainer.UpdateHourRequest\x1a\x16.google.protobuf.Empty\"\x00BFZDgithub.com/vaintrub/go-ddd-template/internal/common/genproto/trainerb\x06proto3"
 
 var (
 	file_trainer_proto_rawDescOnce sync.Once
@@ -200,12 +223,14 @@ var file_trainer_proto_depIdxs = []int32{
 	2, // 3: trainer.TrainerService.ScheduleTraining:input_type -> trainer.UpdateHourRequest
 	2, // 4: trainer.TrainerService.CancelTraining:input_type -> trainer.UpdateHourRequest
 	2, // 5: trainer.TrainerService.MakeHourAvailable:input_type -> trainer.UpdateHourRequest
-	1, // 6: trainer.TrainerService.IsHourAvailable:output_type -> trainer.IsHourAvailableResponse
-	4, // 7: trainer.TrainerService.ScheduleTraining:output_type -> google.protobuf.Empty
-	4, // 8: trainer.TrainerService.CancelTraining:output_type -> google.protobuf.Empty
-	4, // 9: trainer.TrainerService.MakeHourAvailable:output_type -> google.protobuf.Empty
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
+	2, // 6: trainer.TrainerService.MakeHourUnavailable:input_type -> trainer.UpdateHourRequest
+	1, // 7: trainer.TrainerService.IsHourAvailable:output_type -> trainer.IsHourAvailableResponse
+	4, // 8: trainer.TrainerService.ScheduleTraining:output_type -> google.protobuf.Empty
+	4, // 9: trainer.TrainerService.CancelTraining:output_type -> google.protobuf.Empty
+	4, // 10: trainer.TrainerService.MakeHourAvailable:output_type -> google.protobuf.Empty
+	4, // 11: trainer.TrainerService.MakeHourUnavailable:output_type -> google.protobuf.Empty
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
 	2, // [2:2] is the sub-list for extension type_name
 	2, // [2:2] is the sub-list for extension extendee
 	0, // [0:2] is the sub-list for field type_name
@@ -21,10 +21,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TrainerService_IsHourAvailable_FullMethodName   = "/trainer.TrainerService/IsHourAvailable"
-	TrainerService_ScheduleTraining_FullMethodName  = "/trainer.TrainerService/ScheduleTraining"
-	TrainerService_CancelTraining_FullMethodName    = "/trainer.TrainerService/CancelTraining"
-	TrainerService_MakeHourAvailable_FullMethodName = "/trainer.TrainerService/MakeHourAvailable"
+	TrainerService_IsHourAvailable_FullMethodName     = "/trainer.TrainerService/IsHourAvailable"
+	TrainerService_ScheduleTraining_FullMethodName    = "/trainer.TrainerService/ScheduleTraining"
+	TrainerService_CancelTraining_FullMethodName      = "/trainer.TrainerService/CancelTraining"
+	TrainerService_MakeHourAvailable_FullMethodName   = "/trainer.TrainerService/MakeHourAvailable"
+	TrainerService_MakeHourUnavailable_FullMethodName = "/trainer.TrainerService/MakeHourUnavailable"
 )
 
 // TrainerServiceClient is the client API for TrainerService service.
@@ -35,6 +36,7 @@ type TrainerServiceClient interface {
 	ScheduleTraining(ctx context.Context, in *UpdateHourRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CancelTraining(ctx context.Context, in *UpdateHourRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	MakeHourAvailable(ctx context.Context, in *UpdateHourRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MakeHourUnavailable(ctx context.Context, in *UpdateHourRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type trainerServiceClient struct {
@@ -85,6 +87,16 @@ func (c *trainerServiceClient) MakeHourAvailable(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *trainerServiceClient) MakeHourUnavailable(ctx context.Context, in *UpdateHourRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TrainerService_MakeHourUnavailable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TrainerServiceServer is the server API for TrainerService service.
 // All implementations should embed UnimplementedTrainerServiceServer
 // for forward compatibility.
@@ -93,6 +105,7 @@ type TrainerServiceServer interface {
 	ScheduleTraining(context.Context, *UpdateHourRequest) (*emptypb.Empty, error)
 	CancelTraining(context.Context, *UpdateHourRequest) (*emptypb.Empty, error)
 	MakeHourAvailable(context.Context, *UpdateHourRequest) (*emptypb.Empty, error)
+	MakeHourUnavailable(context.Context, *UpdateHourRequest) (*emptypb.Empty, error)
 }
 
 // UnimplementedTrainerServiceServer should be embedded to have
@@ -114,6 +127,9 @@ func (UnimplementedTrainerServiceServer) CancelTraining(context.Context, *Update
 func (UnimplementedTrainerServiceServer) MakeHourAvailable(context.Context, *UpdateHourRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MakeHourAvailable not implemented")
 }
+func (UnimplementedTrainerServiceServer) MakeHourUnavailable(context.Context, *UpdateHourRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakeHourUnavailable not implemented")
+}
 func (UnimplementedTrainerServiceServer) testEmbeddedByValue() {}
 
 // UnsafeTrainerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -206,6 +222,24 @@ func _TrainerService_MakeHourAvailable_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TrainerService_MakeHourUnavailable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHourRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainerServiceServer).MakeHourUnavailable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainerService_MakeHourUnavailable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainerServiceServer).MakeHourUnavailable(ctx, req.(*UpdateHourRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TrainerService_ServiceDesc is the grpc.ServiceDesc for TrainerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +263,10 @@ var TrainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MakeHourAvailable",
 			Handler:    _TrainerService_MakeHourAvailable_Handler,
 		},
+		{
+			MethodName: "MakeHourUnavailable",
+			Handler:    _TrainerService_MakeHourUnavailable_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "trainer.proto",
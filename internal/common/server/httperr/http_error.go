@@ -1,43 +1,75 @@
 package httperr
 
 import (
+	"encoding/json"
 	"log/slog"
 	"net/http"
 
-	"github.com/go-chi/render"
 	"github.com/vaintrub/go-ddd-template/internal/common/errors"
 )
 
+// ProblemContentType is the content type of error responses, as defined in RFC 7807.
+const ProblemContentType = "application/problem+json"
+
 func InternalError(slug string, err error, w http.ResponseWriter, r *http.Request) {
-	httpRespondWithError(err, slug, w, r, "Internal server error", http.StatusInternalServerError)
+	httpRespondWithError(err, slug, nil, w, r, "Internal server error", http.StatusInternalServerError)
 }
 
 func Unauthorised(slug string, err error, w http.ResponseWriter, r *http.Request) {
-	httpRespondWithError(err, slug, w, r, "Unauthorised", http.StatusUnauthorized)
+	httpRespondWithError(err, slug, nil, w, r, "Unauthorised", http.StatusUnauthorized)
 }
 
 func BadRequest(slug string, err error, w http.ResponseWriter, r *http.Request) {
-	httpRespondWithError(err, slug, w, r, "Bad request", http.StatusBadRequest)
+	httpRespondWithError(err, slug, nil, w, r, "Bad request", http.StatusBadRequest)
+}
+
+func PreconditionFailed(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, nil, w, r, "Precondition failed", http.StatusPreconditionFailed)
+}
+
+func PreconditionRequired(slug string, err error, w http.ResponseWriter, r *http.Request) {
+	httpRespondWithError(err, slug, nil, w, r, "Precondition required", http.StatusPreconditionRequired)
 }
 
 func RespondWithSlugError(err error, w http.ResponseWriter, r *http.Request) {
-	slugError, ok := err.(errors.SlugError)
+	slugError, ok := errors.Classify(err)
 	if !ok {
 		InternalError("internal-server-error", err, w, r)
 		return
 	}
 
-	switch slugError.ErrorType() {
+	status, logMsg := statusForErrorType(slugError.ErrorType())
+	httpRespondWithError(err, slugError.Slug(), slugError.Details(), w, r, logMsg, status)
+}
+
+func statusForErrorType(errorType errors.ErrorType) (int, string) {
+	switch errorType {
 	case errors.ErrorTypeAuthorization:
-		Unauthorised(slugError.Slug(), slugError, w, r)
+		return http.StatusUnauthorized, "Unauthorised"
 	case errors.ErrorTypeIncorrectInput:
-		BadRequest(slugError.Slug(), slugError, w, r)
+		return http.StatusBadRequest, "Bad request"
+	case errors.ErrorTypeNotFound:
+		return http.StatusNotFound, "Not found"
+	case errors.ErrorTypeForbidden:
+		return http.StatusForbidden, "Forbidden"
+	case errors.ErrorTypeConflict:
+		return http.StatusConflict, "Conflict"
+	case errors.ErrorTypePreconditionFailed:
+		return http.StatusPreconditionFailed, "Precondition failed"
 	default:
-		InternalError(slugError.Slug(), slugError, w, r)
+		return http.StatusInternalServerError, "Internal server error"
 	}
 }
 
-func httpRespondWithError(err error, slug string, w http.ResponseWriter, r *http.Request, logMSg string, status int) {
+func httpRespondWithError(
+	err error,
+	slug string,
+	details map[string]any,
+	w http.ResponseWriter,
+	r *http.Request,
+	logMSg string,
+	status int,
+) {
 	// Use slog for error logging with context
 	// Log as ERROR for 5xx, WARN for 4xx
 	if status >= 500 {
@@ -51,19 +83,42 @@ func httpRespondWithError(err error, slug string, w http.ResponseWriter, r *http
 			slog.String("error-slug", slug),
 		)
 	}
-	resp := ErrorResponse{slug, status}
 
-	if err := render.Render(w, r, resp); err != nil {
-		panic(err)
+	// internal errors may contain details of the infrastructure, they are only logged
+	message := http.StatusText(status)
+	if status < 500 && err != nil {
+		message = err.Error()
 	}
-}
 
-type ErrorResponse struct {
-	Slug       string `json:"slug"`
-	httpStatus int
+	resp := ErrorResponse{
+		Type:     "about:blank",
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   message,
+		Instance: r.URL.Path,
+		Slug:     slug,
+		Message:  message,
+		Details:  details,
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		slog.ErrorContext(r.Context(), "Failed to write error response", slog.Any("error", err))
+	}
 }
 
-func (e ErrorResponse) Render(w http.ResponseWriter, r *http.Request) error {
-	w.WriteHeader(e.httpStatus)
-	return nil
+// ErrorResponse is a problem details object (RFC 7807), extended with the error slug
+// which clients can rely on to handle the error.
+type ErrorResponse struct {
+	Type     string         `json:"type"`
+	Title    string         `json:"title"`
+	Status   int            `json:"status"`
+	Detail   string         `json:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty"`
+	Slug     string         `json:"slug"`
+	Message  string         `json:"message,omitempty"`
+	Details  map[string]any `json:"details,omitempty"`
 }
@@ -0,0 +1,140 @@
+package httperr_test
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/common/server/httperr"
+)
+
+type trainingNotFoundError struct{}
+
+func (trainingNotFoundError) Error() string  { return "training not found" }
+func (trainingNotFoundError) NotFound() bool { return true }
+func (trainingNotFoundError) Slug() string   { return "training-not-found" }
+
+func TestRespondWithSlugError_status(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		Err            error
+		ExpectedStatus int
+		ExpectedSlug   string
+	}{
+		{
+			Name:           "incorrect_input",
+			Err:            errors.NewIncorrectInputError("invalid hour", "invalid-hour"),
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedSlug:   "invalid-hour",
+		},
+		{
+			Name:           "authorization",
+			Err:            errors.NewAuthorizationError("no user", "no-user"),
+			ExpectedStatus: http.StatusUnauthorized,
+			ExpectedSlug:   "no-user",
+		},
+		{
+			Name:           "forbidden",
+			Err:            errors.NewForbiddenError("can't see training", "forbidden-to-see-training"),
+			ExpectedStatus: http.StatusForbidden,
+			ExpectedSlug:   "forbidden-to-see-training",
+		},
+		{
+			Name:           "domain_not_found",
+			Err:            trainingNotFoundError{},
+			ExpectedStatus: http.StatusNotFound,
+			ExpectedSlug:   "training-not-found",
+		},
+		{
+			Name:           "conflict",
+			Err:            errors.NewConflictError("already exists", "already-exists"),
+			ExpectedStatus: http.StatusConflict,
+			ExpectedSlug:   "already-exists",
+		},
+		{
+			Name:           "precondition_failed",
+			Err:            errors.NewPreconditionFailedError("modified", "training-modified"),
+			ExpectedStatus: http.StatusPreconditionFailed,
+			ExpectedSlug:   "training-modified",
+		},
+		{
+			Name:           "unknown_slug_error",
+			Err:            errors.NewSlugError("db is down", "update-failed"),
+			ExpectedStatus: http.StatusInternalServerError,
+			ExpectedSlug:   "update-failed",
+		},
+		{
+			Name:           "not_classified",
+			Err:            stderrors.New("db is down"),
+			ExpectedStatus: http.StatusInternalServerError,
+			ExpectedSlug:   "internal-server-error",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			httperr.RespondWithSlugError(tc.Err, w, httptest.NewRequest(http.MethodGet, "/trainings", nil))
+
+			assert.Equal(t, tc.ExpectedStatus, w.Code)
+
+			resp := decodeProblem(t, w)
+			assert.Equal(t, tc.ExpectedStatus, resp.Status)
+			assert.Equal(t, tc.ExpectedSlug, resp.Slug)
+		})
+	}
+}
+
+func TestRespondWithSlugError_problem_json(t *testing.T) {
+	t.Parallel()
+
+	err := errors.NewPreconditionFailedError("training was modified", "training-modified").
+		WithDetail("currentVersion", 2)
+
+	w := httptest.NewRecorder()
+	httperr.RespondWithSlugError(err, w, httptest.NewRequest(http.MethodPut, "/trainings/123/cancel", nil))
+
+	assert.Equal(t, httperr.ProblemContentType, w.Header().Get("Content-Type"))
+
+	resp := decodeProblem(t, w)
+	assert.Equal(t, httperr.ErrorResponse{
+		Type:     "about:blank",
+		Title:    "Precondition Failed",
+		Status:   http.StatusPreconditionFailed,
+		Detail:   "training was modified",
+		Instance: "/trainings/123/cancel",
+		Slug:     "training-modified",
+		Message:  "training was modified",
+		Details:  map[string]any{"currentVersion": float64(2)},
+	}, resp)
+}
+
+func TestRespondWithSlugError_hides_internal_message(t *testing.T) {
+	t.Parallel()
+
+	w := httptest.NewRecorder()
+	httperr.RespondWithSlugError(stderrors.New("dial tcp 10.0.0.1:5432: connection refused"), w, httptest.NewRequest(http.MethodGet, "/trainings", nil))
+
+	resp := decodeProblem(t, w)
+	assert.Equal(t, "Internal Server Error", resp.Message)
+	assert.NotContains(t, w.Body.String(), "10.0.0.1")
+}
+
+func decodeProblem(t *testing.T, w *httptest.ResponseRecorder) httperr.ErrorResponse {
+	t.Helper()
+
+	var resp httperr.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+	return resp
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// RunJob calls job every interval until ctx is canceled.
+// A failed run is logged and doesn't stop the next ones.
+func RunJob(ctx context.Context, name string, interval time.Duration, logger *slog.Logger, job func(ctx context.Context) error) {
+	logger = logger.With(slog.String("job", name))
+	logger.InfoContext(ctx, "Starting job", slog.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.InfoContext(ctx, "Job stopped")
+			return
+		case <-ticker.C:
+			if err := job(ctx); err != nil {
+				logger.ErrorContext(ctx, "Job run failed", slog.Any("error", err))
+			}
+		}
+	}
+}
@@ -137,8 +137,28 @@ func (c TrainingsHTTPClient) GetTrainings(t *testing.T) trainings.Trainings {
 	return *response.JSON200
 }
 
+// GetTrainingETag returns the ETag of the training, to be used as If-Match of its changes.
+func (c TrainingsHTTPClient) GetTrainingETag(t *testing.T, trainingUUID string) string {
+	response, err := c.client.GetTrainingWithResponse(context.Background(), uuid.MustParse(trainingUUID))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, response.StatusCode())
+
+	return response.HTTPResponse.Header.Get("ETag")
+}
+
+// CancelTraining cancels the training in its current version.
 func (c TrainingsHTTPClient) CancelTraining(t *testing.T, trainingUUID string, expectedStatusCode int) {
-	response, err := c.client.CancelTraining(context.Background(), uuid.MustParse(trainingUUID))
+	c.CancelTrainingIfMatch(t, trainingUUID, c.GetTrainingETag(t, trainingUUID), expectedStatusCode)
+}
+
+// CancelTrainingIfMatch cancels the training only if it's still in the version of the given ETag.
+func (c TrainingsHTTPClient) CancelTrainingIfMatch(t *testing.T, trainingUUID string, eTag string, expectedStatusCode int) {
+	params := &trainings.CancelTrainingParams{}
+	if eTag != "" {
+		params.IfMatch = &eTag
+	}
+
+	response, err := c.client.CancelTraining(context.Background(), uuid.MustParse(trainingUUID), params)
 	require.NoError(t, err)
 	require.NoError(t, response.Body.Close())
 
@@ -25,6 +25,17 @@ func FakeTrainerJWT(t *testing.T, userID string) string {
 	})
 }
 
+// FakeAttendeeSessionJWT returns the attendee's token for the session, tokens of one session share the sid claim.
+func FakeAttendeeSessionJWT(t *testing.T, userID string, sessionID string) string {
+	return fakeJWT(t, jwt.MapClaims{
+		"user_uuid": userID,
+		"email":     "attendee@threedots.tech",
+		"role":      "attendee",
+		"name":      "Attendee",
+		"sid":       sessionID,
+	})
+}
+
 func fakeJWT(t *testing.T, claims jwt.MapClaims) string {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,7 +15,7 @@ import (
 	"github.com/testcontainers/testcontainers-go/modules/postgres"
 )
 
-// StartPostgresContainer launches a disposable PostgreSQL 14 instance and applies the initial schema.
+// StartPostgresContainer launches a disposable PostgreSQL 14 instance and applies all up migrations.
 // It returns a connection string and a termination function.
 func StartPostgresContainer(ctx context.Context) (string, func(context.Context) error, error) {
 	if err := ensureDockerAccessible(); err != nil {
@@ -37,13 +38,13 @@ func StartPostgresContainer(ctx context.Context) (string, func(context.Context)
 		return "", nil, fmt.Errorf("postgres connection string: %w", err)
 	}
 
-	schema, err := loadInitialSchema()
+	migrations, err := loadMigrations()
 	if err != nil {
 		_ = container.Terminate(ctx)
 		return "", nil, fmt.Errorf("load migrations: %w", err)
 	}
 
-	if err := applyMigrations(ctx, connString, schema); err != nil {
+	if err := applyMigrations(ctx, connString, migrations); err != nil {
 		_ = container.Terminate(ctx)
 		return "", nil, fmt.Errorf("apply migrations: %w", err)
 	}
@@ -53,22 +54,33 @@ func StartPostgresContainer(ctx context.Context) (string, func(context.Context)
 	}, nil
 }
 
-func loadInitialSchema() (string, error) {
+// loadMigrations returns the up migrations in the order they are applied.
+func loadMigrations() ([]string, error) {
 	_, file, _, ok := runtime.Caller(0)
 	if !ok {
-		return "", fmt.Errorf("unable to determine caller")
+		return nil, fmt.Errorf("unable to determine caller")
 	}
 
-	path := filepath.Join(filepath.Dir(file), "..", "..", "..", "migrations", "001_initial_schema.up.sql")
-	data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed from known constants
+	paths, err := filepath.Glob(filepath.Join(filepath.Dir(file), "..", "..", "..", "migrations", "*.up.sql"))
 	if err != nil {
-		return "", fmt.Errorf("read migration file: %w", err)
+		return nil, fmt.Errorf("list migration files: %w", err)
+	}
+	// migration files are prefixed with zero-padded numbers, so sorting by name sorts them by version
+	sort.Strings(paths)
+
+	migrations := make([]string, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path) //nolint:gosec // G304: path is constructed from known constants
+		if err != nil {
+			return nil, fmt.Errorf("read migration file: %w", err)
+		}
+		migrations = append(migrations, string(data))
 	}
 
-	return string(data), nil
+	return migrations, nil
 }
 
-func applyMigrations(ctx context.Context, connString string, schema string) error {
+func applyMigrations(ctx context.Context, connString string, migrations []string) error {
 	cfg, err := pgxpool.ParseConfig(connString)
 	if err != nil {
 		return fmt.Errorf("parse postgres config: %w", err)
@@ -79,9 +91,9 @@ func applyMigrations(ctx context.Context, connString string, schema string) erro
 	}
 	defer pool.Close()
 
-	statements := splitStatements(schema)
-	for _, stmt := range statements {
-		if _, err := pool.Exec(ctx, stmt); err != nil {
+	// without arguments the whole file is sent with the simple protocol, so it can contain more statements
+	for _, migration := range migrations {
+		if _, err := pool.Exec(ctx, migration); err != nil {
 			return fmt.Errorf("execute migration: %w", err)
 		}
 	}
@@ -89,32 +101,6 @@ func applyMigrations(ctx context.Context, connString string, schema string) erro
 	return nil
 }
 
-func splitStatements(sql string) []string {
-	parts := strings.Split(sql, ";")
-	var statements []string
-
-	for _, part := range parts {
-		lines := strings.Split(part, "\n")
-		var cleaned []string
-		for _, line := range lines {
-			line = strings.TrimSpace(line)
-			if line == "" || strings.HasPrefix(line, "--") {
-				continue
-			}
-			cleaned = append(cleaned, line)
-		}
-
-		stmt := strings.TrimSpace(strings.Join(cleaned, "\n"))
-		if stmt == "" {
-			continue
-		}
-
-		statements = append(statements, stmt)
-	}
-
-	return statements
-}
-
 func ensureDockerAccessible() error {
 	candidates := []string{
 		extractUnixPath(os.Getenv("DOCKER_HOST")),
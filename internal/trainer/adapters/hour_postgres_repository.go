@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/vaintrub/go-ddd-template/internal/common/db"
@@ -53,7 +54,12 @@ func (r *HourPostgresRepository) GetHour(ctx context.Context, hourTime time.Time
 
 	dbHour, err := queries.GetHourByTime(ctx, hourTime)
 	if err != nil {
-		return nil, db.TranslatePgError(err)
+		translatedErr := db.TranslatePgError(err)
+		// Hours which were never set are not available, the same as in MemoryHourRepository
+		if db.IsNotFound(translatedErr) {
+			return r.factory.NewNotAvailableHour(hourTime)
+		}
+		return nil, translatedErr
 	}
 
 	// Convert database availability string to domain Availability
@@ -63,7 +69,12 @@ func (r *HourPostgresRepository) GetHour(ctx context.Context, hourTime time.Time
 	}
 
 	// Unmarshal from database using factory
-	domainHour, err := r.factory.UnmarshalHourFromDatabase(dbHour.HourTime, availability)
+	domainHour, err := r.factory.UnmarshalHourFromDatabase(
+		dbHour.HourTime,
+		availability,
+		attendeeUUIDFromDB(dbHour.AttendeeID),
+		trainingDurationFromDB(dbHour.TrainingDurationMinutes),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal hour from database: %w", err)
 	}
@@ -121,7 +132,12 @@ func (r *HourPostgresRepository) UpdateHour(
 		return fmt.Errorf("invalid availability in database: %w", err)
 	}
 
-	currentHour, err := r.factory.UnmarshalHourFromDatabase(dbHour.HourTime, availability)
+	currentHour, err := r.factory.UnmarshalHourFromDatabase(
+		dbHour.HourTime,
+		availability,
+		attendeeUUIDFromDB(dbHour.AttendeeID),
+		trainingDurationFromDB(dbHour.TrainingDurationMinutes),
+	)
 	if err != nil {
 		return fmt.Errorf("failed to unmarshal hour from database: %w", err)
 	}
@@ -132,8 +148,19 @@ func (r *HourPostgresRepository) UpdateHour(
 		return err
 	}
 
+	attendeeID, err := attendeeUUIDToDB(updatedHour.AttendeeUUID())
+	if err != nil {
+		return err
+	}
+
 	// Update availability within transaction
-	err = queries.UpdateHourAvailability(ctx, dbHour.ID, updatedHour.Availability().String())
+	err = queries.UpdateHourBooking(
+		ctx,
+		dbHour.ID,
+		updatedHour.Availability().String(),
+		attendeeID,
+		trainingDurationToDB(updatedHour.TrainingDuration()),
+	)
 	if err != nil {
 		return db.TranslatePgError(err)
 	}
@@ -236,10 +263,21 @@ func (r *HourPostgresRepository) AvailableHours(ctx context.Context, from time.T
 		isAvailable := h.Availability == "available"
 		hasTraining := h.Availability == "training_scheduled"
 
+		var trainingDuration time.Duration
+		if hasTraining {
+			trainingDuration = trainingDurationFromDB(h.TrainingDurationMinutes)
+			if trainingDuration == 0 {
+				// trainings scheduled before their duration was known took the whole hour
+				trainingDuration = time.Hour
+			}
+		}
+
 		date.Hours = append(date.Hours, query.Hour{
 			Available:            isAvailable,
 			HasTrainingScheduled: hasTraining,
 			Hour:                 h.HourTime,
+			TrainingDuration:     trainingDuration,
+			AttendeeUUID:         attendeeUUIDFromDB(h.AttendeeID),
 		})
 
 		// Update HasFreeHours if this hour is available
@@ -256,3 +294,37 @@ func (r *HourPostgresRepository) AvailableHours(ctx context.Context, from time.T
 
 	return dates, nil
 }
+
+func attendeeUUIDFromDB(attendeeID pgtype.UUID) string {
+	if !attendeeID.Valid {
+		return ""
+	}
+	return db.PgtypeToUUID(attendeeID).String()
+}
+
+func attendeeUUIDToDB(attendeeUUID string) (pgtype.UUID, error) {
+	if attendeeUUID == "" {
+		return pgtype.UUID{}, nil
+	}
+
+	attendeeID, err := db.StringToPgtypeUUID(attendeeUUID)
+	if err != nil {
+		return pgtype.UUID{}, fmt.Errorf("invalid attendee UUID: %w", err)
+	}
+	return attendeeID, nil
+}
+
+func trainingDurationFromDB(minutes *int32) time.Duration {
+	if minutes == nil {
+		return 0
+	}
+	return time.Duration(*minutes) * time.Minute
+}
+
+func trainingDurationToDB(duration time.Duration) *int32 {
+	if duration == 0 {
+		return nil
+	}
+	minutes := int32(duration / time.Minute)
+	return &minutes
+}
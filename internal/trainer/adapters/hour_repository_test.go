@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vaintrub/go-ddd-template/internal/common/config"
@@ -127,7 +128,16 @@ func testUpdateHour(t *testing.T, repository hour.Repository) {
 			Name: "hour_with_training",
 			CreateHour: func(t *testing.T) *hour.Hour {
 				h := newValidAvailableHour(t)
-				require.NoError(t, h.ScheduleTraining())
+				require.NoError(t, h.ScheduleTraining(uuid.New().String(), time.Hour))
+
+				return h
+			},
+		},
+		{
+			Name: "hour_with_shorter_training",
+			CreateHour: func(t *testing.T) *hour.Hour {
+				h := newValidAvailableHour(t)
+				require.NoError(t, h.ScheduleTraining(uuid.New().String(), 45*time.Minute))
 
 				return h
 			},
@@ -194,7 +204,7 @@ func testUpdateHour_parallel(t *testing.T, repository hour.Repository) {
 					return h, nil
 				}
 				// training is not scheduled yet, so let's try to do that
-				if err := h.ScheduleTraining(); err != nil {
+				if err := h.ScheduleTraining(uuid.New().String(), time.Hour); err != nil {
 					return nil, err
 				}
 
@@ -266,7 +276,7 @@ func testHourRepository_update_existing(t *testing.T, repository hour.Repository
 
 	var expectedHour *hour.Hour
 	err = repository.UpdateHour(ctx, testHour.Time(), func(h *hour.Hour) (*hour.Hour, error) {
-		if err := h.ScheduleTraining(); err != nil {
+		if err := h.ScheduleTraining(uuid.New().String(), time.Hour); err != nil {
 			return nil, err
 		}
 		expectedHour = h
@@ -21,6 +21,7 @@ type Querier interface {
 	ListHours(ctx context.Context, createdAt time.Time, iD pgtype.UUID, limit int32) ([]TrainerHour, error)
 	ListHoursByTimeRange(ctx context.Context, hourTime time.Time, hourTime_2 time.Time) ([]TrainerHour, error)
 	UpdateHourAvailability(ctx context.Context, iD pgtype.UUID, availability string) error
+	UpdateHourBooking(ctx context.Context, iD pgtype.UUID, availability string, attendeeID pgtype.UUID, trainingDurationMinutes *int32) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -22,7 +22,7 @@ INSERT INTO trainer_hours (
     updated_at
 ) VALUES (
     $1, $2, $3, NOW(), NOW()
-) RETURNING id, hour_time, availability, created_at, updated_at
+) RETURNING id, hour_time, availability, created_at, updated_at, attendee_id, training_duration_minutes
 `
 
 // Trainer Context Queries
@@ -36,6 +36,8 @@ func (q *Queries) CreateHour(ctx context.Context, iD pgtype.UUID, hourTime time.
 		&i.Availability,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AttendeeID,
+		&i.TrainingDurationMinutes,
 	)
 	return i, err
 }
@@ -51,7 +53,7 @@ func (q *Queries) DeleteHour(ctx context.Context, id pgtype.UUID) error {
 }
 
 const getHour = `-- name: GetHour :one
-SELECT id, hour_time, availability, created_at, updated_at FROM trainer_hours
+SELECT id, hour_time, availability, created_at, updated_at, attendee_id, training_duration_minutes FROM trainer_hours
 WHERE id = $1
 `
 
@@ -64,12 +66,14 @@ func (q *Queries) GetHour(ctx context.Context, id pgtype.UUID) (TrainerHour, err
 		&i.Availability,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AttendeeID,
+		&i.TrainingDurationMinutes,
 	)
 	return i, err
 }
 
 const getHourByTime = `-- name: GetHourByTime :one
-SELECT id, hour_time, availability, created_at, updated_at FROM trainer_hours
+SELECT id, hour_time, availability, created_at, updated_at, attendee_id, training_duration_minutes FROM trainer_hours
 WHERE hour_time = $1
 FOR UPDATE
 `
@@ -83,12 +87,14 @@ func (q *Queries) GetHourByTime(ctx context.Context, hourTime time.Time) (Traine
 		&i.Availability,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AttendeeID,
+		&i.TrainingDurationMinutes,
 	)
 	return i, err
 }
 
 const listHours = `-- name: ListHours :many
-SELECT id, hour_time, availability, created_at, updated_at FROM trainer_hours
+SELECT id, hour_time, availability, created_at, updated_at, attendee_id, training_duration_minutes FROM trainer_hours
 WHERE (created_at > $1 OR $1 IS NULL)
   AND (id > $2 OR $2 IS NULL)
 ORDER BY created_at, id
@@ -110,6 +116,8 @@ func (q *Queries) ListHours(ctx context.Context, createdAt time.Time, iD pgtype.
 			&i.Availability,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.AttendeeID,
+			&i.TrainingDurationMinutes,
 		); err != nil {
 			return nil, err
 		}
@@ -122,7 +130,7 @@ func (q *Queries) ListHours(ctx context.Context, createdAt time.Time, iD pgtype.
 }
 
 const listHoursByTimeRange = `-- name: ListHoursByTimeRange :many
-SELECT id, hour_time, availability, created_at, updated_at FROM trainer_hours
+SELECT id, hour_time, availability, created_at, updated_at, attendee_id, training_duration_minutes FROM trainer_hours
 WHERE hour_time BETWEEN $1 AND $2
 ORDER BY hour_time
 `
@@ -142,6 +150,8 @@ func (q *Queries) ListHoursByTimeRange(ctx context.Context, hourTime time.Time,
 			&i.Availability,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.AttendeeID,
+			&i.TrainingDurationMinutes,
 		); err != nil {
 			return nil, err
 		}
@@ -165,3 +175,23 @@ func (q *Queries) UpdateHourAvailability(ctx context.Context, iD pgtype.UUID, av
 	_, err := q.db.Exec(ctx, updateHourAvailability, iD, availability)
 	return err
 }
+
+const updateHourBooking = `-- name: UpdateHourBooking :exec
+UPDATE trainer_hours
+SET
+    availability = $2,
+    attendee_id = $3,
+    training_duration_minutes = $4,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) UpdateHourBooking(ctx context.Context, iD pgtype.UUID, availability string, attendeeID pgtype.UUID, trainingDurationMinutes *int32) error {
+	_, err := q.db.Exec(ctx, updateHourBooking,
+		iD,
+		availability,
+		attendeeID,
+		trainingDurationMinutes,
+	)
+	return err
+}
@@ -0,0 +1,56 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/genproto/users"
+	"github.com/vaintrub/go-ddd-template/internal/trainer/app/query"
+)
+
+// batchGetUsersLimit is the maximum number of users the users service returns from one BatchGetUsers call.
+const batchGetUsersLimit = 500
+
+type UsersGrpc struct {
+	client users.UsersServiceClient
+}
+
+func NewUsersGrpc(client users.UsersServiceClient) UsersGrpc {
+	return UsersGrpc{client: client}
+}
+
+// UserNames returns the current names of the users, users which don't exist are left out.
+func (s UsersGrpc) UserNames(ctx context.Context, userIDs []string) (map[string]string, error) {
+	names := make(map[string]string, len(userIDs))
+
+	for start := 0; start < len(userIDs); start += batchGetUsersLimit {
+		end := min(start+batchGetUsersLimit, len(userIDs))
+
+		resp, err := s.client.BatchGetUsers(ctx, &users.BatchGetUsersRequest{
+			UserIds: userIDs[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range resp.Users {
+			names[user.UserId] = user.DisplayName
+		}
+	}
+
+	return names, nil
+}
+
+// TrainerRoster returns UUIDs of the attendees assigned to the trainer and of those assigned only to other trainers.
+func (s UsersGrpc) TrainerRoster(ctx context.Context, trainerUUID string) (query.Roster, error) {
+	resp, err := s.client.ListTrainerClients(ctx, &users.ListTrainerClientsRequest{
+		TrainerId: trainerUUID,
+	})
+	if err != nil {
+		return query.Roster{}, err
+	}
+
+	return query.Roster{
+		ClientUUIDs:              resp.ClientIds,
+		OtherTrainersClientUUIDs: resp.OtherTrainersClientIds,
+	}, nil
+}
@@ -13,6 +13,11 @@ import (
 
 type ScheduleTraining struct {
 	Hour time.Time
+
+	// AttendeeUUID is the attendee who booked the training, it's optional.
+	AttendeeUUID string
+	// Duration is the length of the training, the whole hour is reserved when it's zero.
+	Duration time.Duration
 }
 
 type ScheduleTrainingHandler decorator.CommandHandler[ScheduleTraining]
@@ -39,7 +44,7 @@ func NewScheduleTrainingHandler(
 
 func (h scheduleTrainingHandler) Handle(ctx context.Context, cmd ScheduleTraining) error {
 	if err := h.hourRepo.UpdateHour(ctx, cmd.Hour, func(h *hour.Hour) (*hour.Hour, error) {
-		if err := h.ScheduleTraining(); err != nil {
+		if err := h.ScheduleTraining(cmd.AttendeeUUID, cmd.Duration); err != nil {
 			return nil, errors.NewIncorrectInputError(err.Error(), "schedule-training-failed")
 		}
 		return h, nil
@@ -2,15 +2,19 @@ package query
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"log/slog"
 
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
 	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
 	"github.com/vaintrub/go-ddd-template/internal/common/errors"
 )
 
 type AvailableHours struct {
+	User auth.User
+
 	From time.Time
 	To   time.Time
 }
@@ -21,17 +25,41 @@ type AvailableHoursReadModel interface {
 	AvailableHours(ctx context.Context, from time.Time, to time.Time) ([]Date, error)
 }
 
+// UserNames looks up the current names of users in the users service.
+type UserNames interface {
+	// UserNames returns the names of the users by their UUIDs, users which don't exist are left out.
+	UserNames(ctx context.Context, userUUIDs []string) (map[string]string, error)
+}
+
+// TrainerClients looks up the attendees assigned to trainers in the users service.
+type TrainerClients interface {
+	// TrainerRoster returns the clients of the trainer and the attendees assigned only to other trainers.
+	TrainerRoster(ctx context.Context, trainerUUID string) (Roster, error)
+}
+
 type availableHoursHandler struct {
 	readModel AvailableHoursReadModel
+	users     UserNames
+	clients   TrainerClients
+	logger    *slog.Logger
 }
 
 func NewAvailableHoursHandler(
 	readModel AvailableHoursReadModel,
+	users UserNames,
+	clients TrainerClients,
 	logger *slog.Logger,
 	metricsClient decorator.MetricsClient,
 ) AvailableHoursHandler {
+	if users == nil {
+		panic("nil users")
+	}
+	if clients == nil {
+		panic("nil clients")
+	}
+
 	return decorator.ApplyQueryDecorators[AvailableHours, []Date](
-		availableHoursHandler{readModel: readModel},
+		availableHoursHandler{readModel: readModel, users: users, clients: clients, logger: logger},
 		logger,
 		metricsClient,
 	)
@@ -42,5 +70,82 @@ func (h availableHoursHandler) Handle(ctx context.Context, query AvailableHours)
 		return nil, errors.NewIncorrectInputError("date-from-after-date-to", "Date from after date to")
 	}
 
-	return h.readModel.AvailableHours(ctx, query.From, query.To)
+	dates, err := h.readModel.AvailableHours(ctx, query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the trainer can see who booked the hours
+	if query.User.Role != "trainer" {
+		hideAttendees(dates, func(string) bool { return false })
+		return dates, nil
+	}
+
+	// the same rule as in the trainings service: trainers see trainings of their clients and of attendees without a trainer
+	roster, err := h.clients.TrainerRoster(ctx, query.User.UUID)
+	if err != nil {
+		return nil, err
+	}
+	hideAttendees(dates, roster.CanSeeTrainingsOf)
+
+	h.addAttendeeNames(ctx, dates)
+
+	return dates, nil
+}
+
+// hideAttendees clears the attendees of the hours booked by attendees which the user can't see.
+func hideAttendees(dates []Date, canSee func(attendeeUUID string) bool) {
+	for i := range dates {
+		for j := range dates[i].Hours {
+			if !canSee(dates[i].Hours[j].AttendeeUUID) {
+				dates[i].Hours[j].AttendeeUUID = ""
+			}
+		}
+	}
+}
+
+// CanSeeTrainingsOf checks if the trainer can see trainings of the attendee,
+// which is their client or is not assigned to any trainer.
+func (r Roster) CanSeeTrainingsOf(attendeeUUID string) bool {
+	if slices.Contains(r.ClientUUIDs, attendeeUUID) {
+		return true
+	}
+
+	return !slices.Contains(r.OtherTrainersClientUUIDs, attendeeUUID)
+}
+
+// addAttendeeNames sets the current names of the attendees who booked the hours.
+// When the users service can't be reached, the hours are returned without the names.
+func (h availableHoursHandler) addAttendeeNames(ctx context.Context, dates []Date) {
+	var attendeeUUIDs []string
+	seen := map[string]struct{}{}
+	for _, date := range dates {
+		for _, hour := range date.Hours {
+			if hour.AttendeeUUID == "" {
+				continue
+			}
+			if _, ok := seen[hour.AttendeeUUID]; ok {
+				continue
+			}
+			seen[hour.AttendeeUUID] = struct{}{}
+			attendeeUUIDs = append(attendeeUUIDs, hour.AttendeeUUID)
+		}
+	}
+	if len(attendeeUUIDs) == 0 {
+		return
+	}
+
+	names, err := h.users.UserNames(ctx, attendeeUUIDs)
+	if err != nil {
+		h.logger.WarnContext(ctx, "Unable to get names of attendees, hours are shown without them",
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	for i := range dates {
+		for j := range dates[i].Hours {
+			dates[i].Hours[j].AttendeeName = names[dates[i].Hours[j].AttendeeUUID]
+		}
+	}
 }
@@ -0,0 +1,130 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainer/app/query"
+)
+
+func TestAvailableHours_attendees(t *testing.T) {
+	t.Parallel()
+
+	trainerUUID := uuid.New().String()
+	clientUUID := uuid.New().String()
+	otherTrainersClientUUID := uuid.New().String()
+	unassignedAttendeeUUID := uuid.New().String()
+
+	day := time.Now().AddDate(0, 0, 1).Truncate(24 * time.Hour)
+	readModel := availableHoursReadModelMock{
+		{
+			Date: day,
+			Hours: []query.Hour{
+				{Hour: day.Add(12 * time.Hour), HasTrainingScheduled: true, AttendeeUUID: clientUUID},
+				{Hour: day.Add(13 * time.Hour), HasTrainingScheduled: true, AttendeeUUID: otherTrainersClientUUID},
+				{Hour: day.Add(14 * time.Hour), HasTrainingScheduled: true, AttendeeUUID: unassignedAttendeeUUID},
+			},
+		},
+	}
+	names := userNamesMock{
+		clientUUID:              "Client",
+		otherTrainersClientUUID: "Other Trainer's Client",
+		unassignedAttendeeUUID:  "Unassigned Attendee",
+	}
+	clients := trainerClientsMock{
+		trainerUUID: {
+			ClientUUIDs:              []string{clientUUID},
+			OtherTrainersClientUUIDs: []string{otherTrainersClientUUID},
+		},
+	}
+
+	handler := query.NewAvailableHoursHandler(readModel, names, clients, slog.Default(), metrics.NoOp{})
+
+	dates, err := handler.Handle(context.Background(), query.AvailableHours{
+		User: auth.User{UUID: trainerUUID, Role: "trainer"},
+		From: day,
+		To:   day.AddDate(0, 0, 1),
+	})
+	require.NoError(t, err)
+	require.Len(t, dates, 1)
+
+	hours := dates[0].Hours
+	require.Len(t, hours, 3)
+
+	assert.Equal(t, clientUUID, hours[0].AttendeeUUID)
+	assert.Equal(t, "Client", hours[0].AttendeeName)
+
+	// the hour is still shown as booked, but not by whom
+	assert.True(t, hours[1].HasTrainingScheduled)
+	assert.Empty(t, hours[1].AttendeeUUID)
+	assert.Empty(t, hours[1].AttendeeName)
+
+	assert.Equal(t, unassignedAttendeeUUID, hours[2].AttendeeUUID)
+	assert.Equal(t, "Unassigned Attendee", hours[2].AttendeeName)
+}
+
+func TestAvailableHours_attendees_hidden_from_attendee(t *testing.T) {
+	t.Parallel()
+
+	attendeeUUID := uuid.New().String()
+	day := time.Now().AddDate(0, 0, 1).Truncate(24 * time.Hour)
+	readModel := availableHoursReadModelMock{
+		{
+			Date: day,
+			Hours: []query.Hour{
+				{Hour: day.Add(12 * time.Hour), HasTrainingScheduled: true, AttendeeUUID: attendeeUUID},
+			},
+		},
+	}
+
+	handler := query.NewAvailableHoursHandler(readModel, userNamesMock{}, trainerClientsMock{}, slog.Default(), metrics.NoOp{})
+
+	dates, err := handler.Handle(context.Background(), query.AvailableHours{
+		User: auth.User{UUID: attendeeUUID, Role: "attendee"},
+		From: day,
+		To:   day.AddDate(0, 0, 1),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, dates[0].Hours, 1)
+	assert.Empty(t, dates[0].Hours[0].AttendeeUUID)
+}
+
+type availableHoursReadModelMock []query.Date
+
+func (m availableHoursReadModelMock) AvailableHours(ctx context.Context, from time.Time, to time.Time) ([]query.Date, error) {
+	dates := make([]query.Date, 0, len(m))
+	for _, date := range m {
+		date.Hours = append([]query.Hour(nil), date.Hours...)
+		dates = append(dates, date)
+	}
+
+	return dates, nil
+}
+
+type userNamesMock map[string]string
+
+func (m userNamesMock) UserNames(ctx context.Context, userUUIDs []string) (map[string]string, error) {
+	names := map[string]string{}
+	for _, userUUID := range userUUIDs {
+		if name, ok := m[userUUID]; ok {
+			names[userUUID] = name
+		}
+	}
+
+	return names, nil
+}
+
+type trainerClientsMock map[string]query.Roster
+
+func (m trainerClientsMock) TrainerRoster(ctx context.Context, trainerUUID string) (query.Roster, error) {
+	return m[trainerUUID], nil
+}
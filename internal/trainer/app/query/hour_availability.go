@@ -37,10 +37,14 @@ func NewHourAvailabilityHandler(
 }
 
 func (h hourAvailabilityHandler) Handle(ctx context.Context, query HourAvailability) (bool, error) {
-	hour, err := h.hourRepo.GetHour(ctx, query.Hour)
+	requestedHour, err := h.hourRepo.GetHour(ctx, query.Hour)
+	if hour.IsOutsideCalendarError(err) {
+		// hours which can't be set in the calendar can't be booked either
+		return false, nil
+	}
 	if err != nil {
 		return false, err
 	}
 
-	return hour.IsAvailable(), nil
+	return requestedHour.IsAvailable(), nil
 }
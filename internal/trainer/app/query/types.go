@@ -14,4 +14,20 @@ type Hour struct {
 	Available            bool
 	HasTrainingScheduled bool
 	Hour                 time.Time
+	// TrainingDuration is the length of the scheduled training, zero when no training is scheduled.
+	TrainingDuration time.Duration
+
+	// AttendeeUUID and AttendeeName tell who booked the hour,
+	// they are returned only to trainers who can see trainings of the attendee.
+	AttendeeUUID string
+	AttendeeName string
+}
+
+// Roster is the trainer's roster kept by the users service.
+type Roster struct {
+	// ClientUUIDs are the attendees assigned to the trainer.
+	ClientUUIDs []string
+	// OtherTrainersClientUUIDs are the attendees assigned only to other trainers.
+	// Attendees not assigned to any trainer are in neither of the lists.
+	OtherTrainersClientUUIDs []string
 }
@@ -1,6 +1,9 @@
 package hour
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
 	Available         = Availability{"available"}
@@ -43,9 +46,10 @@ func (h Availability) String() string {
 }
 
 var (
-	ErrTrainingScheduled   = errors.New("unable to modify hour, because scheduled training")
-	ErrNoTrainingScheduled = errors.New("training is not scheduled")
-	ErrHourNotAvailable    = errors.New("hour is not available")
+	ErrTrainingScheduled       = errors.New("unable to modify hour, because scheduled training")
+	ErrNoTrainingScheduled     = errors.New("training is not scheduled")
+	ErrHourNotAvailable        = errors.New("hour is not available")
+	ErrInvalidTrainingDuration = errors.New("training duration should be positive and at most an hour")
 )
 
 func (h Hour) Availability() Availability {
@@ -78,12 +82,33 @@ func (h *Hour) MakeAvailable() error {
 	return nil
 }
 
-func (h *Hour) ScheduleTraining() error {
+// AttendeeUUID returns the attendee the training is scheduled for.
+// It's empty when no training is scheduled, or when the attendee is not known.
+func (h Hour) AttendeeUUID() string {
+	return h.attendeeUUID
+}
+
+// TrainingDuration returns the length of the scheduled training, it's zero when no training is scheduled.
+func (h Hour) TrainingDuration() time.Duration {
+	return h.trainingDuration
+}
+
+// ScheduleTraining books the hour for the attendee, attendeeUUID is optional.
+// The training takes trainingDuration from the start of the hour, zero duration reserves the whole hour.
+func (h *Hour) ScheduleTraining(attendeeUUID string, trainingDuration time.Duration) error {
+	if trainingDuration < 0 || trainingDuration > time.Hour {
+		return ErrInvalidTrainingDuration
+	}
 	if !h.IsAvailable() {
 		return ErrHourNotAvailable
 	}
+	if trainingDuration == 0 {
+		trainingDuration = time.Hour
+	}
 
 	h.availability = TrainingScheduled
+	h.attendeeUUID = attendeeUUID
+	h.trainingDuration = trainingDuration
 	return nil
 }
 
@@ -93,5 +118,7 @@ func (h *Hour) CancelTraining() error {
 	}
 
 	h.availability = Available
+	h.attendeeUUID = ""
+	h.trainingDuration = 0
 	return nil
 }
@@ -2,6 +2,7 @@ package hour_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -47,16 +48,45 @@ func TestHour_ScheduleTraining(t *testing.T) {
 	h, err := testHourFactory.NewAvailableHour(validTrainingHour())
 	require.NoError(t, err)
 
-	require.NoError(t, h.ScheduleTraining())
+	require.NoError(t, h.ScheduleTraining("attendee-uuid", 45*time.Minute))
 
 	assert.True(t, h.HasTrainingScheduled())
 	assert.False(t, h.IsAvailable())
+	assert.Equal(t, "attendee-uuid", h.AttendeeUUID())
+	assert.Equal(t, 45*time.Minute, h.TrainingDuration())
+}
+
+func TestHour_ScheduleTraining_unknown_duration(t *testing.T) {
+	t.Parallel()
+	h, err := testHourFactory.NewAvailableHour(validTrainingHour())
+	require.NoError(t, err)
+
+	require.NoError(t, h.ScheduleTraining("attendee-uuid", 0))
+
+	assert.Equal(t, time.Hour, h.TrainingDuration())
+}
+
+func TestHour_ScheduleTraining_invalid_duration(t *testing.T) {
+	t.Parallel()
+	testCases := []time.Duration{-time.Minute, time.Hour + time.Minute}
+
+	for _, duration := range testCases {
+		duration := duration
+		t.Run(duration.String(), func(t *testing.T) {
+			t.Parallel()
+			h, err := testHourFactory.NewAvailableHour(validTrainingHour())
+			require.NoError(t, err)
+
+			assert.Equal(t, hour.ErrInvalidTrainingDuration, h.ScheduleTraining("attendee-uuid", duration))
+			assert.True(t, h.IsAvailable())
+		})
+	}
 }
 
 func TestHour_ScheduleTraining_with_not_available(t *testing.T) {
 	t.Parallel()
 	h := newNotAvailableHour(t)
-	assert.Equal(t, hour.ErrHourNotAvailable, h.ScheduleTraining())
+	assert.Equal(t, hour.ErrHourNotAvailable, h.ScheduleTraining("attendee-uuid", time.Hour))
 }
 
 func TestHour_CancelTraining(t *testing.T) {
@@ -67,6 +97,8 @@ func TestHour_CancelTraining(t *testing.T) {
 
 	assert.False(t, h.HasTrainingScheduled())
 	assert.True(t, h.IsAvailable())
+	assert.Empty(t, h.AttendeeUUID())
+	assert.Zero(t, h.TrainingDuration())
 }
 
 func TestHour_CancelTraining_no_training_scheduled(t *testing.T) {
@@ -110,7 +142,7 @@ func newHourWithScheduledTraining(t *testing.T) *hour.Hour {
 	h, err := testHourFactory.NewAvailableHour(validTrainingHour())
 	require.NoError(t, err)
 
-	require.NoError(t, h.ScheduleTraining())
+	require.NoError(t, h.ScheduleTraining("attendee-uuid", time.Hour))
 
 	return h
 }
@@ -10,6 +10,10 @@ type Hour struct {
 	hour time.Time
 
 	availability Availability
+	// attendeeUUID is the attendee the training is scheduled for, empty when it's not known.
+	attendeeUUID string
+	// trainingDuration is the length of the scheduled training, zero when no training is scheduled.
+	trainingDuration time.Duration
 }
 
 type FactoryConfig struct {
@@ -92,7 +96,12 @@ func (f Factory) NewNotAvailableHour(hour time.Time) (*Hour, error) {
 //
 // It should be used only for unmarshalling from the database!
 // You can't use UnmarshalHourFromDatabase as constructor - It may put domain into the invalid state!
-func (f Factory) UnmarshalHourFromDatabase(hour time.Time, availability Availability) (*Hour, error) {
+func (f Factory) UnmarshalHourFromDatabase(
+	hour time.Time,
+	availability Availability,
+	attendeeUUID string,
+	trainingDuration time.Duration,
+) (*Hour, error) {
 	if err := f.validateTime(hour); err != nil {
 		return nil, err
 	}
@@ -101,9 +110,16 @@ func (f Factory) UnmarshalHourFromDatabase(hour time.Time, availability Availabi
 		return nil, errors.New("empty availability")
 	}
 
+	if availability == TrainingScheduled && trainingDuration == 0 {
+		// trainings scheduled before their duration was known took the whole hour
+		trainingDuration = time.Hour
+	}
+
 	return &Hour{
-		hour:         hour,
-		availability: availability,
+		hour:             hour,
+		availability:     availability,
+		attendeeUUID:     attendeeUUID,
+		trainingDuration: trainingDuration,
 	}, nil
 }
 
@@ -154,6 +170,19 @@ func (e TooLateHourError) Error() string {
 	)
 }
 
+// IsOutsideCalendarError returns true when the hour can't be set in the calendar at all,
+// because it's in the past, too distant or outside working hours.
+func IsOutsideCalendarError(err error) bool {
+	var tooDistantErr TooDistantDateError
+	var tooEarlyErr TooEarlyHourError
+	var tooLateErr TooLateHourError
+
+	return errors.Is(err, ErrPastHour) ||
+		errors.As(err, &tooDistantErr) ||
+		errors.As(err, &tooEarlyErr) ||
+		errors.As(err, &tooLateErr)
+}
+
 func (f Factory) validateTime(hour time.Time) error {
 	if !hour.Round(time.Hour).Equal(hour) {
 		return ErrNotFullHour
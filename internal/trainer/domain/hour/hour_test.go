@@ -121,6 +121,61 @@ func TestNewAvailableHour_too_late_hour(t *testing.T) {
 	)
 }
 
+func TestIsOutsideCalendarError(t *testing.T) {
+	t.Parallel()
+	factory := hour.MustNewFactory(hour.FactoryConfig{
+		MaxWeeksInTheFutureToSet: 10,
+		MinUtcHour:               12,
+		MaxUtcHour:               18,
+	})
+
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	day := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name            string
+		Hour            time.Time
+		OutsideCalendar bool
+	}{
+		{
+			Name:            "past",
+			Hour:            time.Now().Truncate(time.Hour).Add(-time.Hour),
+			OutsideCalendar: true,
+		},
+		{
+			Name:            "too_early",
+			Hour:            day.Add(11 * time.Hour),
+			OutsideCalendar: true,
+		},
+		{
+			Name:            "too_late",
+			Hour:            day.Add(19 * time.Hour),
+			OutsideCalendar: true,
+		},
+		{
+			Name:            "too_distant",
+			Hour:            day.AddDate(0, 0, 11*7).Add(12 * time.Hour),
+			OutsideCalendar: true,
+		},
+		{
+			Name:            "not_full_hour",
+			Hour:            day.Add(12*time.Hour + time.Minute),
+			OutsideCalendar: false,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := factory.NewNotAvailableHour(c.Hour)
+			require.Error(t, err)
+			assert.Equal(t, c.OutsideCalendar, hour.IsOutsideCalendarError(err))
+		})
+	}
+}
+
 func TestHour_Time(t *testing.T) {
 	t.Parallel()
 	expectedTime := validTrainingHour()
@@ -135,11 +190,18 @@ func TestUnmarshalHourFromDatabase(t *testing.T) {
 	t.Parallel()
 	trainingTime := validTrainingHour()
 
-	h, err := testHourFactory.UnmarshalHourFromDatabase(trainingTime, hour.TrainingScheduled)
+	h, err := testHourFactory.UnmarshalHourFromDatabase(trainingTime, hour.TrainingScheduled, "attendee-uuid", 30*time.Minute)
 	require.NoError(t, err)
 
 	assert.Equal(t, trainingTime, h.Time())
 	assert.True(t, h.HasTrainingScheduled())
+	assert.Equal(t, "attendee-uuid", h.AttendeeUUID())
+	assert.Equal(t, 30*time.Minute, h.TrainingDuration())
+
+	// trainings scheduled before their duration was stored took the whole hour
+	h, err = testHourFactory.UnmarshalHourFromDatabase(trainingTime, hour.TrainingScheduled, "attendee-uuid", 0)
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, h.TrainingDuration())
 }
 
 func TestFactoryConfig_Validate(t *testing.T) {
@@ -8,6 +8,8 @@ import (
 	"strings"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	grpcClient "github.com/vaintrub/go-ddd-template/internal/common/client"
 	"github.com/vaintrub/go-ddd-template/internal/common/config"
 	"github.com/vaintrub/go-ddd-template/internal/common/genproto/trainer"
 	"github.com/vaintrub/go-ddd-template/internal/common/logs"
@@ -22,15 +24,19 @@ func main() {
 	cfg := config.MustLoad(ctx)
 	logger := logs.Init(cfg.Logging)
 
-	application := service.NewApplication(ctx, cfg)
+	application, usersClient, cleanup := service.NewApplication(ctx, cfg)
+	defer cleanup()
 
 	serverType := strings.ToLower(os.Getenv("SERVER_TO_RUN"))
 	switch serverType {
 	case "http":
 		server.RunHTTPServer(cfg.Server, logger, func(router chi.Router) http.Handler {
-			return ports.HandlerFromMux(
+			return ports.HandlerWithOptions(
 				ports.NewHttpServer(application),
-				router,
+				ports.ChiServerOptions{
+					BaseRouter:  router,
+					Middlewares: []ports.MiddlewareFunc{auth.SessionMiddleware(grpcClient.NewUsersSessionChecker(usersClient))},
+				},
 			)
 		})
 	case "grpc":
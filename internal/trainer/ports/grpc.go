@@ -6,6 +6,7 @@ import (
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/google/uuid"
 	"github.com/vaintrub/go-ddd-template/internal/common/genproto/trainer"
 	"github.com/vaintrub/go-ddd-template/internal/trainer/app"
 	"github.com/vaintrub/go-ddd-template/internal/trainer/app/command"
@@ -32,10 +33,31 @@ func (g GrpcServer) MakeHourAvailable(ctx context.Context, request *trainer.Upda
 	return &empty.Empty{}, nil
 }
 
+func (g GrpcServer) MakeHourUnavailable(ctx context.Context, request *trainer.UpdateHourRequest) (*empty.Empty, error) {
+	hour := protoTimestampToTime(request.Time)
+
+	if err := g.app.Commands.MakeHoursUnavailable.Handle(ctx, command.MakeHoursUnavailable{Hours: []time.Time{hour}}); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (g GrpcServer) ScheduleTraining(ctx context.Context, request *trainer.UpdateHourRequest) (*empty.Empty, error) {
 	trainingTime := protoTimestampToTime(request.Time)
 
-	if err := g.app.Commands.ScheduleTraining.Handle(ctx, command.ScheduleTraining{Hour: trainingTime}); err != nil {
+	if request.AttendeeId != "" {
+		if _, err := uuid.Parse(request.AttendeeId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid attendee id")
+		}
+	}
+
+	cmd := command.ScheduleTraining{
+		Hour:         trainingTime,
+		AttendeeUUID: request.AttendeeId,
+		Duration:     time.Duration(request.TrainingDurationMinutes) * time.Minute,
+	}
+	if err := g.app.Commands.ScheduleTraining.Handle(ctx, cmd); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -2,8 +2,10 @@ package ports
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/go-chi/render"
+	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime/types"
 	"github.com/vaintrub/go-ddd-template/internal/common/auth"
 	"github.com/vaintrub/go-ddd-template/internal/common/server/httperr"
@@ -23,7 +25,14 @@ func NewHttpServer(application app.Application) HttpServer {
 }
 
 func (h HttpServer) GetTrainerAvailableHours(w http.ResponseWriter, r *http.Request, params GetTrainerAvailableHoursParams) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
 	dateModels, err := h.app.Queries.TrainerAvailableHours.Handle(r.Context(), query.AvailableHours{
+		User: user,
 		From: params.DateFrom,
 		To:   params.DateTo,
 	})
@@ -41,11 +50,22 @@ func dateModelsToResponse(models []query.Date) []Date {
 	for _, d := range models {
 		var hours []Hour
 		for _, h := range d.Hours {
-			hours = append(hours, Hour{
+			hour := Hour{
 				Available:            h.Available,
 				HasTrainingScheduled: h.HasTrainingScheduled,
 				Hour:                 h.Hour,
-			})
+			}
+			if h.HasTrainingScheduled {
+				minutes := int(h.TrainingDuration / time.Minute)
+				hour.TrainingDurationMinutes = &minutes
+			}
+			if h.AttendeeUUID != "" {
+				hour.Attendee = &HourAttendee{
+					Uuid: uuid.MustParse(h.AttendeeUUID),
+					Name: h.AttendeeName,
+				}
+			}
+			hours = append(hours, hour)
 		}
 
 		dates = append(dates, Date{
@@ -20,17 +20,35 @@ type Date struct {
 	Hours        []Hour             `json:"hours"`
 }
 
-// Error defines model for Error.
+// Error Problem details (RFC 7807) extended with the error slug.
 type Error struct {
-	Message string `json:"message"`
-	Slug    string `json:"slug"`
+	Detail   *string                 `json:"detail,omitempty"`
+	Details  *map[string]interface{} `json:"details,omitempty"`
+	Instance *string                 `json:"instance,omitempty"`
+	Message  string                  `json:"message"`
+	Slug     string                  `json:"slug"`
+	Status   int                     `json:"status"`
+	Title    string                  `json:"title"`
+	Type     string                  `json:"type"`
 }
 
 // Hour defines model for Hour.
 type Hour struct {
-	Available            bool      `json:"available"`
-	HasTrainingScheduled bool      `json:"hasTrainingScheduled"`
-	Hour                 time.Time `json:"hour"`
+	// Attendee Attendee who booked the hour, returned only to the trainer.
+	Attendee             *HourAttendee `json:"attendee,omitempty"`
+	Available            bool          `json:"available"`
+	HasTrainingScheduled bool          `json:"hasTrainingScheduled"`
+	Hour                 time.Time     `json:"hour"`
+
+	// TrainingDurationMinutes Length of the scheduled training from the start of the hour, set only when a training is scheduled
+	TrainingDurationMinutes *int `json:"trainingDurationMinutes,omitempty"`
+}
+
+// HourAttendee Attendee who booked the hour, returned only to the trainer.
+type HourAttendee struct {
+	// Name Current name of the attendee, empty when it can't be looked up.
+	Name string             `json:"name"`
+	Uuid openapi_types.UUID `json:"uuid"`
 }
 
 // HourUpdate defines model for HourUpdate.
@@ -4,8 +4,10 @@ import (
 	"context"
 	"log/slog"
 
+	grpcClient "github.com/vaintrub/go-ddd-template/internal/common/client"
 	"github.com/vaintrub/go-ddd-template/internal/common/config"
 	"github.com/vaintrub/go-ddd-template/internal/common/db"
+	"github.com/vaintrub/go-ddd-template/internal/common/genproto/users"
 	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
 	"github.com/vaintrub/go-ddd-template/internal/trainer/adapters"
 	"github.com/vaintrub/go-ddd-template/internal/trainer/app"
@@ -14,7 +16,33 @@ import (
 	"github.com/vaintrub/go-ddd-template/internal/trainer/domain/hour"
 )
 
-func NewApplication(ctx context.Context, cfg config.Config) app.Application {
+// NewApplication returns the application with the users client it uses,
+// so the HTTP server can check sessions without opening another connection.
+// The returned function closes the client.
+func NewApplication(ctx context.Context, cfg config.Config) (app.Application, users.UsersServiceClient, func()) {
+	usersClient, closeUsersClient, err := grpcClient.NewUsersClient(cfg.GRPC)
+	if err != nil {
+		panic(err)
+	}
+	usersGrpc := adapters.NewUsersGrpc(usersClient)
+
+	return newApplication(ctx, cfg, usersGrpc),
+		usersClient,
+		func() {
+			_ = closeUsersClient()
+		}
+}
+
+func NewComponentTestApplication(ctx context.Context, cfg config.Config) app.Application {
+	return newApplication(ctx, cfg, UsersServiceMock{})
+}
+
+type usersService interface {
+	query.UserNames
+	query.TrainerClients
+}
+
+func newApplication(ctx context.Context, cfg config.Config, usersGrpc usersService) app.Application {
 	pool := db.MustNewPgxPool(ctx, cfg.Database, cfg.Env)
 
 	factoryConfig := hour.FactoryConfig{
@@ -40,7 +68,7 @@ func NewApplication(ctx context.Context, cfg config.Config) app.Application {
 		},
 		Queries: app.Queries{
 			HourAvailability:      query.NewHourAvailabilityHandler(hourRepository, logger, metricsClient),
-			TrainerAvailableHours: query.NewAvailableHoursHandler(hourRepository, logger, metricsClient),
+			TrainerAvailableHours: query.NewAvailableHoursHandler(hourRepository, usersGrpc, usersGrpc, logger, metricsClient),
 		},
 	}
 }
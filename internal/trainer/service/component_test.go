@@ -84,7 +84,7 @@ func TestUnauthorizedForAttendee(t *testing.T) {
 }
 
 func startService(t *testing.T) bool {
-	app := NewApplication(context.Background(), componentTestConfig())
+	app := NewComponentTestApplication(context.Background(), componentTestConfig())
 	logger := logs.Init(config.LoggingConfig{Level: "INFO"})
 	serverCfg := config.ServerConfig{MockAuth: true}
 
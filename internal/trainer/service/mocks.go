@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/vaintrub/go-ddd-template/internal/trainer/app/query"
+)
+
+type UsersServiceMock struct {
+}
+
+func (u UsersServiceMock) UserNames(ctx context.Context, userIDs []string) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+func (u UsersServiceMock) TrainerRoster(ctx context.Context, trainerUUID string) (query.Roster, error) {
+	return query.Roster{}, nil
+}
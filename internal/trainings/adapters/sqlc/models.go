@@ -21,6 +21,99 @@ type TrainerHour struct {
 	// Record creation timestamp for auditing and pagination
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	// Attendee the hour is booked or held for, NULL when no training is scheduled or the attendee is unknown
+	AttendeeID pgtype.UUID `json:"attendee_id"`
+	// Duration of the training scheduled at the hour, NULL when no training is scheduled
+	TrainingDurationMinutes *int32 `json:"training_duration_minutes"`
+}
+
+// Cancellations of all trainings in a time range requested by the trainer
+type TrainingsBulkCancellation struct {
+	ID        pgtype.UUID `json:"id"`
+	TrainerID pgtype.UUID `json:"trainer_id"`
+	RangeFrom time.Time   `json:"range_from"`
+	RangeTo   time.Time   `json:"range_to"`
+	Completed bool        `json:"completed"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+	// Whether all free hours in the range were blocked in the trainer calendar
+	RangeBlocked bool `json:"range_blocked"`
+}
+
+// Progress of canceling every training of the bulk cancellation
+type TrainingsBulkCancellationItem struct {
+	BulkCancellationID pgtype.UUID `json:"bulk_cancellation_id"`
+	TrainingID         pgtype.UUID `json:"training_id"`
+	// pending, canceled or already-canceled
+	Status       string             `json:"status"`
+	TrainingTime pgtype.Timestamptz `json:"training_time"`
+	AttendeeID   pgtype.UUID        `json:"attendee_id"`
+	// Trainings balance change of the attendee settled when the training was canceled
+	BalanceDelta int32 `json:"balance_delta"`
+	// Whether the hour of the training was blocked in the trainer calendar
+	HourUnavailable bool `json:"hour_unavailable"`
+}
+
+// Attendee ratings of trainings, at most one per training
+type TrainingsFeedback struct {
+	// Rated training
+	TrainingID pgtype.UUID `json:"training_id"`
+	// Rating from 1 to 5
+	Rating int32 `json:"rating"`
+	// Attendee comment, max 1000 characters
+	Comment string `json:"comment"`
+	// Trainer reply to the feedback, max 1000 characters
+	Reply     *string            `json:"reply"`
+	CreatedAt time.Time          `json:"created_at"`
+	RepliedAt pgtype.Timestamptz `json:"replied_at"`
+}
+
+// Every edit of training notes, with the new content
+type TrainingsNotesHistory struct {
+	ID         int64       `json:"id"`
+	TrainingID pgtype.UUID `json:"training_id"`
+	// shared (visible to the attendee) or trainer (visible only to the trainer)
+	Kind         string      `json:"kind"`
+	Notes        string      `json:"notes"`
+	EditedBy     pgtype.UUID `json:"edited_by"`
+	EditedByRole string      `json:"edited_by_role"`
+	EditedAt     time.Time   `json:"edited_at"`
+}
+
+// Weekly series of trainings booked in one request
+type TrainingsSeries struct {
+	ID        pgtype.UUID `json:"id"`
+	UserID    pgtype.UUID `json:"user_id"`
+	UserName  string      `json:"user_name"`
+	Canceled  bool        `json:"canceled"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// Booking result of every week of the series
+type TrainingsSeriesOccurrence struct {
+	SeriesID       pgtype.UUID `json:"series_id"`
+	OccurrenceTime time.Time   `json:"occurrence_time"`
+	// pending, scheduled, skipped or failed
+	Status     string      `json:"status"`
+	TrainingID pgtype.UUID `json:"training_id"`
+	// Error slug explaining why the occurrence could not be booked
+	FailureReason *string `json:"failure_reason"`
+}
+
+// Catalog of session types attendees can book
+type TrainingsSessionType struct {
+	Code            string `json:"code"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	DurationMinutes int32  `json:"duration_minutes"`
+	CreditPrice     int32  `json:"credit_price"`
+	// Archived session types can not be booked anymore, booked trainings are not affected
+	Archived  bool      `json:"archived"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// Cancellation tiers of the session type, NULL when the tiers of the cancellation policy apply
+	CancellationTiers []byte `json:"cancellation_tiers"`
 }
 
 // Training sessions scheduled by users with trainers
@@ -41,21 +134,190 @@ type TrainingsTraining struct {
 	// Record creation timestamp for auditing and pagination
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	// Attendance outcome: attended, no_show or completed (set automatically after grace period)
+	Attendance *string `json:"attendance"`
+	// Version of the cancellation policy in force at booking time
+	CancellationPolicyVersion string `json:"cancellation_policy_version"`
+	// Deadline for answering the reschedule proposal
+	ProposalExpiresAt pgtype.Timestamptz `json:"proposal_expires_at"`
+	// Proposed reschedule hour is reserved until the proposal is answered
+	ProposedTimeHeld bool `json:"proposed_time_held"`
+	// Series the training was booked in, NULL for trainings booked one by one
+	SeriesID pgtype.UUID `json:"series_id"`
+	// Notes visible only to the trainer
+	TrainerNotes *string `json:"trainer_notes"`
+	// Code of the booked session type
+	SessionType string `json:"session_type"`
+	// Credits paid for the training, refunds are based on it rather than on the current catalog price
+	Price int32 `json:"price"`
+	// User type which canceled the training, NULL for trainings canceled before it was recorded
+	CanceledBy *string `json:"canceled_by"`
+	// When the training was canceled, used to report late cancellations
+	CanceledAt pgtype.Timestamptz `json:"canceled_at"`
+	// How many times the training was moved to another time
+	RescheduleCount int32 `json:"reschedule_count"`
+	// Incremented on every update, exposed to clients as the ETag of the training
+	Version int32 `json:"version"`
+	// Duration of the booked session, trainings booked before session types had durations took the whole hour
+	DurationMinutes int32 `json:"duration_minutes"`
+	// Cancellation tiers of the session type when the training was booked, NULL when the tiers of the cancellation policy apply
+	CancellationTiers []byte `json:"cancellation_tiers"`
+}
+
+// Attendees waiting for taken hours
+type TrainingsWaitlist struct {
+	ID       pgtype.UUID `json:"id"`
+	UserID   pgtype.UUID `json:"user_id"`
+	UserName string      `json:"user_name"`
+	Hour     time.Time   `json:"hour"`
+	// Book the freed hour right away if the attendee has enough balance, instead of offering it
+	AutoBook bool `json:"auto_book"`
+	// waiting, offered, booked, expired or left
+	Status string `json:"status"`
+	// Deadline for claiming the offered hour
+	OfferExpiresAt pgtype.Timestamptz `json:"offer_expires_at"`
+	// Training booked from the waitlist
+	TrainingID pgtype.UUID `json:"training_id"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+// Append-only ledger of trainings balance changes
+type UsersBalanceTransaction struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"user_id"`
+	// Balance change, negative for debits
+	Amount int32 `json:"amount"`
+	// Balance of the user after the transaction
+	BalanceAfter int32 `json:"balance_after"`
+	// Why the balance changed, for example training-booked or admin-adjustment
+	Reason string `json:"reason"`
+	// Free-text explanation, required for admin adjustments
+	Description *string `json:"description"`
+	// Training the change relates to, NULL for changes not related to a training
+	TrainingID pgtype.UUID `json:"training_id"`
+	// User who caused the change, NULL when the system changed it by itself
+	ActorID   pgtype.UUID `json:"actor_id"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// Credits granted together, debits consume the oldest non-expired lot first
+type UsersCreditLot struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"user_id"`
+	// Ledger transaction which granted the lot, NULL for balances from before lots existed
+	TransactionID pgtype.UUID `json:"transaction_id"`
+	Credits       int32       `json:"credits"`
+	// Credits which were not consumed or expired yet
+	Remaining int32 `json:"remaining"`
+	// When the remaining credits expire, NULL when they never expire
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+// Credits of the lot consumed by the debit, refunds return them to the lot
+type UsersCreditLotConsumption struct {
+	TransactionID pgtype.UUID `json:"transaction_id"`
+	LotID         pgtype.UUID `json:"lot_id"`
+	Amount        int32       `json:"amount"`
+	// Credits returned to the lot by refunds of the debit
+	Returned int32 `json:"returned"`
+}
+
+// Orders of credit packages, credits are granted when the payment is confirmed
+type UsersCreditOrder struct {
+	ID          pgtype.UUID `json:"id"`
+	UserID      pgtype.UUID `json:"user_id"`
+	PackageCode string      `json:"package_code"`
+	// Credits of the package at the time of the order
+	Credits int32 `json:"credits"`
+	// Price charged in the smallest currency unit, it does not change with the catalog
+	PriceAmount int32  `json:"price_amount"`
+	Currency    string `json:"currency"`
+	Status      string `json:"status"`
+	// Payment identifier assigned by the payment provider
+	PaymentID     *string `json:"payment_id"`
+	CheckoutUrl   *string `json:"checkout_url"`
+	FailureReason *string `json:"failure_reason"`
+	// Ledger transaction granting the credits, set when the order is paid
+	BalanceTransactionID pgtype.UUID `json:"balance_transaction_id"`
+	CreatedAt            time.Time   `json:"created_at"`
+	UpdatedAt            time.Time   `json:"updated_at"`
+	// Validity of the package at the time of the order
+	ValidityDays *int32 `json:"validity_days"`
+}
+
+// Catalog of credit packages users can buy
+type UsersCreditPackage struct {
+	Code    string `json:"code"`
+	Name    string `json:"name"`
+	Credits int32  `json:"credits"`
+	// Price in the smallest currency unit, for example cents
+	PriceAmount int32  `json:"price_amount"`
+	Currency    string `json:"currency"`
+	// Inactive packages can not be bought anymore, existing orders are not affected
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// Days bought credits can be used for, NULL when they never expire
+	ValidityDays *int32 `json:"validity_days"`
+}
+
+// Sessions users signed in with, recorded when their tokens are used
+type UsersSession struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"user_id"`
+	// Session identifier taken from the token (sid or jti claim, or the token hash)
+	SessionKey string `json:"session_key"`
+	// How the user signed in, NULL when the token does not tell
+	AuthMethod *string `json:"auth_method"`
+	// IP address of the last request made in the session
+	Ip *string `json:"ip"`
+	// User agent of the last request made in the session
+	UserAgent *string `json:"user_agent"`
+	// When the session was used for the first time, i.e. the login time
+	FirstSeenAt time.Time `json:"first_seen_at"`
+	// When the session was used for the last time
+	LastSeenAt time.Time `json:"last_seen_at"`
+	// When the user revoked the session, tokens of revoked sessions are rejected
+	RevokedAt pgtype.Timestamptz `json:"revoked_at"`
+}
+
+// Clients of trainers, a client can have more trainers
+type UsersTrainerClient struct {
+	// Trainer who can see the trainings of the client
+	TrainerID pgtype.UUID `json:"trainer_id"`
+	// Attendee assigned to the trainer
+	ClientID pgtype.UUID `json:"client_id"`
+	// When the client was assigned to the trainer
+	AssignedAt time.Time `json:"assigned_at"`
 }
 
 // User accounts with roles (trainer or attendee)
 type UsersUser struct {
 	// User unique identifier
 	ID pgtype.UUID `json:"id"`
-	// User role: trainer or attendee
+	// User role: trainer, attendee or admin
 	UserType string `json:"user_type"`
-	// User full name
+	// User display name, editable by the user
 	Name string `json:"name"`
 	// User email address (optional, for future authentication)
-	Email   *string `json:"email"`
-	Balance int32   `json:"balance"`
-	LastIp  *string `json:"last_ip"`
+	Email *string `json:"email"`
+	// Sum of the balance transactions, updated together with the ledger
+	Balance int32 `json:"balance"`
+	// IP address of the last request of the user, the history is in users_sessions
+	LastIp *string `json:"last_ip"`
 	// Record creation timestamp for auditing and pagination
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	// When the account was deactivated by an admin, NULL for active accounts
+	DeactivatedAt pgtype.Timestamptz `json:"deactivated_at"`
+	// URL of the user avatar image
+	AvatarUrl *string `json:"avatar_url"`
+	// IANA time zone of the user, e.g. Europe/Warsaw
+	Timezone *string `json:"timezone"`
+	// BCP 47 language tag of the user, e.g. en-GB
+	Locale *string `json:"locale"`
+	// When the personal data of the user was erased, erased accounts stay deactivated; the ledger and credit orders are kept for accounting
+	ErasedAt pgtype.Timestamptz `json:"erased_at"`
 }
@@ -12,14 +12,80 @@ import (
 )
 
 type Querier interface {
+	AnonymizeUserTrainingSeries(ctx context.Context, userID pgtype.UUID, userName string) error
+	AnonymizeUserTrainings(ctx context.Context, userID pgtype.UUID, userName string) error
+	// Ratings are kept for the trainer's rating, only the texts are removed.
+	AnonymizeUserTrainingsFeedback(ctx context.Context, userID pgtype.UUID) error
+	AnonymizeUserWaitlistEntries(ctx context.Context, userID pgtype.UUID, userName string) error
+	// Bookings which have to be canceled before the user's data can be erased.
+	CountUserActiveBookings(ctx context.Context, userID pgtype.UUID, now time.Time) (CountUserActiveBookingsRow, error)
+	CreateBulkCancellation(ctx context.Context, iD pgtype.UUID, trainerID pgtype.UUID, rangeFrom time.Time, rangeTo time.Time, rangeBlocked bool, completed bool) error
+	CreateSessionType(ctx context.Context, code string, name string, description string, durationMinutes int32, creditPrice int32, cancellationTiers []byte, archived bool) error
 	// Trainings Context Queries
 	// Purpose: CRUD operations for trainings_trainings table
-	CreateTraining(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID, userName string, trainingTime time.Time, notes *string, proposedNewTime pgtype.Timestamptz, moveProposedBy *string, canceled bool) (TrainingsTraining, error)
+	CreateTraining(ctx context.Context, arg CreateTrainingParams) (TrainingsTraining, error)
+	CreateTrainingNotesRevision(ctx context.Context, trainingID pgtype.UUID, kind string, notes string, editedBy pgtype.UUID, editedByRole string, editedAt time.Time) error
+	CreateTrainingSeries(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID, userName string, canceled bool) error
+	CreateWaitlistEntry(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID, userName string, hour time.Time, autoBook bool, status string) error
 	DeleteTraining(ctx context.Context, id pgtype.UUID) error
+	DeleteUserTrainingsNotesHistory(ctx context.Context, userID pgtype.UUID) error
+	GetBulkCancellation(ctx context.Context, id pgtype.UUID) (TrainingsBulkCancellation, error)
+	GetBulkCancellationForUpdate(ctx context.Context, id pgtype.UUID) (TrainingsBulkCancellation, error)
+	GetSessionType(ctx context.Context, code string) (TrainingsSessionType, error)
+	GetSessionTypeForUpdate(ctx context.Context, code string) (TrainingsSessionType, error)
 	GetTraining(ctx context.Context, id pgtype.UUID) (TrainingsTraining, error)
-	ListAllTrainings(ctx context.Context) ([]TrainingsTraining, error)
+	GetTrainingFeedback(ctx context.Context, trainingID pgtype.UUID) (TrainingsFeedback, error)
+	// Locks the training, so concurrent changes are applied one after another and see each other's version.
+	GetTrainingForUpdate(ctx context.Context, id pgtype.UUID) (TrainingsTraining, error)
+	GetTrainingSeries(ctx context.Context, id pgtype.UUID) (TrainingsSeries, error)
+	GetTrainingsRatingSummary(ctx context.Context) (GetTrainingsRatingSummaryRow, error)
+	// Locks the entry, so claiming the offer and its expiration can't both succeed.
+	GetWaitlistEntryForUpdate(ctx context.Context, id pgtype.UUID) (TrainingsWaitlist, error)
+	ListBulkCancellationItems(ctx context.Context, bulkCancellationID pgtype.UUID) ([]TrainingsBulkCancellationItem, error)
+	ListRecentTrainingsFeedback(ctx context.Context, userID pgtype.UUID, limit int32) ([]ListRecentTrainingsFeedbackRow, error)
+	ListScheduledTrainings(ctx context.Context, from time.Time, to time.Time) ([]ListScheduledTrainingsRow, error)
+	ListSessionTypes(ctx context.Context) ([]TrainingsSessionType, error)
+	// Keyset pagination by (training_time, id), canceled trainings are included.
+	ListTrainingHistoryByUser(ctx context.Context, userID pgtype.UUID, afterTime pgtype.Timestamptz, afterID pgtype.UUID, pageSize int32) ([]TrainingsTraining, error)
+	ListTrainingNotesHistory(ctx context.Context, trainingID pgtype.UUID) ([]TrainingsNotesHistory, error)
+	ListTrainingSeriesOccurrences(ctx context.Context, seriesID pgtype.UUID) ([]TrainingsSeriesOccurrence, error)
+	ListTrainingSeriesOccurrencesWithTrainings(ctx context.Context, seriesID pgtype.UUID) ([]ListTrainingSeriesOccurrencesWithTrainingsRow, error)
 	ListTrainingsByUser(ctx context.Context, userID pgtype.UUID, createdAt time.Time, iD pgtype.UUID, limit int32) ([]TrainingsTraining, error)
-	UpdateTraining(ctx context.Context, iD pgtype.UUID, notes *string, proposedNewTime pgtype.Timestamptz, moveProposedBy *string, canceled bool) error
+	// Trainings of the trainer's clients and of attendees not assigned to any trainer
+	ListTrainingsForRoster(ctx context.Context, clientIds []pgtype.UUID, otherTrainersClientIds []pgtype.UUID) ([]TrainingsTraining, error)
+	ListTrainingsPendingAttendance(ctx context.Context, endedBefore time.Time) ([]pgtype.UUID, error)
+	ListTrainingsWithExpiredRescheduleProposal(ctx context.Context, proposalExpiresAt pgtype.Timestamptz) ([]pgtype.UUID, error)
+	// Trainer notes are private, so only revisions of shared notes are exported.
+	ListUserDataNotesRevisions(ctx context.Context, userID pgtype.UUID) ([]TrainingsNotesHistory, error)
+	ListUserDataSeries(ctx context.Context, userID pgtype.UUID) ([]TrainingsSeries, error)
+	// All trainings of the user with their feedback, exported for data-subject requests.
+	ListUserDataTrainings(ctx context.Context, userID pgtype.UUID) ([]ListUserDataTrainingsRow, error)
+	ListUserDataWaitlistEntries(ctx context.Context, userID pgtype.UUID) ([]TrainingsWaitlist, error)
+	ListWaitingWaitlistEntries(ctx context.Context, hour time.Time) ([]pgtype.UUID, error)
+	ListWaitlistEntriesByUser(ctx context.Context, userID pgtype.UUID) ([]TrainingsWaitlist, error)
+	ListWaitlistEntriesWithExpiredOffer(ctx context.Context, offerExpiresAt pgtype.Timestamptz) ([]pgtype.UUID, error)
+	// Hours offered by the trainer (available or booked) and booked ones, grouped by week or month.
+	// Hours never made available have no row in trainer_hours, so they are not counted.
+	// Unless all_attendees is set, hours booked by clients of other trainers are left out,
+	// the same as trainings in ListTrainingsForRoster. Hours booked by unknown attendees are kept.
+	TrainerHoursReport(ctx context.Context, period string, fromTime time.Time, toTime time.Time, allAttendees bool, clientIds []pgtype.UUID, otherTrainersClientIds []pgtype.UUID) ([]TrainerHoursReportRow, error)
+	// Trainings are grouped by their current time, so reschedules are counted in the period the training was moved to.
+	// Unless all_attendees is set, only trainings of the trainer's clients and of attendees without a trainer are counted.
+	TrainingsActivityReport(ctx context.Context, period string, fromTime time.Time, toTime time.Time, allAttendees bool, clientIds []pgtype.UUID, otherTrainersClientIds []pgtype.UUID) ([]TrainingsActivityReportRow, error)
+	// Cancellations canceled before canceled_by and canceled_at were recorded are reported as canceled by 'unknown'.
+	// Unless all_attendees is set, only trainings of the trainer's clients and of attendees without a trainer are counted.
+	TrainingsCancellationReport(ctx context.Context, period string, lateNoticeSeconds float64, fromTime time.Time, toTime time.Time, allAttendees bool, clientIds []pgtype.UUID, otherTrainersClientIds []pgtype.UUID) ([]TrainingsCancellationReportRow, error)
+	UpdateBulkCancellation(ctx context.Context, iD pgtype.UUID, rangeBlocked bool, completed bool) error
+	UpdateSessionType(ctx context.Context, code string, name string, description string, durationMinutes int32, creditPrice int32, cancellationTiers []byte, archived bool) error
+	// Overwrites all mutable columns, so cleared values (like a rejected reschedule proposal) are persisted too.
+	// Nothing is updated when the training was changed since it was read with the given version.
+	// Every call bumps the version, so it shouldn't be called for unchanged trainings.
+	UpdateTraining(ctx context.Context, arg UpdateTrainingParams) (int64, error)
+	UpdateTrainingSeries(ctx context.Context, iD pgtype.UUID, canceled bool) error
+	UpdateWaitlistEntry(ctx context.Context, iD pgtype.UUID, status string, offerExpiresAt pgtype.Timestamptz, trainingID pgtype.UUID) error
+	UpsertBulkCancellationItem(ctx context.Context, bulkCancellationID pgtype.UUID, trainingID pgtype.UUID, status string, trainingTime pgtype.Timestamptz, attendeeID pgtype.UUID, balanceDelta int32, hourUnavailable bool) error
+	UpsertTrainingFeedback(ctx context.Context, trainingID pgtype.UUID, rating int32, comment string, reply *string, createdAt time.Time, repliedAt pgtype.Timestamptz) error
+	UpsertTrainingSeriesOccurrence(ctx context.Context, seriesID pgtype.UUID, occurrenceTime time.Time, status string, trainingID pgtype.UUID, failureReason *string) error
 }
 
 var _ Querier = (*Queries)(nil)
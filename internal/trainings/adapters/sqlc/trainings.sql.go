@@ -12,6 +12,150 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const anonymizeUserTrainingSeries = `-- name: AnonymizeUserTrainingSeries :exec
+UPDATE trainings_series
+SET
+    user_name = $2,
+    updated_at = NOW()
+WHERE user_id = $1
+`
+
+func (q *Queries) AnonymizeUserTrainingSeries(ctx context.Context, userID pgtype.UUID, userName string) error {
+	_, err := q.db.Exec(ctx, anonymizeUserTrainingSeries, userID, userName)
+	return err
+}
+
+const anonymizeUserTrainings = `-- name: AnonymizeUserTrainings :exec
+UPDATE trainings_trainings
+SET
+    user_name = $2,
+    notes = NULL,
+    trainer_notes = NULL,
+    version = version + 1,
+    updated_at = NOW()
+WHERE user_id = $1
+`
+
+func (q *Queries) AnonymizeUserTrainings(ctx context.Context, userID pgtype.UUID, userName string) error {
+	_, err := q.db.Exec(ctx, anonymizeUserTrainings, userID, userName)
+	return err
+}
+
+const anonymizeUserTrainingsFeedback = `-- name: AnonymizeUserTrainingsFeedback :exec
+UPDATE trainings_feedback
+SET
+    comment = '',
+    reply = NULL
+WHERE training_id IN (SELECT id FROM trainings_trainings WHERE user_id = $1)
+`
+
+// Ratings are kept for the trainer's rating, only the texts are removed.
+func (q *Queries) AnonymizeUserTrainingsFeedback(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, anonymizeUserTrainingsFeedback, userID)
+	return err
+}
+
+const anonymizeUserWaitlistEntries = `-- name: AnonymizeUserWaitlistEntries :exec
+UPDATE trainings_waitlist
+SET
+    user_name = $2,
+    updated_at = NOW()
+WHERE user_id = $1
+`
+
+func (q *Queries) AnonymizeUserWaitlistEntries(ctx context.Context, userID pgtype.UUID, userName string) error {
+	_, err := q.db.Exec(ctx, anonymizeUserWaitlistEntries, userID, userName)
+	return err
+}
+
+const countUserActiveBookings = `-- name: CountUserActiveBookings :one
+SELECT
+    (
+        SELECT COUNT(*) FROM trainings_trainings t
+        WHERE t.user_id = $1 AND t.canceled = false AND t.training_time > $2
+    ) AS upcoming_trainings,
+    (
+        SELECT COUNT(*) FROM trainings_series s
+        WHERE s.user_id = $1 AND s.canceled = false
+          AND EXISTS (
+            SELECT 1 FROM trainings_series_occurrences o
+            WHERE o.series_id = s.id AND o.status = 'pending'
+          )
+    ) AS active_series,
+    (
+        SELECT COUNT(*) FROM trainings_waitlist w
+        WHERE w.user_id = $1 AND w.status IN ('waiting', 'offered')
+    ) AS active_waitlist_entries
+`
+
+type CountUserActiveBookingsRow struct {
+	UpcomingTrainings     int64 `json:"upcoming_trainings"`
+	ActiveSeries          int64 `json:"active_series"`
+	ActiveWaitlistEntries int64 `json:"active_waitlist_entries"`
+}
+
+// Bookings which have to be canceled before the user's data can be erased.
+func (q *Queries) CountUserActiveBookings(ctx context.Context, userID pgtype.UUID, now time.Time) (CountUserActiveBookingsRow, error) {
+	row := q.db.QueryRow(ctx, countUserActiveBookings, userID, now)
+	var i CountUserActiveBookingsRow
+	err := row.Scan(&i.UpcomingTrainings, &i.ActiveSeries, &i.ActiveWaitlistEntries)
+	return i, err
+}
+
+const createBulkCancellation = `-- name: CreateBulkCancellation :exec
+INSERT INTO trainings_bulk_cancellations (
+    id,
+    trainer_id,
+    range_from,
+    range_to,
+    range_blocked,
+    completed,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, NOW(), NOW()
+)
+`
+
+func (q *Queries) CreateBulkCancellation(ctx context.Context, iD pgtype.UUID, trainerID pgtype.UUID, rangeFrom time.Time, rangeTo time.Time, rangeBlocked bool, completed bool) error {
+	_, err := q.db.Exec(ctx, createBulkCancellation,
+		iD,
+		trainerID,
+		rangeFrom,
+		rangeTo,
+		rangeBlocked,
+		completed,
+	)
+	return err
+}
+
+const createSessionType = `-- name: CreateSessionType :exec
+INSERT INTO trainings_session_types (
+    code,
+    name,
+    description,
+    duration_minutes,
+    credit_price,
+    cancellation_tiers,
+    archived
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+`
+
+func (q *Queries) CreateSessionType(ctx context.Context, code string, name string, description string, durationMinutes int32, creditPrice int32, cancellationTiers []byte, archived bool) error {
+	_, err := q.db.Exec(ctx, createSessionType,
+		code,
+		name,
+		description,
+		durationMinutes,
+		creditPrice,
+		cancellationTiers,
+		archived,
+	)
+	return err
+}
+
 const createTraining = `-- name: CreateTraining :one
 
 INSERT INTO trainings_trainings (
@@ -23,25 +167,54 @@ INSERT INTO trainings_trainings (
     proposed_new_time,
     move_proposed_by,
     canceled,
+    cancellation_policy_version,
+    series_id,
+    session_type,
+    price,
+    duration_minutes,
+    cancellation_tiers,
     created_at,
     updated_at
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW()
-) RETURNING id, user_id, user_name, training_time, notes, proposed_new_time, move_proposed_by, canceled, created_at, updated_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, NOW(), NOW()
+) RETURNING id, user_id, user_name, training_time, notes, proposed_new_time, move_proposed_by, canceled, created_at, updated_at, attendance, cancellation_policy_version, proposal_expires_at, proposed_time_held, series_id, trainer_notes, session_type, price, canceled_by, canceled_at, reschedule_count, version, duration_minutes, cancellation_tiers
 `
 
+type CreateTrainingParams struct {
+	ID                        pgtype.UUID        `json:"id"`
+	UserID                    pgtype.UUID        `json:"user_id"`
+	UserName                  string             `json:"user_name"`
+	TrainingTime              time.Time          `json:"training_time"`
+	Notes                     *string            `json:"notes"`
+	ProposedNewTime           pgtype.Timestamptz `json:"proposed_new_time"`
+	MoveProposedBy            *string            `json:"move_proposed_by"`
+	Canceled                  bool               `json:"canceled"`
+	CancellationPolicyVersion string             `json:"cancellation_policy_version"`
+	SeriesID                  pgtype.UUID        `json:"series_id"`
+	SessionType               string             `json:"session_type"`
+	Price                     int32              `json:"price"`
+	DurationMinutes           int32              `json:"duration_minutes"`
+	CancellationTiers         []byte             `json:"cancellation_tiers"`
+}
+
 // Trainings Context Queries
 // Purpose: CRUD operations for trainings_trainings table
-func (q *Queries) CreateTraining(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID, userName string, trainingTime time.Time, notes *string, proposedNewTime pgtype.Timestamptz, moveProposedBy *string, canceled bool) (TrainingsTraining, error) {
+func (q *Queries) CreateTraining(ctx context.Context, arg CreateTrainingParams) (TrainingsTraining, error) {
 	row := q.db.QueryRow(ctx, createTraining,
-		iD,
-		userID,
-		userName,
-		trainingTime,
-		notes,
-		proposedNewTime,
-		moveProposedBy,
-		canceled,
+		arg.ID,
+		arg.UserID,
+		arg.UserName,
+		arg.TrainingTime,
+		arg.Notes,
+		arg.ProposedNewTime,
+		arg.MoveProposedBy,
+		arg.Canceled,
+		arg.CancellationPolicyVersion,
+		arg.SeriesID,
+		arg.SessionType,
+		arg.Price,
+		arg.DurationMinutes,
+		arg.CancellationTiers,
 	)
 	var i TrainingsTraining
 	err := row.Scan(
@@ -55,10 +228,99 @@ func (q *Queries) CreateTraining(ctx context.Context, iD pgtype.UUID, userID pgt
 		&i.Canceled,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Attendance,
+		&i.CancellationPolicyVersion,
+		&i.ProposalExpiresAt,
+		&i.ProposedTimeHeld,
+		&i.SeriesID,
+		&i.TrainerNotes,
+		&i.SessionType,
+		&i.Price,
+		&i.CanceledBy,
+		&i.CanceledAt,
+		&i.RescheduleCount,
+		&i.Version,
+		&i.DurationMinutes,
+		&i.CancellationTiers,
 	)
 	return i, err
 }
 
+const createTrainingNotesRevision = `-- name: CreateTrainingNotesRevision :exec
+INSERT INTO trainings_notes_history (
+    training_id,
+    kind,
+    notes,
+    edited_by,
+    edited_by_role,
+    edited_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+`
+
+func (q *Queries) CreateTrainingNotesRevision(ctx context.Context, trainingID pgtype.UUID, kind string, notes string, editedBy pgtype.UUID, editedByRole string, editedAt time.Time) error {
+	_, err := q.db.Exec(ctx, createTrainingNotesRevision,
+		trainingID,
+		kind,
+		notes,
+		editedBy,
+		editedByRole,
+		editedAt,
+	)
+	return err
+}
+
+const createTrainingSeries = `-- name: CreateTrainingSeries :exec
+INSERT INTO trainings_series (
+    id,
+    user_id,
+    user_name,
+    canceled,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, NOW(), NOW()
+)
+`
+
+func (q *Queries) CreateTrainingSeries(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID, userName string, canceled bool) error {
+	_, err := q.db.Exec(ctx, createTrainingSeries,
+		iD,
+		userID,
+		userName,
+		canceled,
+	)
+	return err
+}
+
+const createWaitlistEntry = `-- name: CreateWaitlistEntry :exec
+INSERT INTO trainings_waitlist (
+    id,
+    user_id,
+    user_name,
+    hour,
+    auto_book,
+    status,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, NOW(), NOW()
+)
+`
+
+func (q *Queries) CreateWaitlistEntry(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID, userName string, hour time.Time, autoBook bool, status string) error {
+	_, err := q.db.Exec(ctx, createWaitlistEntry,
+		iD,
+		userID,
+		userName,
+		hour,
+		autoBook,
+		status,
+	)
+	return err
+}
+
 const deleteTraining = `-- name: DeleteTraining :exec
 DELETE FROM trainings_trainings
 WHERE id = $1
@@ -69,8 +331,106 @@ func (q *Queries) DeleteTraining(ctx context.Context, id pgtype.UUID) error {
 	return err
 }
 
+const deleteUserTrainingsNotesHistory = `-- name: DeleteUserTrainingsNotesHistory :exec
+DELETE FROM trainings_notes_history
+WHERE training_id IN (SELECT id FROM trainings_trainings WHERE user_id = $1)
+`
+
+func (q *Queries) DeleteUserTrainingsNotesHistory(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUserTrainingsNotesHistory, userID)
+	return err
+}
+
+const getBulkCancellation = `-- name: GetBulkCancellation :one
+SELECT id, trainer_id, range_from, range_to, completed, created_at, updated_at, range_blocked FROM trainings_bulk_cancellations
+WHERE id = $1
+`
+
+func (q *Queries) GetBulkCancellation(ctx context.Context, id pgtype.UUID) (TrainingsBulkCancellation, error) {
+	row := q.db.QueryRow(ctx, getBulkCancellation, id)
+	var i TrainingsBulkCancellation
+	err := row.Scan(
+		&i.ID,
+		&i.TrainerID,
+		&i.RangeFrom,
+		&i.RangeTo,
+		&i.Completed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RangeBlocked,
+	)
+	return i, err
+}
+
+const getBulkCancellationForUpdate = `-- name: GetBulkCancellationForUpdate :one
+SELECT id, trainer_id, range_from, range_to, completed, created_at, updated_at, range_blocked FROM trainings_bulk_cancellations
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetBulkCancellationForUpdate(ctx context.Context, id pgtype.UUID) (TrainingsBulkCancellation, error) {
+	row := q.db.QueryRow(ctx, getBulkCancellationForUpdate, id)
+	var i TrainingsBulkCancellation
+	err := row.Scan(
+		&i.ID,
+		&i.TrainerID,
+		&i.RangeFrom,
+		&i.RangeTo,
+		&i.Completed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RangeBlocked,
+	)
+	return i, err
+}
+
+const getSessionType = `-- name: GetSessionType :one
+SELECT code, name, description, duration_minutes, credit_price, archived, created_at, updated_at, cancellation_tiers FROM trainings_session_types
+WHERE code = $1
+`
+
+func (q *Queries) GetSessionType(ctx context.Context, code string) (TrainingsSessionType, error) {
+	row := q.db.QueryRow(ctx, getSessionType, code)
+	var i TrainingsSessionType
+	err := row.Scan(
+		&i.Code,
+		&i.Name,
+		&i.Description,
+		&i.DurationMinutes,
+		&i.CreditPrice,
+		&i.Archived,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CancellationTiers,
+	)
+	return i, err
+}
+
+const getSessionTypeForUpdate = `-- name: GetSessionTypeForUpdate :one
+SELECT code, name, description, duration_minutes, credit_price, archived, created_at, updated_at, cancellation_tiers FROM trainings_session_types
+WHERE code = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetSessionTypeForUpdate(ctx context.Context, code string) (TrainingsSessionType, error) {
+	row := q.db.QueryRow(ctx, getSessionTypeForUpdate, code)
+	var i TrainingsSessionType
+	err := row.Scan(
+		&i.Code,
+		&i.Name,
+		&i.Description,
+		&i.DurationMinutes,
+		&i.CreditPrice,
+		&i.Archived,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CancellationTiers,
+	)
+	return i, err
+}
+
 const getTraining = `-- name: GetTraining :one
-SELECT id, user_id, user_name, training_time, notes, proposed_new_time, move_proposed_by, canceled, created_at, updated_at FROM trainings_trainings
+SELECT id, user_id, user_name, training_time, notes, proposed_new_time, move_proposed_by, canceled, created_at, updated_at, attendance, cancellation_policy_version, proposal_expires_at, proposed_time_held, series_id, trainer_notes, session_type, price, canceled_by, canceled_at, reschedule_count, version, duration_minutes, cancellation_tiers FROM trainings_trainings
 WHERE id = $1
 `
 
@@ -88,36 +448,168 @@ func (q *Queries) GetTraining(ctx context.Context, id pgtype.UUID) (TrainingsTra
 		&i.Canceled,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Attendance,
+		&i.CancellationPolicyVersion,
+		&i.ProposalExpiresAt,
+		&i.ProposedTimeHeld,
+		&i.SeriesID,
+		&i.TrainerNotes,
+		&i.SessionType,
+		&i.Price,
+		&i.CanceledBy,
+		&i.CanceledAt,
+		&i.RescheduleCount,
+		&i.Version,
+		&i.DurationMinutes,
+		&i.CancellationTiers,
 	)
 	return i, err
 }
 
-const listAllTrainings = `-- name: ListAllTrainings :many
-SELECT id, user_id, user_name, training_time, notes, proposed_new_time, move_proposed_by, canceled, created_at, updated_at FROM trainings_trainings
-WHERE canceled = false
-ORDER BY created_at DESC, id
+const getTrainingFeedback = `-- name: GetTrainingFeedback :one
+SELECT training_id, rating, comment, reply, created_at, replied_at FROM trainings_feedback
+WHERE training_id = $1
+`
+
+func (q *Queries) GetTrainingFeedback(ctx context.Context, trainingID pgtype.UUID) (TrainingsFeedback, error) {
+	row := q.db.QueryRow(ctx, getTrainingFeedback, trainingID)
+	var i TrainingsFeedback
+	err := row.Scan(
+		&i.TrainingID,
+		&i.Rating,
+		&i.Comment,
+		&i.Reply,
+		&i.CreatedAt,
+		&i.RepliedAt,
+	)
+	return i, err
+}
+
+const getTrainingForUpdate = `-- name: GetTrainingForUpdate :one
+SELECT id, user_id, user_name, training_time, notes, proposed_new_time, move_proposed_by, canceled, created_at, updated_at, attendance, cancellation_policy_version, proposal_expires_at, proposed_time_held, series_id, trainer_notes, session_type, price, canceled_by, canceled_at, reschedule_count, version, duration_minutes, cancellation_tiers FROM trainings_trainings
+WHERE id = $1
+FOR UPDATE
+`
+
+// Locks the training, so concurrent changes are applied one after another and see each other's version.
+func (q *Queries) GetTrainingForUpdate(ctx context.Context, id pgtype.UUID) (TrainingsTraining, error) {
+	row := q.db.QueryRow(ctx, getTrainingForUpdate, id)
+	var i TrainingsTraining
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.UserName,
+		&i.TrainingTime,
+		&i.Notes,
+		&i.ProposedNewTime,
+		&i.MoveProposedBy,
+		&i.Canceled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Attendance,
+		&i.CancellationPolicyVersion,
+		&i.ProposalExpiresAt,
+		&i.ProposedTimeHeld,
+		&i.SeriesID,
+		&i.TrainerNotes,
+		&i.SessionType,
+		&i.Price,
+		&i.CanceledBy,
+		&i.CanceledAt,
+		&i.RescheduleCount,
+		&i.Version,
+		&i.DurationMinutes,
+		&i.CancellationTiers,
+	)
+	return i, err
+}
+
+const getTrainingSeries = `-- name: GetTrainingSeries :one
+SELECT id, user_id, user_name, canceled, created_at, updated_at FROM trainings_series
+WHERE id = $1
+`
+
+func (q *Queries) GetTrainingSeries(ctx context.Context, id pgtype.UUID) (TrainingsSeries, error) {
+	row := q.db.QueryRow(ctx, getTrainingSeries, id)
+	var i TrainingsSeries
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.UserName,
+		&i.Canceled,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getTrainingsRatingSummary = `-- name: GetTrainingsRatingSummary :one
+SELECT
+    COALESCE(AVG(rating), 0)::float8 AS average_rating,
+    COUNT(*) AS ratings_count
+FROM trainings_feedback
+`
+
+type GetTrainingsRatingSummaryRow struct {
+	AverageRating float64 `json:"average_rating"`
+	RatingsCount  int64   `json:"ratings_count"`
+}
+
+func (q *Queries) GetTrainingsRatingSummary(ctx context.Context) (GetTrainingsRatingSummaryRow, error) {
+	row := q.db.QueryRow(ctx, getTrainingsRatingSummary)
+	var i GetTrainingsRatingSummaryRow
+	err := row.Scan(&i.AverageRating, &i.RatingsCount)
+	return i, err
+}
+
+const getWaitlistEntryForUpdate = `-- name: GetWaitlistEntryForUpdate :one
+SELECT id, user_id, user_name, hour, auto_book, status, offer_expires_at, training_id, created_at, updated_at FROM trainings_waitlist
+WHERE id = $1
+FOR UPDATE
+`
+
+// Locks the entry, so claiming the offer and its expiration can't both succeed.
+func (q *Queries) GetWaitlistEntryForUpdate(ctx context.Context, id pgtype.UUID) (TrainingsWaitlist, error) {
+	row := q.db.QueryRow(ctx, getWaitlistEntryForUpdate, id)
+	var i TrainingsWaitlist
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.UserName,
+		&i.Hour,
+		&i.AutoBook,
+		&i.Status,
+		&i.OfferExpiresAt,
+		&i.TrainingID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listBulkCancellationItems = `-- name: ListBulkCancellationItems :many
+SELECT bulk_cancellation_id, training_id, status, training_time, attendee_id, balance_delta, hour_unavailable FROM trainings_bulk_cancellation_items
+WHERE bulk_cancellation_id = $1
+ORDER BY training_time NULLS LAST, training_id
 `
 
-func (q *Queries) ListAllTrainings(ctx context.Context) ([]TrainingsTraining, error) {
-	rows, err := q.db.Query(ctx, listAllTrainings)
+func (q *Queries) ListBulkCancellationItems(ctx context.Context, bulkCancellationID pgtype.UUID) ([]TrainingsBulkCancellationItem, error) {
+	rows, err := q.db.Query(ctx, listBulkCancellationItems, bulkCancellationID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []TrainingsTraining
+	var items []TrainingsBulkCancellationItem
 	for rows.Next() {
-		var i TrainingsTraining
+		var i TrainingsBulkCancellationItem
 		if err := rows.Scan(
-			&i.ID,
-			&i.UserID,
-			&i.UserName,
+			&i.BulkCancellationID,
+			&i.TrainingID,
+			&i.Status,
 			&i.TrainingTime,
-			&i.Notes,
-			&i.ProposedNewTime,
-			&i.MoveProposedBy,
-			&i.Canceled,
-			&i.CreatedAt,
-			&i.UpdatedAt,
+			&i.AttendeeID,
+			&i.BalanceDelta,
+			&i.HourUnavailable,
 		); err != nil {
 			return nil, err
 		}
@@ -129,41 +621,55 @@ func (q *Queries) ListAllTrainings(ctx context.Context) ([]TrainingsTraining, er
 	return items, nil
 }
 
-const listTrainingsByUser = `-- name: ListTrainingsByUser :many
-SELECT id, user_id, user_name, training_time, notes, proposed_new_time, move_proposed_by, canceled, created_at, updated_at FROM trainings_trainings
-WHERE user_id = $1
-  AND canceled = false
-  AND (created_at > $2 OR $2 IS NULL)
-  AND (id > $3 OR $3 IS NULL)
-ORDER BY created_at, id
-LIMIT $4
+const listRecentTrainingsFeedback = `-- name: ListRecentTrainingsFeedback :many
+SELECT
+    f.training_id,
+    f.rating,
+    f.comment,
+    f.reply,
+    f.created_at,
+    f.replied_at,
+    t.user_id,
+    t.user_name,
+    t.training_time
+FROM trainings_feedback f
+JOIN trainings_trainings t ON t.id = f.training_id
+WHERE ($1::uuid IS NULL OR t.user_id = $1)
+ORDER BY f.created_at DESC, f.training_id
+LIMIT $2
 `
 
-func (q *Queries) ListTrainingsByUser(ctx context.Context, userID pgtype.UUID, createdAt time.Time, iD pgtype.UUID, limit int32) ([]TrainingsTraining, error) {
-	rows, err := q.db.Query(ctx, listTrainingsByUser,
-		userID,
-		createdAt,
-		iD,
-		limit,
-	)
+type ListRecentTrainingsFeedbackRow struct {
+	TrainingID   pgtype.UUID        `json:"training_id"`
+	Rating       int32              `json:"rating"`
+	Comment      string             `json:"comment"`
+	Reply        *string            `json:"reply"`
+	CreatedAt    time.Time          `json:"created_at"`
+	RepliedAt    pgtype.Timestamptz `json:"replied_at"`
+	UserID       pgtype.UUID        `json:"user_id"`
+	UserName     string             `json:"user_name"`
+	TrainingTime time.Time          `json:"training_time"`
+}
+
+func (q *Queries) ListRecentTrainingsFeedback(ctx context.Context, userID pgtype.UUID, limit int32) ([]ListRecentTrainingsFeedbackRow, error) {
+	rows, err := q.db.Query(ctx, listRecentTrainingsFeedback, userID, limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []TrainingsTraining
+	var items []ListRecentTrainingsFeedbackRow
 	for rows.Next() {
-		var i TrainingsTraining
+		var i ListRecentTrainingsFeedbackRow
 		if err := rows.Scan(
-			&i.ID,
+			&i.TrainingID,
+			&i.Rating,
+			&i.Comment,
+			&i.Reply,
+			&i.CreatedAt,
+			&i.RepliedAt,
 			&i.UserID,
 			&i.UserName,
 			&i.TrainingTime,
-			&i.Notes,
-			&i.ProposedNewTime,
-			&i.MoveProposedBy,
-			&i.Canceled,
-			&i.CreatedAt,
-			&i.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -175,24 +681,1092 @@ func (q *Queries) ListTrainingsByUser(ctx context.Context, userID pgtype.UUID, c
 	return items, nil
 }
 
-const updateTraining = `-- name: UpdateTraining :exec
-UPDATE trainings_trainings
+const listScheduledTrainings = `-- name: ListScheduledTrainings :many
+SELECT id, user_id FROM trainings_trainings
+WHERE canceled = false
+  AND training_time >= $1
+  AND training_time < $2
+ORDER BY training_time, id
+`
+
+type ListScheduledTrainingsRow struct {
+	ID     pgtype.UUID `json:"id"`
+	UserID pgtype.UUID `json:"user_id"`
+}
+
+func (q *Queries) ListScheduledTrainings(ctx context.Context, from time.Time, to time.Time) ([]ListScheduledTrainingsRow, error) {
+	rows, err := q.db.Query(ctx, listScheduledTrainings, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListScheduledTrainingsRow
+	for rows.Next() {
+		var i ListScheduledTrainingsRow
+		if err := rows.Scan(&i.ID, &i.UserID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSessionTypes = `-- name: ListSessionTypes :many
+SELECT code, name, description, duration_minutes, credit_price, archived, created_at, updated_at, cancellation_tiers FROM trainings_session_types
+ORDER BY name, code
+`
+
+func (q *Queries) ListSessionTypes(ctx context.Context) ([]TrainingsSessionType, error) {
+	rows, err := q.db.Query(ctx, listSessionTypes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TrainingsSessionType
+	for rows.Next() {
+		var i TrainingsSessionType
+		if err := rows.Scan(
+			&i.Code,
+			&i.Name,
+			&i.Description,
+			&i.DurationMinutes,
+			&i.CreditPrice,
+			&i.Archived,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CancellationTiers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrainingHistoryByUser = `-- name: ListTrainingHistoryByUser :many
+SELECT id, user_id, user_name, training_time, notes, proposed_new_time, move_proposed_by, canceled, created_at, updated_at, attendance, cancellation_policy_version, proposal_expires_at, proposed_time_held, series_id, trainer_notes, session_type, price, canceled_by, canceled_at, reschedule_count, version, duration_minutes, cancellation_tiers FROM trainings_trainings
+WHERE user_id = $1
+  AND (
+    $2::timestamptz IS NULL
+    OR (training_time, id) > ($2::timestamptz, $3::uuid)
+  )
+ORDER BY training_time, id
+LIMIT $4
+`
+
+// Keyset pagination by (training_time, id), canceled trainings are included.
+func (q *Queries) ListTrainingHistoryByUser(ctx context.Context, userID pgtype.UUID, afterTime pgtype.Timestamptz, afterID pgtype.UUID, pageSize int32) ([]TrainingsTraining, error) {
+	rows, err := q.db.Query(ctx, listTrainingHistoryByUser,
+		userID,
+		afterTime,
+		afterID,
+		pageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TrainingsTraining
+	for rows.Next() {
+		var i TrainingsTraining
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.UserName,
+			&i.TrainingTime,
+			&i.Notes,
+			&i.ProposedNewTime,
+			&i.MoveProposedBy,
+			&i.Canceled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Attendance,
+			&i.CancellationPolicyVersion,
+			&i.ProposalExpiresAt,
+			&i.ProposedTimeHeld,
+			&i.SeriesID,
+			&i.TrainerNotes,
+			&i.SessionType,
+			&i.Price,
+			&i.CanceledBy,
+			&i.CanceledAt,
+			&i.RescheduleCount,
+			&i.Version,
+			&i.DurationMinutes,
+			&i.CancellationTiers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrainingNotesHistory = `-- name: ListTrainingNotesHistory :many
+SELECT id, training_id, kind, notes, edited_by, edited_by_role, edited_at FROM trainings_notes_history
+WHERE training_id = $1
+ORDER BY edited_at, id
+`
+
+func (q *Queries) ListTrainingNotesHistory(ctx context.Context, trainingID pgtype.UUID) ([]TrainingsNotesHistory, error) {
+	rows, err := q.db.Query(ctx, listTrainingNotesHistory, trainingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TrainingsNotesHistory
+	for rows.Next() {
+		var i TrainingsNotesHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.TrainingID,
+			&i.Kind,
+			&i.Notes,
+			&i.EditedBy,
+			&i.EditedByRole,
+			&i.EditedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrainingSeriesOccurrences = `-- name: ListTrainingSeriesOccurrences :many
+SELECT series_id, occurrence_time, status, training_id, failure_reason FROM trainings_series_occurrences
+WHERE series_id = $1
+ORDER BY occurrence_time
+`
+
+func (q *Queries) ListTrainingSeriesOccurrences(ctx context.Context, seriesID pgtype.UUID) ([]TrainingsSeriesOccurrence, error) {
+	rows, err := q.db.Query(ctx, listTrainingSeriesOccurrences, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TrainingsSeriesOccurrence
+	for rows.Next() {
+		var i TrainingsSeriesOccurrence
+		if err := rows.Scan(
+			&i.SeriesID,
+			&i.OccurrenceTime,
+			&i.Status,
+			&i.TrainingID,
+			&i.FailureReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrainingSeriesOccurrencesWithTrainings = `-- name: ListTrainingSeriesOccurrencesWithTrainings :many
+SELECT
+    o.occurrence_time,
+    o.status,
+    o.training_id,
+    o.failure_reason,
+    t.canceled AS training_canceled
+FROM trainings_series_occurrences o
+LEFT JOIN trainings_trainings t ON t.id = o.training_id
+WHERE o.series_id = $1
+ORDER BY o.occurrence_time
+`
+
+type ListTrainingSeriesOccurrencesWithTrainingsRow struct {
+	OccurrenceTime   time.Time   `json:"occurrence_time"`
+	Status           string      `json:"status"`
+	TrainingID       pgtype.UUID `json:"training_id"`
+	FailureReason    *string     `json:"failure_reason"`
+	TrainingCanceled *bool       `json:"training_canceled"`
+}
+
+func (q *Queries) ListTrainingSeriesOccurrencesWithTrainings(ctx context.Context, seriesID pgtype.UUID) ([]ListTrainingSeriesOccurrencesWithTrainingsRow, error) {
+	rows, err := q.db.Query(ctx, listTrainingSeriesOccurrencesWithTrainings, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTrainingSeriesOccurrencesWithTrainingsRow
+	for rows.Next() {
+		var i ListTrainingSeriesOccurrencesWithTrainingsRow
+		if err := rows.Scan(
+			&i.OccurrenceTime,
+			&i.Status,
+			&i.TrainingID,
+			&i.FailureReason,
+			&i.TrainingCanceled,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrainingsByUser = `-- name: ListTrainingsByUser :many
+SELECT id, user_id, user_name, training_time, notes, proposed_new_time, move_proposed_by, canceled, created_at, updated_at, attendance, cancellation_policy_version, proposal_expires_at, proposed_time_held, series_id, trainer_notes, session_type, price, canceled_by, canceled_at, reschedule_count, version, duration_minutes, cancellation_tiers FROM trainings_trainings
+WHERE user_id = $1
+  AND canceled = false
+  AND (created_at > $2 OR $2 IS NULL)
+  AND (id > $3 OR $3 IS NULL)
+ORDER BY created_at, id
+LIMIT $4
+`
+
+func (q *Queries) ListTrainingsByUser(ctx context.Context, userID pgtype.UUID, createdAt time.Time, iD pgtype.UUID, limit int32) ([]TrainingsTraining, error) {
+	rows, err := q.db.Query(ctx, listTrainingsByUser,
+		userID,
+		createdAt,
+		iD,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TrainingsTraining
+	for rows.Next() {
+		var i TrainingsTraining
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.UserName,
+			&i.TrainingTime,
+			&i.Notes,
+			&i.ProposedNewTime,
+			&i.MoveProposedBy,
+			&i.Canceled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Attendance,
+			&i.CancellationPolicyVersion,
+			&i.ProposalExpiresAt,
+			&i.ProposedTimeHeld,
+			&i.SeriesID,
+			&i.TrainerNotes,
+			&i.SessionType,
+			&i.Price,
+			&i.CanceledBy,
+			&i.CanceledAt,
+			&i.RescheduleCount,
+			&i.Version,
+			&i.DurationMinutes,
+			&i.CancellationTiers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrainingsForRoster = `-- name: ListTrainingsForRoster :many
+SELECT id, user_id, user_name, training_time, notes, proposed_new_time, move_proposed_by, canceled, created_at, updated_at, attendance, cancellation_policy_version, proposal_expires_at, proposed_time_held, series_id, trainer_notes, session_type, price, canceled_by, canceled_at, reschedule_count, version, duration_minutes, cancellation_tiers FROM trainings_trainings
+WHERE (
+    user_id = ANY($1::uuid[])
+    OR NOT user_id = ANY($2::uuid[])
+  )
+  AND canceled = false
+ORDER BY created_at DESC, id
+`
+
+// Trainings of the trainer's clients and of attendees not assigned to any trainer
+func (q *Queries) ListTrainingsForRoster(ctx context.Context, clientIds []pgtype.UUID, otherTrainersClientIds []pgtype.UUID) ([]TrainingsTraining, error) {
+	rows, err := q.db.Query(ctx, listTrainingsForRoster, clientIds, otherTrainersClientIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TrainingsTraining
+	for rows.Next() {
+		var i TrainingsTraining
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.UserName,
+			&i.TrainingTime,
+			&i.Notes,
+			&i.ProposedNewTime,
+			&i.MoveProposedBy,
+			&i.Canceled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Attendance,
+			&i.CancellationPolicyVersion,
+			&i.ProposalExpiresAt,
+			&i.ProposedTimeHeld,
+			&i.SeriesID,
+			&i.TrainerNotes,
+			&i.SessionType,
+			&i.Price,
+			&i.CanceledBy,
+			&i.CanceledAt,
+			&i.RescheduleCount,
+			&i.Version,
+			&i.DurationMinutes,
+			&i.CancellationTiers,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrainingsPendingAttendance = `-- name: ListTrainingsPendingAttendance :many
+SELECT id FROM trainings_trainings
+WHERE canceled = false
+  AND attendance IS NULL
+  AND training_time + make_interval(mins => duration_minutes) < $1
+ORDER BY training_time, id
+`
+
+func (q *Queries) ListTrainingsPendingAttendance(ctx context.Context, endedBefore time.Time) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listTrainingsPendingAttendance, endedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrainingsWithExpiredRescheduleProposal = `-- name: ListTrainingsWithExpiredRescheduleProposal :many
+SELECT id FROM trainings_trainings
+WHERE canceled = false
+  AND proposal_expires_at IS NOT NULL
+  AND proposal_expires_at <= $1
+ORDER BY proposal_expires_at, id
+`
+
+func (q *Queries) ListTrainingsWithExpiredRescheduleProposal(ctx context.Context, proposalExpiresAt pgtype.Timestamptz) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listTrainingsWithExpiredRescheduleProposal, proposalExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserDataNotesRevisions = `-- name: ListUserDataNotesRevisions :many
+SELECT h.id, h.training_id, h.kind, h.notes, h.edited_by, h.edited_by_role, h.edited_at FROM trainings_notes_history h
+JOIN trainings_trainings t ON t.id = h.training_id
+WHERE t.user_id = $1
+  AND h.kind = 'shared'
+ORDER BY h.training_id, h.edited_at, h.id
+`
+
+// Trainer notes are private, so only revisions of shared notes are exported.
+func (q *Queries) ListUserDataNotesRevisions(ctx context.Context, userID pgtype.UUID) ([]TrainingsNotesHistory, error) {
+	rows, err := q.db.Query(ctx, listUserDataNotesRevisions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TrainingsNotesHistory
+	for rows.Next() {
+		var i TrainingsNotesHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.TrainingID,
+			&i.Kind,
+			&i.Notes,
+			&i.EditedBy,
+			&i.EditedByRole,
+			&i.EditedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserDataSeries = `-- name: ListUserDataSeries :many
+SELECT id, user_id, user_name, canceled, created_at, updated_at FROM trainings_series
+WHERE user_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListUserDataSeries(ctx context.Context, userID pgtype.UUID) ([]TrainingsSeries, error) {
+	rows, err := q.db.Query(ctx, listUserDataSeries, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TrainingsSeries
+	for rows.Next() {
+		var i TrainingsSeries
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.UserName,
+			&i.Canceled,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserDataTrainings = `-- name: ListUserDataTrainings :many
+SELECT
+    t.id,
+    t.training_time,
+    t.session_type,
+    t.price,
+    t.notes,
+    t.attendance,
+    t.canceled,
+    t.canceled_at,
+    t.created_at,
+    f.rating,
+    f.comment AS feedback_comment,
+    f.reply AS feedback_reply
+FROM trainings_trainings t
+LEFT JOIN trainings_feedback f ON f.training_id = t.id
+WHERE t.user_id = $1
+ORDER BY t.training_time, t.id
+`
+
+type ListUserDataTrainingsRow struct {
+	ID              pgtype.UUID        `json:"id"`
+	TrainingTime    time.Time          `json:"training_time"`
+	SessionType     string             `json:"session_type"`
+	Price           int32              `json:"price"`
+	Notes           *string            `json:"notes"`
+	Attendance      *string            `json:"attendance"`
+	Canceled        bool               `json:"canceled"`
+	CanceledAt      pgtype.Timestamptz `json:"canceled_at"`
+	CreatedAt       time.Time          `json:"created_at"`
+	Rating          *int32             `json:"rating"`
+	FeedbackComment *string            `json:"feedback_comment"`
+	FeedbackReply   *string            `json:"feedback_reply"`
+}
+
+// All trainings of the user with their feedback, exported for data-subject requests.
+func (q *Queries) ListUserDataTrainings(ctx context.Context, userID pgtype.UUID) ([]ListUserDataTrainingsRow, error) {
+	rows, err := q.db.Query(ctx, listUserDataTrainings, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserDataTrainingsRow
+	for rows.Next() {
+		var i ListUserDataTrainingsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TrainingTime,
+			&i.SessionType,
+			&i.Price,
+			&i.Notes,
+			&i.Attendance,
+			&i.Canceled,
+			&i.CanceledAt,
+			&i.CreatedAt,
+			&i.Rating,
+			&i.FeedbackComment,
+			&i.FeedbackReply,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserDataWaitlistEntries = `-- name: ListUserDataWaitlistEntries :many
+SELECT id, user_id, user_name, hour, auto_book, status, offer_expires_at, training_id, created_at, updated_at FROM trainings_waitlist
+WHERE user_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListUserDataWaitlistEntries(ctx context.Context, userID pgtype.UUID) ([]TrainingsWaitlist, error) {
+	rows, err := q.db.Query(ctx, listUserDataWaitlistEntries, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TrainingsWaitlist
+	for rows.Next() {
+		var i TrainingsWaitlist
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.UserName,
+			&i.Hour,
+			&i.AutoBook,
+			&i.Status,
+			&i.OfferExpiresAt,
+			&i.TrainingID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWaitingWaitlistEntries = `-- name: ListWaitingWaitlistEntries :many
+SELECT id FROM trainings_waitlist
+WHERE status = 'waiting'
+  AND hour = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) ListWaitingWaitlistEntries(ctx context.Context, hour time.Time) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listWaitingWaitlistEntries, hour)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWaitlistEntriesByUser = `-- name: ListWaitlistEntriesByUser :many
+SELECT id, user_id, user_name, hour, auto_book, status, offer_expires_at, training_id, created_at, updated_at FROM trainings_waitlist
+WHERE user_id = $1
+  AND status IN ('waiting', 'offered')
+ORDER BY hour, created_at
+`
+
+func (q *Queries) ListWaitlistEntriesByUser(ctx context.Context, userID pgtype.UUID) ([]TrainingsWaitlist, error) {
+	rows, err := q.db.Query(ctx, listWaitlistEntriesByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TrainingsWaitlist
+	for rows.Next() {
+		var i TrainingsWaitlist
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.UserName,
+			&i.Hour,
+			&i.AutoBook,
+			&i.Status,
+			&i.OfferExpiresAt,
+			&i.TrainingID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWaitlistEntriesWithExpiredOffer = `-- name: ListWaitlistEntriesWithExpiredOffer :many
+SELECT id FROM trainings_waitlist
+WHERE status = 'offered'
+  AND offer_expires_at <= $1
+ORDER BY offer_expires_at, id
+`
+
+func (q *Queries) ListWaitlistEntriesWithExpiredOffer(ctx context.Context, offerExpiresAt pgtype.Timestamptz) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listWaitlistEntriesWithExpiredOffer, offerExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const trainerHoursReport = `-- name: TrainerHoursReport :many
+SELECT
+    date_trunc($1::text, hour_time, 'UTC')::timestamptz AS period_start,
+    COUNT(*) FILTER (WHERE availability IN ('available', 'training_scheduled')) AS offered_hours,
+    COUNT(*) FILTER (WHERE availability = 'training_scheduled') AS booked_hours
+FROM trainer_hours
+WHERE hour_time >= $2 AND hour_time < $3
+  AND (
+    $4::boolean
+    OR availability <> 'training_scheduled'
+    OR attendee_id IS NULL
+    OR attendee_id = ANY($5::uuid[])
+    OR NOT attendee_id = ANY($6::uuid[])
+  )
+GROUP BY period_start
+ORDER BY period_start
+`
+
+type TrainerHoursReportRow struct {
+	PeriodStart  pgtype.Timestamptz `json:"period_start"`
+	OfferedHours int64              `json:"offered_hours"`
+	BookedHours  int64              `json:"booked_hours"`
+}
+
+// Hours offered by the trainer (available or booked) and booked ones, grouped by week or month.
+// Hours never made available have no row in trainer_hours, so they are not counted.
+// Unless all_attendees is set, hours booked by clients of other trainers are left out,
+// the same as trainings in ListTrainingsForRoster. Hours booked by unknown attendees are kept.
+func (q *Queries) TrainerHoursReport(ctx context.Context, period string, fromTime time.Time, toTime time.Time, allAttendees bool, clientIds []pgtype.UUID, otherTrainersClientIds []pgtype.UUID) ([]TrainerHoursReportRow, error) {
+	rows, err := q.db.Query(ctx, trainerHoursReport,
+		period,
+		fromTime,
+		toTime,
+		allAttendees,
+		clientIds,
+		otherTrainersClientIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TrainerHoursReportRow
+	for rows.Next() {
+		var i TrainerHoursReportRow
+		if err := rows.Scan(&i.PeriodStart, &i.OfferedHours, &i.BookedHours); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const trainingsActivityReport = `-- name: TrainingsActivityReport :many
+SELECT
+    date_trunc($1::text, training_time, 'UTC')::timestamptz AS period_start,
+    COUNT(*) FILTER (WHERE NOT canceled) AS trainings,
+    COUNT(*) FILTER (WHERE attendance IN ('attended', 'completed')) AS attended,
+    COUNT(*) FILTER (WHERE attendance = 'no_show') AS no_shows,
+    COUNT(*) FILTER (WHERE canceled) AS cancellations,
+    COALESCE(SUM(reschedule_count), 0)::bigint AS reschedules
+FROM trainings_trainings
+WHERE training_time >= $2 AND training_time < $3
+  AND (
+    $4::boolean
+    OR user_id = ANY($5::uuid[])
+    OR NOT user_id = ANY($6::uuid[])
+  )
+GROUP BY period_start
+ORDER BY period_start
+`
+
+type TrainingsActivityReportRow struct {
+	PeriodStart   pgtype.Timestamptz `json:"period_start"`
+	Trainings     int64              `json:"trainings"`
+	Attended      int64              `json:"attended"`
+	NoShows       int64              `json:"no_shows"`
+	Cancellations int64              `json:"cancellations"`
+	Reschedules   int64              `json:"reschedules"`
+}
+
+// Trainings are grouped by their current time, so reschedules are counted in the period the training was moved to.
+// Unless all_attendees is set, only trainings of the trainer's clients and of attendees without a trainer are counted.
+func (q *Queries) TrainingsActivityReport(ctx context.Context, period string, fromTime time.Time, toTime time.Time, allAttendees bool, clientIds []pgtype.UUID, otherTrainersClientIds []pgtype.UUID) ([]TrainingsActivityReportRow, error) {
+	rows, err := q.db.Query(ctx, trainingsActivityReport,
+		period,
+		fromTime,
+		toTime,
+		allAttendees,
+		clientIds,
+		otherTrainersClientIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TrainingsActivityReportRow
+	for rows.Next() {
+		var i TrainingsActivityReportRow
+		if err := rows.Scan(
+			&i.PeriodStart,
+			&i.Trainings,
+			&i.Attended,
+			&i.NoShows,
+			&i.Cancellations,
+			&i.Reschedules,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const trainingsCancellationReport = `-- name: TrainingsCancellationReport :many
+SELECT
+    date_trunc($1::text, training_time, 'UTC')::timestamptz AS period_start,
+    COALESCE(canceled_by, 'unknown')::text AS canceled_by,
+    COUNT(*) AS cancellations,
+    COUNT(*) FILTER (
+        WHERE canceled_at > training_time - make_interval(secs => $2::float8)
+    ) AS late_cancellations,
+    COALESCE(AVG(EXTRACT(EPOCH FROM training_time - canceled_at)), 0)::float8 AS average_notice_seconds
+FROM trainings_trainings
+WHERE canceled AND training_time >= $3 AND training_time < $4
+  AND (
+    $5::boolean
+    OR user_id = ANY($6::uuid[])
+    OR NOT user_id = ANY($7::uuid[])
+  )
+GROUP BY period_start, canceled_by
+ORDER BY period_start, canceled_by
+`
+
+type TrainingsCancellationReportRow struct {
+	PeriodStart          pgtype.Timestamptz `json:"period_start"`
+	CanceledBy           string             `json:"canceled_by"`
+	Cancellations        int64              `json:"cancellations"`
+	LateCancellations    int64              `json:"late_cancellations"`
+	AverageNoticeSeconds float64            `json:"average_notice_seconds"`
+}
+
+// Cancellations canceled before canceled_by and canceled_at were recorded are reported as canceled by 'unknown'.
+// Unless all_attendees is set, only trainings of the trainer's clients and of attendees without a trainer are counted.
+func (q *Queries) TrainingsCancellationReport(ctx context.Context, period string, lateNoticeSeconds float64, fromTime time.Time, toTime time.Time, allAttendees bool, clientIds []pgtype.UUID, otherTrainersClientIds []pgtype.UUID) ([]TrainingsCancellationReportRow, error) {
+	rows, err := q.db.Query(ctx, trainingsCancellationReport,
+		period,
+		lateNoticeSeconds,
+		fromTime,
+		toTime,
+		allAttendees,
+		clientIds,
+		otherTrainersClientIds,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TrainingsCancellationReportRow
+	for rows.Next() {
+		var i TrainingsCancellationReportRow
+		if err := rows.Scan(
+			&i.PeriodStart,
+			&i.CanceledBy,
+			&i.Cancellations,
+			&i.LateCancellations,
+			&i.AverageNoticeSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateBulkCancellation = `-- name: UpdateBulkCancellation :exec
+UPDATE trainings_bulk_cancellations
+SET
+    range_blocked = $2,
+    completed = $3,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) UpdateBulkCancellation(ctx context.Context, iD pgtype.UUID, rangeBlocked bool, completed bool) error {
+	_, err := q.db.Exec(ctx, updateBulkCancellation, iD, rangeBlocked, completed)
+	return err
+}
+
+const updateSessionType = `-- name: UpdateSessionType :exec
+UPDATE trainings_session_types
+SET
+    name = $2,
+    description = $3,
+    duration_minutes = $4,
+    credit_price = $5,
+    cancellation_tiers = $6,
+    archived = $7,
+    updated_at = NOW()
+WHERE code = $1
+`
+
+func (q *Queries) UpdateSessionType(ctx context.Context, code string, name string, description string, durationMinutes int32, creditPrice int32, cancellationTiers []byte, archived bool) error {
+	_, err := q.db.Exec(ctx, updateSessionType,
+		code,
+		name,
+		description,
+		durationMinutes,
+		creditPrice,
+		cancellationTiers,
+		archived,
+	)
+	return err
+}
+
+const updateTraining = `-- name: UpdateTraining :execrows
+UPDATE trainings_trainings
+SET
+    training_time = $2,
+    notes = $3,
+    proposed_new_time = $4,
+    move_proposed_by = $5,
+    proposal_expires_at = $6,
+    proposed_time_held = $7,
+    canceled = $8,
+    attendance = $9,
+    trainer_notes = $10,
+    canceled_by = $11,
+    canceled_at = $12,
+    reschedule_count = $13,
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1 AND version = $14
+`
+
+type UpdateTrainingParams struct {
+	ID                pgtype.UUID        `json:"id"`
+	TrainingTime      time.Time          `json:"training_time"`
+	Notes             *string            `json:"notes"`
+	ProposedNewTime   pgtype.Timestamptz `json:"proposed_new_time"`
+	MoveProposedBy    *string            `json:"move_proposed_by"`
+	ProposalExpiresAt pgtype.Timestamptz `json:"proposal_expires_at"`
+	ProposedTimeHeld  bool               `json:"proposed_time_held"`
+	Canceled          bool               `json:"canceled"`
+	Attendance        *string            `json:"attendance"`
+	TrainerNotes      *string            `json:"trainer_notes"`
+	CanceledBy        *string            `json:"canceled_by"`
+	CanceledAt        pgtype.Timestamptz `json:"canceled_at"`
+	RescheduleCount   int32              `json:"reschedule_count"`
+	Version           int32              `json:"version"`
+}
+
+// Overwrites all mutable columns, so cleared values (like a rejected reschedule proposal) are persisted too.
+// Nothing is updated when the training was changed since it was read with the given version.
+// Every call bumps the version, so it shouldn't be called for unchanged trainings.
+func (q *Queries) UpdateTraining(ctx context.Context, arg UpdateTrainingParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTraining,
+		arg.ID,
+		arg.TrainingTime,
+		arg.Notes,
+		arg.ProposedNewTime,
+		arg.MoveProposedBy,
+		arg.ProposalExpiresAt,
+		arg.ProposedTimeHeld,
+		arg.Canceled,
+		arg.Attendance,
+		arg.TrainerNotes,
+		arg.CanceledBy,
+		arg.CanceledAt,
+		arg.RescheduleCount,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateTrainingSeries = `-- name: UpdateTrainingSeries :exec
+UPDATE trainings_series
+SET
+    canceled = $2,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) UpdateTrainingSeries(ctx context.Context, iD pgtype.UUID, canceled bool) error {
+	_, err := q.db.Exec(ctx, updateTrainingSeries, iD, canceled)
+	return err
+}
+
+const updateWaitlistEntry = `-- name: UpdateWaitlistEntry :exec
+UPDATE trainings_waitlist
 SET
-    notes = COALESCE($2, notes),
-    proposed_new_time = COALESCE($3, proposed_new_time),
-    move_proposed_by = COALESCE($4, move_proposed_by),
-    canceled = COALESCE($5, canceled),
+    status = $2,
+    offer_expires_at = $3,
+    training_id = $4,
     updated_at = NOW()
 WHERE id = $1
 `
 
-func (q *Queries) UpdateTraining(ctx context.Context, iD pgtype.UUID, notes *string, proposedNewTime pgtype.Timestamptz, moveProposedBy *string, canceled bool) error {
-	_, err := q.db.Exec(ctx, updateTraining,
+func (q *Queries) UpdateWaitlistEntry(ctx context.Context, iD pgtype.UUID, status string, offerExpiresAt pgtype.Timestamptz, trainingID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, updateWaitlistEntry,
 		iD,
-		notes,
-		proposedNewTime,
-		moveProposedBy,
-		canceled,
+		status,
+		offerExpiresAt,
+		trainingID,
+	)
+	return err
+}
+
+const upsertBulkCancellationItem = `-- name: UpsertBulkCancellationItem :exec
+INSERT INTO trainings_bulk_cancellation_items (
+    bulk_cancellation_id,
+    training_id,
+    status,
+    training_time,
+    attendee_id,
+    balance_delta,
+    hour_unavailable
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (bulk_cancellation_id, training_id) DO UPDATE SET
+    status = EXCLUDED.status,
+    training_time = EXCLUDED.training_time,
+    attendee_id = EXCLUDED.attendee_id,
+    balance_delta = EXCLUDED.balance_delta,
+    hour_unavailable = EXCLUDED.hour_unavailable
+`
+
+func (q *Queries) UpsertBulkCancellationItem(ctx context.Context, bulkCancellationID pgtype.UUID, trainingID pgtype.UUID, status string, trainingTime pgtype.Timestamptz, attendeeID pgtype.UUID, balanceDelta int32, hourUnavailable bool) error {
+	_, err := q.db.Exec(ctx, upsertBulkCancellationItem,
+		bulkCancellationID,
+		trainingID,
+		status,
+		trainingTime,
+		attendeeID,
+		balanceDelta,
+		hourUnavailable,
+	)
+	return err
+}
+
+const upsertTrainingFeedback = `-- name: UpsertTrainingFeedback :exec
+INSERT INTO trainings_feedback (
+    training_id,
+    rating,
+    comment,
+    reply,
+    created_at,
+    replied_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (training_id) DO UPDATE SET
+    reply = EXCLUDED.reply,
+    replied_at = EXCLUDED.replied_at
+`
+
+func (q *Queries) UpsertTrainingFeedback(ctx context.Context, trainingID pgtype.UUID, rating int32, comment string, reply *string, createdAt time.Time, repliedAt pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, upsertTrainingFeedback,
+		trainingID,
+		rating,
+		comment,
+		reply,
+		createdAt,
+		repliedAt,
+	)
+	return err
+}
+
+const upsertTrainingSeriesOccurrence = `-- name: UpsertTrainingSeriesOccurrence :exec
+INSERT INTO trainings_series_occurrences (
+    series_id,
+    occurrence_time,
+    status,
+    training_id,
+    failure_reason
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (series_id, occurrence_time) DO UPDATE SET
+    status = EXCLUDED.status,
+    training_id = EXCLUDED.training_id,
+    failure_reason = EXCLUDED.failure_reason
+`
+
+func (q *Queries) UpsertTrainingSeriesOccurrence(ctx context.Context, seriesID pgtype.UUID, occurrenceTime time.Time, status string, trainingID pgtype.UUID, failureReason *string) error {
+	_, err := q.db.Exec(ctx, upsertTrainingSeriesOccurrence,
+		seriesID,
+		occurrenceTime,
+		status,
+		trainingID,
+		failureReason,
 	)
 	return err
 }
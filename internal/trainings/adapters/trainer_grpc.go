@@ -18,9 +18,22 @@ func NewTrainerGrpc(client trainer.TrainerServiceClient) TrainerGrpc {
 	return TrainerGrpc{client: client}
 }
 
-func (s TrainerGrpc) ScheduleTraining(ctx context.Context, trainingTime time.Time) error {
+func (s TrainerGrpc) IsHourAvailable(ctx context.Context, hour time.Time) (bool, error) {
+	resp, err := s.client.IsHourAvailable(ctx, &trainer.IsHourAvailableRequest{
+		Time: timestamppb.New(hour),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resp.IsAvailable, nil
+}
+
+func (s TrainerGrpc) ScheduleTraining(ctx context.Context, trainingTime time.Time, duration time.Duration, attendeeUUID string) error {
 	_, err := s.client.ScheduleTraining(ctx, &trainer.UpdateHourRequest{
-		Time: timestamppb.New(trainingTime),
+		Time:                    timestamppb.New(trainingTime),
+		AttendeeId:              attendeeUUID,
+		TrainingDurationMinutes: int32(duration / time.Minute),
 	})
 
 	return err
@@ -34,12 +47,22 @@ func (s TrainerGrpc) CancelTraining(ctx context.Context, trainingTime time.Time)
 	return err
 }
 
+func (s TrainerGrpc) MakeHourUnavailable(ctx context.Context, hour time.Time) error {
+	_, err := s.client.MakeHourUnavailable(ctx, &trainer.UpdateHourRequest{
+		Time: timestamppb.New(hour),
+	})
+
+	return err
+}
+
 func (s TrainerGrpc) MoveTraining(
 	ctx context.Context,
 	newTime time.Time,
 	originalTrainingTime time.Time,
+	duration time.Duration,
+	attendeeUUID string,
 ) error {
-	err := s.ScheduleTraining(ctx, newTime)
+	err := s.ScheduleTraining(ctx, newTime, duration, attendeeUUID)
 	if err != nil {
 		return errors.Wrap(err, "unable to schedule training")
 	}
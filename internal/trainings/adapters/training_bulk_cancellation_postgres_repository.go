@@ -0,0 +1,248 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/db"
+	sqlc_trainings "github.com/vaintrub/go-ddd-template/internal/trainings/adapters/sqlc"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// AddBulkCancellation persists a new bulk cancellation together with its trainings.
+// Implements training.BulkCancellationRepository interface.
+func (r *TrainingPostgresRepository) AddBulkCancellation(ctx context.Context, b *training.BulkCancellation) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback is safe to call even if commit succeeds
+	}()
+
+	queries := sqlc_trainings.New(tx)
+
+	id := db.UUIDToPgtype(uuid.MustParse(b.UUID()))
+	trainerID := db.UUIDToPgtype(uuid.MustParse(b.TrainerUUID()))
+
+	if err := queries.CreateBulkCancellation(ctx, id, trainerID, b.From(), b.To(), b.IsRangeBlocked(), b.IsCompleted()); err != nil {
+		return db.TranslatePgError(err)
+	}
+
+	if err := upsertBulkCancellationItems(ctx, queries, id, b.Items()); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetBulkCancellation retrieves a bulk cancellation by UUID.
+// Implements training.BulkCancellationRepository interface.
+func (r *TrainingPostgresRepository) GetBulkCancellation(ctx context.Context, bulkCancellationUUID string) (*training.BulkCancellation, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	id, err := db.StringToPgtypeUUID(bulkCancellationUUID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bulk cancellation UUID: %w", err)
+	}
+
+	return getBulkCancellation(ctx, queries, queries.GetBulkCancellation, id, bulkCancellationUUID)
+}
+
+// UpdateBulkCancellation updates an existing bulk cancellation using the provided update function.
+// The bulk cancellation is locked until the update is committed, so progress of concurrent runs is not lost.
+// Implements training.BulkCancellationRepository interface.
+func (r *TrainingPostgresRepository) UpdateBulkCancellation(
+	ctx context.Context,
+	bulkCancellationUUID string,
+	updateFn func(ctx context.Context, b *training.BulkCancellation) (*training.BulkCancellation, error),
+) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback is safe to call even if commit succeeds
+	}()
+
+	queries := sqlc_trainings.New(tx)
+
+	id, err := db.StringToPgtypeUUID(bulkCancellationUUID)
+	if err != nil {
+		return fmt.Errorf("invalid bulk cancellation UUID: %w", err)
+	}
+
+	b, err := getBulkCancellation(ctx, queries, queries.GetBulkCancellationForUpdate, id, bulkCancellationUUID)
+	if err != nil {
+		return err
+	}
+
+	updatedBulkCancellation, err := updateFn(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	if err := queries.UpdateBulkCancellation(ctx, id, updatedBulkCancellation.IsRangeBlocked(), updatedBulkCancellation.IsCompleted()); err != nil {
+		return db.TranslatePgError(err)
+	}
+
+	if err := upsertBulkCancellationItems(ctx, queries, id, updatedBulkCancellation.Items()); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// BulkCancellation implements the BulkCancellationReadModel interface for queries.
+func (r *TrainingPostgresRepository) BulkCancellation(ctx context.Context, bulkCancellationUUID string) (query.BulkCancellation, error) {
+	b, err := r.GetBulkCancellation(ctx, bulkCancellationUUID)
+	if err != nil {
+		return query.BulkCancellation{}, err
+	}
+
+	items := b.Items()
+	trainings := make([]query.BulkCanceledTraining, 0, len(items))
+	for _, item := range items {
+		canceledTraining := query.BulkCanceledTraining{
+			TrainingUUID:    item.TrainingUUID(),
+			Status:          item.Status().String(),
+			BalanceDelta:    item.BalanceDelta(),
+			HourUnavailable: item.IsHourUnavailable(),
+		}
+		if !item.TrainingTime().IsZero() {
+			canceledTraining.Time = &[]time.Time{item.TrainingTime()}[0]
+		}
+		if item.AttendeeUUID() != "" {
+			canceledTraining.AttendeeUUID = &[]string{item.AttendeeUUID()}[0]
+		}
+
+		trainings = append(trainings, canceledTraining)
+	}
+
+	return query.BulkCancellation{
+		UUID:        b.UUID(),
+		TrainerUUID: b.TrainerUUID(),
+		From:        b.From(),
+		To:          b.To(),
+		Completed:   b.IsCompleted(),
+		Trainings:   trainings,
+	}, nil
+}
+
+// getBulkCancellation loads the bulk cancellation together with its trainings.
+// getRow is either GetBulkCancellation or GetBulkCancellationForUpdate.
+func getBulkCancellation(
+	ctx context.Context,
+	queries *sqlc_trainings.Queries,
+	getRow func(ctx context.Context, id pgtype.UUID) (sqlc_trainings.TrainingsBulkCancellation, error),
+	id pgtype.UUID,
+	bulkCancellationUUID string,
+) (*training.BulkCancellation, error) {
+	row, err := getRow(ctx, id)
+	if err != nil {
+		if db.IsNotFound(db.TranslatePgError(err)) {
+			return nil, training.BulkCancellationNotFoundError{BulkCancellationUUID: bulkCancellationUUID}
+		}
+		return nil, db.TranslatePgError(err)
+	}
+
+	itemRows, err := queries.ListBulkCancellationItems(ctx, id)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	items := make([]training.BulkCancellationItem, 0, len(itemRows))
+	for _, itemRow := range itemRows {
+		item, err := unmarshalBulkCancellationItem(itemRow)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal bulk cancellation item: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	return training.UnmarshalBulkCancellationFromDatabase(
+		db.PgtypeToUUID(row.ID).String(),
+		db.PgtypeToUUID(row.TrainerID).String(),
+		row.RangeFrom,
+		row.RangeTo,
+		items,
+		row.RangeBlocked,
+		row.Completed,
+	), nil
+}
+
+// upsertBulkCancellationItems persists progress of all trainings of the bulk cancellation.
+func upsertBulkCancellationItems(
+	ctx context.Context,
+	queries *sqlc_trainings.Queries,
+	bulkCancellationID pgtype.UUID,
+	items []training.BulkCancellationItem,
+) error {
+	for _, item := range items {
+		var trainingTime pgtype.Timestamptz
+		if !item.TrainingTime().IsZero() {
+			trainingTime = pgtype.Timestamptz{Time: item.TrainingTime(), Valid: true}
+		}
+
+		var attendeeID pgtype.UUID
+		if item.AttendeeUUID() != "" {
+			attendeeID = db.UUIDToPgtype(uuid.MustParse(item.AttendeeUUID()))
+		}
+
+		err := queries.UpsertBulkCancellationItem(
+			ctx,
+			bulkCancellationID,
+			db.UUIDToPgtype(uuid.MustParse(item.TrainingUUID())),
+			item.Status().String(),
+			trainingTime,
+			attendeeID,
+			int32(item.BalanceDelta()),
+			item.IsHourUnavailable(),
+		)
+		if err != nil {
+			return db.TranslatePgError(err)
+		}
+	}
+
+	return nil
+}
+
+// unmarshalBulkCancellationItem converts SQLC TrainingsBulkCancellationItem to domain BulkCancellationItem value.
+func unmarshalBulkCancellationItem(row sqlc_trainings.TrainingsBulkCancellationItem) (training.BulkCancellationItem, error) {
+	status, err := training.NewBulkCancellationItemStatusFromString(row.Status)
+	if err != nil {
+		return training.BulkCancellationItem{}, err
+	}
+
+	var trainingTime time.Time
+	if row.TrainingTime.Valid {
+		trainingTime = row.TrainingTime.Time
+	}
+
+	attendeeUUID := ""
+	if row.AttendeeID.Valid {
+		attendeeUUID = db.PgtypeToUUID(row.AttendeeID).String()
+	}
+
+	return training.UnmarshalBulkCancellationItemFromDatabase(
+		db.PgtypeToUUID(row.TrainingID).String(),
+		status,
+		trainingTime,
+		attendeeUUID,
+		int(row.BalanceDelta),
+		row.HourUnavailable,
+	), nil
+}
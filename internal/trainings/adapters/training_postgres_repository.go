@@ -3,6 +3,7 @@ package adapters
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/google/uuid"
@@ -50,7 +51,32 @@ func (r *TrainingPostgresRepository) AddTraining(ctx context.Context, tr *traini
 		moveProposedBy = &[]string{tr.MovedProposedBy().String()}[0]
 	}
 
-	_, err := queries.CreateTraining(ctx, id, userID, tr.UserName(), tr.Time(), notes, proposedNewTime, moveProposedBy, tr.IsCanceled())
+	var seriesID pgtype.UUID
+	if tr.SeriesUUID() != "" {
+		seriesID = db.UUIDToPgtype(uuid.MustParse(tr.SeriesUUID()))
+	}
+
+	cancellationTiers, err := marshalCancellationTiers(tr.CancellationTiers())
+	if err != nil {
+		return err
+	}
+
+	_, err = queries.CreateTraining(ctx, sqlc_trainings.CreateTrainingParams{
+		ID:                        id,
+		UserID:                    userID,
+		UserName:                  tr.UserName(),
+		TrainingTime:              tr.Time(),
+		Notes:                     notes,
+		ProposedNewTime:           proposedNewTime,
+		MoveProposedBy:            moveProposedBy,
+		Canceled:                  tr.IsCanceled(),
+		CancellationPolicyVersion: tr.CancellationPolicyVersion(),
+		SeriesID:                  seriesID,
+		SessionType:               tr.SessionType(),
+		Price:                     int32(tr.Price()),
+		DurationMinutes:           int32(tr.Duration() / time.Minute),
+		CancellationTiers:         cancellationTiers,
+	})
 	if err != nil {
 		return db.TranslatePgError(err)
 	}
@@ -68,17 +94,9 @@ func (r *TrainingPostgresRepository) GetTraining(ctx context.Context, trainingUU
 		return nil, fmt.Errorf("invalid training UUID: %w", err)
 	}
 
-	row, err := queries.GetTraining(ctx, id)
-	if err != nil {
-		if db.IsNotFound(db.TranslatePgError(err)) {
-			return nil, training.NotFoundError{TrainingUUID: trainingUUID}
-		}
-		return nil, db.TranslatePgError(err)
-	}
-
-	tr, err := unmarshalTraining(row)
+	tr, err := getTraining(ctx, queries.GetTraining, queries, id, trainingUUID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal training: %w", err)
+		return nil, err
 	}
 
 	// Check if user can see this training
@@ -114,17 +132,9 @@ func (r *TrainingPostgresRepository) UpdateTraining(
 		return fmt.Errorf("invalid training UUID: %w", err)
 	}
 
-	row, err := queries.GetTraining(ctx, id)
-	if err != nil {
-		if db.IsNotFound(db.TranslatePgError(err)) {
-			return training.NotFoundError{TrainingUUID: trainingUUID}
-		}
-		return db.TranslatePgError(err)
-	}
-
-	tr, err := unmarshalTraining(row)
+	tr, err := getTraining(ctx, queries.GetTrainingForUpdate, queries, id, trainingUUID)
 	if err != nil {
-		return fmt.Errorf("failed to unmarshal training: %w", err)
+		return err
 	}
 
 	// Check if user can see this training
@@ -132,48 +142,290 @@ func (r *TrainingPostgresRepository) UpdateTraining(
 		return err
 	}
 
+	// updateFn may change the training in place, so the original values are taken before it's called
+	originalParams := updateTrainingParams(id, tr)
+	originalFeedback := tr.Feedback()
+
 	// Apply the update function
 	updatedTr, err := updateFn(ctx, tr)
 	if err != nil {
 		return err
 	}
 
-	// Persist the changes
+	// Saving an unchanged training would bump its version and fail requests made with the current ETag,
+	// for example when a job skips the training.
+	params := updateTrainingParams(id, updatedTr)
+	params.Version = originalParams.Version
+	unchanged := reflect.DeepEqual(params, originalParams) &&
+		reflect.DeepEqual(updatedTr.Feedback(), originalFeedback) &&
+		len(updatedTr.NewNotesRevisions()) == 0
+	if unchanged {
+		return nil
+	}
+
+	updatedRows, err := queries.UpdateTraining(ctx, params)
+	if err != nil {
+		return db.TranslatePgError(err)
+	}
+	if updatedRows == 0 {
+		// can't happen while the training is locked, it guards against updates of trainings read without the lock
+		return fmt.Errorf("%w: version %d is outdated", training.ErrTrainingModified, originalParams.Version)
+	}
+
+	if err := upsertFeedback(ctx, queries, id, updatedTr.Feedback()); err != nil {
+		return err
+	}
+
+	if err := insertNotesRevisions(ctx, queries, id, updatedTr.NewNotesRevisions()); err != nil {
+		return err
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// updateTrainingParams returns the mutable columns of the training, the update is made only for its current version.
+func updateTrainingParams(id pgtype.UUID, tr *training.Training) sqlc_trainings.UpdateTrainingParams {
 	var notes *string
-	if updatedTr.Notes() != "" {
-		notes = &[]string{updatedTr.Notes()}[0]
+	if tr.Notes() != "" {
+		notes = &[]string{tr.Notes()}[0]
 	}
 
 	var proposedNewTime pgtype.Timestamptz
-	if !updatedTr.ProposedNewTime().IsZero() {
-		proposedNewTime = pgtype.Timestamptz{Time: updatedTr.ProposedNewTime(), Valid: true}
+	if !tr.ProposedNewTime().IsZero() {
+		proposedNewTime = pgtype.Timestamptz{Time: tr.ProposedNewTime(), Valid: true}
 	}
 
 	var moveProposedBy *string
-	if !updatedTr.MovedProposedBy().IsZero() {
-		moveProposedBy = &[]string{updatedTr.MovedProposedBy().String()}[0]
+	if !tr.MovedProposedBy().IsZero() {
+		moveProposedBy = &[]string{tr.MovedProposedBy().String()}[0]
+	}
+
+	var proposalExpiresAt pgtype.Timestamptz
+	if !tr.RescheduleProposalExpiresAt().IsZero() {
+		proposalExpiresAt = pgtype.Timestamptz{Time: tr.RescheduleProposalExpiresAt(), Valid: true}
+	}
+
+	var attendance *string
+	if tr.IsAttendanceRecorded() {
+		attendance = &[]string{tr.Attendance().String()}[0]
 	}
 
-	if err := queries.UpdateTraining(ctx, id, notes, proposedNewTime, moveProposedBy, updatedTr.IsCanceled()); err != nil {
+	var trainerNotes *string
+	if tr.TrainerNotes() != "" {
+		trainerNotes = &[]string{tr.TrainerNotes()}[0]
+	}
+
+	var canceledBy *string
+	if !tr.CanceledBy().IsZero() {
+		canceledBy = &[]string{tr.CanceledBy().String()}[0]
+	}
+
+	var canceledAt pgtype.Timestamptz
+	if !tr.CanceledAt().IsZero() {
+		canceledAt = pgtype.Timestamptz{Time: tr.CanceledAt(), Valid: true}
+	}
+
+	return sqlc_trainings.UpdateTrainingParams{
+		ID:                id,
+		TrainingTime:      tr.Time(),
+		Notes:             notes,
+		ProposedNewTime:   proposedNewTime,
+		MoveProposedBy:    moveProposedBy,
+		ProposalExpiresAt: proposalExpiresAt,
+		ProposedTimeHeld:  tr.IsProposedTimeHeld(),
+		Canceled:          tr.IsCanceled(),
+		Attendance:        attendance,
+		TrainerNotes:      trainerNotes,
+		CanceledBy:        canceledBy,
+		CanceledAt:        canceledAt,
+		RescheduleCount:   int32(tr.RescheduleCount()),
+		Version:           int32(tr.Version()),
+	}
+}
+
+// getTraining loads the training with getRow (which may lock it) together with its feedback.
+func getTraining(
+	ctx context.Context,
+	getRow func(ctx context.Context, id pgtype.UUID) (sqlc_trainings.TrainingsTraining, error),
+	queries *sqlc_trainings.Queries,
+	id pgtype.UUID,
+	trainingUUID string,
+) (*training.Training, error) {
+	row, err := getRow(ctx, id)
+	if err != nil {
+		if db.IsNotFound(db.TranslatePgError(err)) {
+			return nil, training.NotFoundError{TrainingUUID: trainingUUID}
+		}
+		return nil, db.TranslatePgError(err)
+	}
+
+	feedback := training.Feedback{}
+	feedbackRow, err := queries.GetTrainingFeedback(ctx, id)
+	if err == nil {
+		feedback = unmarshalFeedback(feedbackRow)
+	} else if !db.IsNotFound(db.TranslatePgError(err)) {
+		return nil, db.TranslatePgError(err)
+	}
+
+	tr, err := unmarshalTraining(row, feedback)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal training: %w", err)
+	}
+
+	return tr, nil
+}
+
+// upsertFeedback persists the training feedback, if the training was rated.
+func upsertFeedback(ctx context.Context, queries *sqlc_trainings.Queries, id pgtype.UUID, feedback training.Feedback) error {
+	if feedback.IsZero() {
+		return nil
+	}
+
+	var reply *string
+	if feedback.Reply() != "" {
+		reply = &[]string{feedback.Reply()}[0]
+	}
+
+	var repliedAt pgtype.Timestamptz
+	if feedback.IsReplied() {
+		repliedAt = pgtype.Timestamptz{Time: feedback.RepliedAt(), Valid: true}
+	}
+
+	err := queries.UpsertTrainingFeedback(
+		ctx,
+		id,
+		int32(feedback.Rating()),
+		feedback.Comment(),
+		reply,
+		feedback.CreatedAt(),
+		repliedAt,
+	)
+	if err != nil {
 		return db.TranslatePgError(err)
 	}
 
-	// Commit the transaction
-	if err := tx.Commit(ctx); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	return nil
+}
+
+// insertNotesRevisions appends notes edits to the notes history.
+func insertNotesRevisions(ctx context.Context, queries *sqlc_trainings.Queries, id pgtype.UUID, revisions []training.NotesRevision) error {
+	for _, revision := range revisions {
+		err := queries.CreateTrainingNotesRevision(
+			ctx,
+			id,
+			revision.Kind().String(),
+			revision.Notes(),
+			db.UUIDToPgtype(uuid.MustParse(revision.EditedBy())),
+			revision.EditedByType().String(),
+			revision.EditedAt(),
+		)
+		if err != nil {
+			return db.TranslatePgError(err)
+		}
 	}
 
 	return nil
 }
 
+// unmarshalFeedback converts SQLC TrainingsFeedback to domain Feedback value.
+func unmarshalFeedback(row sqlc_trainings.TrainingsFeedback) training.Feedback {
+	reply := ""
+	if row.Reply != nil {
+		reply = *row.Reply
+	}
+
+	return training.UnmarshalFeedbackFromDatabase(
+		int(row.Rating),
+		row.Comment,
+		row.CreatedAt,
+		reply,
+		row.RepliedAt.Time,
+	)
+}
+
+// FindTrainingsPendingAttendance returns UUIDs of not canceled trainings without recorded attendance
+// which ended before endedBefore.
+// Implements training.Repository interface.
+func (r *TrainingPostgresRepository) FindTrainingsPendingAttendance(ctx context.Context, endedBefore time.Time) ([]string, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	ids, err := queries.ListTrainingsPendingAttendance(ctx, endedBefore)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	trainingUUIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		trainingUUIDs = append(trainingUUIDs, db.PgtypeToUUID(id).String())
+	}
+
+	return trainingUUIDs, nil
+}
+
+// FindTrainingsWithExpiredRescheduleProposal returns UUIDs of not canceled trainings
+// with reschedule proposal not answered before now.
+// Implements training.Repository interface.
+func (r *TrainingPostgresRepository) FindTrainingsWithExpiredRescheduleProposal(ctx context.Context, now time.Time) ([]string, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	ids, err := queries.ListTrainingsWithExpiredRescheduleProposal(ctx, pgtype.Timestamptz{Time: now, Valid: true})
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	trainingUUIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		trainingUUIDs = append(trainingUUIDs, db.PgtypeToUUID(id).String())
+	}
+
+	return trainingUUIDs, nil
+}
+
+// FindScheduledTrainings returns UUIDs of not canceled trainings starting from from (inclusive) to to (exclusive),
+// which the user can see.
+// Implements training.Repository interface.
+func (r *TrainingPostgresRepository) FindScheduledTrainings(
+	ctx context.Context,
+	from time.Time,
+	to time.Time,
+	user training.User,
+) ([]string, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	rows, err := queries.ListScheduledTrainings(ctx, from, to)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	trainingUUIDs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if !user.CanSeeTrainingsOf(db.PgtypeToUUID(row.UserID).String()) {
+			continue
+		}
+		trainingUUIDs = append(trainingUUIDs, db.PgtypeToUUID(row.ID).String())
+	}
+
+	return trainingUUIDs, nil
+}
+
 // unmarshalTraining converts SQLC TrainingsTraining to domain Training entity.
-func unmarshalTraining(row sqlc_trainings.TrainingsTraining) (*training.Training, error) {
+func unmarshalTraining(row sqlc_trainings.TrainingsTraining, feedback training.Feedback) (*training.Training, error) {
 	// Extract notes
 	notes := ""
 	if row.Notes != nil {
 		notes = *row.Notes
 	}
 
+	trainerNotes := ""
+	if row.TrainerNotes != nil {
+		trainerNotes = *row.TrainerNotes
+	}
+
 	// Extract proposed new time (using pgtype.Timestamptz)
 	proposedNewTime := row.ProposedNewTime.Time
 	// Zero value is fine if not valid
@@ -188,10 +440,38 @@ func unmarshalTraining(row sqlc_trainings.TrainingsTraining) (*training.Training
 		}
 	}
 
+	canceledBy := training.UserType{}
+	if row.CanceledBy != nil {
+		var err error
+		canceledBy, err = userTypeFromDatabase(*row.CanceledBy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid canceled by value: %w", err)
+		}
+	}
+
+	attendance := training.Attendance{}
+	if row.Attendance != nil {
+		var err error
+		attendance, err = training.NewAttendanceFromString(*row.Attendance)
+		if err != nil {
+			return nil, fmt.Errorf("invalid attendance value: %w", err)
+		}
+	}
+
 	// Convert pgtype.UUID to string
 	idStr := db.PgtypeToUUID(row.ID).String()
 	userIDStr := db.PgtypeToUUID(row.UserID).String()
 
+	seriesIDStr := ""
+	if row.SeriesID.Valid {
+		seriesIDStr = db.PgtypeToUUID(row.SeriesID).String()
+	}
+
+	cancellationTiers, err := unmarshalCancellationTiers(row.CancellationTiers)
+	if err != nil {
+		return nil, err
+	}
+
 	// Use the domain unmarshal function
 	tr, err := training.UnmarshalTrainingFromDatabase(
 		idStr,
@@ -199,9 +479,24 @@ func unmarshalTraining(row sqlc_trainings.TrainingsTraining) (*training.Training
 		row.UserName,
 		row.TrainingTime,
 		notes,
+		trainerNotes,
 		row.Canceled,
+		canceledBy,
+		row.CanceledAt.Time,
 		proposedNewTime,
 		moveProposedBy,
+		row.ProposalExpiresAt.Time,
+		row.ProposedTimeHeld,
+		int(row.RescheduleCount),
+		attendance,
+		feedback,
+		row.CancellationPolicyVersion,
+		seriesIDStr,
+		row.SessionType,
+		int(row.Price),
+		time.Duration(row.DurationMinutes)*time.Minute,
+		cancellationTiers,
+		int(row.Version),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal training from database: %w", err)
@@ -210,6 +505,15 @@ func unmarshalTraining(row sqlc_trainings.TrainingsTraining) (*training.Training
 	return tr, nil
 }
 
+// userTypeFromDatabase parses user types stored by the service, which unlike user roles include training.System.
+func userTypeFromDatabase(userType string) (training.UserType, error) {
+	if userType == training.System.String() {
+		return training.System, nil
+	}
+
+	return training.NewUserTypeFromString(userType)
+}
+
 // WithTransaction creates a new repository instance that uses the provided transaction.
 // This allows repository operations to participate in a larger transaction.
 func (r *TrainingPostgresRepository) WithTransaction(tx pgx.Tx) *TrainingPostgresRepository {
@@ -218,12 +522,23 @@ func (r *TrainingPostgresRepository) WithTransaction(tx pgx.Tx) *TrainingPostgre
 	}
 }
 
-// AllTrainings implements the AllTrainingsReadModel interface for queries.
-// It returns all trainings in the system.
-func (r *TrainingPostgresRepository) AllTrainings(ctx context.Context) ([]query.Training, error) {
+// FindTrainingsForRoster implements the ClientsTrainingsReadModel interface for queries.
+// It returns not canceled trainings of the roster's clients and of attendees not assigned to any trainer,
+// the most recently booked first.
+func (r *TrainingPostgresRepository) FindTrainingsForRoster(ctx context.Context, roster query.Roster) ([]query.Training, error) {
 	queries := sqlc_trainings.New(r.pool)
 
-	rows, err := queries.ListAllTrainings(ctx)
+	clientIDs, err := stringsToPgtypeUUIDs(roster.ClientUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	otherTrainersClientIDs, err := stringsToPgtypeUUIDs(roster.OtherTrainersClientUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queries.ListTrainingsForRoster(ctx, clientIDs, otherTrainersClientIDs)
 	if err != nil {
 		return nil, db.TranslatePgError(err)
 	}
@@ -236,6 +551,26 @@ func (r *TrainingPostgresRepository) AllTrainings(ctx context.Context) ([]query.
 	return trainings, nil
 }
 
+// TrainingByUUID implements the TrainingByUUIDReadModel interface for queries.
+func (r *TrainingPostgresRepository) TrainingByUUID(ctx context.Context, trainingUUID string) (query.Training, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	id, err := db.StringToPgtypeUUID(trainingUUID)
+	if err != nil {
+		return query.Training{}, fmt.Errorf("invalid training UUID: %w", err)
+	}
+
+	row, err := queries.GetTraining(ctx, id)
+	if err != nil {
+		if db.IsNotFound(db.TranslatePgError(err)) {
+			return query.Training{}, training.NotFoundError{TrainingUUID: trainingUUID}
+		}
+		return query.Training{}, db.TranslatePgError(err)
+	}
+
+	return rowToQueryTraining(row), nil
+}
+
 // FindTrainingsForUser implements the TrainingsForUserReadModel interface for queries.
 // It returns all trainings for a specific user.
 func (r *TrainingPostgresRepository) FindTrainingsForUser(ctx context.Context, userUUID string) ([]query.Training, error) {
@@ -260,7 +595,113 @@ func (r *TrainingPostgresRepository) FindTrainingsForUser(ctx context.Context, u
 	return trainings, nil
 }
 
+// TrainerRating implements the TrainerRatingReadModel interface for queries.
+// When forUserUUID is set, only feedback for trainings of this user is returned.
+func (r *TrainingPostgresRepository) TrainerRating(ctx context.Context, forUserUUID *string, recentFeedbackLimit int) (query.TrainerRatingSummary, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	var userID pgtype.UUID
+	if forUserUUID != nil {
+		var err error
+		userID, err = db.StringToPgtypeUUID(*forUserUUID)
+		if err != nil {
+			return query.TrainerRatingSummary{}, fmt.Errorf("invalid user UUID: %w", err)
+		}
+	}
+
+	summary, err := queries.GetTrainingsRatingSummary(ctx)
+	if err != nil {
+		return query.TrainerRatingSummary{}, db.TranslatePgError(err)
+	}
+
+	rows, err := queries.ListRecentTrainingsFeedback(ctx, userID, int32(recentFeedbackLimit))
+	if err != nil {
+		return query.TrainerRatingSummary{}, db.TranslatePgError(err)
+	}
+
+	recentFeedback := make([]query.Feedback, 0, len(rows))
+	for _, row := range rows {
+		var repliedAt *time.Time
+		if row.RepliedAt.Valid {
+			repliedAt = &row.RepliedAt.Time
+		}
+
+		recentFeedback = append(recentFeedback, query.Feedback{
+			TrainingUUID: db.PgtypeToUUID(row.TrainingID).String(),
+			UserUUID:     db.PgtypeToUUID(row.UserID).String(),
+			User:         row.UserName,
+			TrainingTime: row.TrainingTime,
+			Rating:       int(row.Rating),
+			Comment:      row.Comment,
+			Reply:        row.Reply,
+			CreatedAt:    row.CreatedAt,
+			RepliedAt:    repliedAt,
+		})
+	}
+
+	return query.TrainerRatingSummary{
+		AverageRating:  summary.AverageRating,
+		RatingsCount:   int(summary.RatingsCount),
+		RecentFeedback: recentFeedback,
+	}, nil
+}
+
+// TrainingNotesHistory implements the TrainingNotesHistoryReadModel interface for queries.
+// It returns all notes edits, including the trainer notes.
+func (r *TrainingPostgresRepository) TrainingNotesHistory(ctx context.Context, trainingUUID string) (query.NotesHistory, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	id, err := db.StringToPgtypeUUID(trainingUUID)
+	if err != nil {
+		return query.NotesHistory{}, fmt.Errorf("invalid training UUID: %w", err)
+	}
+
+	trainingRow, err := queries.GetTraining(ctx, id)
+	if err != nil {
+		if db.IsNotFound(db.TranslatePgError(err)) {
+			return query.NotesHistory{}, training.NotFoundError{TrainingUUID: trainingUUID}
+		}
+		return query.NotesHistory{}, db.TranslatePgError(err)
+	}
+
+	rows, err := queries.ListTrainingNotesHistory(ctx, id)
+	if err != nil {
+		return query.NotesHistory{}, db.TranslatePgError(err)
+	}
+
+	revisions := make([]query.NotesRevision, 0, len(rows))
+	for _, row := range rows {
+		revisions = append(revisions, query.NotesRevision{
+			Kind:         row.Kind,
+			Notes:        row.Notes,
+			EditedBy:     db.PgtypeToUUID(row.EditedBy).String(),
+			EditedByRole: row.EditedByRole,
+			EditedAt:     row.EditedAt,
+		})
+	}
+
+	return query.NotesHistory{
+		TrainingUUID: trainingUUID,
+		UserUUID:     db.PgtypeToUUID(trainingRow.UserID).String(),
+		Revisions:    revisions,
+	}, nil
+}
+
 // rowToQueryTraining converts a SQLC row to a query.Training DTO.
+// stringsToPgtypeUUIDs converts the UUIDs to not nil slice, as NULL array would make ANY() filters match nothing.
+func stringsToPgtypeUUIDs(uuids []string) ([]pgtype.UUID, error) {
+	ids := make([]pgtype.UUID, 0, len(uuids))
+	for _, u := range uuids {
+		id, err := db.StringToPgtypeUUID(u)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user UUID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
 func rowToQueryTraining(row sqlc_trainings.TrainingsTraining) query.Training {
 	var notes string
 	if row.Notes != nil {
@@ -277,14 +718,82 @@ func rowToQueryTraining(row sqlc_trainings.TrainingsTraining) query.Training {
 		moveProposedBy = row.MoveProposedBy
 	}
 
+	var proposalExpiresAt *time.Time
+	if row.ProposalExpiresAt.Valid {
+		proposalExpiresAt = &row.ProposalExpiresAt.Time
+	}
+
+	var seriesUUID *string
+	if row.SeriesID.Valid {
+		seriesUUID = &[]string{db.PgtypeToUUID(row.SeriesID).String()}[0]
+	}
+
 	return query.Training{
-		UUID:           db.PgtypeToUUID(row.ID).String(),
-		UserUUID:       db.PgtypeToUUID(row.UserID).String(),
-		User:           row.UserName,
-		Time:           row.TrainingTime,
-		Notes:          notes,
-		ProposedTime:   proposedTime,
-		MoveProposedBy: moveProposedBy,
-		CanBeCancelled: !row.Canceled, // If not already canceled, it can be cancelled
+		UUID:              db.PgtypeToUUID(row.ID).String(),
+		UserUUID:          db.PgtypeToUUID(row.UserID).String(),
+		User:              row.UserName,
+		Time:              row.TrainingTime,
+		Notes:             notes,
+		TrainerNotes:      row.TrainerNotes,
+		ProposedTime:      proposedTime,
+		MoveProposedBy:    moveProposedBy,
+		ProposalExpiresAt: proposalExpiresAt,
+		CanBeCancelled:    !row.Canceled, // If not already canceled, it can be cancelled
+		Attendance:        row.Attendance,
+		SeriesUUID:        seriesUUID,
+		SessionType:       row.SessionType,
+		Price:             int(row.Price),
+		Version:           int(row.Version),
 	}
 }
+
+// TrainingHistoryPage implements the TrainingHistoryReadModel interface for queries.
+func (r *TrainingPostgresRepository) TrainingHistoryPage(
+	ctx context.Context,
+	userUUID string,
+	afterTime time.Time,
+	afterUUID string,
+	limit int,
+) ([]query.TrainingHistoryEntry, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	uid, err := db.StringToPgtypeUUID(userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	var after pgtype.Timestamptz
+	var afterID pgtype.UUID
+	if !afterTime.IsZero() {
+		after = pgtype.Timestamptz{Time: afterTime, Valid: true}
+		afterID, err = db.StringToPgtypeUUID(afterUUID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid training UUID: %w", err)
+		}
+	}
+
+	rows, err := queries.ListTrainingHistoryByUser(ctx, uid, after, afterID, int32(limit))
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	entries := make([]query.TrainingHistoryEntry, 0, len(rows))
+	for _, row := range rows {
+		var notes string
+		if row.Notes != nil {
+			notes = *row.Notes
+		}
+
+		entries = append(entries, query.TrainingHistoryEntry{
+			UUID:        db.PgtypeToUUID(row.ID).String(),
+			Time:        row.TrainingTime,
+			SessionType: row.SessionType,
+			Price:       int(row.Price),
+			Canceled:    row.Canceled,
+			Attendance:  row.Attendance,
+			Notes:       notes,
+		})
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,71 @@
+package adapters_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/adapters"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestTrainingPostgresRepository_UpdateTraining_version(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repository := adapters.NewTrainingPostgresRepository(newPostgresPool(t, ctx))
+
+	attendeeUUID := uuid.New().String()
+	attendee := training.MustNewUser(attendeeUUID, training.Attendee)
+
+	tr, err := training.NewTraining(uuid.New().String(), attendeeUUID, "Attendee", time.Now().Add(48*time.Hour).Truncate(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, repository.AddTraining(ctx, tr))
+
+	testCases := []struct {
+		Name            string
+		Update          func(tr *training.Training) error
+		ExpectedVersion int
+	}{
+		{
+			Name: "unchanged",
+			Update: func(tr *training.Training) error {
+				return nil
+			},
+			ExpectedVersion: 1,
+		},
+		{
+			Name: "notes_updated",
+			Update: func(tr *training.Training) error {
+				return tr.UpdateNotes("bring a towel")
+			},
+			ExpectedVersion: 2,
+		},
+		{
+			Name: "same_notes",
+			Update: func(tr *training.Training) error {
+				return tr.UpdateNotes("bring a towel")
+			},
+			ExpectedVersion: 2,
+		},
+	}
+
+	// the cases depend on each other, so they are run in order
+	for _, c := range testCases {
+		err := repository.UpdateTraining(ctx, tr.UUID(), attendee, func(ctx context.Context, tr *training.Training) (*training.Training, error) {
+			if err := c.Update(tr); err != nil {
+				return nil, err
+			}
+
+			return tr, nil
+		})
+		require.NoError(t, err, c.Name)
+
+		updated, err := repository.GetTraining(ctx, tr.UUID(), attendee)
+		require.NoError(t, err, c.Name)
+		assert.Equal(t, c.ExpectedVersion, updated.Version(), c.Name)
+	}
+}
@@ -0,0 +1,140 @@
+package adapters
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/vaintrub/go-ddd-template/internal/common/db"
+	sqlc_trainings "github.com/vaintrub/go-ddd-template/internal/trainings/adapters/sqlc"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+)
+
+// UtilizationReport implements the ReportsReadModel interface for queries.
+// Offered and booked hours are read from the trainer's calendar (trainer_hours),
+// the rest from trainings, and both are merged by the period start.
+func (r *TrainingPostgresRepository) UtilizationReport(
+	ctx context.Context,
+	roster *query.Roster,
+	period string,
+	from time.Time,
+	to time.Time,
+) ([]query.UtilizationReportPeriod, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	scope, err := newReportScope(roster)
+	if err != nil {
+		return nil, err
+	}
+
+	hoursRows, err := queries.TrainerHoursReport(
+		ctx, period, from, to, scope.allAttendees, scope.clientIDs, scope.otherTrainersClientIDs,
+	)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	activityRows, err := queries.TrainingsActivityReport(
+		ctx, period, from, to, scope.allAttendees, scope.clientIDs, scope.otherTrainersClientIDs,
+	)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	periods := make([]query.UtilizationReportPeriod, 0, len(hoursRows))
+	periodIndex := map[int64]int{}
+	periodAt := func(start time.Time) *query.UtilizationReportPeriod {
+		i, ok := periodIndex[start.Unix()]
+		if !ok {
+			i = len(periods)
+			periodIndex[start.Unix()] = i
+			periods = append(periods, query.UtilizationReportPeriod{PeriodStart: start})
+		}
+
+		return &periods[i]
+	}
+
+	for _, row := range hoursRows {
+		p := periodAt(row.PeriodStart.Time)
+		p.OfferedHours = int(row.OfferedHours)
+		p.BookedHours = int(row.BookedHours)
+	}
+
+	for _, row := range activityRows {
+		p := periodAt(row.PeriodStart.Time)
+		p.Trainings = int(row.Trainings)
+		p.Attended = int(row.Attended)
+		p.NoShows = int(row.NoShows)
+		p.Cancellations = int(row.Cancellations)
+		p.Reschedules = int(row.Reschedules)
+	}
+
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].PeriodStart.Before(periods[j].PeriodStart)
+	})
+
+	return periods, nil
+}
+
+// CancellationReport implements the ReportsReadModel interface for queries.
+func (r *TrainingPostgresRepository) CancellationReport(
+	ctx context.Context,
+	roster *query.Roster,
+	period string,
+	from time.Time,
+	to time.Time,
+	lateNotice time.Duration,
+) ([]query.CancellationReportPeriod, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	scope, err := newReportScope(roster)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queries.TrainingsCancellationReport(
+		ctx, period, lateNotice.Seconds(), from, to, scope.allAttendees, scope.clientIDs, scope.otherTrainersClientIDs,
+	)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	periods := make([]query.CancellationReportPeriod, 0, len(rows))
+	for _, row := range rows {
+		periods = append(periods, query.CancellationReportPeriod{
+			PeriodStart:       row.PeriodStart.Time,
+			CanceledBy:        row.CanceledBy,
+			Cancellations:     int(row.Cancellations),
+			LateCancellations: int(row.LateCancellations),
+			AverageNotice:     time.Duration(row.AverageNoticeSeconds * float64(time.Second)),
+		})
+	}
+
+	return periods, nil
+}
+
+// reportScope are the arguments limiting report queries to the roster.
+type reportScope struct {
+	allAttendees           bool
+	clientIDs              []pgtype.UUID
+	otherTrainersClientIDs []pgtype.UUID
+}
+
+func newReportScope(roster *query.Roster) (reportScope, error) {
+	if roster == nil {
+		return reportScope{allAttendees: true, clientIDs: []pgtype.UUID{}, otherTrainersClientIDs: []pgtype.UUID{}}, nil
+	}
+
+	clientIDs, err := stringsToPgtypeUUIDs(roster.ClientUUIDs)
+	if err != nil {
+		return reportScope{}, err
+	}
+
+	otherTrainersClientIDs, err := stringsToPgtypeUUIDs(roster.OtherTrainersClientUUIDs)
+	if err != nil {
+		return reportScope{}, err
+	}
+
+	return reportScope{clientIDs: clientIDs, otherTrainersClientIDs: otherTrainersClientIDs}, nil
+}
@@ -0,0 +1,197 @@
+package adapters_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/config"
+	"github.com/vaintrub/go-ddd-template/internal/common/db"
+	"github.com/vaintrub/go-ddd-template/internal/common/tests"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/adapters"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+var (
+	postgresURL       string
+	terminatePostgres func(context.Context) error
+)
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+	dsn, terminate, err := tests.StartPostgresContainer(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "skipping trainings adapters tests: %v\n", err)
+		os.Exit(0)
+	}
+	postgresURL = dsn
+	terminatePostgres = terminate
+
+	code := m.Run()
+
+	if terminatePostgres != nil {
+		_ = terminatePostgres(context.Background())
+	}
+	os.Exit(code)
+}
+
+func TestTrainingPostgresRepository_reports_scope(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	pool := newPostgresPool(t, ctx)
+	repository := adapters.NewTrainingPostgresRepository(pool)
+
+	clientUUID := uuid.New().String()
+	otherTrainerClientUUID := uuid.New().String()
+	unassignedAttendeeUUID := uuid.New().String()
+
+	// every attendee has one training and one canceled training in the reported month,
+	// and one booked trainer hour; there is also one available hour
+	monthStart := newReportMonth()
+	hourTime := monthStart.Add(24 * time.Hour)
+	for _, attendeeUUID := range []string{clientUUID, otherTrainerClientUUID, unassignedAttendeeUUID} {
+		addReportTraining(t, repository, attendeeUUID, hourTime, false)
+		addReportTraining(t, repository, attendeeUUID, hourTime.Add(time.Hour), true)
+		addTrainerHour(t, pool, hourTime, "training_scheduled", attendeeUUID)
+
+		hourTime = hourTime.Add(24 * time.Hour)
+	}
+	addTrainerHour(t, pool, hourTime, "available", "")
+
+	testCases := []struct {
+		Name   string
+		Roster *query.Roster
+
+		ExpectedOfferedHours  int
+		ExpectedBookedHours   int
+		ExpectedTrainings     int
+		ExpectedCancellations int
+	}{
+		{
+			Name:                  "admin",
+			ExpectedOfferedHours:  4,
+			ExpectedBookedHours:   3,
+			ExpectedTrainings:     3,
+			ExpectedCancellations: 3,
+		},
+		{
+			Name: "trainer",
+			Roster: &query.Roster{
+				ClientUUIDs:              []string{clientUUID},
+				OtherTrainersClientUUIDs: []string{otherTrainerClientUUID},
+			},
+			ExpectedOfferedHours:  3,
+			ExpectedBookedHours:   2,
+			ExpectedTrainings:     2,
+			ExpectedCancellations: 2,
+		},
+		{
+			Name:                  "trainer_without_clients",
+			Roster:                &query.Roster{OtherTrainersClientUUIDs: []string{clientUUID, otherTrainerClientUUID}},
+			ExpectedOfferedHours:  2,
+			ExpectedBookedHours:   1,
+			ExpectedTrainings:     1,
+			ExpectedCancellations: 1,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			utilization, err := repository.UtilizationReport(ctx, c.Roster, query.ReportPeriodMonth, monthStart, monthStart.AddDate(0, 1, 0))
+			require.NoError(t, err)
+			require.Len(t, utilization, 1)
+			assert.True(t, utilization[0].PeriodStart.Equal(monthStart))
+			assert.Equal(t, c.ExpectedOfferedHours, utilization[0].OfferedHours)
+			assert.Equal(t, c.ExpectedBookedHours, utilization[0].BookedHours)
+			assert.Equal(t, c.ExpectedTrainings, utilization[0].Trainings)
+			assert.Equal(t, c.ExpectedCancellations, utilization[0].Cancellations)
+
+			cancellations, err := repository.CancellationReport(
+				ctx, c.Roster, query.ReportPeriodMonth, monthStart, monthStart.AddDate(0, 1, 0), 24*time.Hour,
+			)
+			require.NoError(t, err)
+			require.Len(t, cancellations, 1)
+			assert.Equal(t, training.Attendee.String(), cancellations[0].CanceledBy)
+			assert.Equal(t, c.ExpectedCancellations, cancellations[0].Cancellations)
+		})
+	}
+}
+
+func addReportTraining(t *testing.T, repository *adapters.TrainingPostgresRepository, attendeeUUID string, trainingTime time.Time, canceled bool) {
+	t.Helper()
+	ctx := context.Background()
+
+	tr, err := training.NewTraining(uuid.New().String(), attendeeUUID, "Attendee", trainingTime)
+	require.NoError(t, err)
+	require.NoError(t, repository.AddTraining(ctx, tr))
+
+	if !canceled {
+		return
+	}
+
+	err = repository.UpdateTraining(
+		ctx,
+		tr.UUID(),
+		training.MustNewUser(attendeeUUID, training.Attendee),
+		func(ctx context.Context, tr *training.Training) (*training.Training, error) {
+			if err := tr.Cancel(training.Attendee); err != nil {
+				return nil, err
+			}
+
+			return tr, nil
+		},
+	)
+	require.NoError(t, err)
+}
+
+func addTrainerHour(t *testing.T, pool *pgxpool.Pool, hourTime time.Time, availability string, attendeeUUID string) {
+	t.Helper()
+
+	var attendeeID *string
+	if attendeeUUID != "" {
+		attendeeID = &attendeeUUID
+	}
+
+	_, err := pool.Exec(
+		context.Background(),
+		"INSERT INTO trainer_hours (id, hour_time, availability, attendee_id) VALUES ($1, $2, $3, $4)",
+		uuid.New().String(), hourTime, availability, attendeeID,
+	)
+	require.NoError(t, err)
+}
+
+// newReportMonth returns the start of a random month far in the future,
+// so reports don't count trainings and hours added by other tests.
+func newReportMonth() time.Time {
+	// #nosec G404 - math/rand is sufficient for test data generation
+	return time.Date(3000+rand.IntN(5000), time.Month(1+rand.IntN(12)), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func newPostgresPool(t *testing.T, ctx context.Context) *pgxpool.Pool {
+	cfg := config.Config{
+		Env: config.EnvConfig{
+			Name: os.Getenv("ENV"),
+		},
+		Database: config.DatabaseConfig{
+			URL: postgresURL,
+		},
+	}
+
+	pool, err := db.NewPgxPool(ctx, cfg.Database, cfg.Env)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return pool
+}
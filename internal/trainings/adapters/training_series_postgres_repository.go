@@ -0,0 +1,256 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/db"
+	sqlc_trainings "github.com/vaintrub/go-ddd-template/internal/trainings/adapters/sqlc"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// AddSeries persists a new training series together with its occurrences.
+// Implements training.SeriesRepository interface.
+func (r *TrainingPostgresRepository) AddSeries(ctx context.Context, s *training.Series) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback is safe to call even if commit succeeds
+	}()
+
+	queries := sqlc_trainings.New(tx)
+
+	id := db.UUIDToPgtype(uuid.MustParse(s.UUID()))
+	userID := db.UUIDToPgtype(uuid.MustParse(s.UserUUID()))
+
+	if err := queries.CreateTrainingSeries(ctx, id, userID, s.UserName(), s.IsCanceled()); err != nil {
+		return db.TranslatePgError(err)
+	}
+
+	if err := upsertSeriesOccurrences(ctx, queries, id, s.Occurrences()); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetSeries retrieves a training series by UUID for the specified user.
+// Implements training.SeriesRepository interface.
+func (r *TrainingPostgresRepository) GetSeries(ctx context.Context, seriesUUID string, user training.User) (*training.Series, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	id, err := db.StringToPgtypeUUID(seriesUUID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid series UUID: %w", err)
+	}
+
+	s, err := getSeries(ctx, queries, id, seriesUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := training.CanUserSeeSeries(user, *s); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// UpdateSeries updates an existing training series using the provided update function.
+// Implements training.SeriesRepository interface.
+func (r *TrainingPostgresRepository) UpdateSeries(
+	ctx context.Context,
+	seriesUUID string,
+	user training.User,
+	updateFn func(ctx context.Context, s *training.Series) (*training.Series, error),
+) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback is safe to call even if commit succeeds
+	}()
+
+	queries := sqlc_trainings.New(tx)
+
+	id, err := db.StringToPgtypeUUID(seriesUUID)
+	if err != nil {
+		return fmt.Errorf("invalid series UUID: %w", err)
+	}
+
+	s, err := getSeries(ctx, queries, id, seriesUUID)
+	if err != nil {
+		return err
+	}
+
+	if err := training.CanUserSeeSeries(user, *s); err != nil {
+		return err
+	}
+
+	updatedSeries, err := updateFn(ctx, s)
+	if err != nil {
+		return err
+	}
+
+	if err := queries.UpdateTrainingSeries(ctx, id, updatedSeries.IsCanceled()); err != nil {
+		return db.TranslatePgError(err)
+	}
+
+	if err := upsertSeriesOccurrences(ctx, queries, id, updatedSeries.Occurrences()); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// TrainingSeries implements the TrainingSeriesReadModel interface for queries.
+// Occurrences with canceled trainings are returned with the canceled status.
+func (r *TrainingPostgresRepository) TrainingSeries(ctx context.Context, seriesUUID string) (query.Series, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	id, err := db.StringToPgtypeUUID(seriesUUID)
+	if err != nil {
+		return query.Series{}, fmt.Errorf("invalid series UUID: %w", err)
+	}
+
+	seriesRow, err := queries.GetTrainingSeries(ctx, id)
+	if err != nil {
+		if db.IsNotFound(db.TranslatePgError(err)) {
+			return query.Series{}, training.SeriesNotFoundError{SeriesUUID: seriesUUID}
+		}
+		return query.Series{}, db.TranslatePgError(err)
+	}
+
+	rows, err := queries.ListTrainingSeriesOccurrencesWithTrainings(ctx, id)
+	if err != nil {
+		return query.Series{}, db.TranslatePgError(err)
+	}
+
+	occurrences := make([]query.SeriesOccurrence, 0, len(rows))
+	for _, row := range rows {
+		status := row.Status
+		if row.TrainingCanceled != nil && *row.TrainingCanceled {
+			status = "canceled"
+		}
+
+		var trainingUUID *string
+		if row.TrainingID.Valid {
+			trainingUUID = &[]string{db.PgtypeToUUID(row.TrainingID).String()}[0]
+		}
+
+		occurrences = append(occurrences, query.SeriesOccurrence{
+			Time:          row.OccurrenceTime,
+			Status:        status,
+			TrainingUUID:  trainingUUID,
+			FailureReason: row.FailureReason,
+		})
+	}
+
+	return query.Series{
+		UUID:        db.PgtypeToUUID(seriesRow.ID).String(),
+		UserUUID:    db.PgtypeToUUID(seriesRow.UserID).String(),
+		User:        seriesRow.UserName,
+		Canceled:    seriesRow.Canceled,
+		Occurrences: occurrences,
+	}, nil
+}
+
+// getSeries loads the training series together with its occurrences.
+func getSeries(
+	ctx context.Context,
+	queries *sqlc_trainings.Queries,
+	id pgtype.UUID,
+	seriesUUID string,
+) (*training.Series, error) {
+	row, err := queries.GetTrainingSeries(ctx, id)
+	if err != nil {
+		if db.IsNotFound(db.TranslatePgError(err)) {
+			return nil, training.SeriesNotFoundError{SeriesUUID: seriesUUID}
+		}
+		return nil, db.TranslatePgError(err)
+	}
+
+	occurrenceRows, err := queries.ListTrainingSeriesOccurrences(ctx, id)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	occurrences := make([]training.SeriesOccurrence, 0, len(occurrenceRows))
+	for _, occurrenceRow := range occurrenceRows {
+		occurrence, err := unmarshalSeriesOccurrence(occurrenceRow)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal series occurrence: %w", err)
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+
+	return training.UnmarshalSeriesFromDatabase(
+		db.PgtypeToUUID(row.ID).String(),
+		db.PgtypeToUUID(row.UserID).String(),
+		row.UserName,
+		row.Canceled,
+		occurrences,
+	), nil
+}
+
+// upsertSeriesOccurrences persists booking results of all series occurrences.
+func upsertSeriesOccurrences(
+	ctx context.Context,
+	queries *sqlc_trainings.Queries,
+	seriesID pgtype.UUID,
+	occurrences []training.SeriesOccurrence,
+) error {
+	for _, o := range occurrences {
+		var trainingID pgtype.UUID
+		if o.TrainingUUID() != "" {
+			trainingID = db.UUIDToPgtype(uuid.MustParse(o.TrainingUUID()))
+		}
+
+		var failureReason *string
+		if o.FailureReason() != "" {
+			failureReason = &[]string{o.FailureReason()}[0]
+		}
+
+		err := queries.UpsertTrainingSeriesOccurrence(ctx, seriesID, o.Time(), o.Status().String(), trainingID, failureReason)
+		if err != nil {
+			return db.TranslatePgError(err)
+		}
+	}
+
+	return nil
+}
+
+// unmarshalSeriesOccurrence converts SQLC TrainingsSeriesOccurrence to domain SeriesOccurrence value.
+func unmarshalSeriesOccurrence(row sqlc_trainings.TrainingsSeriesOccurrence) (training.SeriesOccurrence, error) {
+	status, err := training.NewSeriesOccurrenceStatusFromString(row.Status)
+	if err != nil {
+		return training.SeriesOccurrence{}, err
+	}
+
+	trainingUUID := ""
+	if row.TrainingID.Valid {
+		trainingUUID = db.PgtypeToUUID(row.TrainingID).String()
+	}
+
+	failureReason := ""
+	if row.FailureReason != nil {
+		failureReason = *row.FailureReason
+	}
+
+	return training.UnmarshalSeriesOccurrenceFromDatabase(row.OccurrenceTime, status, trainingUUID, failureReason), nil
+}
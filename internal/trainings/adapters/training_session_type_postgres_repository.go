@@ -0,0 +1,229 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/db"
+	sqlc_trainings "github.com/vaintrub/go-ddd-template/internal/trainings/adapters/sqlc"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// AddSessionType persists a new session type of the catalog.
+// Implements training.SessionTypeRepository interface.
+func (r *TrainingPostgresRepository) AddSessionType(ctx context.Context, st *training.SessionType) error {
+	queries := sqlc_trainings.New(r.pool)
+
+	cancellationTiers, err := marshalCancellationTiers(st.CancellationTiers())
+	if err != nil {
+		return err
+	}
+
+	err = queries.CreateSessionType(
+		ctx,
+		st.Code(),
+		st.Name(),
+		st.Description(),
+		int32(st.Duration()/time.Minute),
+		int32(st.CreditPrice()),
+		cancellationTiers,
+		st.IsArchived(),
+	)
+	if err != nil {
+		if db.IsConflict(db.TranslatePgError(err)) {
+			return training.SessionTypeAlreadyExistsError{Code: st.Code()}
+		}
+		return db.TranslatePgError(err)
+	}
+
+	return nil
+}
+
+// GetSessionType retrieves a session type by its code.
+// Implements training.SessionTypeRepository interface.
+func (r *TrainingPostgresRepository) GetSessionType(ctx context.Context, code string) (*training.SessionType, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	row, err := queries.GetSessionType(ctx, code)
+	if err != nil {
+		if db.IsNotFound(db.TranslatePgError(err)) {
+			return nil, training.SessionTypeNotFoundError{Code: code}
+		}
+		return nil, db.TranslatePgError(err)
+	}
+
+	return unmarshalSessionType(row)
+}
+
+// UpdateSessionType updates an existing session type using the provided update function.
+// Implements training.SessionTypeRepository interface.
+func (r *TrainingPostgresRepository) UpdateSessionType(
+	ctx context.Context,
+	code string,
+	updateFn func(ctx context.Context, st *training.SessionType) (*training.SessionType, error),
+) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback is safe to call even if commit succeeds
+	}()
+
+	queries := sqlc_trainings.New(tx)
+
+	row, err := queries.GetSessionTypeForUpdate(ctx, code)
+	if err != nil {
+		if db.IsNotFound(db.TranslatePgError(err)) {
+			return training.SessionTypeNotFoundError{Code: code}
+		}
+		return db.TranslatePgError(err)
+	}
+
+	currentSessionType, err := unmarshalSessionType(row)
+	if err != nil {
+		return err
+	}
+
+	updatedSessionType, err := updateFn(ctx, currentSessionType)
+	if err != nil {
+		return err
+	}
+
+	cancellationTiers, err := marshalCancellationTiers(updatedSessionType.CancellationTiers())
+	if err != nil {
+		return err
+	}
+
+	err = queries.UpdateSessionType(
+		ctx,
+		code,
+		updatedSessionType.Name(),
+		updatedSessionType.Description(),
+		int32(updatedSessionType.Duration()/time.Minute),
+		int32(updatedSessionType.CreditPrice()),
+		cancellationTiers,
+		updatedSessionType.IsArchived(),
+	)
+	if err != nil {
+		return db.TranslatePgError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// SessionTypes implements the SessionTypesReadModel interface for queries.
+// It returns the whole catalog, including archived session types.
+func (r *TrainingPostgresRepository) SessionTypes(ctx context.Context) ([]query.SessionType, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	rows, err := queries.ListSessionTypes(ctx)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	sessionTypes := make([]query.SessionType, 0, len(rows))
+	for _, row := range rows {
+		tiers, err := unmarshalCancellationTiers(row.CancellationTiers)
+		if err != nil {
+			return nil, err
+		}
+
+		sessionType := query.SessionType{
+			Code:            row.Code,
+			Name:            row.Name,
+			Description:     row.Description,
+			DurationMinutes: int(row.DurationMinutes),
+			CreditPrice:     int(row.CreditPrice),
+			Archived:        row.Archived,
+		}
+		for _, tier := range tiers {
+			sessionType.CancellationTiers = append(sessionType.CancellationTiers, query.CancellationTier{
+				MinNotice:             tier.MinNotice,
+				AttendeeRefundPercent: tier.AttendeeRefundPercent,
+				TrainerRefundPercent:  tier.TrainerRefundPercent,
+			})
+		}
+
+		sessionTypes = append(sessionTypes, sessionType)
+	}
+
+	return sessionTypes, nil
+}
+
+// unmarshalSessionType converts SQLC TrainingsSessionType to domain SessionType entity.
+func unmarshalSessionType(row sqlc_trainings.TrainingsSessionType) (*training.SessionType, error) {
+	tiers, err := unmarshalCancellationTiers(row.CancellationTiers)
+	if err != nil {
+		return nil, err
+	}
+
+	return training.UnmarshalSessionTypeFromDatabase(
+		row.Code,
+		row.Name,
+		row.Description,
+		time.Duration(row.DurationMinutes)*time.Minute,
+		int(row.CreditPrice),
+		tiers,
+		row.Archived,
+	), nil
+}
+
+// cancellationTierRow is the form in which cancellation tiers are stored in JSONB columns.
+type cancellationTierRow struct {
+	MinNoticeMinutes      int `json:"min_notice_minutes"`
+	AttendeeRefundPercent int `json:"attendee_refund_percent"`
+	TrainerRefundPercent  int `json:"trainer_refund_percent"`
+}
+
+// marshalCancellationTiers returns nil for no tiers, so the column is NULL when the policy tiers apply.
+func marshalCancellationTiers(tiers []training.CancellationTier) ([]byte, error) {
+	if len(tiers) == 0 {
+		return nil, nil
+	}
+
+	rows := make([]cancellationTierRow, 0, len(tiers))
+	for _, tier := range tiers {
+		rows = append(rows, cancellationTierRow{
+			MinNoticeMinutes:      int(tier.MinNotice / time.Minute),
+			AttendeeRefundPercent: tier.AttendeeRefundPercent,
+			TrainerRefundPercent:  tier.TrainerRefundPercent,
+		})
+	}
+
+	data, err := json.Marshal(rows)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal cancellation tiers: %w", err)
+	}
+
+	return data, nil
+}
+
+func unmarshalCancellationTiers(data []byte) ([]training.CancellationTier, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var rows []cancellationTierRow
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal cancellation tiers: %w", err)
+	}
+
+	tiers := make([]training.CancellationTier, 0, len(rows))
+	for _, row := range rows {
+		tiers = append(tiers, training.CancellationTier{
+			MinNotice:             time.Duration(row.MinNoticeMinutes) * time.Minute,
+			AttendeeRefundPercent: row.AttendeeRefundPercent,
+			TrainerRefundPercent:  row.TrainerRefundPercent,
+		})
+	}
+
+	return tiers, nil
+}
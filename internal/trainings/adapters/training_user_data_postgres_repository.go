@@ -0,0 +1,164 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/db"
+	sqlc_trainings "github.com/vaintrub/go-ddd-template/internal/trainings/adapters/sqlc"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+)
+
+// UserTrainingsData implements the UserDataReadModel interface for queries.
+// Trainer notes are private to the trainer, so they are not exported.
+func (r *TrainingPostgresRepository) UserTrainingsData(ctx context.Context, userUUID string) (query.UserTrainingsData, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	uid, err := db.StringToPgtypeUUID(userUUID)
+	if err != nil {
+		return query.UserTrainingsData{}, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	trainingRows, err := queries.ListUserDataTrainings(ctx, uid)
+	if err != nil {
+		return query.UserTrainingsData{}, db.TranslatePgError(err)
+	}
+
+	revisionRows, err := queries.ListUserDataNotesRevisions(ctx, uid)
+	if err != nil {
+		return query.UserTrainingsData{}, db.TranslatePgError(err)
+	}
+
+	revisions := make(map[string][]query.NotesRevision)
+	for _, row := range revisionRows {
+		trainingUUID := db.PgtypeToUUID(row.TrainingID).String()
+		revisions[trainingUUID] = append(revisions[trainingUUID], query.NotesRevision{
+			Kind:         row.Kind,
+			Notes:        row.Notes,
+			EditedBy:     db.PgtypeToUUID(row.EditedBy).String(),
+			EditedByRole: row.EditedByRole,
+			EditedAt:     row.EditedAt,
+		})
+	}
+
+	seriesRows, err := queries.ListUserDataSeries(ctx, uid)
+	if err != nil {
+		return query.UserTrainingsData{}, db.TranslatePgError(err)
+	}
+
+	waitlistRows, err := queries.ListUserDataWaitlistEntries(ctx, uid)
+	if err != nil {
+		return query.UserTrainingsData{}, db.TranslatePgError(err)
+	}
+
+	data := query.UserTrainingsData{
+		Trainings:       make([]query.UserDataTraining, 0, len(trainingRows)),
+		Series:          make([]query.UserDataSeries, 0, len(seriesRows)),
+		WaitlistEntries: make([]query.UserDataWaitlistEntry, 0, len(waitlistRows)),
+	}
+
+	for _, row := range trainingRows {
+		trainingUUID := db.PgtypeToUUID(row.ID).String()
+
+		tr := query.UserDataTraining{
+			UUID:            trainingUUID,
+			Time:            row.TrainingTime,
+			SessionType:     row.SessionType,
+			Price:           int(row.Price),
+			NotesRevisions:  revisions[trainingUUID],
+			Attendance:      row.Attendance,
+			Canceled:        row.Canceled,
+			FeedbackComment: row.FeedbackComment,
+			FeedbackReply:   row.FeedbackReply,
+			CreatedAt:       row.CreatedAt,
+		}
+		if tr.NotesRevisions == nil {
+			tr.NotesRevisions = []query.NotesRevision{}
+		}
+		if row.Notes != nil {
+			tr.Notes = *row.Notes
+		}
+		if row.CanceledAt.Valid {
+			canceledAt := row.CanceledAt.Time
+			tr.CanceledAt = &canceledAt
+		}
+		if row.Rating != nil {
+			rating := int(*row.Rating)
+			tr.Rating = &rating
+		}
+
+		data.Trainings = append(data.Trainings, tr)
+	}
+
+	for _, row := range seriesRows {
+		data.Series = append(data.Series, query.UserDataSeries{
+			UUID:      db.PgtypeToUUID(row.ID).String(),
+			Canceled:  row.Canceled,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+
+	for _, row := range waitlistRows {
+		data.WaitlistEntries = append(data.WaitlistEntries, query.UserDataWaitlistEntry{
+			UUID:      db.PgtypeToUUID(row.ID).String(),
+			Hour:      row.Hour,
+			AutoBook:  row.AutoBook,
+			Status:    row.Status,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+
+	return data, nil
+}
+
+// AnonymizeUserData replaces the user's name in trainings, series and waitlist entries with anonymizedName,
+// and removes the notes, their history and feedback texts. Trainings, prices and ratings are kept for accounting.
+// It returns command.ErrUserHasActiveBookings when the user still has bookings after now.
+func (r *TrainingPostgresRepository) AnonymizeUserData(ctx context.Context, userUUID string, anonymizedName string, now time.Time) error {
+	uid, err := db.StringToPgtypeUUID(userUUID)
+	if err != nil {
+		return fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback is safe to call even if commit succeeds
+	}()
+
+	queries := sqlc_trainings.New(tx)
+
+	active, err := queries.CountUserActiveBookings(ctx, uid, now)
+	if err != nil {
+		return db.TranslatePgError(err)
+	}
+	if active.UpcomingTrainings > 0 || active.ActiveSeries > 0 || active.ActiveWaitlistEntries > 0 {
+		return command.ErrUserHasActiveBookings
+	}
+
+	if err := queries.DeleteUserTrainingsNotesHistory(ctx, uid); err != nil {
+		return db.TranslatePgError(err)
+	}
+	if err := queries.AnonymizeUserTrainingsFeedback(ctx, uid); err != nil {
+		return db.TranslatePgError(err)
+	}
+	if err := queries.AnonymizeUserTrainings(ctx, uid, anonymizedName); err != nil {
+		return db.TranslatePgError(err)
+	}
+	if err := queries.AnonymizeUserTrainingSeries(ctx, uid, anonymizedName); err != nil {
+		return db.TranslatePgError(err)
+	}
+	if err := queries.AnonymizeUserWaitlistEntries(ctx, uid, anonymizedName); err != nil {
+		return db.TranslatePgError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/db"
+	sqlc_trainings "github.com/vaintrub/go-ddd-template/internal/trainings/adapters/sqlc"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// AddWaitlistEntry persists a new waitlist entry.
+// Implements training.WaitlistRepository interface.
+func (r *TrainingPostgresRepository) AddWaitlistEntry(ctx context.Context, entry *training.WaitlistEntry) error {
+	queries := sqlc_trainings.New(r.pool)
+
+	err := queries.CreateWaitlistEntry(
+		ctx,
+		db.UUIDToPgtype(uuid.MustParse(entry.UUID())),
+		db.UUIDToPgtype(uuid.MustParse(entry.UserUUID())),
+		entry.UserName(),
+		entry.Hour(),
+		entry.AutoBook(),
+		entry.Status().String(),
+	)
+	if err != nil {
+		return db.TranslatePgError(err)
+	}
+
+	return nil
+}
+
+// UpdateWaitlistEntry updates an existing waitlist entry using the provided update function.
+// The entry is locked until the update is committed.
+// Implements training.WaitlistRepository interface.
+func (r *TrainingPostgresRepository) UpdateWaitlistEntry(
+	ctx context.Context,
+	entryUUID string,
+	user training.User,
+	updateFn func(ctx context.Context, entry *training.WaitlistEntry) (*training.WaitlistEntry, error),
+) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback is safe to call even if commit succeeds
+	}()
+
+	queries := sqlc_trainings.New(tx)
+
+	id, err := db.StringToPgtypeUUID(entryUUID)
+	if err != nil {
+		return fmt.Errorf("invalid waitlist entry UUID: %w", err)
+	}
+
+	row, err := queries.GetWaitlistEntryForUpdate(ctx, id)
+	if err != nil {
+		if db.IsNotFound(db.TranslatePgError(err)) {
+			return training.WaitlistEntryNotFoundError{EntryUUID: entryUUID}
+		}
+		return db.TranslatePgError(err)
+	}
+
+	entry, err := unmarshalWaitlistEntry(row)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal waitlist entry: %w", err)
+	}
+
+	if err := training.CanUserSeeWaitlistEntry(user, *entry); err != nil {
+		return err
+	}
+
+	updatedEntry, err := updateFn(ctx, entry)
+	if err != nil {
+		return err
+	}
+
+	var offerExpiresAt pgtype.Timestamptz
+	if !updatedEntry.OfferExpiresAt().IsZero() {
+		offerExpiresAt = pgtype.Timestamptz{Time: updatedEntry.OfferExpiresAt(), Valid: true}
+	}
+
+	var trainingID pgtype.UUID
+	if updatedEntry.TrainingUUID() != "" {
+		trainingID = db.UUIDToPgtype(uuid.MustParse(updatedEntry.TrainingUUID()))
+	}
+
+	err = queries.UpdateWaitlistEntry(ctx, id, updatedEntry.Status().String(), offerExpiresAt, trainingID)
+	if err != nil {
+		return db.TranslatePgError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// FindWaitingEntries returns UUIDs of entries waiting for the hour, in the order they joined the waitlist.
+// Implements training.WaitlistRepository interface.
+func (r *TrainingPostgresRepository) FindWaitingEntries(ctx context.Context, hour time.Time) ([]string, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	ids, err := queries.ListWaitingWaitlistEntries(ctx, hour)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	entryUUIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		entryUUIDs = append(entryUUIDs, db.PgtypeToUUID(id).String())
+	}
+
+	return entryUUIDs, nil
+}
+
+// FindWaitlistEntriesWithExpiredOffer returns UUIDs of entries which didn't claim the offered hour before now.
+// Implements training.WaitlistRepository interface.
+func (r *TrainingPostgresRepository) FindWaitlistEntriesWithExpiredOffer(ctx context.Context, now time.Time) ([]string, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	ids, err := queries.ListWaitlistEntriesWithExpiredOffer(ctx, pgtype.Timestamptz{Time: now, Valid: true})
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	entryUUIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		entryUUIDs = append(entryUUIDs, db.PgtypeToUUID(id).String())
+	}
+
+	return entryUUIDs, nil
+}
+
+// WaitlistForUser implements the WaitlistForUserReadModel interface for queries.
+// It returns waiting and offered entries of the user.
+func (r *TrainingPostgresRepository) WaitlistForUser(ctx context.Context, userUUID string) ([]query.WaitlistEntry, error) {
+	queries := sqlc_trainings.New(r.pool)
+
+	uid, err := db.StringToPgtypeUUID(userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	rows, err := queries.ListWaitlistEntriesByUser(ctx, uid)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	entries := make([]query.WaitlistEntry, 0, len(rows))
+	for _, row := range rows {
+		var offerExpiresAt *time.Time
+		if row.OfferExpiresAt.Valid {
+			offerExpiresAt = &row.OfferExpiresAt.Time
+		}
+
+		entries = append(entries, query.WaitlistEntry{
+			UUID:           db.PgtypeToUUID(row.ID).String(),
+			Hour:           row.Hour,
+			AutoBook:       row.AutoBook,
+			Status:         row.Status,
+			OfferExpiresAt: offerExpiresAt,
+		})
+	}
+
+	return entries, nil
+}
+
+// unmarshalWaitlistEntry converts SQLC TrainingsWaitlist to domain WaitlistEntry entity.
+func unmarshalWaitlistEntry(row sqlc_trainings.TrainingsWaitlist) (*training.WaitlistEntry, error) {
+	status, err := training.NewWaitlistStatusFromString(row.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	trainingUUID := ""
+	if row.TrainingID.Valid {
+		trainingUUID = db.PgtypeToUUID(row.TrainingID).String()
+	}
+
+	return training.UnmarshalWaitlistEntryFromDatabase(
+		db.PgtypeToUUID(row.ID).String(),
+		db.PgtypeToUUID(row.UserID).String(),
+		row.UserName,
+		row.Hour,
+		row.AutoBook,
+		status,
+		row.OfferExpiresAt.Time,
+		trainingUUID,
+	), nil
+}
@@ -2,8 +2,24 @@ package adapters
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
 	"github.com/vaintrub/go-ddd-template/internal/common/genproto/users"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+)
+
+const (
+	// insufficientBalanceReason is the reason of the users service error, when the debit would overdraw the balance.
+	insufficientBalanceReason = "insufficient-balance"
+	// batchGetUsersLimit is the maximum number of users the users service returns from one BatchGetUsers call.
+	batchGetUsersLimit = 500
 )
 
 type UsersGrpc struct {
@@ -14,11 +30,145 @@ func NewUsersGrpc(client users.UsersServiceClient) UsersGrpc {
 	return UsersGrpc{client: client}
 }
 
-func (s UsersGrpc) UpdateTrainingBalance(ctx context.Context, userID string, amountChange int) error {
+func (s UsersGrpc) GetTrainingBalance(ctx context.Context, userID string) (int, error) {
+	resp, err := s.client.GetTrainingBalance(ctx, &users.GetTrainingBalanceRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.Amount), nil
+}
+
+func (s UsersGrpc) UpdateTrainingBalance(ctx context.Context, userID string, amountChange int, change command.BalanceChange) error {
 	_, err := s.client.UpdateTrainingBalance(ctx, &users.UpdateTrainingBalanceRequest{
 		UserId:       userID,
 		AmountChange: int64(amountChange),
+		Reason:       change.Reason,
+		TrainingUuid: change.TrainingUUID,
+		ActorId:      change.ActorUUID,
+	})
+	if isInsufficientBalance(err) {
+		return fmt.Errorf("%w: %s", command.ErrInsufficientBalance, status.Convert(err).Message())
+	}
+
+	return err
+}
+
+// UserNames returns the current names of the users, users which don't exist are left out.
+func (s UsersGrpc) UserNames(ctx context.Context, userIDs []string) (map[string]string, error) {
+	names := make(map[string]string, len(userIDs))
+
+	for start := 0; start < len(userIDs); start += batchGetUsersLimit {
+		end := min(start+batchGetUsersLimit, len(userIDs))
+
+		resp, err := s.client.BatchGetUsers(ctx, &users.BatchGetUsersRequest{
+			UserIds: userIDs[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range resp.Users {
+			names[user.UserId] = user.DisplayName
+		}
+	}
+
+	return names, nil
+}
+
+// UserTimezone returns the timezone from the user's profile, it's empty when the user didn't set any.
+func (s UsersGrpc) UserTimezone(ctx context.Context, userID string) (string, error) {
+	user, err := s.client.GetUser(ctx, &users.GetUserRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		return "", userError(err)
+	}
+
+	return user.Timezone, nil
+}
+
+// TrainerRoster returns UUIDs of the attendees assigned to the trainer and of those assigned only to other trainers.
+func (s UsersGrpc) TrainerRoster(ctx context.Context, trainerUUID string) (query.Roster, error) {
+	resp, err := s.client.ListTrainerClients(ctx, &users.ListTrainerClientsRequest{
+		TrainerId: trainerUUID,
+	})
+	if err != nil {
+		return query.Roster{}, err
+	}
+
+	return query.Roster{
+		ClientUUIDs:              resp.ClientIds,
+		OtherTrainersClientUUIDs: resp.OtherTrainersClientIds,
+	}, nil
+}
+
+// ClientProfile returns the client's profile together with the trainings balance.
+func (s UsersGrpc) ClientProfile(ctx context.Context, clientUUID string) (query.ClientProfile, error) {
+	user, err := s.client.GetUser(ctx, &users.GetUserRequest{
+		UserId: clientUUID,
 	})
+	if err != nil {
+		return query.ClientProfile{}, userError(err)
+	}
+
+	balance, err := s.GetTrainingBalance(ctx, clientUUID)
+	if err != nil {
+		return query.ClientProfile{}, err
+	}
+
+	return query.ClientProfile{
+		UUID:        user.UserId,
+		Name:        user.DisplayName,
+		Email:       user.Email,
+		AvatarURL:   user.AvatarUrl,
+		Deactivated: user.Deactivated,
+		Balance:     balance,
+	}, nil
+}
+
+func (s UsersGrpc) ExportUserData(ctx context.Context, userID string) (json.RawMessage, error) {
+	resp, err := s.client.ExportUserData(ctx, &users.ExportUserDataRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, userError(err)
+	}
+
+	return resp.Data, nil
+}
+
+func (s UsersGrpc) EraseUser(ctx context.Context, userID string) error {
+	_, err := s.client.EraseUser(ctx, &users.EraseUserRequest{
+		UserId: userID,
+	})
+
+	return userError(err)
+}
+
+// userError translates the NotFound error of the users service to the client error.
+func userError(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return errors.NewNotFoundError(status.Convert(err).Message(), "user-not-found").WithCause(err)
+	}
 
 	return err
 }
+
+// isInsufficientBalance checks if the users service rejected the debit, because it would overdraw the balance.
+func isInsufficientBalance(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.FailedPrecondition {
+		return false
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Reason == insufficientBalanceReason {
+			return true
+		}
+	}
+
+	return false
+}
@@ -11,15 +11,44 @@ type Application struct {
 }
 
 type Commands struct {
+	AddSessionType            command.AddSessionTypeHandler
 	ApproveTrainingReschedule command.ApproveTrainingRescheduleHandler
+	BulkCancelTrainings       command.BulkCancelTrainingsHandler
 	CancelTraining            command.CancelTrainingHandler
+	CancelTrainingSeries      command.CancelTrainingSeriesHandler
+	ClaimWaitlistOffer        command.ClaimWaitlistOfferHandler
+	CompleteTrainings         command.CompleteTrainingsHandler
+	EraseUserData             command.EraseUserDataHandler
+	ExpireRescheduleProposals command.ExpireRescheduleProposalsHandler
+	ExpireWaitlistOffers      command.ExpireWaitlistOffersHandler
+	JoinWaitlist              command.JoinWaitlistHandler
+	LeaveWaitlist             command.LeaveWaitlistHandler
+	RateTraining              command.RateTrainingHandler
+	RecordTrainingAttendance  command.RecordTrainingAttendanceHandler
 	RejectTrainingReschedule  command.RejectTrainingRescheduleHandler
+	ReplyToTrainingFeedback   command.ReplyToTrainingFeedbackHandler
 	RescheduleTraining        command.RescheduleTrainingHandler
 	RequestTrainingReschedule command.RequestTrainingRescheduleHandler
 	ScheduleTraining          command.ScheduleTrainingHandler
+	ScheduleTrainingSeries    command.ScheduleTrainingSeriesHandler
+	UpdateSessionType         command.UpdateSessionTypeHandler
+	UpdateTrainingNotes       command.UpdateTrainingNotesHandler
 }
 
 type Queries struct {
-	AllTrainings     query.AllTrainingsHandler
-	TrainingsForUser query.TrainingsForUserHandler
+	BulkCancellationByUUID query.BulkCancellationByUUIDHandler
+	CancellationReport     query.CancellationReportHandler
+	ClientByUUID           query.ClientByUUIDHandler
+	ClientsTrainings       query.ClientsTrainingsHandler
+	SessionTypes           query.SessionTypesHandler
+	TrainerRoster          query.TrainerRosterHandler
+	TrainerRating          query.TrainerRatingHandler
+	TrainingByUUID         query.TrainingByUUIDHandler
+	TrainingHistory        query.TrainingHistoryHandler
+	TrainingNotesHistory   query.TrainingNotesHistoryHandler
+	TrainingSeries         query.TrainingSeriesHandler
+	TrainingsForUser       query.TrainingsForUserHandler
+	UserDataExport         query.UserDataExportHandler
+	UtilizationReport      query.UtilizationReportHandler
+	WaitlistForUser        query.WaitlistForUserHandler
 }
@@ -0,0 +1,76 @@
+package command
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// AddSessionType adds a new session type to the catalog.
+type AddSessionType struct {
+	Code        string
+	Name        string
+	Description string
+
+	Duration    time.Duration
+	CreditPrice int
+
+	// CancellationTiers are the cancellation policy of the session type, the current policy applies without them.
+	CancellationTiers []training.CancellationTier
+
+	User training.User
+}
+
+type AddSessionTypeHandler decorator.CommandHandler[AddSessionType]
+
+type addSessionTypeHandler struct {
+	sessionTypeRepo training.SessionTypeRepository
+}
+
+func NewAddSessionTypeHandler(
+	sessionTypeRepo training.SessionTypeRepository,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) AddSessionTypeHandler {
+	if sessionTypeRepo == nil {
+		panic("nil sessionTypeRepo")
+	}
+
+	return decorator.ApplyCommandDecorators[AddSessionType](
+		addSessionTypeHandler{sessionTypeRepo: sessionTypeRepo},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h addSessionTypeHandler) Handle(ctx context.Context, cmd AddSessionType) (err error) {
+	if cmd.User.Type() != training.Trainer {
+		return errors.NewForbiddenError("only trainer can manage session types", "forbidden-to-manage-session-types")
+	}
+
+	st, err := training.NewSessionType(cmd.Code, cmd.Name, cmd.Description, cmd.Duration, cmd.CreditPrice)
+	if err != nil {
+		return errors.NewIncorrectInputError(err.Error(), "invalid-session-type")
+	}
+	if err := st.SetCancellationTiers(cmd.CancellationTiers); err != nil {
+		return errors.NewIncorrectInputError(err.Error(), "invalid-session-type")
+	}
+
+	err = h.sessionTypeRepo.AddSessionType(ctx, st)
+	var alreadyExistsErr training.SessionTypeAlreadyExistsError
+	if stderrors.As(err, &alreadyExistsErr) {
+		return errors.NewConflictError(err.Error(), "session-type-already-exists").WithCause(err)
+	}
+	if err != nil {
+		return errors.NewSlugError(fmt.Sprintf("unable to add session type: %s", err.Error()), "add-session-type-failed")
+	}
+
+	return nil
+}
@@ -14,6 +14,9 @@ import (
 type ApproveTrainingReschedule struct {
 	TrainingUUID string
 	User         training.User
+
+	// ExpectedVersion is the version of the training the change is based on, zero skips the check.
+	ExpectedVersion int
 }
 
 type ApproveTrainingRescheduleHandler decorator.CommandHandler[ApproveTrainingReschedule]
@@ -54,13 +57,26 @@ func (h approveTrainingRescheduleHandler) Handle(ctx context.Context, cmd Approv
 		cmd.TrainingUUID,
 		cmd.User,
 		func(ctx context.Context, tr *training.Training) (*training.Training, error) {
+			if err := checkExpectedVersion(*tr, cmd.ExpectedVersion); err != nil {
+				return nil, err
+			}
+
 			originalTrainingTime := tr.Time()
+			proposedTimeHeld := tr.IsProposedTimeHeld()
 
 			if err := tr.ApproveReschedule(cmd.User.Type()); err != nil {
 				return nil, errors.NewIncorrectInputError(err.Error(), "approve-reschedule-failed")
 			}
 
-			err := h.trainerService.MoveTraining(ctx, tr.Time(), originalTrainingTime)
+			if proposedTimeHeld {
+				// the new hour was reserved when the reschedule was requested, only the original one is left to release
+				if err := h.trainerService.CancelTraining(ctx, originalTrainingTime); err != nil {
+					return nil, errors.NewSlugError(fmt.Sprintf("unable to release original hour: %s", err.Error()), "move-training-failed")
+				}
+				return tr, nil
+			}
+
+			err := h.trainerService.MoveTraining(ctx, tr.Time(), originalTrainingTime, tr.Duration(), tr.UserUUID())
 			if err != nil {
 				return nil, errors.NewSlugError(fmt.Sprintf("unable to move training: %s", err.Error()), "move-training-failed")
 			}
@@ -0,0 +1,336 @@
+package command
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// BulkCancelTrainings cancels all upcoming trainings of the trainer's clients in the range on the trainer's request,
+// for example when they are sick.
+// Attendees are refunded by the cancellation policy of every training, as if the trainer canceled it one by one.
+// All free hours in the range are made unavailable before the trainings are canceled,
+// and the hours of canceled trainings right after each of them, so nobody books them again.
+//
+// When it fails midway, it can be resumed by running it again with the same BulkCancellationUUID:
+// trainings which were canceled already are not canceled and refunded again.
+type BulkCancelTrainings struct {
+	BulkCancellationUUID string
+	User                 training.User
+
+	// From and To are required to start the bulk cancellation. When it's resumed, they can be omitted.
+	From time.Time
+	To   time.Time
+}
+
+type BulkCancelTrainingsHandler decorator.CommandHandler[BulkCancelTrainings]
+
+type bulkCancelTrainingsHandler struct {
+	repo                 training.Repository
+	bulkCancellationRepo training.BulkCancellationRepository
+	userService          UserService
+	trainerService       TrainerService
+	policies             training.CancellationPolicies
+	logger               *slog.Logger
+}
+
+func NewBulkCancelTrainingsHandler(
+	repo training.Repository,
+	bulkCancellationRepo training.BulkCancellationRepository,
+	userService UserService,
+	trainerService TrainerService,
+	policies training.CancellationPolicies,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) BulkCancelTrainingsHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if bulkCancellationRepo == nil {
+		panic("nil bulkCancellationRepo")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	if trainerService == nil {
+		panic("nil trainerService")
+	}
+
+	return decorator.ApplyCommandDecorators[BulkCancelTrainings](
+		bulkCancelTrainingsHandler{
+			repo:                 repo,
+			bulkCancellationRepo: bulkCancellationRepo,
+			userService:          userService,
+			trainerService:       trainerService,
+			policies:             policies,
+			logger:               logger,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h bulkCancelTrainingsHandler) Handle(ctx context.Context, cmd BulkCancelTrainings) (err error) {
+	if cmd.User.Type() != training.Trainer {
+		return errors.NewForbiddenError("only trainer can cancel trainings in bulk", "forbidden-to-bulk-cancel-trainings")
+	}
+
+	b, err := h.startOrResume(ctx, cmd)
+	if err != nil {
+		return err
+	}
+	if b.IsCompleted() {
+		return nil
+	}
+
+	if !b.IsRangeBlocked() {
+		if err := h.blockRange(ctx, b); err != nil {
+			return errors.NewSlugError(
+				fmt.Sprintf("unable to block hours in the range: %s, the bulk cancellation can be resumed", err.Error()),
+				"bulk-cancel-trainings-failed",
+			).WithDetail("bulkCancellationUuid", b.UUID())
+		}
+	}
+
+	// trainings booked in the range since the previous run are canceled too
+	from := b.From()
+	if now := time.Now(); now.After(from) {
+		from = now
+	}
+
+	var scheduledTrainingUUIDs []string
+	if from.Before(b.To()) {
+		scheduledTrainingUUIDs, err = h.repo.FindScheduledTrainings(ctx, from, b.To(), cmd.User)
+		if err != nil {
+			return errors.NewSlugError(fmt.Sprintf("unable to find trainings to cancel: %s", err.Error()), "bulk-cancel-trainings-failed")
+		}
+	}
+
+	err = h.bulkCancellationRepo.UpdateBulkCancellation(
+		ctx,
+		b.UUID(),
+		func(ctx context.Context, updated *training.BulkCancellation) (*training.BulkCancellation, error) {
+			if err := updated.AddTrainings(scheduledTrainingUUIDs...); err != nil {
+				return nil, err
+			}
+
+			b = updated
+			return updated, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	unfinished := b.UnfinishedItems()
+
+	var failed int
+	for _, item := range unfinished {
+		if err := h.cancelTraining(ctx, b.UUID(), item, cmd.User); err != nil {
+			failed++
+			h.logger.WarnContext(ctx, "Unable to cancel training in bulk",
+				slog.String("bulk_cancellation_uuid", b.UUID()),
+				slog.String("training_uuid", item.TrainingUUID()),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	if failed > 0 {
+		return errors.NewSlugError(
+			fmt.Sprintf("unable to cancel %d of %d trainings, the bulk cancellation can be resumed", failed, len(unfinished)),
+			"bulk-cancel-trainings-failed",
+		).WithDetail("bulkCancellationUuid", b.UUID())
+	}
+
+	return h.bulkCancellationRepo.UpdateBulkCancellation(
+		ctx,
+		b.UUID(),
+		func(ctx context.Context, b *training.BulkCancellation) (*training.BulkCancellation, error) {
+			if err := b.Complete(); err != nil {
+				return nil, err
+			}
+
+			return b, nil
+		},
+	)
+}
+
+// startOrResume returns the bulk cancellation started before with the same UUID, or starts a new one.
+func (h bulkCancelTrainingsHandler) startOrResume(ctx context.Context, cmd BulkCancelTrainings) (*training.BulkCancellation, error) {
+	b, err := h.bulkCancellationRepo.GetBulkCancellation(ctx, cmd.BulkCancellationUUID)
+
+	var notFoundErr training.BulkCancellationNotFoundError
+	if stderrors.As(err, &notFoundErr) && !cmd.From.IsZero() {
+		b, err = training.NewBulkCancellation(cmd.BulkCancellationUUID, cmd.User.UUID(), cmd.From, cmd.To)
+		if err != nil {
+			return nil, errors.NewIncorrectInputError(err.Error(), "invalid-bulk-cancellation")
+		}
+
+		if err := h.bulkCancellationRepo.AddBulkCancellation(ctx, b); err != nil {
+			return nil, errors.NewSlugError(fmt.Sprintf("unable to start bulk cancellation: %s", err.Error()), "bulk-cancel-trainings-failed")
+		}
+
+		return b, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if b.TrainerUUID() != cmd.User.UUID() {
+		return nil, errors.NewForbiddenError("trainer can resume only own bulk cancellations", "forbidden-to-bulk-cancel-trainings")
+	}
+
+	if !cmd.From.IsZero() && (!cmd.From.Equal(b.From()) || !cmd.To.Equal(b.To())) {
+		return nil, errors.NewIncorrectInputError(
+			"bulk cancellation was started for a different range",
+			"bulk-cancellation-range-mismatch",
+		)
+	}
+
+	return b, nil
+}
+
+// blockRange makes all free hours in the range unavailable, so nobody books them while the trainings are canceled.
+// Blocking is recorded once all hours are blocked, hours blocked by a failed run are skipped when it's resumed.
+func (h bulkCancelTrainingsHandler) blockRange(ctx context.Context, b *training.BulkCancellation) error {
+	for hour := firstBlockableHour(b.From()); hour.Before(b.To()); hour = hour.Add(time.Hour) {
+		available, err := h.trainerService.IsHourAvailable(ctx, hour)
+		if err != nil {
+			return fmt.Errorf("unable to check availability of %s: %w", hour, err)
+		}
+		if !available {
+			// blocked already, booked by a training canceled below or outside the trainer's calendar
+			continue
+		}
+
+		if err := h.trainerService.MakeHourUnavailable(ctx, hour); err != nil {
+			return fmt.Errorf("unable to make %s unavailable: %w", hour, err)
+		}
+	}
+
+	return h.bulkCancellationRepo.UpdateBulkCancellation(
+		ctx,
+		b.UUID(),
+		func(ctx context.Context, updated *training.BulkCancellation) (*training.BulkCancellation, error) {
+			if err := updated.MarkRangeBlocked(); err != nil {
+				return nil, err
+			}
+
+			return updated, nil
+		},
+	)
+}
+
+// firstBlockableHour returns the first full hour of the range which didn't start yet.
+func firstBlockableHour(from time.Time) time.Time {
+	if nextHour := time.Now().Truncate(time.Hour).Add(time.Hour); from.Before(nextHour) {
+		return nextHour
+	}
+
+	hour := from.Truncate(time.Hour)
+	if hour.Before(from) {
+		hour = hour.Add(time.Hour)
+	}
+
+	return hour
+}
+
+// cancelTraining cancels a single training of the bulk cancellation and blocks its hour,
+// recording progress after each step, so a resumed run continues where the previous one failed.
+// When recording the cancellation itself fails, the resumed run sees the training as already canceled:
+// the attendee is not refunded twice, but the refund is missing in the summary.
+func (h bulkCancelTrainingsHandler) cancelTraining(
+	ctx context.Context,
+	bulkCancellationUUID string,
+	item training.BulkCancellationItem,
+	user training.User,
+) error {
+	trainingTime := item.TrainingTime()
+
+	if item.Status() == training.BulkCancellationItemPending {
+		var canceledTraining training.Training
+		var alreadyCanceled bool
+		var balanceDelta int
+
+		err := h.repo.UpdateTraining(
+			ctx,
+			item.TrainingUUID(),
+			user,
+			func(ctx context.Context, tr *training.Training) (*training.Training, error) {
+				canceledTraining = *tr
+
+				if tr.IsCanceled() {
+					alreadyCanceled = true
+					return tr, nil
+				}
+
+				policy, err := h.policies.ForTraining(*tr)
+				if err != nil {
+					return nil, err
+				}
+				balanceDelta = policy.CancelBalanceDelta(*tr, user.Type())
+
+				// freed hours are not offered to the waitlist, they are made unavailable below
+				if _, err := cancelTraining(ctx, tr, user, h.policies, h.userService, h.trainerService); err != nil {
+					return nil, err
+				}
+
+				canceledTraining = *tr
+				return tr, nil
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		err = h.bulkCancellationRepo.UpdateBulkCancellation(
+			ctx,
+			bulkCancellationUUID,
+			func(ctx context.Context, b *training.BulkCancellation) (*training.BulkCancellation, error) {
+				var err error
+				if alreadyCanceled {
+					err = b.MarkTrainingAlreadyCanceled(canceledTraining)
+				} else {
+					err = b.MarkTrainingCanceled(canceledTraining, balanceDelta)
+				}
+				if err != nil {
+					return nil, err
+				}
+
+				return b, nil
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		trainingTime = canceledTraining.Time()
+	}
+
+	if item.IsHourUnavailable() {
+		return nil
+	}
+
+	if err := h.trainerService.MakeHourUnavailable(ctx, trainingTime); err != nil {
+		return errors.NewSlugError(fmt.Sprintf("unable to make hour unavailable: %s", err.Error()), "make-hour-unavailable-failed")
+	}
+
+	return h.bulkCancellationRepo.UpdateBulkCancellation(
+		ctx,
+		bulkCancellationUUID,
+		func(ctx context.Context, b *training.BulkCancellation) (*training.BulkCancellation, error) {
+			if err := b.MarkHourUnavailable(item.TrainingUUID()); err != nil {
+				return nil, err
+			}
+
+			return b, nil
+		},
+	)
+}
@@ -0,0 +1,315 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonerrors "github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestBulkCancelTrainings(t *testing.T) {
+	t.Parallel()
+
+	dayStart := time.Now().AddDate(0, 0, 3).Truncate(24 * time.Hour)
+	attendeeUUID := uuid.New().String()
+	otherAttendeeUUID := uuid.New().String()
+	anotherTrainersClientUUID := uuid.New().String()
+	unassignedAttendeeUUID := uuid.New().String()
+
+	repository := &repositoryMock{}
+	firstTraining := createExampleTraining(t, attendeeUUID, dayStart.Add(9*time.Hour))
+	secondTraining := createExampleTraining(t, otherAttendeeUUID, dayStart.Add(15*time.Hour))
+	nextDayTraining := createExampleTraining(t, attendeeUUID, dayStart.Add(33*time.Hour))
+	anotherTrainersClientTraining := createExampleTraining(t, anotherTrainersClientUUID, dayStart.Add(12*time.Hour))
+	unassignedAttendeeTraining := createExampleTraining(t, unassignedAttendeeUUID, dayStart.Add(17*time.Hour))
+	for _, tr := range []*training.Training{firstTraining, secondTraining, nextDayTraining, anotherTrainersClientTraining, unassignedAttendeeTraining} {
+		require.NoError(t, repository.AddTraining(context.Background(), tr))
+	}
+
+	bulkCancellationRepository := &bulkCancellationRepositoryMock{}
+	trainingTimes := []time.Time{
+		firstTraining.Time(),
+		secondTraining.Time(),
+		nextDayTraining.Time(),
+		anotherTrainersClientTraining.Time(),
+		unassignedAttendeeTraining.Time(),
+	}
+	trainerService := &trainerServiceMock{
+		trainingsScheduled:  append([]time.Time(nil), trainingTimes...),
+		hoursFailingToBlock: trainingTimes,
+	}
+	userService := &userServiceMock{}
+
+	handler := command.NewBulkCancelTrainingsHandler(
+		repository,
+		bulkCancellationRepository,
+		userService,
+		trainerService,
+		newDefaultCancellationPolicies(t),
+		slog.Default(),
+		metrics.NoOp{},
+	)
+
+	trainer := training.MustNewTrainer(
+		uuid.New().String(),
+		[]string{attendeeUUID, otherAttendeeUUID},
+		[]string{anotherTrainersClientUUID},
+	)
+	bulkCancellationUUID := uuid.New().String()
+
+	err := handler.Handle(context.Background(), command.BulkCancelTrainings{
+		BulkCancellationUUID: bulkCancellationUUID,
+		User:                 trainer,
+		From:                 dayStart,
+		To:                   dayStart.AddDate(0, 0, 1),
+	})
+	require.Error(t, err)
+
+	// free hours are blocked before any training is canceled, booked hours are left to the trainings
+	assert.True(t, bulkCancellationRepository.BulkCancellations[bulkCancellationUUID].IsRangeBlocked())
+	assert.Len(t, trainerService.unavailableHours, 24-4)
+	for _, trainingTime := range trainingTimes {
+		assert.NotContains(t, trainerService.unavailableHours, trainingTime)
+	}
+
+	// trainings are canceled, but their hours are not blocked yet
+	assert.True(t, repository.Trainings[firstTraining.UUID()].IsCanceled())
+	assert.True(t, repository.Trainings[secondTraining.UUID()].IsCanceled())
+	assert.False(t, repository.Trainings[nextDayTraining.UUID()].IsCanceled())
+	// trainings of attendees without a trainer are canceled too, clients of other trainers are left to them
+	assert.True(t, repository.Trainings[unassignedAttendeeTraining.UUID()].IsCanceled())
+	assert.False(t, repository.Trainings[anotherTrainersClientTraining.UUID()].IsCanceled())
+	assert.False(t, bulkCancellationRepository.BulkCancellations[bulkCancellationUUID].IsCompleted())
+
+	trainerService.hoursFailingToBlock = nil
+	blockedFreeHours := len(trainerService.unavailableHours)
+
+	err = handler.Handle(context.Background(), command.BulkCancelTrainings{
+		BulkCancellationUUID: bulkCancellationUUID,
+		User:                 trainer,
+	})
+	require.NoError(t, err)
+
+	b := bulkCancellationRepository.BulkCancellations[bulkCancellationUUID]
+	assert.True(t, b.IsCompleted())
+	for _, item := range b.Items() {
+		assert.Equal(t, training.BulkCancellationItemCanceled, item.Status())
+		assert.Equal(t, 1, item.BalanceDelta())
+	}
+
+	// attendees are refunded once, even though the cancellation was resumed
+	assert.ElementsMatch(t, []balanceUpdate{{attendeeUUID, 1}, {otherAttendeeUUID, 1}, {unassignedAttendeeUUID, 1}}, userService.balanceUpdates)
+	// the range is not blocked again, only hours of the canceled trainings
+	assert.ElementsMatch(
+		t,
+		[]time.Time{firstTraining.Time(), secondTraining.Time(), unassignedAttendeeTraining.Time()},
+		trainerService.unavailableHours[blockedFreeHours:],
+	)
+}
+
+func TestBulkCancelTrainings_range_blocking_failed(t *testing.T) {
+	t.Parallel()
+
+	dayStart := time.Now().AddDate(0, 0, 3).Truncate(24 * time.Hour)
+	attendeeUUID := uuid.New().String()
+
+	repository := &repositoryMock{}
+	tr := createExampleTraining(t, attendeeUUID, dayStart.Add(9*time.Hour))
+	require.NoError(t, repository.AddTraining(context.Background(), tr))
+
+	bulkCancellationRepository := &bulkCancellationRepositoryMock{}
+	trainerService := &trainerServiceMock{
+		trainingsScheduled:     []time.Time{tr.Time()},
+		makeHourUnavailableErr: errors.New("trainer service is down"),
+	}
+	userService := &userServiceMock{}
+
+	handler := command.NewBulkCancelTrainingsHandler(
+		repository,
+		bulkCancellationRepository,
+		userService,
+		trainerService,
+		newDefaultCancellationPolicies(t),
+		slog.Default(),
+		metrics.NoOp{},
+	)
+
+	trainer := training.MustNewTrainer(uuid.New().String(), []string{attendeeUUID}, nil)
+	bulkCancellationUUID := uuid.New().String()
+
+	err := handler.Handle(context.Background(), command.BulkCancelTrainings{
+		BulkCancellationUUID: bulkCancellationUUID,
+		User:                 trainer,
+		From:                 dayStart,
+		To:                   dayStart.AddDate(0, 0, 1),
+	})
+	require.Error(t, err)
+
+	// no training is canceled while free hours of the range can still be booked
+	assert.False(t, bulkCancellationRepository.BulkCancellations[bulkCancellationUUID].IsRangeBlocked())
+	assert.False(t, repository.Trainings[tr.UUID()].IsCanceled())
+	assert.Empty(t, userService.balanceUpdates)
+	assert.Empty(t, trainerService.trainingsCancelled)
+
+	trainerService.makeHourUnavailableErr = nil
+
+	err = handler.Handle(context.Background(), command.BulkCancelTrainings{
+		BulkCancellationUUID: bulkCancellationUUID,
+		User:                 trainer,
+	})
+	require.NoError(t, err)
+
+	assert.True(t, bulkCancellationRepository.BulkCancellations[bulkCancellationUUID].IsCompleted())
+	assert.True(t, repository.Trainings[tr.UUID()].IsCanceled())
+	assert.Len(t, trainerService.unavailableHours, 24)
+}
+
+func TestBulkCancelTrainings_range_mismatch(t *testing.T) {
+	t.Parallel()
+
+	dayStart := time.Now().AddDate(0, 0, 3).Truncate(24 * time.Hour)
+	bulkCancellationRepository := &bulkCancellationRepositoryMock{}
+
+	handler := command.NewBulkCancelTrainingsHandler(
+		&repositoryMock{},
+		bulkCancellationRepository,
+		&userServiceMock{},
+		&trainerServiceMock{},
+		newDefaultCancellationPolicies(t),
+		slog.Default(),
+		metrics.NoOp{},
+	)
+
+	cmd := command.BulkCancelTrainings{
+		BulkCancellationUUID: uuid.New().String(),
+		User:                 training.MustNewTrainer(uuid.New().String(), nil, nil),
+		From:                 dayStart,
+		To:                   dayStart.AddDate(0, 0, 1),
+	}
+	require.NoError(t, handler.Handle(context.Background(), cmd))
+
+	cmd.To = dayStart.AddDate(0, 0, 2)
+	assert.Error(t, handler.Handle(context.Background(), cmd))
+}
+
+func TestBulkCancelTrainings_resumed_by_another_trainer(t *testing.T) {
+	t.Parallel()
+
+	dayStart := time.Now().AddDate(0, 0, 3).Truncate(24 * time.Hour)
+	attendeeUUID := uuid.New().String()
+
+	repository := &repositoryMock{}
+	tr := createExampleTraining(t, attendeeUUID, dayStart.Add(9*time.Hour))
+	require.NoError(t, repository.AddTraining(context.Background(), tr))
+
+	trainerUUID := uuid.New().String()
+	anotherTrainerUUID := uuid.New().String()
+	bulkCancellationUUID := uuid.New().String()
+
+	b, err := training.NewBulkCancellation(bulkCancellationUUID, trainerUUID, dayStart, dayStart.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	bulkCancellationRepository := &bulkCancellationRepositoryMock{}
+	require.NoError(t, bulkCancellationRepository.AddBulkCancellation(context.Background(), b))
+
+	trainerService := &trainerServiceMock{trainingsScheduled: []time.Time{tr.Time()}}
+	handler := command.NewBulkCancelTrainingsHandler(
+		repository,
+		bulkCancellationRepository,
+		&userServiceMock{},
+		trainerService,
+		newDefaultCancellationPolicies(t),
+		slog.Default(),
+		metrics.NoOp{},
+	)
+
+	err = handler.Handle(context.Background(), command.BulkCancelTrainings{
+		BulkCancellationUUID: bulkCancellationUUID,
+		User:                 training.MustNewTrainer(anotherTrainerUUID, []string{attendeeUUID}, nil),
+	})
+
+	var slugErr commonerrors.SlugError
+	require.ErrorAs(t, err, &slugErr)
+	assert.Equal(t, commonerrors.ErrorTypeForbidden, slugErr.ErrorType())
+
+	stored := bulkCancellationRepository.BulkCancellations[bulkCancellationUUID]
+	assert.False(t, stored.IsRangeBlocked())
+	assert.Empty(t, stored.UnfinishedItems())
+	assert.False(t, repository.Trainings[tr.UUID()].IsCanceled())
+	assert.Empty(t, trainerService.unavailableHours)
+}
+
+func TestBulkCancelTrainings_attendee(t *testing.T) {
+	t.Parallel()
+
+	dayStart := time.Now().AddDate(0, 0, 3).Truncate(24 * time.Hour)
+
+	handler := command.NewBulkCancelTrainingsHandler(
+		&repositoryMock{},
+		&bulkCancellationRepositoryMock{},
+		&userServiceMock{},
+		&trainerServiceMock{},
+		newDefaultCancellationPolicies(t),
+		slog.Default(),
+		metrics.NoOp{},
+	)
+
+	err := handler.Handle(context.Background(), command.BulkCancelTrainings{
+		BulkCancellationUUID: uuid.New().String(),
+		User:                 training.MustNewUser(uuid.New().String(), training.Attendee),
+		From:                 dayStart,
+		To:                   dayStart.AddDate(0, 0, 1),
+	})
+	assert.Error(t, err)
+}
+
+type bulkCancellationRepositoryMock struct {
+	BulkCancellations map[string]training.BulkCancellation
+}
+
+func (r *bulkCancellationRepositoryMock) AddBulkCancellation(ctx context.Context, b *training.BulkCancellation) error {
+	if r.BulkCancellations == nil {
+		r.BulkCancellations = map[string]training.BulkCancellation{}
+	}
+	r.BulkCancellations[b.UUID()] = *b
+
+	return nil
+}
+
+func (r *bulkCancellationRepositoryMock) GetBulkCancellation(ctx context.Context, bulkCancellationUUID string) (*training.BulkCancellation, error) {
+	b, ok := r.BulkCancellations[bulkCancellationUUID]
+	if !ok {
+		return nil, training.BulkCancellationNotFoundError{BulkCancellationUUID: bulkCancellationUUID}
+	}
+
+	return &b, nil
+}
+
+func (r *bulkCancellationRepositoryMock) UpdateBulkCancellation(
+	ctx context.Context,
+	bulkCancellationUUID string,
+	updateFn func(ctx context.Context, b *training.BulkCancellation) (*training.BulkCancellation, error),
+) error {
+	b, err := r.GetBulkCancellation(ctx, bulkCancellationUUID)
+	if err != nil {
+		return err
+	}
+
+	updatedBulkCancellation, err := updateFn(ctx, b)
+	if err != nil {
+		return err
+	}
+
+	r.BulkCancellations[bulkCancellationUUID] = *updatedBulkCancellation
+
+	return nil
+}
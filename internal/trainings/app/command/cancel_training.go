@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"log/slog"
 
@@ -14,6 +15,9 @@ import (
 type CancelTraining struct {
 	TrainingUUID string
 	User         training.User
+
+	// ExpectedVersion is the version of the training the change is based on, zero skips the check.
+	ExpectedVersion int
 }
 
 type CancelTrainingHandler decorator.CommandHandler[CancelTraining]
@@ -22,12 +26,19 @@ type cancelTrainingHandler struct {
 	repo           training.Repository
 	userService    UserService
 	trainerService TrainerService
+	policies       training.CancellationPolicies
+	offers         waitlistOffers
+	logger         *slog.Logger
 }
 
 func NewCancelTrainingHandler(
 	repo training.Repository,
+	waitlistRepo training.WaitlistRepository,
+	sessionTypeRepo training.SessionTypeRepository,
 	userService UserService,
 	trainerService TrainerService,
+	policies training.CancellationPolicies,
+	waitlistClaimTTL time.Duration,
 	logger *slog.Logger,
 	metricsClient decorator.MetricsClient,
 ) decorator.CommandHandler[CancelTraining] {
@@ -42,34 +53,95 @@ func NewCancelTrainingHandler(
 	}
 
 	return decorator.ApplyCommandDecorators[CancelTraining](
-		cancelTrainingHandler{repo: repo, userService: userService, trainerService: trainerService},
+		cancelTrainingHandler{
+			repo:           repo,
+			userService:    userService,
+			trainerService: trainerService,
+			policies:       policies,
+			offers:         newWaitlistOffers(repo, waitlistRepo, sessionTypeRepo, userService, trainerService, policies, waitlistClaimTTL),
+			logger:         logger,
+		},
 		logger,
 		metricsClient,
 	)
 }
 
 func (h cancelTrainingHandler) Handle(ctx context.Context, cmd CancelTraining) (err error) {
-	return h.repo.UpdateTraining(
+	var freedHours []time.Time
+
+	err = h.repo.UpdateTraining(
 		ctx,
 		cmd.TrainingUUID,
 		cmd.User,
 		func(ctx context.Context, tr *training.Training) (*training.Training, error) {
-			if err := tr.Cancel(); err != nil {
-				return nil, errors.NewIncorrectInputError(err.Error(), "cancel-training-failed")
+			if err := checkExpectedVersion(*tr, cmd.ExpectedVersion); err != nil {
+				return nil, err
 			}
 
-			if balanceDelta := training.CancelBalanceDelta(*tr, cmd.User.Type()); balanceDelta != 0 {
-				err := h.userService.UpdateTrainingBalance(ctx, tr.UserUUID(), balanceDelta)
-				if err != nil {
-					return nil, errors.NewSlugError(fmt.Sprintf("unable to change trainings balance: %s", err.Error()), "update-balance-failed")
-				}
-			}
-
-			if err := h.trainerService.CancelTraining(ctx, tr.Time()); err != nil {
-				return nil, errors.NewSlugError(fmt.Sprintf("unable to cancel training: %s", err.Error()), "cancel-training-failed")
+			var err error
+			freedHours, err = cancelTraining(ctx, tr, cmd.User, h.policies, h.userService, h.trainerService)
+			if err != nil {
+				return nil, err
 			}
 
 			return tr, nil
 		},
 	)
+	if err != nil {
+		return err
+	}
+
+	h.offers.offerFreedHours(ctx, h.logger, freedHours...)
+
+	return nil
+}
+
+// cancelTraining cancels the training, settles the trainings balance according to the cancellation policy
+// and frees the training hour in the trainer's calendar.
+// It returns the freed hours, so they can be offered to the waitlist once the cancellation is persisted.
+func cancelTraining(
+	ctx context.Context,
+	tr *training.Training,
+	canceledBy training.User,
+	policies training.CancellationPolicies,
+	userService UserService,
+	trainerService TrainerService,
+) ([]time.Time, error) {
+	policy, err := policies.ForTraining(*tr)
+	if err != nil {
+		return nil, err
+	}
+
+	proposedTimeHeld := tr.IsProposedTimeHeld()
+	proposedTime := tr.ProposedNewTime()
+
+	if err := tr.Cancel(canceledBy.Type()); err != nil {
+		return nil, errors.NewIncorrectInputError(err.Error(), "cancel-training-failed")
+	}
+
+	if balanceDelta := policy.CancelBalanceDelta(*tr, canceledBy.Type()); balanceDelta != 0 {
+		err := userService.UpdateTrainingBalance(ctx, tr.UserUUID(), balanceDelta, BalanceChange{
+			Reason:       BalanceReasonTrainingCanceled,
+			TrainingUUID: tr.UUID(),
+			ActorUUID:    canceledBy.UUID(),
+		})
+		if err != nil {
+			return nil, updateBalanceError(err)
+		}
+	}
+
+	if err := trainerService.CancelTraining(ctx, tr.Time()); err != nil {
+		return nil, errors.NewSlugError(fmt.Sprintf("unable to cancel training: %s", err.Error()), "cancel-training-failed")
+	}
+
+	freedHours := []time.Time{tr.Time()}
+
+	if proposedTimeHeld {
+		if err := trainerService.CancelTraining(ctx, proposedTime); err != nil {
+			return nil, errors.NewSlugError(fmt.Sprintf("unable to release proposed hour: %s", err.Error()), "release-proposed-hour-failed")
+		}
+		freedHours = append(freedHours, proposedTime)
+	}
+
+	return freedHours, nil
 }
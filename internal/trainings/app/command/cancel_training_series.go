@@ -0,0 +1,145 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// CancelTrainingSeries cancels the remainder of the series.
+// Every upcoming training is canceled separately, with the balance settled by its cancellation policy.
+type CancelTrainingSeries struct {
+	SeriesUUID string
+	User       training.User
+}
+
+type CancelTrainingSeriesHandler decorator.CommandHandler[CancelTrainingSeries]
+
+type cancelTrainingSeriesHandler struct {
+	repo           training.Repository
+	seriesRepo     training.SeriesRepository
+	userService    UserService
+	trainerService TrainerService
+	policies       training.CancellationPolicies
+	offers         waitlistOffers
+	logger         *slog.Logger
+}
+
+func NewCancelTrainingSeriesHandler(
+	repo training.Repository,
+	seriesRepo training.SeriesRepository,
+	waitlistRepo training.WaitlistRepository,
+	sessionTypeRepo training.SessionTypeRepository,
+	userService UserService,
+	trainerService TrainerService,
+	policies training.CancellationPolicies,
+	waitlistClaimTTL time.Duration,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) CancelTrainingSeriesHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if seriesRepo == nil {
+		panic("nil seriesRepo")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	if trainerService == nil {
+		panic("nil trainerService")
+	}
+
+	return decorator.ApplyCommandDecorators[CancelTrainingSeries](
+		cancelTrainingSeriesHandler{
+			repo:           repo,
+			seriesRepo:     seriesRepo,
+			userService:    userService,
+			trainerService: trainerService,
+			policies:       policies,
+			offers:         newWaitlistOffers(repo, waitlistRepo, sessionTypeRepo, userService, trainerService, policies, waitlistClaimTTL),
+			logger:         logger,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h cancelTrainingSeriesHandler) Handle(ctx context.Context, cmd CancelTrainingSeries) (err error) {
+	series, err := h.seriesRepo.GetSeries(ctx, cmd.SeriesUUID, cmd.User)
+	if err != nil {
+		return err
+	}
+	if series.IsCanceled() {
+		return errors.NewIncorrectInputError(training.ErrSeriesAlreadyCanceled.Error(), "cancel-series-failed")
+	}
+
+	now := time.Now()
+	trainingUUIDs := series.ScheduledTrainingUUIDs()
+
+	var failed int
+	var freedHours []time.Time
+	for _, trainingUUID := range trainingUUIDs {
+		var trainingFreedHours []time.Time
+
+		err := h.repo.UpdateTraining(
+			ctx,
+			trainingUUID,
+			cmd.User,
+			func(ctx context.Context, tr *training.Training) (*training.Training, error) {
+				if tr.IsCanceled() || !tr.Time().After(now) {
+					// already canceled one by one, or it's not the remainder of the series
+					return tr, nil
+				}
+
+				var err error
+				trainingFreedHours, err = cancelTraining(ctx, tr, cmd.User, h.policies, h.userService, h.trainerService)
+				if err != nil {
+					return nil, err
+				}
+
+				return tr, nil
+			},
+		)
+		if err != nil {
+			// the series is canceled only when all trainings are, so it can be retried
+			failed++
+			h.logger.WarnContext(ctx, "Unable to cancel training of the series",
+				slog.String("series_uuid", cmd.SeriesUUID),
+				slog.String("training_uuid", trainingUUID),
+				slog.Any("error", err),
+			)
+			continue
+		}
+
+		freedHours = append(freedHours, trainingFreedHours...)
+	}
+
+	h.offers.offerFreedHours(ctx, h.logger, freedHours...)
+
+	if failed > 0 {
+		return errors.NewSlugError(
+			fmt.Sprintf("unable to cancel %d of %d trainings of the series", failed, len(trainingUUIDs)),
+			"cancel-series-failed",
+		)
+	}
+
+	return h.seriesRepo.UpdateSeries(
+		ctx,
+		cmd.SeriesUUID,
+		cmd.User,
+		func(ctx context.Context, s *training.Series) (*training.Series, error) {
+			if err := s.Cancel(); err != nil {
+				return nil, errors.NewIncorrectInputError(err.Error(), "cancel-series-failed")
+			}
+
+			return s, nil
+		},
+	)
+}
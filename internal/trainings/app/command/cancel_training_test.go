@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	commonerrors "github.com/vaintrub/go-ddd-template/internal/common/errors"
 	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
 	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
 	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
@@ -83,7 +84,7 @@ func TestCancelTraining(t *testing.T) {
 
 			err := deps.handler.Handle(context.Background(), command.CancelTraining{
 				TrainingUUID: trainingUUID,
-				User:         training.MustNewUser(requestingUserID, tc.UserType),
+				User:         newTestUser(requestingUserID, tc.UserType),
 			})
 
 			if tc.ShouldFail {
@@ -97,6 +98,11 @@ func TestCancelTraining(t *testing.T) {
 				require.Len(t, deps.userService.balanceUpdates, 1)
 				require.Equal(t, tr.UserUUID(), deps.userService.balanceUpdates[0].userID)
 				require.Equal(t, tc.ExpectedBalanceChange, deps.userService.balanceUpdates[0].amountChange)
+				require.Equal(t, command.BalanceChange{
+					Reason:       command.BalanceReasonTrainingCanceled,
+					TrainingUUID: tr.UUID(),
+					ActorUUID:    requestingUserID,
+				}, deps.userService.balanceChanges[0])
 			} else {
 				require.Len(t, deps.userService.balanceUpdates, 0)
 			}
@@ -107,6 +113,95 @@ func TestCancelTraining(t *testing.T) {
 	}
 }
 
+func TestCancelTraining_by_trainer(t *testing.T) {
+	t.Parallel()
+	clientUUID := uuid.New().String()
+	otherTrainersClientUUID := uuid.New().String()
+	trainer := training.MustNewTrainer(uuid.New().String(), []string{clientUUID}, []string{otherTrainersClientUUID})
+
+	testCases := []struct {
+		Name         string
+		AttendeeUUID string
+		ShouldFail   bool
+	}{
+		{
+			Name:         "trainers_client",
+			AttendeeUUID: clientUUID,
+		},
+		{
+			Name:         "unassigned_attendee",
+			AttendeeUUID: uuid.New().String(),
+		},
+		{
+			Name:         "another_trainers_client",
+			AttendeeUUID: otherTrainersClientUUID,
+			ShouldFail:   true,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			trainingUUID := "any-training-uuid"
+			deps := newDependencies()
+
+			tr := createExampleTraining(t, tc.AttendeeUUID, time.Now().Add(48*time.Hour))
+			deps.repository.Trainings = map[string]training.Training{
+				trainingUUID: *tr,
+			}
+
+			err := deps.handler.Handle(context.Background(), command.CancelTraining{
+				TrainingUUID: trainingUUID,
+				User:         trainer,
+			})
+
+			if tc.ShouldFail {
+				require.ErrorAs(t, err, &training.ForbiddenToSeeTrainingError{})
+				require.False(t, deps.repository.Trainings[trainingUUID].IsCanceled())
+				require.Empty(t, deps.trainerService.trainingsCancelled)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, deps.repository.Trainings[trainingUUID].IsCanceled())
+			require.Len(t, deps.trainerService.trainingsCancelled, 1)
+		})
+	}
+}
+
+func TestCancelTraining_outdated_version(t *testing.T) {
+	t.Parallel()
+
+	deps := newDependencies()
+
+	tr := createExampleTraining(t, "attendee-uuid", time.Now().Add(48*time.Hour))
+	deps.repository.Trainings = map[string]training.Training{tr.UUID(): *tr}
+
+	err := deps.handler.Handle(context.Background(), command.CancelTraining{
+		TrainingUUID:    tr.UUID(),
+		User:            training.MustNewUser("attendee-uuid", training.Attendee),
+		ExpectedVersion: tr.Version() + 1,
+	})
+
+	var slugErr commonerrors.SlugError
+	require.ErrorAs(t, err, &slugErr)
+	require.Equal(t, commonerrors.ErrorTypePreconditionFailed, slugErr.ErrorType())
+
+	require.False(t, deps.repository.Trainings[tr.UUID()].IsCanceled())
+	require.Empty(t, deps.userService.balanceUpdates)
+	require.Empty(t, deps.trainerService.trainingsCancelled)
+
+	err = deps.handler.Handle(context.Background(), command.CancelTraining{
+		TrainingUUID:    tr.UUID(),
+		User:            training.MustNewUser("attendee-uuid", training.Attendee),
+		ExpectedVersion: tr.Version(),
+	})
+	require.NoError(t, err)
+	require.True(t, deps.repository.Trainings[tr.UUID()].IsCanceled())
+}
+
 func createExampleTraining(t *testing.T, requestingUserID string, trainingTime time.Time) *training.Training {
 	tr, err := training.NewTraining(
 		uuid.New().String(),
@@ -120,30 +215,62 @@ func createExampleTraining(t *testing.T, requestingUserID string, trainingTime t
 }
 
 type dependencies struct {
-	repository     *repositoryMock
-	trainerService *trainerServiceMock
-	userService    *userServiceMock
-	handler        command.CancelTrainingHandler
+	repository         *repositoryMock
+	waitlistRepository *waitlistRepositoryMock
+	trainerService     *trainerServiceMock
+	userService        *userServiceMock
+	handler            command.CancelTrainingHandler
 }
 
 func newDependencies() dependencies {
 	repository := &repositoryMock{}
+	waitlistRepository := &waitlistRepositoryMock{}
 	trainerService := &trainerServiceMock{}
 	userService := &userServiceMock{}
 
 	logger := slog.Default()
 	metricsClient := metrics.NoOp{}
 
+	policies, err := training.NewCancellationPolicies(
+		training.DefaultCancellationPolicyVersion,
+		training.DefaultCancellationPolicy(),
+	)
+	if err != nil {
+		panic(err)
+	}
+
 	return dependencies{
-		repository:     repository,
-		trainerService: trainerService,
-		userService:    userService,
-		handler:        command.NewCancelTrainingHandler(repository, userService, trainerService, logger, metricsClient),
+		repository:         repository,
+		waitlistRepository: waitlistRepository,
+		trainerService:     trainerService,
+		userService:        userService,
+		handler: command.NewCancelTrainingHandler(
+			repository,
+			waitlistRepository,
+			newSessionTypeRepositoryMock(),
+			userService,
+			trainerService,
+			policies,
+			time.Hour,
+			logger,
+			metricsClient,
+		),
+	}
+}
+
+// newTestUser returns the user of the given type, trainers have an empty roster, so they can see all trainings.
+func newTestUser(userUUID string, userType training.UserType) training.User {
+	if userType == training.Trainer {
+		return training.MustNewTrainer(userUUID, nil, nil)
 	}
+
+	return training.MustNewUser(userUUID, userType)
 }
 
 type repositoryMock struct {
 	Trainings map[string]training.Training
+
+	addTrainingErr error
 }
 
 func (r *repositoryMock) GetTraining(ctx context.Context, trainingUUID string, user training.User) (*training.Training, error) {
@@ -161,6 +288,10 @@ func (r *repositoryMock) UpdateTraining(
 		return errors.Errorf("training '%s' not found", trainingUUID)
 	}
 
+	if err := training.CanUserSeeTraining(user, tr); err != nil {
+		return err
+	}
+
 	updatedTraining, err := updateFn(ctx, &tr)
 	if err != nil {
 		return err
@@ -171,20 +302,93 @@ func (r *repositoryMock) UpdateTraining(
 	return nil
 }
 
-func (r repositoryMock) AddTraining(ctx context.Context, tr *training.Training) error {
-	panic("implement me")
+func (r *repositoryMock) FindTrainingsPendingAttendance(ctx context.Context, endedBefore time.Time) ([]string, error) {
+	var trainingUUIDs []string
+	for trainingUUID, tr := range r.Trainings {
+		if !tr.IsCanceled() && !tr.IsAttendanceRecorded() && tr.EndTime().Before(endedBefore) {
+			trainingUUIDs = append(trainingUUIDs, trainingUUID)
+		}
+	}
+
+	return trainingUUIDs, nil
+}
+
+func (r *repositoryMock) FindTrainingsWithExpiredRescheduleProposal(ctx context.Context, now time.Time) ([]string, error) {
+	var trainingUUIDs []string
+	for trainingUUID, tr := range r.Trainings {
+		if !tr.IsCanceled() && tr.IsRescheduleProposalExpired(now) {
+			trainingUUIDs = append(trainingUUIDs, trainingUUID)
+		}
+	}
+
+	return trainingUUIDs, nil
+}
+
+func (r *repositoryMock) FindScheduledTrainings(ctx context.Context, from time.Time, to time.Time, user training.User) ([]string, error) {
+	var trainingUUIDs []string
+	for trainingUUID, tr := range r.Trainings {
+		if !tr.IsCanceled() && !tr.Time().Before(from) && tr.Time().Before(to) && user.CanSeeTrainingsOf(tr.UserUUID()) {
+			trainingUUIDs = append(trainingUUIDs, trainingUUID)
+		}
+	}
+
+	return trainingUUIDs, nil
+}
+
+func (r *repositoryMock) AddTraining(ctx context.Context, tr *training.Training) error {
+	if r.addTrainingErr != nil {
+		return r.addTrainingErr
+	}
+
+	if r.Trainings == nil {
+		r.Trainings = map[string]training.Training{}
+	}
+	r.Trainings[tr.UUID()] = *tr
+
+	return nil
 }
 
 type trainerServiceMock struct {
+	unavailableHours   []time.Time
+	trainingsScheduled []time.Time
+	trainingsMoved     []time.Time
 	trainingsCancelled []time.Time
+	// scheduledDurations are durations of the scheduled trainings, in the order of trainingsScheduled
+	scheduledDurations []time.Duration
+
+	makeHourUnavailableErr error
+	hoursFailingToBlock    []time.Time
+	scheduleTrainingErr    error
 }
 
-func (t *trainerServiceMock) MoveTraining(ctx context.Context, newTime time.Time, originalTrainingTime time.Time) error {
-	panic("implement me")
+func (t *trainerServiceMock) IsHourAvailable(ctx context.Context, hour time.Time) (bool, error) {
+	if containsTime(t.unavailableHours, hour) {
+		return false, nil
+	}
+
+	// the hour is booked while it has more trainings scheduled than canceled
+	return countTime(t.trainingsScheduled, hour) <= countTime(t.trainingsCancelled, hour), nil
 }
 
-func (t *trainerServiceMock) ScheduleTraining(ctx context.Context, trainingTime time.Time) error {
-	panic("implement me")
+func (t *trainerServiceMock) MoveTraining(
+	ctx context.Context,
+	newTime time.Time,
+	originalTrainingTime time.Time,
+	duration time.Duration,
+	attendeeUUID string,
+) error {
+	t.trainingsMoved = append(t.trainingsMoved, newTime)
+	return nil
+}
+
+func (t *trainerServiceMock) ScheduleTraining(ctx context.Context, trainingTime time.Time, duration time.Duration, attendeeUUID string) error {
+	if t.scheduleTrainingErr != nil {
+		return t.scheduleTrainingErr
+	}
+
+	t.trainingsScheduled = append(t.trainingsScheduled, trainingTime)
+	t.scheduledDurations = append(t.scheduledDurations, duration)
+	return nil
 }
 
 func (t *trainerServiceMock) CancelTraining(ctx context.Context, trainingTime time.Time) error {
@@ -192,16 +396,57 @@ func (t *trainerServiceMock) CancelTraining(ctx context.Context, trainingTime ti
 	return nil
 }
 
+func (t *trainerServiceMock) MakeHourUnavailable(ctx context.Context, hour time.Time) error {
+	if t.makeHourUnavailableErr != nil {
+		return t.makeHourUnavailableErr
+	}
+	if containsTime(t.hoursFailingToBlock, hour) {
+		return errors.Errorf("unable to block hour %s", hour)
+	}
+
+	t.unavailableHours = append(t.unavailableHours, hour)
+	return nil
+}
+
+func containsTime(times []time.Time, t time.Time) bool {
+	return countTime(times, t) > 0
+}
+
+func countTime(times []time.Time, t time.Time) int {
+	count := 0
+	for _, tt := range times {
+		if tt.Equal(t) {
+			count++
+		}
+	}
+
+	return count
+}
+
 type balanceUpdate struct {
 	userID       string
 	amountChange int
 }
 
 type userServiceMock struct {
+	balance        int
 	balanceUpdates []balanceUpdate
+	balanceChanges []command.BalanceChange
+
+	// insufficientBalance makes every debit fail, as if it overdraws the balance
+	insufficientBalance bool
+}
+
+func (u *userServiceMock) GetTrainingBalance(ctx context.Context, userID string) (int, error) {
+	return u.balance, nil
 }
 
-func (u *userServiceMock) UpdateTrainingBalance(ctx context.Context, userID string, amountChange int) error {
+func (u *userServiceMock) UpdateTrainingBalance(ctx context.Context, userID string, amountChange int, change command.BalanceChange) error {
+	if u.insufficientBalance && amountChange < 0 {
+		return command.ErrInsufficientBalance
+	}
+
 	u.balanceUpdates = append(u.balanceUpdates, balanceUpdate{userID, amountChange})
+	u.balanceChanges = append(u.balanceChanges, change)
 	return nil
 }
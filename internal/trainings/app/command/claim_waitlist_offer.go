@@ -0,0 +1,92 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// ClaimWaitlistOffer books the hour offered to the waitlisted attendee.
+type ClaimWaitlistOffer struct {
+	EntryUUID    string
+	TrainingUUID string
+
+	User training.User
+
+	Notes string
+}
+
+type ClaimWaitlistOfferHandler decorator.CommandHandler[ClaimWaitlistOffer]
+
+type claimWaitlistOfferHandler struct {
+	waitlistRepo training.WaitlistRepository
+	offers       waitlistOffers
+}
+
+func NewClaimWaitlistOfferHandler(
+	repo training.Repository,
+	waitlistRepo training.WaitlistRepository,
+	sessionTypeRepo training.SessionTypeRepository,
+	userService UserService,
+	trainerService TrainerService,
+	policies training.CancellationPolicies,
+	claimTTL time.Duration,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) ClaimWaitlistOfferHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	if trainerService == nil {
+		panic("nil trainerService")
+	}
+
+	return decorator.ApplyCommandDecorators[ClaimWaitlistOffer](
+		claimWaitlistOfferHandler{
+			waitlistRepo: waitlistRepo,
+			offers:       newWaitlistOffers(repo, waitlistRepo, sessionTypeRepo, userService, trainerService, policies, claimTTL),
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h claimWaitlistOfferHandler) Handle(ctx context.Context, cmd ClaimWaitlistOffer) (err error) {
+	return h.waitlistRepo.UpdateWaitlistEntry(
+		ctx,
+		cmd.EntryUUID,
+		cmd.User,
+		func(ctx context.Context, entry *training.WaitlistEntry) (*training.WaitlistEntry, error) {
+			if cmd.User.UUID() != entry.UserUUID() {
+				return nil, errors.NewForbiddenError("only the waitlisted attendee can claim the offer", "claim-waitlist-offer-forbidden")
+			}
+
+			if err := entry.Claim(cmd.TrainingUUID, time.Now()); err != nil {
+				return nil, errors.NewIncorrectInputError(err.Error(), "claim-waitlist-offer-failed")
+			}
+
+			tr, err := h.offers.newTraining(ctx, cmd.TrainingUUID, *entry)
+			if err != nil {
+				return nil, err
+			}
+			if err := tr.UpdateNotes(cmd.Notes); err != nil {
+				return nil, errors.NewIncorrectInputError(err.Error(), "update-notes-failed")
+			}
+
+			// the hour was reserved in the trainer's calendar when it was offered
+			if err := h.offers.book(ctx, tr); err != nil {
+				return nil, err
+			}
+
+			return entry, nil
+		},
+	)
+}
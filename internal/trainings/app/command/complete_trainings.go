@@ -0,0 +1,83 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// CompleteTrainings marks all trainings without recorded attendance as completed
+// once the attendance grace period is over.
+type CompleteTrainings struct{}
+
+type CompleteTrainingsHandler decorator.CommandHandler[CompleteTrainings]
+
+type completeTrainingsHandler struct {
+	repo   training.Repository
+	policy training.AttendancePolicy
+	logger *slog.Logger
+}
+
+func NewCompleteTrainingsHandler(
+	repo training.Repository,
+	policy training.AttendancePolicy,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) CompleteTrainingsHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if err := policy.Validate(); err != nil {
+		panic(err)
+	}
+
+	return decorator.ApplyCommandDecorators[CompleteTrainings](
+		completeTrainingsHandler{repo: repo, policy: policy, logger: logger},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h completeTrainingsHandler) Handle(ctx context.Context, cmd CompleteTrainings) (err error) {
+	endedBefore := h.policy.CompletableEndedBefore(time.Now())
+
+	trainingUUIDs, err := h.repo.FindTrainingsPendingAttendance(ctx, endedBefore)
+	if err != nil {
+		return fmt.Errorf("unable to find trainings pending attendance: %w", err)
+	}
+
+	var failed int
+	for _, trainingUUID := range trainingUUIDs {
+		err := h.repo.UpdateTraining(
+			ctx,
+			trainingUUID,
+			training.SystemUser,
+			func(ctx context.Context, tr *training.Training) (*training.Training, error) {
+				if err := tr.Complete(h.policy.GracePeriod); err != nil {
+					return nil, err
+				}
+
+				return tr, nil
+			},
+		)
+		if err != nil {
+			// one broken training shouldn't block completing the others
+			failed++
+			h.logger.WarnContext(ctx, "Unable to complete training",
+				slog.String("training_uuid", trainingUUID),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("unable to complete %d of %d trainings", failed, len(trainingUUIDs))
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package command
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+)
+
+// ErrUserHasActiveBookings is returned by UserDataRepository when the user still has upcoming trainings,
+// series or waitlist entries, which have to be canceled before the user's data is erased.
+var ErrUserHasActiveBookings = stderrors.New("user has active bookings")
+
+// erasedUserName replaces the name of the erased user in the trainings records.
+const erasedUserName = "Erased user"
+
+// EraseUserData erases the user's personal data in all bounded contexts, to answer the data-subject erasure request.
+// Trainings are kept anonymized for accounting. Erasing already erased user is a no-op.
+type EraseUserData struct {
+	User auth.User
+
+	UserUUID string
+}
+
+type EraseUserDataHandler decorator.CommandHandler[EraseUserData]
+
+type eraseUserDataHandler struct {
+	repo  UserDataRepository
+	users UserEraser
+}
+
+func NewEraseUserDataHandler(
+	repo UserDataRepository,
+	users UserEraser,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) EraseUserDataHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if users == nil {
+		panic("nil users")
+	}
+
+	return decorator.ApplyCommandDecorators[EraseUserData](
+		eraseUserDataHandler{repo: repo, users: users},
+		logger,
+		metricsClient,
+	)
+}
+
+type UserDataRepository interface {
+	AnonymizeUserData(ctx context.Context, userUUID string, anonymizedName string, now time.Time) error
+}
+
+// UserEraser erases the user's data stored by the users service.
+type UserEraser interface {
+	// EraseUser removes the user's profile and sessions and deactivates the account.
+	// The balance ledger and credit orders are kept for accounting.
+	EraseUser(ctx context.Context, userUUID string) error
+}
+
+func (h eraseUserDataHandler) Handle(ctx context.Context, cmd EraseUserData) error {
+	if cmd.User.Role != "admin" {
+		return errors.NewForbiddenError("only admin can erase user data", "forbidden-to-erase-user-data")
+	}
+
+	// Trainings are anonymized first, so the erasure can be retried when the users service fails.
+	err := h.repo.AnonymizeUserData(ctx, cmd.UserUUID, erasedUserName, time.Now())
+	if stderrors.Is(err, ErrUserHasActiveBookings) {
+		return errors.NewIncorrectInputError(
+			"upcoming trainings, series and waitlist entries of the user have to be canceled first",
+			"user-has-active-bookings",
+		).WithCause(err)
+	}
+	if err != nil {
+		return err
+	}
+
+	return h.users.EraseUser(ctx, cmd.UserUUID)
+}
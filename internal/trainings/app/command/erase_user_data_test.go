@@ -0,0 +1,98 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
+)
+
+func TestEraseUserData(t *testing.T) {
+	t.Parallel()
+
+	repository := &userDataRepositoryMock{}
+	users := &userEraserMock{}
+	handler := command.NewEraseUserDataHandler(repository, users, slog.Default(), metrics.NoOp{})
+
+	err := handler.Handle(context.Background(), command.EraseUserData{
+		User:     auth.User{UUID: "admin-uuid", Role: "admin"},
+		UserUUID: "attendee-uuid",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"attendee-uuid"}, repository.Anonymized)
+	assert.NotEqual(t, "", repository.AnonymizedName)
+	assert.Equal(t, []string{"attendee-uuid"}, users.Erased)
+}
+
+func TestEraseUserData_not_admin(t *testing.T) {
+	t.Parallel()
+
+	repository := &userDataRepositoryMock{}
+	users := &userEraserMock{}
+	handler := command.NewEraseUserDataHandler(repository, users, slog.Default(), metrics.NoOp{})
+
+	err := handler.Handle(context.Background(), command.EraseUserData{
+		User:     auth.User{UUID: "trainer-uuid", Role: "trainer"},
+		UserUUID: "attendee-uuid",
+	})
+
+	var slugErr errors.SlugError
+	require.ErrorAs(t, err, &slugErr)
+	assert.Equal(t, errors.ErrorTypeForbidden, slugErr.ErrorType())
+	assert.Empty(t, repository.Anonymized)
+	assert.Empty(t, users.Erased)
+}
+
+func TestEraseUserData_active_bookings(t *testing.T) {
+	t.Parallel()
+
+	repository := &userDataRepositoryMock{Err: command.ErrUserHasActiveBookings}
+	users := &userEraserMock{}
+	handler := command.NewEraseUserDataHandler(repository, users, slog.Default(), metrics.NoOp{})
+
+	err := handler.Handle(context.Background(), command.EraseUserData{
+		User:     auth.User{UUID: "admin-uuid", Role: "admin"},
+		UserUUID: "attendee-uuid",
+	})
+
+	var slugErr errors.SlugError
+	require.ErrorAs(t, err, &slugErr)
+	assert.Equal(t, errors.ErrorTypeIncorrectInput, slugErr.ErrorType())
+	assert.Equal(t, "user-has-active-bookings", slugErr.Slug())
+	assert.Empty(t, users.Erased, "user is erased only after the trainings are anonymized")
+}
+
+type userDataRepositoryMock struct {
+	Err error
+
+	Anonymized     []string
+	AnonymizedName string
+}
+
+func (m *userDataRepositoryMock) AnonymizeUserData(ctx context.Context, userUUID string, anonymizedName string, now time.Time) error {
+	if m.Err != nil {
+		return m.Err
+	}
+
+	m.Anonymized = append(m.Anonymized, userUUID)
+	m.AnonymizedName = anonymizedName
+	return nil
+}
+
+type userEraserMock struct {
+	Erased []string
+}
+
+func (m *userEraserMock) EraseUser(ctx context.Context, userUUID string) error {
+	m.Erased = append(m.Erased, userUUID)
+	return nil
+}
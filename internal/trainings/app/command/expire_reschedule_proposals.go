@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// ExpireRescheduleProposals rejects all reschedule proposals which were not answered before their deadline.
+type ExpireRescheduleProposals struct{}
+
+type ExpireRescheduleProposalsHandler decorator.CommandHandler[ExpireRescheduleProposals]
+
+type expireRescheduleProposalsHandler struct {
+	repo           training.Repository
+	trainerService TrainerService
+	logger         *slog.Logger
+}
+
+func NewExpireRescheduleProposalsHandler(
+	repo training.Repository,
+	trainerService TrainerService,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) ExpireRescheduleProposalsHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if trainerService == nil {
+		panic("nil trainerService")
+	}
+
+	return decorator.ApplyCommandDecorators[ExpireRescheduleProposals](
+		expireRescheduleProposalsHandler{repo: repo, trainerService: trainerService, logger: logger},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h expireRescheduleProposalsHandler) Handle(ctx context.Context, cmd ExpireRescheduleProposals) (err error) {
+	trainingUUIDs, err := h.repo.FindTrainingsWithExpiredRescheduleProposal(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("unable to find expired reschedule proposals: %w", err)
+	}
+
+	var failed int
+	for _, trainingUUID := range trainingUUIDs {
+		err := h.repo.UpdateTraining(
+			ctx,
+			trainingUUID,
+			training.SystemUser,
+			func(ctx context.Context, tr *training.Training) (*training.Training, error) {
+				if !tr.IsRescheduleProposalExpired(time.Now()) {
+					// answered in the meantime
+					return tr, nil
+				}
+
+				proposedTimeHeld := tr.IsProposedTimeHeld()
+				proposedTime := tr.ProposedNewTime()
+
+				if err := tr.RejectReschedule(); err != nil {
+					return nil, err
+				}
+
+				if proposedTimeHeld {
+					if err := h.trainerService.CancelTraining(ctx, proposedTime); err != nil {
+						return nil, fmt.Errorf("unable to release proposed hour: %w", err)
+					}
+				}
+
+				return tr, nil
+			},
+		)
+		if err != nil {
+			// one broken training shouldn't block expiring the others
+			failed++
+			h.logger.WarnContext(ctx, "Unable to reject expired reschedule proposal",
+				slog.String("training_uuid", trainingUUID),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("unable to reject %d of %d expired reschedule proposals", failed, len(trainingUUIDs))
+	}
+
+	return nil
+}
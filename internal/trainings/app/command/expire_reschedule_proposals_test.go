@@ -0,0 +1,86 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestExpireRescheduleProposals(t *testing.T) {
+	t.Parallel()
+
+	trainingTime := time.Now().AddDate(0, 0, 5).Round(time.Hour)
+	proposedTime := trainingTime.AddDate(0, 0, 1)
+
+	expiredHeld := createTrainingWithRescheduleProposal(t, trainingTime, proposedTime, time.Now().Add(-time.Minute))
+	require.NoError(t, expiredHeld.HoldProposedTime())
+
+	repository := &repositoryMock{
+		Trainings: map[string]training.Training{
+			"expired":      *createTrainingWithRescheduleProposal(t, trainingTime, proposedTime, time.Now().Add(-time.Minute)),
+			"expired-held": *expiredHeld,
+			"not-expired":  *createTrainingWithRescheduleProposal(t, trainingTime, proposedTime, time.Now().Add(time.Hour)),
+		},
+	}
+	trainerService := &trainerServiceMock{}
+
+	handler := command.NewExpireRescheduleProposalsHandler(repository, trainerService, slog.Default(), metrics.NoOp{})
+
+	err := handler.Handle(context.Background(), command.ExpireRescheduleProposals{})
+	require.NoError(t, err)
+
+	expired := repository.Trainings["expired"]
+	require.False(t, expired.IsRescheduleProposed())
+	require.True(t, expired.Time().Equal(trainingTime))
+
+	notExpired := repository.Trainings["not-expired"]
+	require.True(t, notExpired.IsRescheduleProposed())
+
+	// only the held proposed hour is released
+	require.Len(t, trainerService.trainingsCancelled, 1)
+	require.True(t, trainerService.trainingsCancelled[0].Equal(proposedTime))
+}
+
+func createTrainingWithRescheduleProposal(
+	t *testing.T,
+	trainingTime time.Time,
+	proposedTime time.Time,
+	expiresAt time.Time,
+) *training.Training {
+	tr, err := training.UnmarshalTrainingFromDatabase(
+		uuid.New().String(),
+		uuid.New().String(),
+		"foo",
+		trainingTime,
+		"",
+		"",
+		false,
+		training.UserType{},
+		time.Time{},
+		proposedTime,
+		training.Attendee,
+		expiresAt,
+		false,
+		0,
+		training.Attendance{},
+		training.Feedback{},
+		training.DefaultCancellationPolicyVersion,
+		"",
+		training.DefaultSessionTypeCode,
+		1,
+		time.Hour,
+		nil,
+		1,
+	)
+	require.NoError(t, err)
+
+	return tr
+}
@@ -0,0 +1,115 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// ExpireWaitlistOffers passes hours which were not claimed in time to the next waitlisted attendees.
+type ExpireWaitlistOffers struct{}
+
+type ExpireWaitlistOffersHandler decorator.CommandHandler[ExpireWaitlistOffers]
+
+type expireWaitlistOffersHandler struct {
+	waitlistRepo training.WaitlistRepository
+	offers       waitlistOffers
+	logger       *slog.Logger
+}
+
+func NewExpireWaitlistOffersHandler(
+	repo training.Repository,
+	waitlistRepo training.WaitlistRepository,
+	sessionTypeRepo training.SessionTypeRepository,
+	userService UserService,
+	trainerService TrainerService,
+	policies training.CancellationPolicies,
+	claimTTL time.Duration,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) ExpireWaitlistOffersHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	if trainerService == nil {
+		panic("nil trainerService")
+	}
+
+	return decorator.ApplyCommandDecorators[ExpireWaitlistOffers](
+		expireWaitlistOffersHandler{
+			waitlistRepo: waitlistRepo,
+			offers:       newWaitlistOffers(repo, waitlistRepo, sessionTypeRepo, userService, trainerService, policies, claimTTL),
+			logger:       logger,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h expireWaitlistOffersHandler) Handle(ctx context.Context, cmd ExpireWaitlistOffers) (err error) {
+	entryUUIDs, err := h.waitlistRepo.FindWaitlistEntriesWithExpiredOffer(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("unable to find expired waitlist offers: %w", err)
+	}
+
+	var failed int
+	for _, entryUUID := range entryUUIDs {
+		if err := h.expireOffer(ctx, entryUUID); err != nil {
+			// one broken entry shouldn't block expiring the others
+			failed++
+			h.logger.WarnContext(ctx, "Unable to expire waitlist offer",
+				slog.String("waitlist_entry_uuid", entryUUID),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("unable to expire %d of %d waitlist offers", failed, len(entryUUIDs))
+	}
+
+	return nil
+}
+
+func (h expireWaitlistOffersHandler) expireOffer(ctx context.Context, entryUUID string) error {
+	var releasedHour time.Time
+
+	err := h.waitlistRepo.UpdateWaitlistEntry(
+		ctx,
+		entryUUID,
+		training.SystemUser,
+		func(ctx context.Context, entry *training.WaitlistEntry) (*training.WaitlistEntry, error) {
+			if !entry.IsOfferExpired(time.Now()) {
+				// claimed in the meantime
+				return entry, nil
+			}
+
+			if err := entry.ExpireOffer(time.Now()); err != nil {
+				return nil, err
+			}
+			if err := h.offers.releaseOfferedHour(ctx, entry.Hour()); err != nil {
+				return nil, err
+			}
+			releasedHour = entry.Hour()
+
+			return entry, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if releasedHour.IsZero() {
+		return nil
+	}
+
+	return h.offers.offerFreedHour(ctx, releasedHour)
+}
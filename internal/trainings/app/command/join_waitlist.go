@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+type JoinWaitlist struct {
+	EntryUUID string
+
+	UserUUID string
+	UserName string
+
+	Hour time.Time
+	// AutoBook books the freed hour right away if the attendee has enough balance, instead of offering it.
+	AutoBook bool
+}
+
+type JoinWaitlistHandler decorator.CommandHandler[JoinWaitlist]
+
+type joinWaitlistHandler struct {
+	waitlistRepo   training.WaitlistRepository
+	trainerService TrainerService
+}
+
+func NewJoinWaitlistHandler(
+	waitlistRepo training.WaitlistRepository,
+	trainerService TrainerService,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) JoinWaitlistHandler {
+	if waitlistRepo == nil {
+		panic("nil waitlistRepo")
+	}
+	if trainerService == nil {
+		panic("nil trainerService")
+	}
+
+	return decorator.ApplyCommandDecorators[JoinWaitlist](
+		joinWaitlistHandler{waitlistRepo: waitlistRepo, trainerService: trainerService},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h joinWaitlistHandler) Handle(ctx context.Context, cmd JoinWaitlist) (err error) {
+	entry, err := training.NewWaitlistEntry(cmd.EntryUUID, cmd.UserUUID, cmd.UserName, cmd.Hour, cmd.AutoBook)
+	if err != nil {
+		return errors.NewIncorrectInputError(err.Error(), "invalid-waitlist-entry")
+	}
+
+	available, err := h.trainerService.IsHourAvailable(ctx, cmd.Hour)
+	if err != nil {
+		return errors.NewSlugError(fmt.Sprintf("unable to check hour availability: %s", err.Error()), "check-hour-availability-failed")
+	}
+	if available {
+		return errors.NewIncorrectInputError("hour is available, it can be booked right away", "hour-available")
+	}
+
+	if err := h.waitlistRepo.AddWaitlistEntry(ctx, entry); err != nil {
+		return errors.NewSlugError(fmt.Sprintf("unable to join waitlist: %s", err.Error()), "join-waitlist-failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// LeaveWaitlist removes the attendee from the waitlist.
+// When the hour was already offered to the attendee, it's passed to the next one.
+type LeaveWaitlist struct {
+	EntryUUID string
+	User      training.User
+}
+
+type LeaveWaitlistHandler decorator.CommandHandler[LeaveWaitlist]
+
+type leaveWaitlistHandler struct {
+	waitlistRepo training.WaitlistRepository
+	offers       waitlistOffers
+	logger       *slog.Logger
+}
+
+func NewLeaveWaitlistHandler(
+	repo training.Repository,
+	waitlistRepo training.WaitlistRepository,
+	sessionTypeRepo training.SessionTypeRepository,
+	userService UserService,
+	trainerService TrainerService,
+	policies training.CancellationPolicies,
+	claimTTL time.Duration,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) LeaveWaitlistHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	if trainerService == nil {
+		panic("nil trainerService")
+	}
+
+	return decorator.ApplyCommandDecorators[LeaveWaitlist](
+		leaveWaitlistHandler{
+			waitlistRepo: waitlistRepo,
+			offers:       newWaitlistOffers(repo, waitlistRepo, sessionTypeRepo, userService, trainerService, policies, claimTTL),
+			logger:       logger,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h leaveWaitlistHandler) Handle(ctx context.Context, cmd LeaveWaitlist) (err error) {
+	var declinedHour time.Time
+
+	err = h.waitlistRepo.UpdateWaitlistEntry(
+		ctx,
+		cmd.EntryUUID,
+		cmd.User,
+		func(ctx context.Context, entry *training.WaitlistEntry) (*training.WaitlistEntry, error) {
+			wasOffered := entry.IsOffered()
+
+			if err := entry.Leave(); err != nil {
+				return nil, errors.NewIncorrectInputError(err.Error(), "leave-waitlist-failed")
+			}
+
+			if wasOffered {
+				if err := h.offers.releaseOfferedHour(ctx, entry.Hour()); err != nil {
+					return nil, err
+				}
+				declinedHour = entry.Hour()
+			}
+
+			return entry, nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !declinedHour.IsZero() {
+		h.offers.offerFreedHours(ctx, h.logger, declinedHour)
+	}
+
+	return nil
+}
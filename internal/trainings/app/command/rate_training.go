@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+type RateTraining struct {
+	TrainingUUID string
+	User         training.User
+
+	Rating  int
+	Comment string
+}
+
+type RateTrainingHandler decorator.CommandHandler[RateTraining]
+
+type rateTrainingHandler struct {
+	repo training.Repository
+}
+
+func NewRateTrainingHandler(
+	repo training.Repository,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) RateTrainingHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+
+	return decorator.ApplyCommandDecorators[RateTraining](
+		rateTrainingHandler{repo: repo},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h rateTrainingHandler) Handle(ctx context.Context, cmd RateTraining) (err error) {
+	return h.repo.UpdateTraining(
+		ctx,
+		cmd.TrainingUUID,
+		cmd.User,
+		func(ctx context.Context, tr *training.Training) (*training.Training, error) {
+			if err := tr.Rate(cmd.Rating, cmd.Comment, cmd.User.Type()); err != nil {
+				return nil, errors.NewIncorrectInputError(err.Error(), "rate-training-failed")
+			}
+
+			return tr, nil
+		},
+	)
+}
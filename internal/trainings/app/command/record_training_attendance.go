@@ -0,0 +1,76 @@
+package command
+
+import (
+	"context"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+type RecordTrainingAttendance struct {
+	TrainingUUID string
+	User         training.User
+
+	Attendance training.Attendance
+}
+
+type RecordTrainingAttendanceHandler decorator.CommandHandler[RecordTrainingAttendance]
+
+type recordTrainingAttendanceHandler struct {
+	repo        training.Repository
+	userService UserService
+	policy      training.AttendancePolicy
+}
+
+func NewRecordTrainingAttendanceHandler(
+	repo training.Repository,
+	userService UserService,
+	policy training.AttendancePolicy,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) RecordTrainingAttendanceHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if userService == nil {
+		panic("nil user service")
+	}
+	if err := policy.Validate(); err != nil {
+		panic(err)
+	}
+
+	return decorator.ApplyCommandDecorators[RecordTrainingAttendance](
+		recordTrainingAttendanceHandler{repo: repo, userService: userService, policy: policy},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h recordTrainingAttendanceHandler) Handle(ctx context.Context, cmd RecordTrainingAttendance) (err error) {
+	return h.repo.UpdateTraining(
+		ctx,
+		cmd.TrainingUUID,
+		cmd.User,
+		func(ctx context.Context, tr *training.Training) (*training.Training, error) {
+			if err := tr.RecordAttendance(cmd.Attendance, cmd.User.Type()); err != nil {
+				return nil, errors.NewIncorrectInputError(err.Error(), "record-attendance-failed")
+			}
+
+			if balanceDelta := h.policy.BalanceDelta(tr.Attendance()); balanceDelta != 0 {
+				err := h.userService.UpdateTrainingBalance(ctx, tr.UserUUID(), balanceDelta, BalanceChange{
+					Reason:       BalanceReasonTrainingAttendance,
+					TrainingUUID: tr.UUID(),
+					ActorUUID:    cmd.User.UUID(),
+				})
+				if err != nil {
+					return nil, updateBalanceError(err)
+				}
+			}
+
+			return tr, nil
+		},
+	)
+}
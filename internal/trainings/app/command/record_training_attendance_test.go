@@ -0,0 +1,120 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+var testAttendancePolicy = training.AttendancePolicy{
+	GracePeriod:   24 * time.Hour,
+	NoShowPenalty: 1,
+	AttendedBonus: 0,
+}
+
+func TestRecordTrainingAttendance(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name       string
+		Attendance training.Attendance
+		UserType   training.UserType
+
+		ShouldFail bool
+
+		ShouldUpdateBalance   bool
+		ExpectedBalanceChange int
+	}{
+		{
+			Name:                  "no_show_takes_penalty",
+			Attendance:            training.NoShow,
+			UserType:              training.Trainer,
+			ShouldUpdateBalance:   true,
+			ExpectedBalanceChange: -1,
+		},
+		{
+			Name:                "attended_without_bonus_does_not_change_balance",
+			Attendance:          training.Attended,
+			UserType:            training.Trainer,
+			ShouldUpdateBalance: false,
+		},
+		{
+			Name:       "attendee_cannot_record_attendance",
+			Attendance: training.Attended,
+			UserType:   training.Attendee,
+			ShouldFail: true,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			trainingUUID := "any-training-uuid"
+			repository := &repositoryMock{}
+			userService := &userServiceMock{}
+
+			tr := createExampleTraining(t, "attendee-id", time.Now().Add(-2*time.Hour))
+			repository.Trainings = map[string]training.Training{
+				trainingUUID: *tr,
+			}
+
+			handler := command.NewRecordTrainingAttendanceHandler(repository, userService, testAttendancePolicy, slog.Default(), metrics.NoOp{})
+
+			err := handler.Handle(context.Background(), command.RecordTrainingAttendance{
+				TrainingUUID: trainingUUID,
+				User:         newTestUser("attendee-id", tc.UserType),
+				Attendance:   tc.Attendance,
+			})
+
+			if tc.ShouldFail {
+				require.Error(t, err)
+				require.Len(t, userService.balanceUpdates, 0)
+				return
+			}
+
+			require.NoError(t, err)
+
+			updated := repository.Trainings[trainingUUID]
+			require.Equal(t, tc.Attendance, updated.Attendance())
+
+			if tc.ShouldUpdateBalance {
+				require.Len(t, userService.balanceUpdates, 1)
+				require.Equal(t, tr.UserUUID(), userService.balanceUpdates[0].userID)
+				require.Equal(t, tc.ExpectedBalanceChange, userService.balanceUpdates[0].amountChange)
+			} else {
+				require.Len(t, userService.balanceUpdates, 0)
+			}
+		})
+	}
+}
+
+func TestCompleteTrainings(t *testing.T) {
+	t.Parallel()
+
+	repository := &repositoryMock{
+		Trainings: map[string]training.Training{
+			"after-grace-period":  *createExampleTraining(t, "attendee-id", time.Now().Add(-48*time.Hour)),
+			"within-grace-period": *createExampleTraining(t, "attendee-id", time.Now().Add(-2*time.Hour)),
+		},
+	}
+
+	handler := command.NewCompleteTrainingsHandler(repository, testAttendancePolicy, slog.Default(), metrics.NoOp{})
+
+	err := handler.Handle(context.Background(), command.CompleteTrainings{})
+	require.NoError(t, err)
+
+	completed := repository.Trainings["after-grace-period"]
+	require.Equal(t, training.Completed, completed.Attendance())
+
+	pending := repository.Trainings["within-grace-period"]
+	require.False(t, pending.IsAttendanceRecorded())
+}
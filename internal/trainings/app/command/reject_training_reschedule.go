@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"fmt"
 
 	"log/slog"
 
@@ -13,25 +14,33 @@ import (
 type RejectTrainingReschedule struct {
 	TrainingUUID string
 	User         training.User
+
+	// ExpectedVersion is the version of the training the change is based on, zero skips the check.
+	ExpectedVersion int
 }
 
 type RejectTrainingRescheduleHandler decorator.CommandHandler[RejectTrainingReschedule]
 
 type rejectTrainingRescheduleHandler struct {
-	repo training.Repository
+	repo           training.Repository
+	trainerService TrainerService
 }
 
 func NewRejectTrainingRescheduleHandler(
 	repo training.Repository,
+	trainerService TrainerService,
 	logger *slog.Logger,
 	metricsClient decorator.MetricsClient,
 ) RejectTrainingRescheduleHandler {
 	if repo == nil {
 		panic("nil repo service")
 	}
+	if trainerService == nil {
+		panic("nil trainerService")
+	}
 
 	return decorator.ApplyCommandDecorators[RejectTrainingReschedule](
-		rejectTrainingRescheduleHandler{repo: repo},
+		rejectTrainingRescheduleHandler{repo: repo, trainerService: trainerService},
 		logger,
 		metricsClient,
 	)
@@ -43,10 +52,23 @@ func (h rejectTrainingRescheduleHandler) Handle(ctx context.Context, cmd RejectT
 		cmd.TrainingUUID,
 		cmd.User,
 		func(ctx context.Context, tr *training.Training) (*training.Training, error) {
+			if err := checkExpectedVersion(*tr, cmd.ExpectedVersion); err != nil {
+				return nil, err
+			}
+
+			proposedTimeHeld := tr.IsProposedTimeHeld()
+			proposedTime := tr.ProposedNewTime()
+
 			if err := tr.RejectReschedule(); err != nil {
 				return nil, errors.NewIncorrectInputError(err.Error(), "reject-reschedule-failed")
 			}
 
+			if proposedTimeHeld {
+				if err := h.trainerService.CancelTraining(ctx, proposedTime); err != nil {
+					return nil, errors.NewSlugError(fmt.Sprintf("unable to release proposed hour: %s", err.Error()), "release-proposed-hour-failed")
+				}
+			}
+
 			return tr, nil
 		},
 	)
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"context"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+type ReplyToTrainingFeedback struct {
+	TrainingUUID string
+	User         training.User
+
+	Reply string
+}
+
+type ReplyToTrainingFeedbackHandler decorator.CommandHandler[ReplyToTrainingFeedback]
+
+type replyToTrainingFeedbackHandler struct {
+	repo training.Repository
+}
+
+func NewReplyToTrainingFeedbackHandler(
+	repo training.Repository,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) ReplyToTrainingFeedbackHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+
+	return decorator.ApplyCommandDecorators[ReplyToTrainingFeedback](
+		replyToTrainingFeedbackHandler{repo: repo},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h replyToTrainingFeedbackHandler) Handle(ctx context.Context, cmd ReplyToTrainingFeedback) (err error) {
+	return h.repo.UpdateTraining(
+		ctx,
+		cmd.TrainingUUID,
+		cmd.User,
+		func(ctx context.Context, tr *training.Training) (*training.Training, error) {
+			if err := tr.ReplyToFeedback(cmd.Reply, cmd.User.Type()); err != nil {
+				return nil, errors.NewIncorrectInputError(err.Error(), "reply-to-feedback-failed")
+			}
+
+			return tr, nil
+		},
+	)
+}
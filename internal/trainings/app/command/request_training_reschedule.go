@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"log/slog"
@@ -18,25 +19,46 @@ type RequestTrainingReschedule struct {
 	User training.User
 
 	NewNotes string
+
+	// ExpectedVersion is the version of the training the change is based on, zero skips the check.
+	ExpectedVersion int
 }
 
 type RequestTrainingRescheduleHandler decorator.CommandHandler[RequestTrainingReschedule]
 
 type requestTrainingRescheduleHandler struct {
-	repo training.Repository
+	repo           training.Repository
+	trainerService TrainerService
+	proposalTTL    time.Duration
+	// holdProposedHour reserves the proposed hour in the trainer's calendar until the proposal is answered.
+	holdProposedHour bool
 }
 
 func NewRequestTrainingRescheduleHandler(
 	repo training.Repository,
+	trainerService TrainerService,
+	proposalTTL time.Duration,
+	holdProposedHour bool,
 	logger *slog.Logger,
 	metricsClient decorator.MetricsClient,
 ) RequestTrainingRescheduleHandler {
 	if repo == nil {
 		panic("nil repo service")
 	}
+	if trainerService == nil {
+		panic("nil trainerService")
+	}
+	if proposalTTL <= 0 {
+		panic("proposal TTL must be positive")
+	}
 
 	return decorator.ApplyCommandDecorators[RequestTrainingReschedule](
-		requestTrainingRescheduleHandler{repo: repo},
+		requestTrainingRescheduleHandler{
+			repo:             repo,
+			trainerService:   trainerService,
+			proposalTTL:      proposalTTL,
+			holdProposedHour: holdProposedHour,
+		},
 		logger,
 		metricsClient,
 	)
@@ -48,11 +70,51 @@ func (h requestTrainingRescheduleHandler) Handle(ctx context.Context, cmd Reques
 		cmd.TrainingUUID,
 		cmd.User,
 		func(ctx context.Context, tr *training.Training) (*training.Training, error) {
-			if err := tr.UpdateNotes(cmd.NewNotes); err != nil {
+			if err := checkExpectedVersion(*tr, cmd.ExpectedVersion); err != nil {
+				return nil, err
+			}
+
+			if err := tr.EditNotes(cmd.NewNotes, cmd.User, time.Now()); err != nil {
 				return nil, errors.NewIncorrectInputError(err.Error(), "update-notes-failed")
 			}
 
-			tr.ProposeReschedule(cmd.NewTime, cmd.User.Type())
+			previousProposedTimeHeld := tr.IsProposedTimeHeld()
+			previousProposedTime := tr.ProposedNewTime()
+
+			if err := tr.ProposeReschedule(cmd.NewTime, cmd.User.Type(), time.Now().Add(h.proposalTTL)); err != nil {
+				return nil, errors.NewIncorrectInputError(err.Error(), "request-reschedule-failed")
+			}
+
+			if previousProposedTimeHeld && previousProposedTime.Equal(cmd.NewTime) {
+				// the same hour is proposed again, it's already reserved for this training
+				if err := tr.HoldProposedTime(); err != nil {
+					return nil, err
+				}
+				return tr, nil
+			}
+
+			available, err := h.trainerService.IsHourAvailable(ctx, cmd.NewTime)
+			if err != nil {
+				return nil, errors.NewSlugError(fmt.Sprintf("unable to check hour availability: %s", err.Error()), "check-hour-availability-failed")
+			}
+			if !available {
+				return nil, errors.NewIncorrectInputError("proposed hour is not available", "hour-not-available")
+			}
+
+			if h.holdProposedHour {
+				if err := h.trainerService.ScheduleTraining(ctx, cmd.NewTime, tr.Duration(), tr.UserUUID()); err != nil {
+					return nil, errors.NewSlugError(fmt.Sprintf("unable to hold proposed hour: %s", err.Error()), "hold-proposed-hour-failed")
+				}
+				if err := tr.HoldProposedTime(); err != nil {
+					return nil, err
+				}
+			}
+
+			if previousProposedTimeHeld {
+				if err := h.trainerService.CancelTraining(ctx, previousProposedTime); err != nil {
+					return nil, errors.NewSlugError(fmt.Sprintf("unable to release proposed hour: %s", err.Error()), "release-proposed-hour-failed")
+				}
+			}
 
 			return tr, nil
 		},
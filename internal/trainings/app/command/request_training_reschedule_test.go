@@ -0,0 +1,182 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestRequestTrainingReschedule(t *testing.T) {
+	t.Parallel()
+
+	trainingTime := time.Now().AddDate(0, 0, 5).Round(time.Hour)
+	proposedTime := trainingTime.AddDate(0, 0, 1)
+
+	testCases := []struct {
+		Name             string
+		HoldProposedHour bool
+		UnavailableHours []time.Time
+
+		ShouldFail        bool
+		ExpectedSlug      string
+		ExpectedHeld      bool
+		ExpectedScheduled []time.Time
+	}{
+		{
+			Name:             "available_without_hold",
+			HoldProposedHour: false,
+		},
+		{
+			Name:              "available_with_hold",
+			HoldProposedHour:  true,
+			ExpectedHeld:      true,
+			ExpectedScheduled: []time.Time{proposedTime},
+		},
+		{
+			Name:             "hour_not_available",
+			HoldProposedHour: true,
+			UnavailableHours: []time.Time{proposedTime},
+			ShouldFail:       true,
+			ExpectedSlug:     "hour-not-available",
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := createExampleTraining(t, "user-uuid", trainingTime)
+			repository := &repositoryMock{
+				Trainings: map[string]training.Training{tr.UUID(): *tr},
+			}
+			trainerService := &trainerServiceMock{unavailableHours: c.UnavailableHours}
+
+			handler := command.NewRequestTrainingRescheduleHandler(
+				repository,
+				trainerService,
+				time.Hour,
+				c.HoldProposedHour,
+				slog.Default(),
+				metrics.NoOp{},
+			)
+
+			err := handler.Handle(context.Background(), command.RequestTrainingReschedule{
+				TrainingUUID: tr.UUID(),
+				NewTime:      proposedTime,
+				User:         training.MustNewUser(tr.UserUUID(), training.Attendee),
+			})
+
+			if c.ShouldFail {
+				var slugErr errors.SlugError
+				require.ErrorAs(t, err, &slugErr)
+				assert.Equal(t, c.ExpectedSlug, slugErr.Slug())
+				assert.Empty(t, trainerService.trainingsScheduled)
+				return
+			}
+			require.NoError(t, err)
+
+			updated := repository.Trainings[tr.UUID()]
+			assert.True(t, updated.IsRescheduleProposed())
+			assert.Equal(t, c.ExpectedHeld, updated.IsProposedTimeHeld())
+			assert.Equal(t, c.ExpectedScheduled, trainerService.trainingsScheduled)
+		})
+	}
+}
+
+func TestRequestTrainingReschedule_releases_previously_held_hour(t *testing.T) {
+	t.Parallel()
+
+	trainingTime := time.Now().AddDate(0, 0, 5).Round(time.Hour)
+	previousProposedTime := trainingTime.AddDate(0, 0, 1)
+	newProposedTime := trainingTime.AddDate(0, 0, 2)
+
+	tr := createTrainingWithRescheduleProposal(t, trainingTime, previousProposedTime, time.Now().Add(time.Hour))
+	require.NoError(t, tr.HoldProposedTime())
+
+	repository := &repositoryMock{
+		Trainings: map[string]training.Training{tr.UUID(): *tr},
+	}
+	trainerService := &trainerServiceMock{}
+
+	handler := command.NewRequestTrainingRescheduleHandler(repository, trainerService, time.Hour, true, slog.Default(), metrics.NoOp{})
+
+	err := handler.Handle(context.Background(), command.RequestTrainingReschedule{
+		TrainingUUID: tr.UUID(),
+		NewTime:      newProposedTime,
+		User:         training.MustNewTrainer(tr.UserUUID(), nil, nil),
+	})
+	require.NoError(t, err)
+
+	updated := repository.Trainings[tr.UUID()]
+	assert.True(t, updated.ProposedNewTime().Equal(newProposedTime))
+	assert.True(t, updated.IsProposedTimeHeld())
+	assert.Equal(t, []time.Time{newProposedTime}, trainerService.trainingsScheduled)
+	assert.Equal(t, []time.Time{previousProposedTime}, trainerService.trainingsCancelled)
+}
+
+func TestRejectTrainingReschedule_releases_held_hour(t *testing.T) {
+	t.Parallel()
+
+	trainingTime := time.Now().AddDate(0, 0, 5).Round(time.Hour)
+	proposedTime := trainingTime.AddDate(0, 0, 1)
+
+	tr := createTrainingWithRescheduleProposal(t, trainingTime, proposedTime, time.Now().Add(time.Hour))
+	require.NoError(t, tr.HoldProposedTime())
+
+	repository := &repositoryMock{
+		Trainings: map[string]training.Training{tr.UUID(): *tr},
+	}
+	trainerService := &trainerServiceMock{}
+
+	handler := command.NewRejectTrainingRescheduleHandler(repository, trainerService, slog.Default(), metrics.NoOp{})
+
+	err := handler.Handle(context.Background(), command.RejectTrainingReschedule{
+		TrainingUUID: tr.UUID(),
+		User:         training.MustNewTrainer(tr.UserUUID(), nil, nil),
+	})
+	require.NoError(t, err)
+
+	updated := repository.Trainings[tr.UUID()]
+	assert.False(t, updated.IsRescheduleProposed())
+	assert.False(t, updated.IsProposedTimeHeld())
+	assert.Equal(t, []time.Time{proposedTime}, trainerService.trainingsCancelled)
+}
+
+func TestApproveTrainingReschedule_held_hour(t *testing.T) {
+	t.Parallel()
+
+	trainingTime := time.Now().AddDate(0, 0, 5).Round(time.Hour)
+	proposedTime := trainingTime.AddDate(0, 0, 1)
+
+	tr := createTrainingWithRescheduleProposal(t, trainingTime, proposedTime, time.Now().Add(time.Hour))
+	require.NoError(t, tr.HoldProposedTime())
+
+	repository := &repositoryMock{
+		Trainings: map[string]training.Training{tr.UUID(): *tr},
+	}
+	trainerService := &trainerServiceMock{}
+
+	handler := command.NewApproveTrainingRescheduleHandler(repository, &userServiceMock{}, trainerService, slog.Default(), metrics.NoOp{})
+
+	err := handler.Handle(context.Background(), command.ApproveTrainingReschedule{
+		TrainingUUID: tr.UUID(),
+		User:         training.MustNewTrainer(tr.UserUUID(), nil, nil),
+	})
+	require.NoError(t, err)
+
+	updated := repository.Trainings[tr.UUID()]
+	assert.True(t, updated.Time().Equal(proposedTime))
+	// the proposed hour is already taken, so only the original one is released
+	assert.Empty(t, trainerService.trainingsMoved)
+	assert.Equal(t, []time.Time{trainingTime}, trainerService.trainingsCancelled)
+}
@@ -19,6 +19,9 @@ type RescheduleTraining struct {
 	User training.User
 
 	NewNotes string
+
+	// ExpectedVersion is the version of the training the change is based on, zero skips the check.
+	ExpectedVersion int
 }
 
 type RescheduleTrainingHandler decorator.CommandHandler[RescheduleTraining]
@@ -27,12 +30,14 @@ type rescheduleTrainingHandler struct {
 	repo           training.Repository
 	userService    UserService
 	trainerService TrainerService
+	policies       training.CancellationPolicies
 }
 
 func NewRescheduleTrainingHandler(
 	repo training.Repository,
 	userService UserService,
 	trainerService TrainerService,
+	policies training.CancellationPolicies,
 	logger *slog.Logger,
 	metricsClient decorator.MetricsClient,
 ) RescheduleTrainingHandler {
@@ -47,7 +52,7 @@ func NewRescheduleTrainingHandler(
 	}
 
 	return decorator.ApplyCommandDecorators[RescheduleTraining](
-		rescheduleTrainingHandler{repo: repo, userService: userService, trainerService: trainerService},
+		rescheduleTrainingHandler{repo: repo, userService: userService, trainerService: trainerService, policies: policies},
 		logger,
 		metricsClient,
 	)
@@ -59,17 +64,26 @@ func (h rescheduleTrainingHandler) Handle(ctx context.Context, cmd RescheduleTra
 		cmd.TrainingUUID,
 		cmd.User,
 		func(ctx context.Context, tr *training.Training) (*training.Training, error) {
+			if err := checkExpectedVersion(*tr, cmd.ExpectedVersion); err != nil {
+				return nil, err
+			}
+
 			originalTrainingTime := tr.Time()
 
-			if err := tr.UpdateNotes(cmd.NewNotes); err != nil {
+			if err := tr.EditNotes(cmd.NewNotes, cmd.User, time.Now()); err != nil {
 				return nil, errors.NewIncorrectInputError(err.Error(), "update-notes-failed")
 			}
 
-			if err := tr.RescheduleTraining(cmd.NewTime); err != nil {
+			policy, err := h.policies.ForTraining(*tr)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := tr.RescheduleTraining(cmd.NewTime, policy); err != nil {
 				return nil, errors.NewIncorrectInputError(err.Error(), "reschedule-training-failed")
 			}
 
-			err := h.trainerService.MoveTraining(ctx, cmd.NewTime, originalTrainingTime)
+			err = h.trainerService.MoveTraining(ctx, cmd.NewTime, originalTrainingTime, tr.Duration(), tr.UserUUID())
 			if err != nil {
 				return nil, errors.NewSlugError(fmt.Sprintf("unable to move training: %s", err.Error()), "move-training-failed")
 			}
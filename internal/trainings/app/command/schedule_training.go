@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"time"
 
@@ -20,26 +21,36 @@ type ScheduleTraining struct {
 
 	TrainingTime time.Time
 	Notes        string
+
+	// SessionType is the code of the booked session type, the default one is booked when it's empty.
+	SessionType string
 }
 
 type ScheduleTrainingHandler decorator.CommandHandler[ScheduleTraining]
 
 type scheduleTrainingHandler struct {
-	repo           training.Repository
-	userService    UserService
-	trainerService TrainerService
+	repo            training.Repository
+	sessionTypeRepo training.SessionTypeRepository
+	userService     UserService
+	trainerService  TrainerService
+	policies        training.CancellationPolicies
 }
 
 func NewScheduleTrainingHandler(
 	repo training.Repository,
+	sessionTypeRepo training.SessionTypeRepository,
 	userService UserService,
 	trainerService TrainerService,
+	policies training.CancellationPolicies,
 	logger *slog.Logger,
 	metricsClient decorator.MetricsClient,
 ) ScheduleTrainingHandler {
 	if repo == nil {
 		panic("nil repo")
 	}
+	if sessionTypeRepo == nil {
+		panic("nil sessionTypeRepo")
+	}
 	if userService == nil {
 		panic("nil repo")
 	}
@@ -48,7 +59,13 @@ func NewScheduleTrainingHandler(
 	}
 
 	return decorator.ApplyCommandDecorators[ScheduleTraining](
-		scheduleTrainingHandler{repo: repo, userService: userService, trainerService: trainerService},
+		scheduleTrainingHandler{
+			repo:            repo,
+			sessionTypeRepo: sessionTypeRepo,
+			userService:     userService,
+			trainerService:  trainerService,
+			policies:        policies,
+		},
 		logger,
 		metricsClient,
 	)
@@ -59,20 +76,80 @@ func (h scheduleTrainingHandler) Handle(ctx context.Context, cmd ScheduleTrainin
 	if err != nil {
 		return errors.NewIncorrectInputError(err.Error(), "invalid-training-data")
 	}
+	tr.BookUnderCancellationPolicy(h.policies.Current())
 
-	if err := h.repo.AddTraining(ctx, tr); err != nil {
-		return errors.NewSlugError(fmt.Sprintf("unable to add training: %s", err.Error()), "add-training-failed")
+	st, err := bookableSessionType(ctx, h.sessionTypeRepo, cmd.SessionType)
+	if err != nil {
+		return err
+	}
+	if err := tr.BookSessionType(st); err != nil {
+		return errors.NewIncorrectInputError(err.Error(), "session-type-archived")
 	}
 
-	err = h.userService.UpdateTrainingBalance(ctx, tr.UserUUID(), -1)
+	// the attendee is charged first, so the training is not added when they can't pay for it
+	err = h.userService.UpdateTrainingBalance(ctx, tr.UserUUID(), -tr.Price(), BalanceChange{
+		Reason:       BalanceReasonTrainingBooked,
+		TrainingUUID: tr.UUID(),
+		ActorUUID:    tr.UserUUID(),
+	})
 	if err != nil {
-		return errors.NewSlugError(fmt.Sprintf("unable to change trainings balance: %s", err.Error()), "update-balance-failed")
+		return updateBalanceError(err)
 	}
 
-	err = h.trainerService.ScheduleTraining(ctx, tr.Time())
+	// the hour is reserved before the training is added, so there is no training left behind when the hour is taken
+	err = h.trainerService.ScheduleTraining(ctx, tr.Time(), tr.Duration(), tr.UserUUID())
 	if err != nil {
-		return errors.NewSlugError(fmt.Sprintf("unable to schedule training: %s", err.Error()), "schedule-training-failed")
+		return refundBooking(ctx, h.userService, tr, errors.NewSlugError(fmt.Sprintf("unable to schedule training: %s", err.Error()), "schedule-training-failed"))
+	}
+
+	if err := h.repo.AddTraining(ctx, tr); err != nil {
+		if cancelErr := h.trainerService.CancelTraining(ctx, tr.Time()); cancelErr != nil {
+			return errors.NewSlugError(
+				fmt.Sprintf("unable to add training: %s, and unable to cancel it in trainer's calendar: %s", err.Error(), cancelErr.Error()),
+				"add-training-failed",
+			)
+		}
+		return refundBooking(ctx, h.userService, tr, errors.NewSlugError(fmt.Sprintf("unable to add training: %s", err.Error()), "add-training-failed"))
 	}
 
 	return nil
 }
+
+// refundBooking returns the price charged for the training which was not booked, and passes the booking error through.
+func refundBooking(ctx context.Context, userService UserService, tr *training.Training, bookingErr errors.SlugError) error {
+	err := userService.UpdateTrainingBalance(ctx, tr.UserUUID(), tr.Price(), BalanceChange{
+		Reason:       BalanceReasonTrainingBookingRefunded,
+		TrainingUUID: tr.UUID(),
+		ActorUUID:    tr.UserUUID(),
+	})
+	if err != nil {
+		return errors.NewSlugError(
+			fmt.Sprintf("%s, and unable to refund trainings balance: %s", bookingErr.Error(), err.Error()),
+			bookingErr.Slug(),
+		)
+	}
+
+	return bookingErr
+}
+
+// bookableSessionType returns the session type from the catalog, or the default one when code is empty.
+func bookableSessionType(ctx context.Context, sessionTypeRepo training.SessionTypeRepository, code string) (training.SessionType, error) {
+	if code == "" {
+		code = training.DefaultSessionTypeCode
+	}
+
+	st, err := sessionTypeRepo.GetSessionType(ctx, code)
+	var notFoundErr training.SessionTypeNotFoundError
+	if stderrors.As(err, &notFoundErr) {
+		return training.SessionType{}, errors.NewIncorrectInputError(err.Error(), "unknown-session-type")
+	}
+	if err != nil {
+		return training.SessionType{}, errors.NewSlugError(fmt.Sprintf("unable to get session type: %s", err.Error()), "get-session-type-failed")
+	}
+
+	if st.IsArchived() {
+		return training.SessionType{}, errors.NewIncorrectInputError(training.ErrSessionTypeArchived.Error(), "session-type-archived")
+	}
+
+	return *st, nil
+}
@@ -0,0 +1,200 @@
+package command
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// ScheduleTrainingSeries books a training at the same hour every week.
+// Occurrences are booked one by one, so one taken hour doesn't prevent booking the rest of the series.
+type ScheduleTrainingSeries struct {
+	SeriesUUID string
+
+	UserUUID string
+	UserName string
+
+	FirstTrainingTime time.Time
+	Occurrences       int
+	SkipTimes         []time.Time
+
+	Notes string
+
+	// SessionType is the code of the session type booked every week, the default one is booked when it's empty.
+	SessionType string
+}
+
+type ScheduleTrainingSeriesHandler decorator.CommandHandler[ScheduleTrainingSeries]
+
+type scheduleTrainingSeriesHandler struct {
+	repo            training.Repository
+	seriesRepo      training.SeriesRepository
+	sessionTypeRepo training.SessionTypeRepository
+	userService     UserService
+	trainerService  TrainerService
+	policies        training.CancellationPolicies
+}
+
+func NewScheduleTrainingSeriesHandler(
+	repo training.Repository,
+	seriesRepo training.SeriesRepository,
+	sessionTypeRepo training.SessionTypeRepository,
+	userService UserService,
+	trainerService TrainerService,
+	policies training.CancellationPolicies,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) ScheduleTrainingSeriesHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+	if seriesRepo == nil {
+		panic("nil seriesRepo")
+	}
+	if sessionTypeRepo == nil {
+		panic("nil sessionTypeRepo")
+	}
+	if userService == nil {
+		panic("nil userService")
+	}
+	if trainerService == nil {
+		panic("nil trainerService")
+	}
+
+	return decorator.ApplyCommandDecorators[ScheduleTrainingSeries](
+		scheduleTrainingSeriesHandler{
+			repo:            repo,
+			seriesRepo:      seriesRepo,
+			sessionTypeRepo: sessionTypeRepo,
+			userService:     userService,
+			trainerService:  trainerService,
+			policies:        policies,
+		},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h scheduleTrainingSeriesHandler) Handle(ctx context.Context, cmd ScheduleTrainingSeries) (err error) {
+	series, err := training.NewSeries(
+		cmd.SeriesUUID,
+		cmd.UserUUID,
+		cmd.UserName,
+		cmd.FirstTrainingTime,
+		cmd.Occurrences,
+		cmd.SkipTimes,
+	)
+	if err != nil {
+		return errors.NewIncorrectInputError(err.Error(), "invalid-series-data")
+	}
+
+	st, err := bookableSessionType(ctx, h.sessionTypeRepo, cmd.SessionType)
+	if err != nil {
+		return err
+	}
+
+	if err := h.seriesRepo.AddSeries(ctx, series); err != nil {
+		return errors.NewSlugError(fmt.Sprintf("unable to add training series: %s", err.Error()), "add-series-failed")
+	}
+
+	for _, occurrenceTime := range series.PendingOccurrences() {
+		trainingUUID := uuid.New().String()
+
+		if err := h.scheduleOccurrence(ctx, *series, st, trainingUUID, occurrenceTime, cmd.Notes); err != nil {
+			if err := series.MarkOccurrenceFailed(occurrenceTime, occurrenceFailureReason(err)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := series.MarkOccurrenceScheduled(occurrenceTime, trainingUUID); err != nil {
+			return err
+		}
+	}
+
+	return h.seriesRepo.UpdateSeries(
+		ctx,
+		series.UUID(),
+		training.MustNewUser(cmd.UserUUID, training.Attendee),
+		func(ctx context.Context, s *training.Series) (*training.Series, error) {
+			return series, nil
+		},
+	)
+}
+
+// scheduleOccurrence books a single occurrence of the series, charging its price from the attendee balance.
+// When any step fails, the previous steps are compensated, so the attendee pays only for booked trainings.
+func (h scheduleTrainingSeriesHandler) scheduleOccurrence(
+	ctx context.Context,
+	series training.Series,
+	st training.SessionType,
+	trainingUUID string,
+	trainingTime time.Time,
+	notes string,
+) error {
+	tr, err := training.NewTraining(trainingUUID, series.UserUUID(), series.UserName(), trainingTime)
+	if err != nil {
+		return errors.NewIncorrectInputError(err.Error(), "invalid-training-data")
+	}
+	if err := tr.UpdateNotes(notes); err != nil {
+		return errors.NewIncorrectInputError(err.Error(), "update-notes-failed")
+	}
+	if err := tr.AssignToSeries(series.UUID()); err != nil {
+		return err
+	}
+	tr.BookUnderCancellationPolicy(h.policies.Current())
+	if err := tr.BookSessionType(st); err != nil {
+		return errors.NewIncorrectInputError(err.Error(), "session-type-archived")
+	}
+
+	available, err := h.trainerService.IsHourAvailable(ctx, trainingTime)
+	if err != nil {
+		return errors.NewSlugError(fmt.Sprintf("unable to check hour availability: %s", err.Error()), "check-hour-availability-failed")
+	}
+	if !available {
+		return errors.NewIncorrectInputError("hour is not available", "hour-not-available")
+	}
+
+	err = h.userService.UpdateTrainingBalance(ctx, tr.UserUUID(), -tr.Price(), BalanceChange{
+		Reason:       BalanceReasonTrainingBooked,
+		TrainingUUID: tr.UUID(),
+		ActorUUID:    tr.UserUUID(),
+	})
+	if err != nil {
+		return updateBalanceError(err)
+	}
+
+	err = h.trainerService.ScheduleTraining(ctx, tr.Time(), tr.Duration(), tr.UserUUID())
+	if err != nil {
+		return refundBooking(ctx, h.userService, tr, errors.NewSlugError(fmt.Sprintf("unable to schedule training: %s", err.Error()), "schedule-training-failed"))
+	}
+
+	if err := h.repo.AddTraining(ctx, tr); err != nil {
+		if cancelErr := h.trainerService.CancelTraining(ctx, tr.Time()); cancelErr != nil {
+			return errors.NewSlugError(
+				fmt.Sprintf("unable to add training: %s, and unable to cancel it in trainer's calendar: %s", err.Error(), cancelErr.Error()),
+				"add-training-failed",
+			)
+		}
+		return refundBooking(ctx, h.userService, tr, errors.NewSlugError(fmt.Sprintf("unable to add training: %s", err.Error()), "add-training-failed"))
+	}
+
+	return nil
+}
+
+func occurrenceFailureReason(err error) string {
+	var slugErr errors.SlugError
+	if stderrors.As(err, &slugErr) {
+		return slugErr.Slug()
+	}
+
+	return "schedule-training-failed"
+}
@@ -0,0 +1,183 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestScheduleTrainingSeries(t *testing.T) {
+	t.Parallel()
+
+	firstTrainingTime := time.Now().AddDate(0, 0, 5).Round(time.Hour)
+	takenTime := firstTrainingTime.AddDate(0, 0, 7)
+	skippedTime := firstTrainingTime.AddDate(0, 0, 14)
+	userUUID := uuid.New().String()
+
+	repository := &repositoryMock{}
+	seriesRepository := &seriesRepositoryMock{}
+	trainerService := &trainerServiceMock{unavailableHours: []time.Time{takenTime}}
+	userService := &userServiceMock{}
+
+	handler := command.NewScheduleTrainingSeriesHandler(
+		repository,
+		seriesRepository,
+		newSessionTypeRepositoryMock(),
+		userService,
+		trainerService,
+		newDefaultCancellationPolicies(t),
+		slog.Default(),
+		metrics.NoOp{},
+	)
+
+	seriesUUID := uuid.New().String()
+	err := handler.Handle(context.Background(), command.ScheduleTrainingSeries{
+		SeriesUUID:        seriesUUID,
+		UserUUID:          userUUID,
+		UserName:          "foo",
+		FirstTrainingTime: firstTrainingTime,
+		Occurrences:       4,
+		SkipTimes:         []time.Time{skippedTime},
+		Notes:             "leg day",
+	})
+	require.NoError(t, err)
+
+	occurrences := seriesRepository.Series[seriesUUID].Occurrences()
+	require.Len(t, occurrences, 4)
+
+	assert.Equal(t, training.SeriesOccurrenceScheduled, occurrences[0].Status())
+	assert.Equal(t, training.SeriesOccurrenceFailed, occurrences[1].Status())
+	assert.Equal(t, "hour-not-available", occurrences[1].FailureReason())
+	assert.Equal(t, training.SeriesOccurrenceSkipped, occurrences[2].Status())
+	assert.Equal(t, training.SeriesOccurrenceScheduled, occurrences[3].Status())
+
+	// only booked occurrences are charged
+	assert.Equal(t, []balanceUpdate{{userUUID, -1}, {userUUID, -1}}, userService.balanceUpdates)
+	assert.Equal(t, []time.Time{firstTrainingTime, firstTrainingTime.AddDate(0, 0, 21)}, trainerService.trainingsScheduled)
+
+	require.Len(t, repository.Trainings, 2)
+	for _, trainingUUID := range seriesRepository.Series[seriesUUID].ScheduledTrainingUUIDs() {
+		tr := repository.Trainings[trainingUUID]
+		assert.Equal(t, seriesUUID, tr.SeriesUUID())
+		assert.Equal(t, "leg day", tr.Notes())
+	}
+}
+
+func TestCancelTrainingSeries(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New().String()
+	pastTrainingTime := time.Now().AddDate(0, 0, -1).Round(time.Hour)
+	firstUpcomingTime := pastTrainingTime.AddDate(0, 0, 7)
+	secondUpcomingTime := pastTrainingTime.AddDate(0, 0, 14)
+
+	series, err := training.NewSeries(uuid.New().String(), userUUID, "foo", pastTrainingTime, 3, nil)
+	require.NoError(t, err)
+
+	repository := &repositoryMock{}
+	for _, trainingTime := range []time.Time{pastTrainingTime, firstUpcomingTime, secondUpcomingTime} {
+		tr := createExampleTraining(t, userUUID, trainingTime)
+		require.NoError(t, tr.AssignToSeries(series.UUID()))
+		require.NoError(t, repository.AddTraining(context.Background(), tr))
+		require.NoError(t, series.MarkOccurrenceScheduled(trainingTime, tr.UUID()))
+	}
+
+	seriesRepository := &seriesRepositoryMock{}
+	require.NoError(t, seriesRepository.AddSeries(context.Background(), series))
+
+	trainerService := &trainerServiceMock{}
+	userService := &userServiceMock{}
+
+	handler := command.NewCancelTrainingSeriesHandler(
+		repository,
+		seriesRepository,
+		&waitlistRepositoryMock{},
+		newSessionTypeRepositoryMock(),
+		userService,
+		trainerService,
+		newDefaultCancellationPolicies(t),
+		time.Hour,
+		slog.Default(),
+		metrics.NoOp{},
+	)
+
+	err = handler.Handle(context.Background(), command.CancelTrainingSeries{
+		SeriesUUID: series.UUID(),
+		User:       training.MustNewUser(userUUID, training.Attendee),
+	})
+	require.NoError(t, err)
+
+	assert.True(t, seriesRepository.Series[series.UUID()].IsCanceled())
+
+	// past trainings are not the remainder of the series
+	assert.ElementsMatch(t, []time.Time{firstUpcomingTime, secondUpcomingTime}, trainerService.trainingsCancelled)
+	for _, tr := range repository.Trainings {
+		assert.Equal(t, tr.Time().After(time.Now()), tr.IsCanceled())
+	}
+
+	// every canceled occurrence is refunded separately, as both were canceled more than 24h before
+	assert.Equal(t, []balanceUpdate{{userUUID, 1}, {userUUID, 1}}, userService.balanceUpdates)
+}
+
+func newDefaultCancellationPolicies(t *testing.T) training.CancellationPolicies {
+	policies, err := training.NewCancellationPolicies(
+		training.DefaultCancellationPolicyVersion,
+		training.DefaultCancellationPolicy(),
+	)
+	require.NoError(t, err)
+
+	return policies
+}
+
+type seriesRepositoryMock struct {
+	Series map[string]training.Series
+}
+
+func (r *seriesRepositoryMock) AddSeries(ctx context.Context, s *training.Series) error {
+	if r.Series == nil {
+		r.Series = map[string]training.Series{}
+	}
+	r.Series[s.UUID()] = *s
+
+	return nil
+}
+
+func (r *seriesRepositoryMock) GetSeries(ctx context.Context, seriesUUID string, user training.User) (*training.Series, error) {
+	s, ok := r.Series[seriesUUID]
+	if !ok {
+		return nil, errors.Errorf("series '%s' not found", seriesUUID)
+	}
+
+	return &s, nil
+}
+
+func (r *seriesRepositoryMock) UpdateSeries(
+	ctx context.Context,
+	seriesUUID string,
+	user training.User,
+	updateFn func(ctx context.Context, s *training.Series) (*training.Series, error),
+) error {
+	s, ok := r.Series[seriesUUID]
+	if !ok {
+		return errors.Errorf("series '%s' not found", seriesUUID)
+	}
+
+	updatedSeries, err := updateFn(ctx, &s)
+	if err != nil {
+		return err
+	}
+
+	r.Series[seriesUUID] = *updatedSeries
+
+	return nil
+}
@@ -0,0 +1,284 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonerrors "github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestScheduleTraining_charges_session_type_price(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name             string
+		SessionType      string
+		ExpectedPrice    int
+		ExpectedDuration time.Duration
+	}{
+		{
+			Name:             "default_session_type",
+			SessionType:      "",
+			ExpectedPrice:    1,
+			ExpectedDuration: time.Hour,
+		},
+		{
+			Name:             "assessment",
+			SessionType:      "assessment",
+			ExpectedPrice:    3,
+			ExpectedDuration: 45 * time.Minute,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			repository := &repositoryMock{}
+			userService := &userServiceMock{}
+			trainerService := &trainerServiceMock{}
+			handler := command.NewScheduleTrainingHandler(
+				repository,
+				newSessionTypeRepositoryMock(),
+				userService,
+				trainerService,
+				newDefaultCancellationPolicies(t),
+				slog.Default(),
+				metrics.NoOp{},
+			)
+
+			trainingUUID := uuid.New().String()
+			err := handler.Handle(context.Background(), command.ScheduleTraining{
+				TrainingUUID: trainingUUID,
+				UserUUID:     "attendee-uuid",
+				UserName:     "foo",
+				TrainingTime: time.Now().Add(48 * time.Hour).Truncate(time.Hour),
+				SessionType:  tc.SessionType,
+			})
+			require.NoError(t, err)
+
+			tr := repository.Trainings[trainingUUID]
+			assert.Equal(t, tc.ExpectedPrice, tr.Price())
+			assert.Equal(t, tc.ExpectedDuration, tr.Duration())
+			assert.Equal(t, tr.Time().Add(tc.ExpectedDuration), tr.EndTime())
+			assert.Equal(t, []balanceUpdate{{"attendee-uuid", -tc.ExpectedPrice}}, userService.balanceUpdates)
+			assert.Equal(t, []time.Duration{tc.ExpectedDuration}, trainerService.scheduledDurations)
+		})
+	}
+}
+
+func TestScheduleTraining_insufficient_balance(t *testing.T) {
+	t.Parallel()
+
+	repository := &repositoryMock{}
+	trainerService := &trainerServiceMock{}
+	handler := command.NewScheduleTrainingHandler(
+		repository,
+		newSessionTypeRepositoryMock(),
+		&userServiceMock{insufficientBalance: true},
+		trainerService,
+		newDefaultCancellationPolicies(t),
+		slog.Default(),
+		metrics.NoOp{},
+	)
+
+	err := handler.Handle(context.Background(), command.ScheduleTraining{
+		TrainingUUID: uuid.New().String(),
+		UserUUID:     "attendee-uuid",
+		UserName:     "foo",
+		TrainingTime: time.Now().Add(48 * time.Hour).Truncate(time.Hour),
+	})
+
+	var slugErr commonerrors.SlugError
+	require.ErrorAs(t, err, &slugErr)
+	assert.Equal(t, "insufficient-balance", slugErr.Slug())
+	assert.Equal(t, commonerrors.ErrorTypeIncorrectInput, slugErr.ErrorType())
+
+	// nothing is booked when the attendee can't pay for it
+	assert.Empty(t, repository.Trainings)
+	assert.Empty(t, trainerService.trainingsScheduled)
+}
+
+func TestScheduleTraining_hour_not_available(t *testing.T) {
+	t.Parallel()
+
+	repository := &repositoryMock{}
+	userService := &userServiceMock{}
+	handler := command.NewScheduleTrainingHandler(
+		repository,
+		newSessionTypeRepositoryMock(),
+		userService,
+		&trainerServiceMock{scheduleTrainingErr: errors.New("hour is not available")},
+		newDefaultCancellationPolicies(t),
+		slog.Default(),
+		metrics.NoOp{},
+	)
+
+	err := handler.Handle(context.Background(), command.ScheduleTraining{
+		TrainingUUID: uuid.New().String(),
+		UserUUID:     "attendee-uuid",
+		UserName:     "foo",
+		TrainingTime: time.Now().Add(48 * time.Hour).Truncate(time.Hour),
+	})
+
+	var slugErr commonerrors.SlugError
+	require.ErrorAs(t, err, &slugErr)
+	assert.Equal(t, "schedule-training-failed", slugErr.Slug())
+
+	// the attendee gets the price back and no training is left without the hour
+	assert.Empty(t, repository.Trainings)
+	assert.Equal(t, []balanceUpdate{{"attendee-uuid", -1}, {"attendee-uuid", 1}}, userService.balanceUpdates)
+}
+
+func TestScheduleTraining_not_bookable_session_type(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name         string
+		SessionType  string
+		ExpectedSlug string
+	}{
+		{
+			Name:         "unknown",
+			SessionType:  "yoga",
+			ExpectedSlug: "unknown-session-type",
+		},
+		{
+			Name:         "archived",
+			SessionType:  "archived",
+			ExpectedSlug: "session-type-archived",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			repository := &repositoryMock{}
+			userService := &userServiceMock{}
+			handler := command.NewScheduleTrainingHandler(
+				repository,
+				newSessionTypeRepositoryMock(),
+				userService,
+				&trainerServiceMock{},
+				newDefaultCancellationPolicies(t),
+				slog.Default(),
+				metrics.NoOp{},
+			)
+
+			err := handler.Handle(context.Background(), command.ScheduleTraining{
+				TrainingUUID: uuid.New().String(),
+				UserUUID:     "attendee-uuid",
+				UserName:     "foo",
+				TrainingTime: time.Now().Add(48 * time.Hour).Truncate(time.Hour),
+				SessionType:  tc.SessionType,
+			})
+
+			var slugErr commonerrors.SlugError
+			require.ErrorAs(t, err, &slugErr)
+			assert.Equal(t, tc.ExpectedSlug, slugErr.Slug())
+			assert.Empty(t, repository.Trainings)
+			assert.Empty(t, userService.balanceUpdates)
+		})
+	}
+}
+
+func TestCancelTraining_refunds_paid_price(t *testing.T) {
+	t.Parallel()
+
+	deps := newDependencies()
+
+	tr := createExampleTraining(t, "attendee-uuid", time.Now().Add(48*time.Hour))
+	assessment, err := training.NewSessionType("assessment", "Assessment", "", time.Hour, 3)
+	require.NoError(t, err)
+	require.NoError(t, tr.BookSessionType(*assessment))
+	deps.repository.Trainings = map[string]training.Training{tr.UUID(): *tr}
+
+	err = deps.handler.Handle(context.Background(), command.CancelTraining{
+		TrainingUUID: tr.UUID(),
+		User:         training.MustNewUser("attendee-uuid", training.Attendee),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []balanceUpdate{{"attendee-uuid", 3}}, deps.userService.balanceUpdates)
+}
+
+// newSessionTypeRepositoryMock returns the catalog with the default session type,
+// a three credits assessment and an archived session type.
+func newSessionTypeRepositoryMock() *sessionTypeRepositoryMock {
+	personal, err := training.NewSessionType(training.DefaultSessionTypeCode, "Personal training", "", time.Hour, 1)
+	if err != nil {
+		panic(err)
+	}
+	assessment, err := training.NewSessionType("assessment", "Assessment", "", 45*time.Minute, 3)
+	if err != nil {
+		panic(err)
+	}
+	archived, err := training.NewSessionType("archived", "Archived", "", time.Hour, 1)
+	if err != nil {
+		panic(err)
+	}
+	archived.Archive()
+
+	return &sessionTypeRepositoryMock{
+		SessionTypes: map[string]training.SessionType{
+			personal.Code():   *personal,
+			assessment.Code(): *assessment,
+			archived.Code():   *archived,
+		},
+	}
+}
+
+type sessionTypeRepositoryMock struct {
+	SessionTypes map[string]training.SessionType
+}
+
+func (r *sessionTypeRepositoryMock) AddSessionType(ctx context.Context, st *training.SessionType) error {
+	if _, ok := r.SessionTypes[st.Code()]; ok {
+		return training.SessionTypeAlreadyExistsError{Code: st.Code()}
+	}
+	r.SessionTypes[st.Code()] = *st
+
+	return nil
+}
+
+func (r *sessionTypeRepositoryMock) GetSessionType(ctx context.Context, code string) (*training.SessionType, error) {
+	st, ok := r.SessionTypes[code]
+	if !ok {
+		return nil, training.SessionTypeNotFoundError{Code: code}
+	}
+
+	return &st, nil
+}
+
+func (r *sessionTypeRepositoryMock) UpdateSessionType(
+	ctx context.Context,
+	code string,
+	updateFn func(ctx context.Context, st *training.SessionType) (*training.SessionType, error),
+) error {
+	st, ok := r.SessionTypes[code]
+	if !ok {
+		return errors.Errorf("session type '%s' not found", code)
+	}
+
+	updatedSessionType, err := updateFn(ctx, &st)
+	if err != nil {
+		return err
+	}
+
+	r.SessionTypes[code] = *updatedSessionType
+
+	return nil
+}
@@ -2,20 +2,62 @@ package command
 
 import (
 	"context"
+	stderrors "errors"
+	"fmt"
 	"time"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+)
+
+// ErrInsufficientBalance is returned by UserService when the debit would overdraw the trainings balance.
+var ErrInsufficientBalance = stderrors.New("insufficient trainings balance")
+
+// Reasons of trainings balance changes, recorded in the users' balance ledger.
+const (
+	BalanceReasonTrainingBooked          = "training-booked"
+	BalanceReasonTrainingBookingRefunded = "training-booking-refunded"
+	BalanceReasonTrainingCanceled        = "training-canceled"
+	BalanceReasonTrainingAttendance      = "training-attendance"
 )
 
+// BalanceChange explains why the trainings balance changed.
+type BalanceChange struct {
+	Reason       string
+	TrainingUUID string
+	// ActorUUID is the user who caused the change.
+	ActorUUID string
+}
+
 type UserService interface {
-	UpdateTrainingBalance(ctx context.Context, userID string, amountChange int) error
+	GetTrainingBalance(ctx context.Context, userID string) (int, error)
+	UpdateTrainingBalance(ctx context.Context, userID string, amountChange int, change BalanceChange) error
+}
+
+// updateBalanceError translates the error of UserService.UpdateTrainingBalance,
+// so the attendee who can't pay for the training gets a clear client error.
+func updateBalanceError(err error) errors.SlugError {
+	if stderrors.Is(err, ErrInsufficientBalance) {
+		return errors.NewIncorrectInputError("not enough trainings balance to book the training", "insufficient-balance").WithCause(err)
+	}
+
+	return errors.NewSlugError(fmt.Sprintf("unable to change trainings balance: %s", err.Error()), "update-balance-failed")
 }
 
 type TrainerService interface {
-	ScheduleTraining(ctx context.Context, trainingTime time.Time) error
+	IsHourAvailable(ctx context.Context, hour time.Time) (bool, error)
+
+	// ScheduleTraining books the hour for the attendee, so the trainer sees who booked it and for how long.
+	ScheduleTraining(ctx context.Context, trainingTime time.Time, duration time.Duration, attendeeUUID string) error
 	CancelTraining(ctx context.Context, trainingTime time.Time) error
 
+	// MakeHourUnavailable blocks the free hour, so no training can be booked at it.
+	MakeHourUnavailable(ctx context.Context, hour time.Time) error
+
 	MoveTraining(
 		ctx context.Context,
 		newTime time.Time,
 		originalTrainingTime time.Time,
+		duration time.Duration,
+		attendeeUUID string,
 	) error
 }
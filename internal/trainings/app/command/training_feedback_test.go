@@ -0,0 +1,205 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonerrors "github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestRateTraining(t *testing.T) {
+	t.Parallel()
+
+	attendeeUUID := uuid.New().String()
+	trainerUUID := uuid.New().String()
+
+	testCases := []struct {
+		Name                string
+		TrainingConstructor func(t *testing.T) *training.Training
+		User                training.User
+
+		ExpectedSlug      string
+		ExpectedForbidden bool
+	}{
+		{
+			Name:                "attended_training",
+			TrainingConstructor: func(t *testing.T) *training.Training { return createAttendedTraining(t, attendeeUUID) },
+			User:                training.MustNewUser(attendeeUUID, training.Attendee),
+		},
+		{
+			Name: "training_not_ended",
+			TrainingConstructor: func(t *testing.T) *training.Training {
+				return createExampleTraining(t, attendeeUUID, time.Now().Add(-10*time.Minute))
+			},
+			User:         training.MustNewUser(attendeeUUID, training.Attendee),
+			ExpectedSlug: "rate-training-failed",
+		},
+		{
+			Name: "already_rated",
+			TrainingConstructor: func(t *testing.T) *training.Training {
+				tr := createAttendedTraining(t, attendeeUUID)
+				require.NoError(t, tr.Rate(4, "", training.Attendee))
+
+				return tr
+			},
+			User:         training.MustNewUser(attendeeUUID, training.Attendee),
+			ExpectedSlug: "rate-training-failed",
+		},
+		{
+			Name:                "trainer",
+			TrainingConstructor: func(t *testing.T) *training.Training { return createAttendedTraining(t, attendeeUUID) },
+			User:                training.MustNewTrainer(trainerUUID, []string{attendeeUUID}, nil),
+			ExpectedSlug:        "rate-training-failed",
+		},
+		{
+			Name:                "another_attendee",
+			TrainingConstructor: func(t *testing.T) *training.Training { return createAttendedTraining(t, attendeeUUID) },
+			User:                training.MustNewUser(uuid.New().String(), training.Attendee),
+			ExpectedForbidden:   true,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := c.TrainingConstructor(t)
+			repository := &repositoryMock{Trainings: map[string]training.Training{tr.UUID(): *tr}}
+			handler := command.NewRateTrainingHandler(repository, slog.Default(), metrics.NoOp{})
+
+			err := handler.Handle(context.Background(), command.RateTraining{
+				TrainingUUID: tr.UUID(),
+				User:         c.User,
+				Rating:       5,
+				Comment:      "great session",
+			})
+
+			if c.ExpectedForbidden {
+				assert.ErrorAs(t, err, &training.ForbiddenToSeeTrainingError{})
+				return
+			}
+			if c.ExpectedSlug != "" {
+				assertSlugError(t, err, c.ExpectedSlug)
+				return
+			}
+
+			require.NoError(t, err)
+			feedback := repository.Trainings[tr.UUID()].Feedback()
+			assert.Equal(t, 5, feedback.Rating())
+			assert.Equal(t, "great session", feedback.Comment())
+		})
+	}
+}
+
+func TestReplyToTrainingFeedback(t *testing.T) {
+	t.Parallel()
+
+	attendeeUUID := uuid.New().String()
+	trainerUUID := uuid.New().String()
+
+	ratedTraining := func(t *testing.T) *training.Training {
+		tr := createAttendedTraining(t, attendeeUUID)
+		require.NoError(t, tr.Rate(3, "too short", training.Attendee))
+
+		return tr
+	}
+
+	testCases := []struct {
+		Name                string
+		TrainingConstructor func(t *testing.T) *training.Training
+		User                training.User
+
+		ExpectedSlug      string
+		ExpectedForbidden bool
+	}{
+		{
+			Name:                "clients_trainer",
+			TrainingConstructor: ratedTraining,
+			User:                training.MustNewTrainer(trainerUUID, []string{attendeeUUID}, nil),
+		},
+		{
+			Name:                "trainer_of_another_client",
+			TrainingConstructor: ratedTraining,
+			User:                training.MustNewTrainer(uuid.New().String(), nil, []string{attendeeUUID}),
+			ExpectedForbidden:   true,
+		},
+		{
+			Name:                "attendee",
+			TrainingConstructor: ratedTraining,
+			User:                training.MustNewUser(attendeeUUID, training.Attendee),
+			ExpectedSlug:        "reply-to-feedback-failed",
+		},
+		{
+			Name:                "not_rated",
+			TrainingConstructor: func(t *testing.T) *training.Training { return createAttendedTraining(t, attendeeUUID) },
+			User:                training.MustNewTrainer(trainerUUID, []string{attendeeUUID}, nil),
+			ExpectedSlug:        "reply-to-feedback-failed",
+		},
+		{
+			Name: "already_replied",
+			TrainingConstructor: func(t *testing.T) *training.Training {
+				tr := ratedTraining(t)
+				require.NoError(t, tr.ReplyToFeedback("sorry", training.Trainer))
+
+				return tr
+			},
+			User:         training.MustNewTrainer(trainerUUID, []string{attendeeUUID}, nil),
+			ExpectedSlug: "reply-to-feedback-failed",
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := c.TrainingConstructor(t)
+			repository := &repositoryMock{Trainings: map[string]training.Training{tr.UUID(): *tr}}
+			handler := command.NewReplyToTrainingFeedbackHandler(repository, slog.Default(), metrics.NoOp{})
+
+			err := handler.Handle(context.Background(), command.ReplyToTrainingFeedback{
+				TrainingUUID: tr.UUID(),
+				User:         c.User,
+				Reply:        "thanks, next one will be longer",
+			})
+
+			if c.ExpectedForbidden {
+				assert.ErrorAs(t, err, &training.ForbiddenToSeeTrainingError{})
+				return
+			}
+			if c.ExpectedSlug != "" {
+				assertSlugError(t, err, c.ExpectedSlug)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "thanks, next one will be longer", repository.Trainings[tr.UUID()].Feedback().Reply())
+		})
+	}
+}
+
+// createAttendedTraining returns a past training which the attendee attended, so it can be rated.
+func createAttendedTraining(t *testing.T, attendeeUUID string) *training.Training {
+	tr := createExampleTraining(t, attendeeUUID, time.Now().Add(-2*time.Hour))
+	require.NoError(t, tr.RecordAttendance(training.Attended, training.Trainer))
+
+	return tr
+}
+
+func assertSlugError(t *testing.T, err error, expectedSlug string) {
+	t.Helper()
+
+	var slugErr commonerrors.SlugError
+	require.ErrorAs(t, err, &slugErr)
+	assert.Equal(t, expectedSlug, slugErr.Slug())
+}
@@ -0,0 +1,24 @@
+package command
+
+import (
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// checkExpectedVersion fails when the change is based on an outdated version of the training,
+// for example when the attendee cancels the training the trainer has just moved.
+// Zero expectedVersion skips the check, for "If-Match: *" and changes made by the system.
+func checkExpectedVersion(tr training.Training, expectedVersion int) error {
+	if expectedVersion == 0 {
+		return nil
+	}
+
+	if err := tr.CheckVersion(expectedVersion); err != nil {
+		return errors.NewPreconditionFailedError(err.Error(), "training-modified").
+			WithCause(err).
+			WithDetail("expectedVersion", expectedVersion).
+			WithDetail("currentVersion", tr.Version())
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// UpdateSessionType changes the session type in the catalog.
+// Already booked trainings keep the price, duration and cancellation tiers they were booked for.
+type UpdateSessionType struct {
+	Code        string
+	Name        string
+	Description string
+
+	Duration    time.Duration
+	CreditPrice int
+
+	// CancellationTiers are the cancellation policy of the session type, the current policy applies without them.
+	CancellationTiers []training.CancellationTier
+
+	// Archived session types can't be booked anymore.
+	Archived bool
+
+	User training.User
+}
+
+type UpdateSessionTypeHandler decorator.CommandHandler[UpdateSessionType]
+
+type updateSessionTypeHandler struct {
+	sessionTypeRepo training.SessionTypeRepository
+}
+
+func NewUpdateSessionTypeHandler(
+	sessionTypeRepo training.SessionTypeRepository,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) UpdateSessionTypeHandler {
+	if sessionTypeRepo == nil {
+		panic("nil sessionTypeRepo")
+	}
+
+	return decorator.ApplyCommandDecorators[UpdateSessionType](
+		updateSessionTypeHandler{sessionTypeRepo: sessionTypeRepo},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h updateSessionTypeHandler) Handle(ctx context.Context, cmd UpdateSessionType) (err error) {
+	if cmd.User.Type() != training.Trainer {
+		return errors.NewForbiddenError("only trainer can manage session types", "forbidden-to-manage-session-types")
+	}
+
+	return h.sessionTypeRepo.UpdateSessionType(
+		ctx,
+		cmd.Code,
+		func(ctx context.Context, st *training.SessionType) (*training.SessionType, error) {
+			if err := st.Update(cmd.Name, cmd.Description, cmd.Duration, cmd.CreditPrice); err != nil {
+				return nil, errors.NewIncorrectInputError(err.Error(), "invalid-session-type")
+			}
+			if err := st.SetCancellationTiers(cmd.CancellationTiers); err != nil {
+				return nil, errors.NewIncorrectInputError(err.Error(), "invalid-session-type")
+			}
+
+			if cmd.Archived {
+				st.Archive()
+			} else {
+				st.Restore()
+			}
+
+			return st, nil
+		},
+	)
+}
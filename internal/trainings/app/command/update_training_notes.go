@@ -0,0 +1,80 @@
+package command
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// UpdateTrainingNotes changes the notes of the training, every change is recorded in the notes history.
+// Nil notes are left unchanged.
+type UpdateTrainingNotes struct {
+	TrainingUUID string
+	User         training.User
+
+	// Notes are shared with the attendee.
+	Notes *string
+	// TrainerNotes are private notes of the trainer.
+	TrainerNotes *string
+}
+
+type UpdateTrainingNotesHandler decorator.CommandHandler[UpdateTrainingNotes]
+
+type updateTrainingNotesHandler struct {
+	repo training.Repository
+}
+
+func NewUpdateTrainingNotesHandler(
+	repo training.Repository,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) UpdateTrainingNotesHandler {
+	if repo == nil {
+		panic("nil repo")
+	}
+
+	return decorator.ApplyCommandDecorators[UpdateTrainingNotes](
+		updateTrainingNotesHandler{repo: repo},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h updateTrainingNotesHandler) Handle(ctx context.Context, cmd UpdateTrainingNotes) (err error) {
+	if cmd.Notes == nil && cmd.TrainerNotes == nil {
+		return errors.NewIncorrectInputError("no notes to update", "update-notes-failed")
+	}
+
+	return h.repo.UpdateTraining(
+		ctx,
+		cmd.TrainingUUID,
+		cmd.User,
+		func(ctx context.Context, tr *training.Training) (*training.Training, error) {
+			now := time.Now()
+
+			if cmd.Notes != nil {
+				if err := tr.EditNotes(*cmd.Notes, cmd.User, now); err != nil {
+					return nil, errors.NewIncorrectInputError(err.Error(), "update-notes-failed")
+				}
+			}
+
+			if cmd.TrainerNotes != nil {
+				err := tr.EditTrainerNotes(*cmd.TrainerNotes, cmd.User, now)
+				if stderrors.Is(err, training.ErrOnlyTrainerCanEditTrainerNotes) {
+					return nil, errors.NewForbiddenError(err.Error(), "forbidden-to-edit-trainer-notes")
+				}
+				if err != nil {
+					return nil, errors.NewIncorrectInputError(err.Error(), "update-notes-failed")
+				}
+			}
+
+			return tr, nil
+		},
+	)
+}
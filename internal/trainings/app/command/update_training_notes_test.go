@@ -0,0 +1,65 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestUpdateTrainingNotes(t *testing.T) {
+	t.Parallel()
+
+	attendeeUUID := "attendee-uuid"
+	tr := createExampleTraining(t, attendeeUUID, time.Now().Add(48*time.Hour))
+
+	repository := &repositoryMock{Trainings: map[string]training.Training{tr.UUID(): *tr}}
+	handler := command.NewUpdateTrainingNotesHandler(repository, slog.Default(), metrics.NoOp{})
+
+	notes := "leg day"
+	trainerNotes := "watch the left knee"
+
+	err := handler.Handle(context.Background(), command.UpdateTrainingNotes{
+		TrainingUUID: tr.UUID(),
+		User:         training.MustNewTrainer("trainer-uuid", nil, nil),
+		Notes:        &notes,
+		TrainerNotes: &trainerNotes,
+	})
+	require.NoError(t, err)
+
+	updated := repository.Trainings[tr.UUID()]
+	assert.Equal(t, notes, updated.Notes())
+	assert.Equal(t, trainerNotes, updated.TrainerNotes())
+	assert.Len(t, updated.NewNotesRevisions(), 2)
+}
+
+func TestUpdateTrainingNotes_trainer_notes_by_attendee(t *testing.T) {
+	t.Parallel()
+
+	attendeeUUID := "attendee-uuid"
+	tr := createExampleTraining(t, attendeeUUID, time.Now().Add(48*time.Hour))
+
+	repository := &repositoryMock{Trainings: map[string]training.Training{tr.UUID(): *tr}}
+	handler := command.NewUpdateTrainingNotesHandler(repository, slog.Default(), metrics.NoOp{})
+
+	trainerNotes := "I'm fine"
+
+	err := handler.Handle(context.Background(), command.UpdateTrainingNotes{
+		TrainingUUID: tr.UUID(),
+		User:         training.MustNewUser(attendeeUUID, training.Attendee),
+		TrainerNotes: &trainerNotes,
+	})
+
+	var slugErr errors.SlugError
+	require.ErrorAs(t, err, &slugErr)
+	assert.Equal(t, errors.ErrorTypeForbidden, slugErr.ErrorType())
+	assert.Empty(t, repository.Trainings[tr.UUID()].TrainerNotes())
+}
@@ -0,0 +1,219 @@
+package command
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// waitlistOffers passes freed hours to waitlisted attendees.
+type waitlistOffers struct {
+	repo            training.Repository
+	waitlistRepo    training.WaitlistRepository
+	sessionTypeRepo training.SessionTypeRepository
+	userService     UserService
+	trainerService  TrainerService
+	policies        training.CancellationPolicies
+	claimTTL        time.Duration
+}
+
+func newWaitlistOffers(
+	repo training.Repository,
+	waitlistRepo training.WaitlistRepository,
+	sessionTypeRepo training.SessionTypeRepository,
+	userService UserService,
+	trainerService TrainerService,
+	policies training.CancellationPolicies,
+	claimTTL time.Duration,
+) waitlistOffers {
+	if waitlistRepo == nil {
+		panic("nil waitlistRepo")
+	}
+	if sessionTypeRepo == nil {
+		panic("nil sessionTypeRepo")
+	}
+	if claimTTL <= 0 {
+		panic("waitlist claim TTL must be positive")
+	}
+
+	return waitlistOffers{
+		repo:            repo,
+		waitlistRepo:    waitlistRepo,
+		sessionTypeRepo: sessionTypeRepo,
+		userService:     userService,
+		trainerService:  trainerService,
+		policies:        policies,
+		claimTTL:        claimTTL,
+	}
+}
+
+// offerFreedHour passes the freed hour to the first waitlisted attendee.
+// The training is booked right away if the attendee opted in for it and has enough balance,
+// otherwise the hour is reserved in the trainer's calendar until the attendee claims it or the offer expires.
+func (w waitlistOffers) offerFreedHour(ctx context.Context, hour time.Time) error {
+	if !hour.After(time.Now()) {
+		return nil
+	}
+
+	entryUUIDs, err := w.waitlistRepo.FindWaitingEntries(ctx, hour)
+	if err != nil {
+		return fmt.Errorf("unable to find waitlist entries: %w", err)
+	}
+
+	for _, entryUUID := range entryUUIDs {
+		offered := false
+
+		err := w.waitlistRepo.UpdateWaitlistEntry(
+			ctx,
+			entryUUID,
+			training.SystemUser,
+			func(ctx context.Context, entry *training.WaitlistEntry) (*training.WaitlistEntry, error) {
+				if !entry.IsWaiting() {
+					// left the waitlist in the meantime
+					return entry, nil
+				}
+				offered = true
+
+				if entry.AutoBook() {
+					booked, err := w.autoBook(ctx, entry)
+					if err != nil {
+						return nil, err
+					}
+					if booked {
+						return entry, nil
+					}
+				}
+
+				// the offer is claimed as the default session type, see newTraining
+				st, err := bookableSessionType(ctx, w.sessionTypeRepo, "")
+				if err != nil {
+					return nil, err
+				}
+				if err := w.trainerService.ScheduleTraining(ctx, hour, st.Duration(), entry.UserUUID()); err != nil {
+					return nil, errors.NewSlugError(fmt.Sprintf("unable to reserve offered hour: %s", err.Error()), "reserve-offered-hour-failed")
+				}
+				if err := entry.Offer(time.Now().Add(w.claimTTL)); err != nil {
+					return nil, err
+				}
+
+				return entry, nil
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if offered {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// offerFreedHours offers every freed hour to the waitlist.
+// Failures are only logged, the hours stay free to book as usual.
+func (w waitlistOffers) offerFreedHours(ctx context.Context, logger *slog.Logger, hours ...time.Time) {
+	for _, hour := range hours {
+		if err := w.offerFreedHour(ctx, hour); err != nil {
+			logger.WarnContext(ctx, "Unable to offer freed hour to the waitlist",
+				slog.Time("hour", hour),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+// autoBook books the hour for the waitlisted attendee, when the attendee can pay for it.
+func (w waitlistOffers) autoBook(ctx context.Context, entry *training.WaitlistEntry) (bool, error) {
+	tr, err := w.newTraining(ctx, uuid.New().String(), *entry)
+	if err != nil {
+		return false, err
+	}
+
+	balance, err := w.userService.GetTrainingBalance(ctx, entry.UserUUID())
+	if err != nil {
+		return false, errors.NewSlugError(fmt.Sprintf("unable to get trainings balance: %s", err.Error()), "get-balance-failed")
+	}
+	if balance < tr.Price() {
+		// the attendee can still top up the balance and claim the offer
+		return false, nil
+	}
+
+	if err := w.trainerService.ScheduleTraining(ctx, tr.Time(), tr.Duration(), tr.UserUUID()); err != nil {
+		return false, errors.NewSlugError(fmt.Sprintf("unable to schedule training: %s", err.Error()), "schedule-training-failed")
+	}
+
+	if err := w.book(ctx, tr); err != nil {
+		// the hour reserved for the training which was not booked is released, so it's not blocked forever
+		if releaseErr := w.releaseOfferedHour(ctx, tr.Time()); releaseErr != nil {
+			return false, errors.NewSlugError(
+				fmt.Sprintf("%s, and %s", err.Error(), releaseErr.Error()),
+				"release-offered-hour-failed",
+			)
+		}
+		if stderrors.Is(err, ErrInsufficientBalance) {
+			// the balance was spent in the meantime, the hour is offered instead
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := entry.MarkAutoBooked(tr.UUID()); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// newTraining creates the training booked from the waitlist, as the default session type.
+func (w waitlistOffers) newTraining(ctx context.Context, trainingUUID string, entry training.WaitlistEntry) (*training.Training, error) {
+	tr, err := training.NewTraining(trainingUUID, entry.UserUUID(), entry.UserName(), entry.Hour())
+	if err != nil {
+		return nil, errors.NewIncorrectInputError(err.Error(), "invalid-training-data")
+	}
+	tr.BookUnderCancellationPolicy(w.policies.Current())
+
+	st, err := bookableSessionType(ctx, w.sessionTypeRepo, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := tr.BookSessionType(st); err != nil {
+		return nil, errors.NewIncorrectInputError(err.Error(), "session-type-archived")
+	}
+
+	return tr, nil
+}
+
+// book charges the attendee and adds the training for the hour already reserved in the trainer's calendar.
+func (w waitlistOffers) book(ctx context.Context, tr *training.Training) error {
+	err := w.userService.UpdateTrainingBalance(ctx, tr.UserUUID(), -tr.Price(), BalanceChange{
+		Reason:       BalanceReasonTrainingBooked,
+		TrainingUUID: tr.UUID(),
+		ActorUUID:    tr.UserUUID(),
+	})
+	if err != nil {
+		return updateBalanceError(err)
+	}
+
+	if err := w.repo.AddTraining(ctx, tr); err != nil {
+		return refundBooking(ctx, w.userService, tr, errors.NewSlugError(fmt.Sprintf("unable to add training: %s", err.Error()), "add-training-failed"))
+	}
+
+	return nil
+}
+
+// releaseOfferedHour frees the hour reserved for the offer which won't be claimed.
+func (w waitlistOffers) releaseOfferedHour(ctx context.Context, hour time.Time) error {
+	if err := w.trainerService.CancelTraining(ctx, hour); err != nil {
+		return errors.NewSlugError(fmt.Sprintf("unable to release offered hour: %s", err.Error()), "release-offered-hour-failed")
+	}
+
+	return nil
+}
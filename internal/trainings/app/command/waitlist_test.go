@@ -0,0 +1,288 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestCancelTraining_offers_freed_hour_to_waitlist(t *testing.T) {
+	t.Parallel()
+
+	deps := newDependencies()
+	trainingTime := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+
+	tr := createExampleTraining(t, "attendee-uuid", trainingTime)
+	deps.repository.Trainings = map[string]training.Training{tr.UUID(): *tr}
+
+	first := addWaitlistEntry(t, deps.waitlistRepository, trainingTime, false)
+	second := addWaitlistEntry(t, deps.waitlistRepository, trainingTime, false)
+
+	err := deps.handler.Handle(context.Background(), command.CancelTraining{
+		TrainingUUID: tr.UUID(),
+		User:         training.MustNewUser("attendee-uuid", training.Attendee),
+	})
+	require.NoError(t, err)
+
+	offered := deps.waitlistRepository.Entries[first]
+	assert.True(t, offered.IsOffered())
+	assert.WithinDuration(t, time.Now().Add(time.Hour), offered.OfferExpiresAt(), time.Minute)
+	assert.True(t, deps.waitlistRepository.Entries[second].IsWaiting())
+
+	// the freed hour is held for the offer
+	assert.Equal(t, []time.Time{trainingTime}, deps.trainerService.trainingsScheduled)
+}
+
+func TestCancelTraining_auto_books_freed_hour(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		Balance       int
+		ExpectBooking bool
+	}{
+		{
+			Name:          "with_balance",
+			Balance:       1,
+			ExpectBooking: true,
+		},
+		{
+			Name:          "offered_without_balance",
+			Balance:       0,
+			ExpectBooking: false,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			deps := newDependencies()
+			deps.userService.balance = tc.Balance
+			trainingTime := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+
+			tr := createExampleTraining(t, "attendee-uuid", trainingTime)
+			deps.repository.Trainings = map[string]training.Training{tr.UUID(): *tr}
+
+			entryUUID := addWaitlistEntry(t, deps.waitlistRepository, trainingTime, true)
+
+			err := deps.handler.Handle(context.Background(), command.CancelTraining{
+				TrainingUUID: tr.UUID(),
+				User:         training.MustNewUser("attendee-uuid", training.Attendee),
+			})
+			require.NoError(t, err)
+
+			entry := deps.waitlistRepository.Entries[entryUUID]
+			if !tc.ExpectBooking {
+				assert.True(t, entry.IsOffered())
+				assert.Len(t, deps.repository.Trainings, 1)
+				return
+			}
+
+			require.Equal(t, training.WaitlistBooked, entry.Status())
+			booked, ok := deps.repository.Trainings[entry.TrainingUUID()]
+			require.True(t, ok)
+			assert.Equal(t, "waitlisted-uuid", booked.UserUUID())
+			assert.True(t, booked.Time().Equal(trainingTime))
+
+			assert.Contains(t, deps.userService.balanceUpdates, balanceUpdate{"waitlisted-uuid", -1})
+		})
+	}
+}
+
+func TestCancelTraining_auto_book_failure_releases_hour(t *testing.T) {
+	t.Parallel()
+
+	deps := newDependencies()
+	deps.userService.balance = 1
+	trainingTime := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+
+	tr := createExampleTraining(t, "attendee-uuid", trainingTime)
+	deps.repository.Trainings = map[string]training.Training{tr.UUID(): *tr}
+
+	entryUUID := addWaitlistEntry(t, deps.waitlistRepository, trainingTime, true)
+	deps.repository.addTrainingErr = errors.New("connection refused")
+
+	err := deps.handler.Handle(context.Background(), command.CancelTraining{
+		TrainingUUID: tr.UUID(),
+		User:         training.MustNewUser("attendee-uuid", training.Attendee),
+	})
+	require.NoError(t, err)
+
+	// the hour reserved for the auto-booking is freed again and the attendee is refunded
+	assert.Equal(t, []time.Time{trainingTime}, deps.trainerService.trainingsScheduled)
+	assert.Equal(t, []time.Time{trainingTime, trainingTime}, deps.trainerService.trainingsCancelled)
+	assert.Contains(t, deps.userService.balanceUpdates, balanceUpdate{"waitlisted-uuid", -1})
+	assert.Contains(t, deps.userService.balanceUpdates, balanceUpdate{"waitlisted-uuid", 1})
+	assert.True(t, deps.waitlistRepository.Entries[entryUUID].IsWaiting())
+}
+
+func TestClaimWaitlistOffer(t *testing.T) {
+	t.Parallel()
+
+	repository := &repositoryMock{}
+	waitlistRepository := &waitlistRepositoryMock{}
+	trainerService := &trainerServiceMock{}
+	userService := &userServiceMock{}
+
+	hour := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	entryUUID := addWaitlistEntry(t, waitlistRepository, hour, false)
+	entry := waitlistRepository.Entries[entryUUID]
+	require.NoError(t, entry.Offer(time.Now().Add(time.Hour)))
+	waitlistRepository.Entries[entryUUID] = entry
+
+	handler := command.NewClaimWaitlistOfferHandler(
+		repository,
+		waitlistRepository,
+		newSessionTypeRepositoryMock(),
+		userService,
+		trainerService,
+		newDefaultCancellationPolicies(t),
+		time.Hour,
+		slog.Default(),
+		metrics.NoOp{},
+	)
+
+	trainingUUID := uuid.New().String()
+	err := handler.Handle(context.Background(), command.ClaimWaitlistOffer{
+		EntryUUID:    entryUUID,
+		TrainingUUID: trainingUUID,
+		User:         training.MustNewUser("waitlisted-uuid", training.Attendee),
+		Notes:        "claimed",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, training.WaitlistBooked, waitlistRepository.Entries[entryUUID].Status())
+	require.Contains(t, repository.Trainings, trainingUUID)
+	assert.Equal(t, "claimed", repository.Trainings[trainingUUID].Notes())
+	assert.Equal(t, []balanceUpdate{{"waitlisted-uuid", -1}}, userService.balanceUpdates)
+
+	// the hour was already held when offered
+	assert.Empty(t, trainerService.trainingsScheduled)
+}
+
+func TestExpireWaitlistOffers_passes_hour_to_next_attendee(t *testing.T) {
+	t.Parallel()
+
+	repository := &repositoryMock{}
+	waitlistRepository := &waitlistRepositoryMock{}
+	trainerService := &trainerServiceMock{}
+	userService := &userServiceMock{}
+
+	hour := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	expiredUUID := addWaitlistEntry(t, waitlistRepository, hour, false)
+	nextUUID := addWaitlistEntry(t, waitlistRepository, hour, false)
+
+	expired := training.UnmarshalWaitlistEntryFromDatabase(
+		expiredUUID,
+		"waitlisted-uuid",
+		"waitlisted",
+		hour,
+		false,
+		training.WaitlistOffered,
+		time.Now().Add(-time.Minute),
+		"",
+	)
+	waitlistRepository.Entries[expiredUUID] = *expired
+
+	handler := command.NewExpireWaitlistOffersHandler(
+		repository,
+		waitlistRepository,
+		newSessionTypeRepositoryMock(),
+		userService,
+		trainerService,
+		newDefaultCancellationPolicies(t),
+		time.Hour,
+		slog.Default(),
+		metrics.NoOp{},
+	)
+
+	err := handler.Handle(context.Background(), command.ExpireWaitlistOffers{})
+	require.NoError(t, err)
+
+	assert.Equal(t, training.WaitlistExpired, waitlistRepository.Entries[expiredUUID].Status())
+	assert.True(t, waitlistRepository.Entries[nextUUID].IsOffered())
+
+	// released for the expired offer and held again for the next one
+	assert.Equal(t, []time.Time{hour}, trainerService.trainingsCancelled)
+	assert.Equal(t, []time.Time{hour}, trainerService.trainingsScheduled)
+}
+
+func addWaitlistEntry(t *testing.T, repo *waitlistRepositoryMock, hour time.Time, autoBook bool) string {
+	entry, err := training.NewWaitlistEntry(uuid.New().String(), "waitlisted-uuid", "waitlisted", hour, autoBook)
+	require.NoError(t, err)
+	require.NoError(t, repo.AddWaitlistEntry(context.Background(), entry))
+
+	return entry.UUID()
+}
+
+type waitlistRepositoryMock struct {
+	Entries map[string]training.WaitlistEntry
+	// order keeps the order in which attendees joined the waitlist
+	order []string
+}
+
+func (r *waitlistRepositoryMock) AddWaitlistEntry(ctx context.Context, entry *training.WaitlistEntry) error {
+	if r.Entries == nil {
+		r.Entries = map[string]training.WaitlistEntry{}
+	}
+	r.Entries[entry.UUID()] = *entry
+	r.order = append(r.order, entry.UUID())
+
+	return nil
+}
+
+func (r *waitlistRepositoryMock) UpdateWaitlistEntry(
+	ctx context.Context,
+	entryUUID string,
+	user training.User,
+	updateFn func(ctx context.Context, entry *training.WaitlistEntry) (*training.WaitlistEntry, error),
+) error {
+	entry, ok := r.Entries[entryUUID]
+	if !ok {
+		return errors.Errorf("waitlist entry '%s' not found", entryUUID)
+	}
+
+	updatedEntry, err := updateFn(ctx, &entry)
+	if err != nil {
+		return err
+	}
+
+	r.Entries[entryUUID] = *updatedEntry
+
+	return nil
+}
+
+func (r *waitlistRepositoryMock) FindWaitingEntries(ctx context.Context, hour time.Time) ([]string, error) {
+	var entryUUIDs []string
+	for _, entryUUID := range r.order {
+		entry := r.Entries[entryUUID]
+		if entry.IsWaiting() && entry.Hour().Equal(hour) {
+			entryUUIDs = append(entryUUIDs, entryUUID)
+		}
+	}
+
+	return entryUUIDs, nil
+}
+
+func (r *waitlistRepositoryMock) FindWaitlistEntriesWithExpiredOffer(ctx context.Context, now time.Time) ([]string, error) {
+	var entryUUIDs []string
+	for _, entryUUID := range r.order {
+		if r.Entries[entryUUID].IsOfferExpired(now) {
+			entryUUIDs = append(entryUUIDs, entryUUID)
+		}
+	}
+
+	return entryUUIDs, nil
+}
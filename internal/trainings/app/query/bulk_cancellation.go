@@ -0,0 +1,70 @@
+package query
+
+import (
+	"context"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+)
+
+type BulkCancellationByUUID struct {
+	User                 auth.User
+	BulkCancellationUUID string
+}
+
+type BulkCancellationByUUIDHandler decorator.QueryHandler[BulkCancellationByUUID, BulkCancellation]
+
+type bulkCancellationByUUIDHandler struct {
+	readModel BulkCancellationReadModel
+}
+
+func NewBulkCancellationByUUIDHandler(
+	readModel BulkCancellationReadModel,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) BulkCancellationByUUIDHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+
+	return decorator.ApplyQueryDecorators[BulkCancellationByUUID, BulkCancellation](
+		bulkCancellationByUUIDHandler{readModel: readModel},
+		logger,
+		metricsClient,
+	)
+}
+
+type BulkCancellationReadModel interface {
+	BulkCancellation(ctx context.Context, bulkCancellationUUID string) (BulkCancellation, error)
+}
+
+func (h bulkCancellationByUUIDHandler) Handle(ctx context.Context, query BulkCancellationByUUID) (BulkCancellation, error) {
+	if query.User.Role != "trainer" {
+		return BulkCancellation{}, errors.NewForbiddenError("only trainer can see bulk cancellations", "forbidden-to-see-bulk-cancellation")
+	}
+
+	b, err := h.readModel.BulkCancellation(ctx, query.BulkCancellationUUID)
+	if err != nil {
+		return BulkCancellation{}, err
+	}
+	if b.TrainerUUID != query.User.UUID {
+		return BulkCancellation{}, errors.NewForbiddenError("trainer can see only own bulk cancellations", "forbidden-to-see-bulk-cancellation")
+	}
+
+	for _, tr := range b.Trainings {
+		switch tr.Status {
+		case "canceled":
+			b.CanceledCount++
+			b.RefundedCredits += tr.BalanceDelta
+		case "already-canceled":
+			b.AlreadyCanceledCount++
+		default:
+			b.PendingCount++
+		}
+	}
+
+	return b, nil
+}
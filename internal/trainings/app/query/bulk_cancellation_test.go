@@ -0,0 +1,88 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	commonerrors "github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+)
+
+func TestBulkCancellationByUUID(t *testing.T) {
+	t.Parallel()
+
+	trainerUUID := uuid.New().String()
+	bulkCancellationUUID := uuid.New().String()
+
+	readModel := bulkCancellationReadModelMock{
+		bulkCancellationUUID: {
+			UUID:        bulkCancellationUUID,
+			TrainerUUID: trainerUUID,
+			Trainings: []query.BulkCanceledTraining{
+				{Status: "canceled", BalanceDelta: 2},
+				{Status: "already-canceled"},
+				{Status: "pending"},
+			},
+		},
+	}
+	handler := query.NewBulkCancellationByUUIDHandler(readModel, slog.Default(), metrics.NoOp{})
+
+	testCases := []struct {
+		Name       string
+		User       auth.User
+		ShouldFail bool
+	}{
+		{
+			Name: "owner",
+			User: auth.User{UUID: trainerUUID, Role: "trainer"},
+		},
+		{
+			Name:       "another_trainer",
+			User:       auth.User{UUID: uuid.New().String(), Role: "trainer"},
+			ShouldFail: true,
+		},
+		{
+			Name:       "attendee",
+			User:       auth.User{UUID: uuid.New().String(), Role: "attendee"},
+			ShouldFail: true,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := handler.Handle(context.Background(), query.BulkCancellationByUUID{
+				User:                 c.User,
+				BulkCancellationUUID: bulkCancellationUUID,
+			})
+
+			if c.ShouldFail {
+				var slugErr commonerrors.SlugError
+				require.ErrorAs(t, err, &slugErr)
+				assert.Equal(t, commonerrors.ErrorTypeForbidden, slugErr.ErrorType())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 1, b.CanceledCount)
+			assert.Equal(t, 1, b.AlreadyCanceledCount)
+			assert.Equal(t, 1, b.PendingCount)
+			assert.Equal(t, 2, b.RefundedCredits)
+		})
+	}
+}
+
+type bulkCancellationReadModelMock map[string]query.BulkCancellation
+
+func (m bulkCancellationReadModelMock) BulkCancellation(ctx context.Context, bulkCancellationUUID string) (query.BulkCancellation, error) {
+	return m[bulkCancellationUUID], nil
+}
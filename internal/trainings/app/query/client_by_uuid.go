@@ -0,0 +1,86 @@
+package query
+
+import (
+	"context"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+)
+
+// ClientByUUID returns the profile, balance and trainings of the trainer's client.
+type ClientByUUID struct {
+	User auth.User
+
+	ClientUUID string
+}
+
+type ClientByUUIDHandler decorator.QueryHandler[ClientByUUID, ClientDetails]
+
+type clientByUUIDHandler struct {
+	readModel TrainingsForUserReadModel
+	clients   TrainerClients
+	profiles  ClientProfiles
+}
+
+func NewClientByUUIDHandler(
+	readModel TrainingsForUserReadModel,
+	clients TrainerClients,
+	profiles ClientProfiles,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) ClientByUUIDHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if clients == nil {
+		panic("nil clients")
+	}
+	if profiles == nil {
+		panic("nil profiles")
+	}
+
+	return decorator.ApplyQueryDecorators[ClientByUUID, ClientDetails](
+		clientByUUIDHandler{readModel: readModel, clients: clients, profiles: profiles},
+		logger,
+		metricsClient,
+	)
+}
+
+// ClientProfiles looks up the clients' profiles and balances in the users service.
+type ClientProfiles interface {
+	ClientProfile(ctx context.Context, clientUUID string) (ClientProfile, error)
+}
+
+func (h clientByUUIDHandler) Handle(ctx context.Context, query ClientByUUID) (ClientDetails, error) {
+	if query.User.Role != "trainer" {
+		return ClientDetails{}, errors.NewForbiddenError("only trainer can see details of clients", "forbidden-to-see-client")
+	}
+
+	isClient, err := canUserSeeTrainingsOf(ctx, h.clients, query.User, query.ClientUUID)
+	if err != nil {
+		return ClientDetails{}, err
+	}
+	if !isClient {
+		return ClientDetails{}, errors.NewForbiddenError("user is not the trainer's client", "forbidden-to-see-client")
+	}
+
+	profile, err := h.profiles.ClientProfile(ctx, query.ClientUUID)
+	if err != nil {
+		return ClientDetails{}, err
+	}
+
+	trainings, err := h.readModel.FindTrainingsForUser(ctx, query.ClientUUID)
+	if err != nil {
+		return ClientDetails{}, err
+	}
+
+	// trainings are shown with the current name of the client, not the one from the booking
+	for i := range trainings {
+		trainings[i].User = profile.Name
+	}
+
+	return ClientDetails{Profile: profile, Trainings: trainings}, nil
+}
@@ -0,0 +1,117 @@
+package query_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	commonerrors "github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+)
+
+func TestClientByUUID(t *testing.T) {
+	t.Parallel()
+
+	trainerUUID := uuid.New().String()
+	clientUUID := uuid.New().String()
+	readModel := trainingsForUserReadModelMock{
+		{UUID: uuid.New().String(), UserUUID: clientUUID, User: "Booked Name"},
+	}
+	clients := trainerClientsMock{trainerUUID: {clientUUID}}
+	profiles := clientProfilesMock{clientUUID: {UUID: clientUUID, Name: "Current Name", Balance: 3}}
+
+	handler := query.NewClientByUUIDHandler(readModel, clients, profiles, slog.Default(), metrics.NoOp{})
+
+	client, err := handler.Handle(context.Background(), query.ClientByUUID{
+		User:       auth.User{UUID: trainerUUID, Role: "trainer"},
+		ClientUUID: clientUUID,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Current Name", client.Profile.Name)
+	assert.Equal(t, 3, client.Profile.Balance)
+	require.Len(t, client.Trainings, 1)
+	assert.Equal(t, "Current Name", client.Trainings[0].User)
+}
+
+func TestClientByUUID_forbidden(t *testing.T) {
+	t.Parallel()
+
+	trainerUUID := uuid.New().String()
+	clientUUID := uuid.New().String()
+	anotherTrainerUUID := uuid.New().String()
+	anotherTrainersClientUUID := uuid.New().String()
+	clients := trainerClientsMock{trainerUUID: {clientUUID}, anotherTrainerUUID: {anotherTrainersClientUUID}}
+	profiles := clientProfilesMock{}
+
+	handler := query.NewClientByUUIDHandler(trainingsForUserReadModelMock{}, clients, profiles, slog.Default(), metrics.NoOp{})
+
+	testCases := []struct {
+		Name       string
+		User       auth.User
+		ClientUUID string
+	}{
+		{
+			Name:       "not_trainers_client",
+			User:       auth.User{UUID: trainerUUID, Role: "trainer"},
+			ClientUUID: anotherTrainersClientUUID,
+		},
+		{
+			Name:       "another_trainer",
+			User:       auth.User{UUID: anotherTrainerUUID, Role: "trainer"},
+			ClientUUID: clientUUID,
+		},
+		{
+			Name:       "attendee",
+			User:       auth.User{UUID: clientUUID, Role: "attendee"},
+			ClientUUID: clientUUID,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := handler.Handle(context.Background(), query.ClientByUUID{
+				User:       c.User,
+				ClientUUID: c.ClientUUID,
+			})
+
+			var slugErr commonerrors.SlugError
+			require.ErrorAs(t, err, &slugErr)
+			assert.Equal(t, "forbidden-to-see-client", slugErr.Slug())
+		})
+	}
+}
+
+// trainerClientsMock maps trainers to their clients.
+type trainerClientsMock map[string][]string
+
+func (m trainerClientsMock) TrainerRoster(ctx context.Context, trainerUUID string) (query.Roster, error) {
+	roster := query.Roster{ClientUUIDs: m[trainerUUID]}
+	for otherTrainerUUID, clientUUIDs := range m {
+		if otherTrainerUUID == trainerUUID {
+			continue
+		}
+		for _, clientUUID := range clientUUIDs {
+			if !slices.Contains(roster.ClientUUIDs, clientUUID) {
+				roster.OtherTrainersClientUUIDs = append(roster.OtherTrainersClientUUIDs, clientUUID)
+			}
+		}
+	}
+
+	return roster, nil
+}
+
+type clientProfilesMock map[string]query.ClientProfile
+
+func (m clientProfilesMock) ClientProfile(ctx context.Context, clientUUID string) (query.ClientProfile, error) {
+	return m[clientUUID], nil
+}
@@ -0,0 +1,71 @@
+package query
+
+import (
+	"context"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+)
+
+// ClientsTrainings returns trainings of all clients of the trainer and of attendees not assigned to any trainer.
+type ClientsTrainings struct {
+	User auth.User
+}
+
+type ClientsTrainingsHandler decorator.QueryHandler[ClientsTrainings, []Training]
+
+type clientsTrainingsHandler struct {
+	readModel ClientsTrainingsReadModel
+	clients   TrainerClients
+	names     currentUserNames
+}
+
+func NewClientsTrainingsHandler(
+	readModel ClientsTrainingsReadModel,
+	users UserNames,
+	clients TrainerClients,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) ClientsTrainingsHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if clients == nil {
+		panic("nil clients")
+	}
+
+	return decorator.ApplyQueryDecorators[ClientsTrainings, []Training](
+		clientsTrainingsHandler{readModel: readModel, clients: clients, names: newCurrentUserNames(users, logger)},
+		logger,
+		metricsClient,
+	)
+}
+
+type ClientsTrainingsReadModel interface {
+	// FindTrainingsForRoster returns not canceled trainings of the roster's clients and of attendees
+	// not assigned to any trainer, the most recently booked first.
+	FindTrainingsForRoster(ctx context.Context, roster Roster) ([]Training, error)
+}
+
+func (h clientsTrainingsHandler) Handle(ctx context.Context, query ClientsTrainings) (tr []Training, err error) {
+	if query.User.Role != "trainer" {
+		return nil, errors.NewForbiddenError("only trainer can see trainings of clients", "forbidden-to-see-clients-trainings")
+	}
+
+	roster, err := h.clients.TrainerRoster(ctx, query.User.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err = h.readModel.FindTrainingsForRoster(ctx, roster)
+	if err != nil {
+		return nil, err
+	}
+
+	h.names.refreshTrainings(ctx, tr)
+
+	return tr, nil
+}
@@ -0,0 +1,182 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+)
+
+const (
+	ReportPeriodWeek  = "week"
+	ReportPeriodMonth = "month"
+)
+
+const (
+	maxReportRange = 366 * 24 * time.Hour
+
+	// lateCancellationNotice is fixed rather than taken from the cancellation policy,
+	// so reports stay comparable when the policy changes.
+	lateCancellationNotice = 24 * time.Hour
+)
+
+// ReportRange is shared by all reports, periods start at Monday (weeks) or the 1st day (months) in UTC.
+// Admins see reports of all trainings, trainers only of trainings they can see.
+type ReportRange struct {
+	User auth.User
+
+	From   time.Time
+	To     time.Time
+	Period string
+}
+
+func validateReportRange(r ReportRange) error {
+	if r.User.Role != "trainer" && r.User.Role != "admin" {
+		return errors.NewForbiddenError("only trainer or admin can see reports", "forbidden-to-see-reports")
+	}
+	if r.Period != ReportPeriodWeek && r.Period != ReportPeriodMonth {
+		return errors.NewIncorrectInputError("report period should be week or month", "invalid-report-period")
+	}
+	if !r.From.Before(r.To) {
+		return errors.NewIncorrectInputError("date from should be before date to", "date-from-after-date-to")
+	}
+	if r.To.Sub(r.From) > maxReportRange {
+		return errors.NewIncorrectInputError("report range can't be longer than a year", "report-range-too-long")
+	}
+
+	return nil
+}
+
+// reportRoster returns the roster the report is limited to, it's nil when the report covers all trainers.
+func reportRoster(ctx context.Context, clients TrainerClients, user auth.User) (*Roster, error) {
+	if user.Role == "admin" {
+		return nil, nil
+	}
+
+	roster, err := clients.TrainerRoster(ctx, user.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &roster, nil
+}
+
+type UtilizationReport ReportRange
+
+type UtilizationReportHandler decorator.QueryHandler[UtilizationReport, []UtilizationReportPeriod]
+
+type utilizationReportHandler struct {
+	readModel ReportsReadModel
+	clients   TrainerClients
+}
+
+func NewUtilizationReportHandler(
+	readModel ReportsReadModel,
+	clients TrainerClients,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) UtilizationReportHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if clients == nil {
+		panic("nil clients")
+	}
+
+	return decorator.ApplyQueryDecorators[UtilizationReport, []UtilizationReportPeriod](
+		utilizationReportHandler{readModel: readModel, clients: clients},
+		logger,
+		metricsClient,
+	)
+}
+
+type CancellationReport ReportRange
+
+type CancellationReportHandler decorator.QueryHandler[CancellationReport, []CancellationReportPeriod]
+
+type cancellationReportHandler struct {
+	readModel ReportsReadModel
+	clients   TrainerClients
+}
+
+func NewCancellationReportHandler(
+	readModel ReportsReadModel,
+	clients TrainerClients,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) CancellationReportHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if clients == nil {
+		panic("nil clients")
+	}
+
+	return decorator.ApplyQueryDecorators[CancellationReport, []CancellationReportPeriod](
+		cancellationReportHandler{readModel: readModel, clients: clients},
+		logger,
+		metricsClient,
+	)
+}
+
+// ReportsReadModel limits the reports to trainings of attendees the roster can see, nil roster reports all trainings.
+type ReportsReadModel interface {
+	// UtilizationReport returns periods with any offered hour or training, sorted by PeriodStart.
+	// UtilizationRate is calculated by the handler.
+	UtilizationReport(
+		ctx context.Context,
+		roster *Roster,
+		period string,
+		from time.Time,
+		to time.Time,
+	) ([]UtilizationReportPeriod, error)
+	CancellationReport(
+		ctx context.Context,
+		roster *Roster,
+		period string,
+		from time.Time,
+		to time.Time,
+		lateNotice time.Duration,
+	) ([]CancellationReportPeriod, error)
+}
+
+func (h utilizationReportHandler) Handle(ctx context.Context, query UtilizationReport) ([]UtilizationReportPeriod, error) {
+	if err := validateReportRange(ReportRange(query)); err != nil {
+		return nil, err
+	}
+
+	roster, err := reportRoster(ctx, h.clients, query.User)
+	if err != nil {
+		return nil, err
+	}
+
+	periods, err := h.readModel.UtilizationReport(ctx, roster, query.Period, query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range periods {
+		if periods[i].OfferedHours > 0 {
+			periods[i].UtilizationRate = float64(periods[i].BookedHours) / float64(periods[i].OfferedHours)
+		}
+	}
+
+	return periods, nil
+}
+
+func (h cancellationReportHandler) Handle(ctx context.Context, query CancellationReport) ([]CancellationReportPeriod, error) {
+	if err := validateReportRange(ReportRange(query)); err != nil {
+		return nil, err
+	}
+
+	roster, err := reportRoster(ctx, h.clients, query.User)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.readModel.CancellationReport(ctx, roster, query.Period, query.From, query.To, lateCancellationNotice)
+}
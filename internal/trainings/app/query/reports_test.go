@@ -0,0 +1,157 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	commonerrors "github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+)
+
+func TestUtilizationReport_roles(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Role       string
+		ShouldFail bool
+	}{
+		{Role: "trainer"},
+		{Role: "admin"},
+		{Role: "attendee", ShouldFail: true},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Role, func(t *testing.T) {
+			t.Parallel()
+
+			readModel := reportsReadModelMock{
+				utilization: []query.UtilizationReportPeriod{{OfferedHours: 4, BookedHours: 1}},
+			}
+			handler := query.NewUtilizationReportHandler(readModel, trainerClientsMock{}, slog.Default(), metrics.NoOp{})
+
+			from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+			periods, err := handler.Handle(context.Background(), query.UtilizationReport{
+				User:   auth.User{UUID: uuid.New().String(), Role: c.Role},
+				From:   from,
+				To:     from.AddDate(0, 0, 14),
+				Period: query.ReportPeriodWeek,
+			})
+
+			if c.ShouldFail {
+				var slugErr commonerrors.SlugError
+				require.ErrorAs(t, err, &slugErr)
+				assert.Equal(t, commonerrors.ErrorTypeForbidden, slugErr.ErrorType())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, periods, 1)
+			assert.InDelta(t, 0.25, periods[0].UtilizationRate, 0.0001)
+		})
+	}
+}
+
+func TestReports_scope(t *testing.T) {
+	t.Parallel()
+
+	trainerUUID := uuid.New().String()
+	clientUUID := uuid.New().String()
+	otherTrainerClientUUID := uuid.New().String()
+
+	clients := trainerClientsMock{
+		trainerUUID:         {clientUUID},
+		uuid.New().String(): {otherTrainerClientUUID},
+	}
+
+	testCases := []struct {
+		Name           string
+		User           auth.User
+		ExpectedRoster *query.Roster
+	}{
+		{
+			Name: "admin_sees_all_trainings",
+			User: auth.User{UUID: uuid.New().String(), Role: "admin"},
+		},
+		{
+			Name: "trainer_sees_own_roster",
+			User: auth.User{UUID: trainerUUID, Role: "trainer"},
+			ExpectedRoster: &query.Roster{
+				ClientUUIDs:              []string{clientUUID},
+				OtherTrainersClientUUIDs: []string{otherTrainerClientUUID},
+			},
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			readModel := reportsReadModelMock{rosters: &[]*query.Roster{}}
+			utilizationHandler := query.NewUtilizationReportHandler(readModel, clients, slog.Default(), metrics.NoOp{})
+			cancellationHandler := query.NewCancellationReportHandler(readModel, clients, slog.Default(), metrics.NoOp{})
+
+			from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+			reportRange := query.ReportRange{
+				User:   c.User,
+				From:   from,
+				To:     from.AddDate(0, 0, 14),
+				Period: query.ReportPeriodWeek,
+			}
+
+			_, err := utilizationHandler.Handle(context.Background(), query.UtilizationReport(reportRange))
+			require.NoError(t, err)
+
+			_, err = cancellationHandler.Handle(context.Background(), query.CancellationReport(reportRange))
+			require.NoError(t, err)
+
+			assert.Equal(t, []*query.Roster{c.ExpectedRoster, c.ExpectedRoster}, *readModel.rosters)
+		})
+	}
+}
+
+type reportsReadModelMock struct {
+	utilization  []query.UtilizationReportPeriod
+	cancellation []query.CancellationReportPeriod
+
+	// rosters records the roster passed to each report, when set
+	rosters *[]*query.Roster
+}
+
+func (m reportsReadModelMock) recordRoster(roster *query.Roster) {
+	if m.rosters != nil {
+		*m.rosters = append(*m.rosters, roster)
+	}
+}
+
+func (m reportsReadModelMock) UtilizationReport(
+	ctx context.Context,
+	roster *query.Roster,
+	period string,
+	from time.Time,
+	to time.Time,
+) ([]query.UtilizationReportPeriod, error) {
+	m.recordRoster(roster)
+	return append([]query.UtilizationReportPeriod(nil), m.utilization...), nil
+}
+
+func (m reportsReadModelMock) CancellationReport(
+	ctx context.Context,
+	roster *query.Roster,
+	period string,
+	from time.Time,
+	to time.Time,
+	lateNotice time.Duration,
+) ([]query.CancellationReportPeriod, error) {
+	m.recordRoster(roster)
+	return m.cancellation, nil
+}
@@ -0,0 +1,78 @@
+package query
+
+import (
+	"context"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+type SessionTypes struct {
+	User auth.User
+}
+
+type SessionTypesHandler decorator.QueryHandler[SessionTypes, []SessionType]
+
+type sessionTypesHandler struct {
+	readModel SessionTypesReadModel
+	policies  training.CancellationPolicies
+}
+
+func NewSessionTypesHandler(
+	readModel SessionTypesReadModel,
+	policies training.CancellationPolicies,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) SessionTypesHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+
+	return decorator.ApplyQueryDecorators[SessionTypes, []SessionType](
+		sessionTypesHandler{readModel: readModel, policies: policies},
+		logger,
+		metricsClient,
+	)
+}
+
+type SessionTypesReadModel interface {
+	SessionTypes(ctx context.Context) ([]SessionType, error)
+}
+
+func (h sessionTypesHandler) Handle(ctx context.Context, query SessionTypes) ([]SessionType, error) {
+	sessionTypes, err := h.readModel.SessionTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := h.policies.Current()
+
+	result := make([]SessionType, 0, len(sessionTypes))
+	for _, st := range sessionTypes {
+		// archived session types can't be booked, only trainers managing the catalog need them
+		if st.Archived && query.User.Role != "trainer" {
+			continue
+		}
+
+		if len(st.CancellationTiers) > 0 {
+			// the session type has a cancellation policy of its own
+			result = append(result, st)
+			continue
+		}
+
+		for _, tier := range policy.ForSessionType(st.Code).Tiers() {
+			st.CancellationTiers = append(st.CancellationTiers, CancellationTier{
+				MinNotice:             tier.MinNotice,
+				AttendeeRefundPercent: tier.AttendeeRefundPercent,
+				TrainerRefundPercent:  tier.TrainerRefundPercent,
+			})
+		}
+
+		result = append(result, st)
+	}
+
+	return result, nil
+}
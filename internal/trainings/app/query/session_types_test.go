@@ -0,0 +1,52 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestSessionTypes_cancellation_tiers(t *testing.T) {
+	t.Parallel()
+
+	policies, err := training.NewCancellationPolicies(
+		training.DefaultCancellationPolicyVersion,
+		training.DefaultCancellationPolicy(),
+	)
+	require.NoError(t, err)
+
+	ownTiers := []query.CancellationTier{{MinNotice: 0, AttendeeRefundPercent: 50, TrainerRefundPercent: 100}}
+	readModel := sessionTypesReadModelMock{
+		{Code: "personal", Name: "Personal training", DurationMinutes: 60, CreditPrice: 1},
+		{Code: "assessment", Name: "Assessment", DurationMinutes: 45, CreditPrice: 3, CancellationTiers: ownTiers},
+		{Code: "archived", Name: "Archived", DurationMinutes: 60, CreditPrice: 1, Archived: true},
+	}
+	handler := query.NewSessionTypesHandler(readModel, policies, slog.Default(), metrics.NoOp{})
+
+	sessionTypes, err := handler.Handle(context.Background(), query.SessionTypes{
+		User: auth.User{UUID: "attendee-uuid", Role: "attendee"},
+	})
+	require.NoError(t, err)
+	require.Len(t, sessionTypes, 2)
+
+	assert.Equal(t, []query.CancellationTier{
+		{MinNotice: 24 * time.Hour, AttendeeRefundPercent: 100, TrainerRefundPercent: 100},
+		{MinNotice: 0, AttendeeRefundPercent: 0, TrainerRefundPercent: 200},
+	}, sessionTypes[0].CancellationTiers)
+	assert.Equal(t, ownTiers, sessionTypes[1].CancellationTiers)
+}
+
+type sessionTypesReadModelMock []query.SessionType
+
+func (m sessionTypesReadModelMock) SessionTypes(ctx context.Context) ([]query.SessionType, error) {
+	return append([]query.SessionType(nil), m...), nil
+}
@@ -0,0 +1,79 @@
+package query
+
+import (
+	"context"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+// TrainerClients looks up the attendees assigned to trainers in the users service.
+type TrainerClients interface {
+	// TrainerRoster returns the clients of the trainer and the attendees assigned only to other trainers.
+	TrainerRoster(ctx context.Context, trainerUUID string) (Roster, error)
+}
+
+// TrainerRoster returns the trainer's roster, trainers can see trainings of their clients and of attendees without a trainer.
+type TrainerRoster struct {
+	User auth.User
+}
+
+type TrainerRosterHandler decorator.QueryHandler[TrainerRoster, Roster]
+
+type trainerRosterHandler struct {
+	clients TrainerClients
+}
+
+func NewTrainerRosterHandler(
+	clients TrainerClients,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) TrainerRosterHandler {
+	if clients == nil {
+		panic("nil clients")
+	}
+
+	return decorator.ApplyQueryDecorators[TrainerRoster, Roster](
+		trainerRosterHandler{clients: clients},
+		logger,
+		metricsClient,
+	)
+}
+
+func (h trainerRosterHandler) Handle(ctx context.Context, query TrainerRoster) (Roster, error) {
+	if query.User.Role != "trainer" {
+		return Roster{}, errors.NewForbiddenError("only trainer has clients", "forbidden-to-see-trainer-clients")
+	}
+
+	return h.clients.TrainerRoster(ctx, query.User.UUID)
+}
+
+// canUserSeeTrainingsOf checks by the rule of training.User if the user can see trainings of the attendee,
+// trainers are checked with their roster.
+func canUserSeeTrainingsOf(ctx context.Context, clients TrainerClients, user auth.User, attendeeUUID string) (bool, error) {
+	domainUser, err := newDomainUser(ctx, clients, user)
+	if err != nil {
+		return false, err
+	}
+
+	return domainUser.CanSeeTrainingsOf(attendeeUUID), nil
+}
+
+// newDomainUser returns the user of the query as seen by the training domain.
+// Admins and attendees can see only their own trainings.
+func newDomainUser(ctx context.Context, clients TrainerClients, user auth.User) (training.User, error) {
+	if user.Role != "trainer" {
+		return training.NewUser(user.UUID, training.Attendee)
+	}
+
+	roster, err := clients.TrainerRoster(ctx, user.UUID)
+	if err != nil {
+		return training.User{}, err
+	}
+
+	return training.NewTrainer(user.UUID, roster.ClientUUIDs, roster.OtherTrainersClientUUIDs)
+}
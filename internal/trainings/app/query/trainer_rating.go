@@ -0,0 +1,76 @@
+package query
+
+import (
+	"context"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+)
+
+const (
+	defaultRecentFeedbackLimit = 10
+	maxRecentFeedbackLimit     = 100
+)
+
+type TrainerRating struct {
+	User auth.User
+
+	// RecentFeedbackLimit limits the number of returned feedback entries, 10 is used when not set
+	// and it can't be more than 100.
+	RecentFeedbackLimit int
+}
+
+type TrainerRatingHandler decorator.QueryHandler[TrainerRating, TrainerRatingSummary]
+
+type trainerRatingHandler struct {
+	readModel TrainerRatingReadModel
+	names     currentUserNames
+}
+
+func NewTrainerRatingHandler(
+	readModel TrainerRatingReadModel,
+	users UserNames,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) TrainerRatingHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+
+	return decorator.ApplyQueryDecorators[TrainerRating, TrainerRatingSummary](
+		trainerRatingHandler{readModel: readModel, names: newCurrentUserNames(users, logger)},
+		logger,
+		metricsClient,
+	)
+}
+
+type TrainerRatingReadModel interface {
+	TrainerRating(ctx context.Context, forUserUUID *string, recentFeedbackLimit int) (TrainerRatingSummary, error)
+}
+
+func (h trainerRatingHandler) Handle(ctx context.Context, query TrainerRating) (TrainerRatingSummary, error) {
+	limit := query.RecentFeedbackLimit
+	if limit <= 0 {
+		limit = defaultRecentFeedbackLimit
+	}
+	if limit > maxRecentFeedbackLimit {
+		limit = maxRecentFeedbackLimit
+	}
+
+	// the same rule as in training.CanUserSeeTraining: attendees can see only feedback of their own trainings
+	var forUserUUID *string
+	if query.User.Role != "trainer" {
+		forUserUUID = &query.User.UUID
+	}
+
+	rating, err := h.readModel.TrainerRating(ctx, forUserUUID, limit)
+	if err != nil {
+		return TrainerRatingSummary{}, err
+	}
+
+	h.names.refreshFeedback(ctx, rating.RecentFeedback)
+
+	return rating, nil
+}
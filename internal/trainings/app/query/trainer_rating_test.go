@@ -0,0 +1,118 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+)
+
+func TestTrainerRating(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                string
+		Role                string
+		RecentFeedbackLimit int
+
+		ExpectedOwnFeedbackOnly bool
+		ExpectedLimit           int
+	}{
+		{
+			Name:          "trainer",
+			Role:          "trainer",
+			ExpectedLimit: 10,
+		},
+		{
+			Name:                    "attendee",
+			Role:                    "attendee",
+			ExpectedOwnFeedbackOnly: true,
+			ExpectedLimit:           10,
+		},
+		{
+			Name:                    "admin",
+			Role:                    "admin",
+			ExpectedOwnFeedbackOnly: true,
+			ExpectedLimit:           10,
+		},
+		{
+			Name:                "custom_limit",
+			Role:                "trainer",
+			RecentFeedbackLimit: 5,
+			ExpectedLimit:       5,
+		},
+		{
+			Name:                "too_big_limit",
+			Role:                "trainer",
+			RecentFeedbackLimit: 500,
+			ExpectedLimit:       100,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			renamedUUID := uuid.New().String()
+			readModel := &trainerRatingReadModelMock{
+				summary: query.TrainerRatingSummary{
+					AverageRating: 4.5,
+					RatingsCount:  2,
+					RecentFeedback: []query.Feedback{
+						{TrainingUUID: uuid.New().String(), UserUUID: renamedUUID, User: "Booked Name", Rating: 5},
+						{TrainingUUID: uuid.New().String(), UserUUID: uuid.New().String(), User: "Another Name", Rating: 4},
+					},
+				},
+			}
+			users := &userNamesMock{names: map[string]string{renamedUUID: "New Name"}}
+
+			handler := query.NewTrainerRatingHandler(readModel, users, slog.Default(), metrics.NoOp{})
+
+			user := auth.User{UUID: uuid.New().String(), Role: c.Role}
+			rating, err := handler.Handle(context.Background(), query.TrainerRating{
+				User:                user,
+				RecentFeedbackLimit: c.RecentFeedbackLimit,
+			})
+			require.NoError(t, err)
+
+			if c.ExpectedOwnFeedbackOnly {
+				require.NotNil(t, readModel.forUserUUID)
+				assert.Equal(t, user.UUID, *readModel.forUserUUID)
+			} else {
+				assert.Nil(t, readModel.forUserUUID)
+			}
+			assert.Equal(t, c.ExpectedLimit, readModel.recentFeedbackLimit)
+
+			assert.Equal(t, 4.5, rating.AverageRating)
+			require.Len(t, rating.RecentFeedback, 2)
+			assert.Equal(t, "New Name", rating.RecentFeedback[0].User)
+			assert.Equal(t, "Another Name", rating.RecentFeedback[1].User, "names of unknown users are kept")
+		})
+	}
+}
+
+type trainerRatingReadModelMock struct {
+	summary query.TrainerRatingSummary
+
+	forUserUUID         *string
+	recentFeedbackLimit int
+}
+
+func (m *trainerRatingReadModelMock) TrainerRating(
+	ctx context.Context,
+	forUserUUID *string,
+	recentFeedbackLimit int,
+) (query.TrainerRatingSummary, error) {
+	m.forUserUUID = forUserUUID
+	m.recentFeedbackLimit = recentFeedbackLimit
+
+	return m.summary, nil
+}
@@ -0,0 +1,73 @@
+package query
+
+import (
+	"context"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+)
+
+type TrainingByUUID struct {
+	User         auth.User
+	TrainingUUID string
+}
+
+type TrainingByUUIDHandler decorator.QueryHandler[TrainingByUUID, Training]
+
+type trainingByUUIDHandler struct {
+	readModel TrainingByUUIDReadModel
+	clients   TrainerClients
+	names     currentUserNames
+}
+
+func NewTrainingByUUIDHandler(
+	readModel TrainingByUUIDReadModel,
+	users UserNames,
+	clients TrainerClients,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) TrainingByUUIDHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if clients == nil {
+		panic("nil clients")
+	}
+
+	return decorator.ApplyQueryDecorators[TrainingByUUID, Training](
+		trainingByUUIDHandler{readModel: readModel, clients: clients, names: newCurrentUserNames(users, logger)},
+		logger,
+		metricsClient,
+	)
+}
+
+type TrainingByUUIDReadModel interface {
+	TrainingByUUID(ctx context.Context, trainingUUID string) (Training, error)
+}
+
+func (h trainingByUUIDHandler) Handle(ctx context.Context, query TrainingByUUID) (Training, error) {
+	tr, err := h.readModel.TrainingByUUID(ctx, query.TrainingUUID)
+	if err != nil {
+		return Training{}, err
+	}
+
+	canSee, err := canUserSeeTrainingsOf(ctx, h.clients, query.User, tr.UserUUID)
+	if err != nil {
+		return Training{}, err
+	}
+	if !canSee {
+		return Training{}, errors.NewForbiddenError("user can't see this training", "forbidden-to-see-training")
+	}
+
+	if query.User.Role != "trainer" {
+		tr.TrainerNotes = nil
+	}
+
+	trainings := []Training{tr}
+	h.names.refreshTrainings(ctx, trainings)
+
+	return trainings[0], nil
+}
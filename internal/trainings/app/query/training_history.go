@@ -0,0 +1,134 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+)
+
+const trainingHistoryPageSize = 500
+
+// TrainingHistory exports all trainings of the user, including canceled ones.
+// Trainings are passed to Emit page by page, so the history doesn't have to fit into memory.
+type TrainingHistory struct {
+	User auth.User
+
+	// Timezone is an IANA timezone name. When it's empty, the timezone from the user's profile is used,
+	// or UTC when the user didn't set any.
+	Timezone string
+
+	// Emit is called for every training sorted by time, the export stops on the first returned error.
+	Emit func(TrainingHistoryEntry) error
+}
+
+// TrainingHistoryHandler returns the number of exported trainings.
+type TrainingHistoryHandler decorator.QueryHandler[TrainingHistory, int]
+
+type trainingHistoryHandler struct {
+	readModel TrainingHistoryReadModel
+	timezones UserTimezones
+}
+
+func NewTrainingHistoryHandler(
+	readModel TrainingHistoryReadModel,
+	timezones UserTimezones,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) TrainingHistoryHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if timezones == nil {
+		panic("nil timezones")
+	}
+
+	return decorator.ApplyQueryDecorators[TrainingHistory, int](
+		trainingHistoryHandler{readModel: readModel, timezones: timezones},
+		logger,
+		metricsClient,
+	)
+}
+
+type TrainingHistoryReadModel interface {
+	// TrainingHistoryPage returns up to limit trainings of the user sorted by time and UUID,
+	// starting after the given training. Zero afterTime returns the first page.
+	TrainingHistoryPage(
+		ctx context.Context,
+		userUUID string,
+		afterTime time.Time,
+		afterUUID string,
+		limit int,
+	) ([]TrainingHistoryEntry, error)
+}
+
+// UserTimezones looks up the timezone the user set in their profile in the users service.
+type UserTimezones interface {
+	// UserTimezone returns the IANA timezone name, it's empty when the user didn't set any.
+	UserTimezone(ctx context.Context, userUUID string) (string, error)
+}
+
+func (h trainingHistoryHandler) Handle(ctx context.Context, query TrainingHistory) (int, error) {
+	location, err := h.location(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+
+	exported := 0
+	var afterTime time.Time
+	var afterUUID string
+
+	for {
+		page, err := h.readModel.TrainingHistoryPage(ctx, query.User.UUID, afterTime, afterUUID, trainingHistoryPageSize)
+		if err != nil {
+			return exported, err
+		}
+
+		for _, entry := range page {
+			afterTime, afterUUID = entry.Time, entry.UUID
+
+			entry.Time = entry.Time.In(location)
+			if err := query.Emit(entry); err != nil {
+				return exported, err
+			}
+			exported++
+		}
+
+		if len(page) < trainingHistoryPageSize {
+			return exported, nil
+		}
+	}
+}
+
+// location returns the requested timezone, or the one from the user's profile when none was requested.
+func (h trainingHistoryHandler) location(ctx context.Context, query TrainingHistory) (*time.Location, error) {
+	if query.Timezone != "" {
+		location, err := time.LoadLocation(query.Timezone)
+		if err != nil {
+			return nil, errors.NewIncorrectInputError(err.Error(), "invalid-timezone")
+		}
+
+		return location, nil
+	}
+
+	timezone, err := h.timezones.UserTimezone(ctx, query.User.UUID)
+	if err != nil {
+		return nil, errors.NewSlugError(fmt.Sprintf("unable to get user's timezone: %s", err.Error()), "get-user-timezone-failed")
+	}
+	if timezone == "" {
+		return time.UTC, nil
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		// the timezone was valid when the user set it, the export doesn't fail when it's not known here
+		return time.UTC, nil
+	}
+
+	return location, nil
+}
@@ -0,0 +1,163 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	commonerrors "github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+)
+
+func TestTrainingHistory_exports_all_pages(t *testing.T) {
+	t.Parallel()
+
+	firstTrainingTime := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	readModel := &trainingHistoryReadModelMock{}
+	// more than a single page
+	for i := 0; i < 1201; i++ {
+		readModel.entries = append(readModel.entries, query.TrainingHistoryEntry{
+			UUID: uuid.New().String(),
+			Time: firstTrainingTime.Add(time.Duration(i) * time.Hour),
+		})
+	}
+
+	handler := query.NewTrainingHistoryHandler(readModel, userTimezonesMock{}, slog.Default(), metrics.NoOp{})
+
+	var exported []query.TrainingHistoryEntry
+	count, err := handler.Handle(context.Background(), query.TrainingHistory{
+		User:     auth.User{UUID: uuid.New().String(), Role: "attendee"},
+		Timezone: "Europe/Warsaw",
+		Emit: func(entry query.TrainingHistoryEntry) error {
+			exported = append(exported, entry)
+			return nil
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1201, count)
+	require.Len(t, exported, 1201)
+	assert.Equal(t, readModel.entries[1200].UUID, exported[1200].UUID)
+
+	assert.Equal(t, "Europe/Warsaw", exported[0].Time.Location().String())
+	assert.Equal(t, 13, exported[0].Time.Hour())
+	assert.True(t, exported[0].Time.Equal(firstTrainingTime))
+}
+
+func TestTrainingHistory_invalid_timezone(t *testing.T) {
+	t.Parallel()
+
+	handler := query.NewTrainingHistoryHandler(&trainingHistoryReadModelMock{}, userTimezonesMock{}, slog.Default(), metrics.NoOp{})
+
+	_, err := handler.Handle(context.Background(), query.TrainingHistory{
+		User:     auth.User{UUID: uuid.New().String(), Role: "attendee"},
+		Timezone: "Mars/Olympus_Mons",
+		Emit: func(entry query.TrainingHistoryEntry) error {
+			return nil
+		},
+	})
+
+	var slugErr commonerrors.SlugError
+	require.ErrorAs(t, err, &slugErr)
+	assert.Equal(t, "invalid-timezone", slugErr.Slug())
+}
+
+func TestTrainingHistory_profile_timezone(t *testing.T) {
+	t.Parallel()
+
+	trainingTime := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	readModel := &trainingHistoryReadModelMock{
+		entries: []query.TrainingHistoryEntry{{UUID: uuid.New().String(), Time: trainingTime}},
+	}
+
+	userWithTimezone := uuid.New().String()
+	userWithoutTimezone := uuid.New().String()
+	timezones := userTimezonesMock{userWithTimezone: "America/New_York"}
+
+	handler := query.NewTrainingHistoryHandler(readModel, timezones, slog.Default(), metrics.NoOp{})
+
+	testCases := []struct {
+		Name             string
+		UserUUID         string
+		Timezone         string
+		ExpectedLocation string
+	}{
+		{
+			Name:             "profile_timezone",
+			UserUUID:         userWithTimezone,
+			ExpectedLocation: "America/New_York",
+		},
+		{
+			Name:             "requested_timezone_wins",
+			UserUUID:         userWithTimezone,
+			Timezone:         "Europe/Warsaw",
+			ExpectedLocation: "Europe/Warsaw",
+		},
+		{
+			Name:             "no_profile_timezone",
+			UserUUID:         userWithoutTimezone,
+			ExpectedLocation: "UTC",
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var exported []query.TrainingHistoryEntry
+			_, err := handler.Handle(context.Background(), query.TrainingHistory{
+				User:     auth.User{UUID: c.UserUUID, Role: "attendee"},
+				Timezone: c.Timezone,
+				Emit: func(entry query.TrainingHistoryEntry) error {
+					exported = append(exported, entry)
+					return nil
+				},
+			})
+			require.NoError(t, err)
+
+			require.Len(t, exported, 1)
+			assert.Equal(t, c.ExpectedLocation, exported[0].Time.Location().String())
+			assert.True(t, exported[0].Time.Equal(trainingTime))
+		})
+	}
+}
+
+type userTimezonesMock map[string]string
+
+func (m userTimezonesMock) UserTimezone(ctx context.Context, userUUID string) (string, error) {
+	return m[userUUID], nil
+}
+
+type trainingHistoryReadModelMock struct {
+	// entries are sorted by time
+	entries []query.TrainingHistoryEntry
+}
+
+func (m *trainingHistoryReadModelMock) TrainingHistoryPage(
+	_ context.Context,
+	_ string,
+	afterTime time.Time,
+	_ string,
+	limit int,
+) ([]query.TrainingHistoryEntry, error) {
+	var page []query.TrainingHistoryEntry
+	for _, entry := range m.entries {
+		if !afterTime.IsZero() && !entry.Time.After(afterTime) {
+			continue
+		}
+		if len(page) == limit {
+			break
+		}
+		page = append(page, entry)
+	}
+
+	return page, nil
+}
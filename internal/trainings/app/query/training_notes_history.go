@@ -0,0 +1,77 @@
+package query
+
+import (
+	"context"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+)
+
+type TrainingNotesHistory struct {
+	User         auth.User
+	TrainingUUID string
+}
+
+type TrainingNotesHistoryHandler decorator.QueryHandler[TrainingNotesHistory, NotesHistory]
+
+type trainingNotesHistoryHandler struct {
+	readModel TrainingNotesHistoryReadModel
+	clients   TrainerClients
+}
+
+func NewTrainingNotesHistoryHandler(
+	readModel TrainingNotesHistoryReadModel,
+	clients TrainerClients,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) TrainingNotesHistoryHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if clients == nil {
+		panic("nil clients")
+	}
+
+	return decorator.ApplyQueryDecorators[TrainingNotesHistory, NotesHistory](
+		trainingNotesHistoryHandler{readModel: readModel, clients: clients},
+		logger,
+		metricsClient,
+	)
+}
+
+type TrainingNotesHistoryReadModel interface {
+	TrainingNotesHistory(ctx context.Context, trainingUUID string) (NotesHistory, error)
+}
+
+func (h trainingNotesHistoryHandler) Handle(ctx context.Context, query TrainingNotesHistory) (NotesHistory, error) {
+	history, err := h.readModel.TrainingNotesHistory(ctx, query.TrainingUUID)
+	if err != nil {
+		return NotesHistory{}, err
+	}
+
+	canSee, err := canUserSeeTrainingsOf(ctx, h.clients, query.User, history.UserUUID)
+	if err != nil {
+		return NotesHistory{}, err
+	}
+	if !canSee {
+		return NotesHistory{}, errors.NewForbiddenError("user can't see this training", "forbidden-to-see-training")
+	}
+
+	if query.User.Role == "trainer" {
+		return history, nil
+	}
+
+	// trainer notes are private
+	sharedRevisions := make([]NotesRevision, 0, len(history.Revisions))
+	for _, revision := range history.Revisions {
+		if revision.Kind == "shared" {
+			sharedRevisions = append(sharedRevisions, revision)
+		}
+	}
+	history.Revisions = sharedRevisions
+
+	return history, nil
+}
@@ -0,0 +1,69 @@
+package query
+
+import (
+	"context"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+)
+
+type TrainingSeries struct {
+	User       auth.User
+	SeriesUUID string
+}
+
+type TrainingSeriesHandler decorator.QueryHandler[TrainingSeries, Series]
+
+type trainingSeriesHandler struct {
+	readModel TrainingSeriesReadModel
+	clients   TrainerClients
+	names     currentUserNames
+}
+
+func NewTrainingSeriesHandler(
+	readModel TrainingSeriesReadModel,
+	users UserNames,
+	clients TrainerClients,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) TrainingSeriesHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if clients == nil {
+		panic("nil clients")
+	}
+
+	return decorator.ApplyQueryDecorators[TrainingSeries, Series](
+		trainingSeriesHandler{readModel: readModel, clients: clients, names: newCurrentUserNames(users, logger)},
+		logger,
+		metricsClient,
+	)
+}
+
+type TrainingSeriesReadModel interface {
+	TrainingSeries(ctx context.Context, seriesUUID string) (Series, error)
+}
+
+func (h trainingSeriesHandler) Handle(ctx context.Context, query TrainingSeries) (Series, error) {
+	series, err := h.readModel.TrainingSeries(ctx, query.SeriesUUID)
+	if err != nil {
+		return Series{}, err
+	}
+
+	// the same rule as in training.CanUserSeeSeries
+	canSee, err := canUserSeeTrainingsOf(ctx, h.clients, query.User, series.UserUUID)
+	if err != nil {
+		return Series{}, err
+	}
+	if !canSee {
+		return Series{}, errors.NewForbiddenError("user can't see this training series", "forbidden-to-see-series")
+	}
+
+	h.names.refreshSeries(ctx, &series)
+
+	return series, nil
+}
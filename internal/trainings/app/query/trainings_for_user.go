@@ -17,10 +17,12 @@ type TrainingsForUserHandler decorator.QueryHandler[TrainingsForUser, []Training
 
 type trainingsForUserHandler struct {
 	readModel TrainingsForUserReadModel
+	names     currentUserNames
 }
 
 func NewTrainingsForUserHandler(
 	readModel TrainingsForUserReadModel,
+	users UserNames,
 	logger *slog.Logger,
 	metricsClient decorator.MetricsClient,
 ) TrainingsForUserHandler {
@@ -29,7 +31,7 @@ func NewTrainingsForUserHandler(
 	}
 
 	return decorator.ApplyQueryDecorators[TrainingsForUser, []Training](
-		trainingsForUserHandler{readModel: readModel},
+		trainingsForUserHandler{readModel: readModel, names: newCurrentUserNames(users, logger)},
 		logger,
 		metricsClient,
 	)
@@ -40,5 +42,18 @@ type TrainingsForUserReadModel interface {
 }
 
 func (h trainingsForUserHandler) Handle(ctx context.Context, query TrainingsForUser) (tr []Training, err error) {
-	return h.readModel.FindTrainingsForUser(ctx, query.User.UUID)
+	tr, err = h.readModel.FindTrainingsForUser(ctx, query.User.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.User.Role != "trainer" {
+		for i := range tr {
+			tr[i].TrainerNotes = nil
+		}
+	}
+
+	h.names.refreshTrainings(ctx, tr)
+
+	return tr, nil
 }
@@ -0,0 +1,83 @@
+package query_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+)
+
+func TestTrainingsForUser_shows_current_user_names(t *testing.T) {
+	t.Parallel()
+
+	renamedUUID := uuid.New().String()
+	unknownUUID := uuid.New().String()
+	readModel := trainingsForUserReadModelMock{
+		{UUID: uuid.New().String(), UserUUID: renamedUUID, User: "Old Name"},
+		{UUID: uuid.New().String(), UserUUID: renamedUUID, User: "Old Name"},
+		{UUID: uuid.New().String(), UserUUID: unknownUUID, User: "Booked Name"},
+	}
+	users := &userNamesMock{names: map[string]string{renamedUUID: "New Name"}}
+
+	handler := query.NewTrainingsForUserHandler(readModel, users, slog.Default(), metrics.NoOp{})
+
+	trainings, err := handler.Handle(context.Background(), query.TrainingsForUser{
+		User: auth.User{UUID: uuid.New().String(), Role: "trainer"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, trainings, 3)
+	assert.Equal(t, "New Name", trainings[0].User)
+	assert.Equal(t, "New Name", trainings[1].User)
+	assert.Equal(t, "Booked Name", trainings[2].User, "names of unknown users are kept")
+	assert.Equal(t, [][]string{{renamedUUID, unknownUUID}}, users.requested, "every user is requested once")
+}
+
+func TestTrainingsForUser_keeps_booked_names_when_users_service_fails(t *testing.T) {
+	t.Parallel()
+
+	readModel := trainingsForUserReadModelMock{
+		{UUID: uuid.New().String(), UserUUID: uuid.New().String(), User: "Booked Name"},
+	}
+	users := &userNamesMock{err: errors.New("users service is unavailable")}
+
+	handler := query.NewTrainingsForUserHandler(readModel, users, slog.Default(), metrics.NoOp{})
+
+	trainings, err := handler.Handle(context.Background(), query.TrainingsForUser{
+		User: auth.User{UUID: uuid.New().String(), Role: "trainer"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, trainings, 1)
+	assert.Equal(t, "Booked Name", trainings[0].User)
+}
+
+type trainingsForUserReadModelMock []query.Training
+
+func (m trainingsForUserReadModelMock) FindTrainingsForUser(ctx context.Context, userUUID string) ([]query.Training, error) {
+	return append([]query.Training(nil), m...), nil
+}
+
+type userNamesMock struct {
+	names map[string]string
+	err   error
+
+	requested [][]string
+}
+
+func (m *userNamesMock) UserNames(ctx context.Context, userUUIDs []string) (map[string]string, error) {
+	m.requested = append(m.requested, userUUIDs)
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	return m.names, nil
+}
@@ -1,6 +1,9 @@
 package query
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 type Training struct {
 	UUID     string
@@ -9,9 +12,265 @@ type Training struct {
 
 	Time  time.Time
 	Notes string
+	// TrainerNotes are private notes of the trainer, they must be cleared before returning trainings to attendees.
+	TrainerNotes *string
 
-	ProposedTime   *time.Time
-	MoveProposedBy *string
+	ProposedTime      *time.Time
+	MoveProposedBy    *string
+	ProposalExpiresAt *time.Time
 
 	CanBeCancelled bool
+
+	Attendance *string
+
+	SeriesUUID *string
+
+	SessionType string
+	Price       int
+
+	// Version changes with every change of the training, it's used for optimistic concurrency control.
+	Version int
+}
+
+type Series struct {
+	UUID     string
+	UserUUID string
+	User     string
+
+	Canceled bool
+
+	Occurrences []SeriesOccurrence
+}
+
+type SeriesOccurrence struct {
+	Time time.Time
+	// Status is pending, scheduled, skipped, failed or canceled, when the scheduled training was canceled later.
+	Status string
+
+	TrainingUUID  *string
+	FailureReason *string
+}
+
+type BulkCancellation struct {
+	UUID        string
+	TrainerUUID string
+
+	From time.Time
+	To   time.Time
+
+	Completed bool
+
+	Trainings []BulkCanceledTraining
+
+	CanceledCount        int
+	AlreadyCanceledCount int
+	PendingCount         int
+	// RefundedCredits is the sum of credits returned to attendees of the canceled trainings.
+	RefundedCredits int
+}
+
+type BulkCanceledTraining struct {
+	TrainingUUID string
+	// Status is pending, canceled or already-canceled, when the training was canceled before the bulk cancellation got to it.
+	Status string
+
+	// Time and AttendeeUUID are nil while the training is pending.
+	Time         *time.Time
+	AttendeeUUID *string
+
+	BalanceDelta    int
+	HourUnavailable bool
+}
+
+type TrainerRatingSummary struct {
+	AverageRating float64
+	RatingsCount  int
+
+	RecentFeedback []Feedback
+}
+
+type Feedback struct {
+	TrainingUUID string
+	UserUUID     string
+	User         string
+	TrainingTime time.Time
+
+	Rating  int
+	Comment string
+
+	Reply *string
+
+	CreatedAt time.Time
+	RepliedAt *time.Time
+}
+
+type WaitlistEntry struct {
+	UUID string
+	Hour time.Time
+
+	AutoBook bool
+	// Status is waiting or offered, closed entries are not returned.
+	Status string
+
+	OfferExpiresAt *time.Time
+}
+
+type NotesHistory struct {
+	TrainingUUID string
+	UserUUID     string
+
+	Revisions []NotesRevision
+}
+
+type NotesRevision struct {
+	// Kind is shared or trainer, trainer notes are returned only to trainers.
+	Kind  string
+	Notes string
+
+	EditedBy     string
+	EditedByRole string
+	EditedAt     time.Time
+}
+
+type SessionType struct {
+	Code        string
+	Name        string
+	Description string
+
+	DurationMinutes int
+	CreditPrice     int
+
+	Archived bool
+
+	// CancellationTiers are tiers of the session type's own cancellation policy,
+	// or of the current cancellation policy when it has none.
+	CancellationTiers []CancellationTier
+}
+
+type CancellationTier struct {
+	MinNotice time.Duration
+
+	AttendeeRefundPercent int
+	TrainerRefundPercent  int
+}
+
+type UtilizationReportPeriod struct {
+	PeriodStart time.Time
+
+	// OfferedHours are hours the trainer made available, including the booked ones.
+	OfferedHours int
+	BookedHours  int
+	// UtilizationRate is the share of offered hours which are booked, 0 when no hours were offered.
+	UtilizationRate float64
+
+	Trainings     int
+	Attended      int
+	NoShows       int
+	Cancellations int
+	Reschedules   int
+}
+
+type CancellationReportPeriod struct {
+	PeriodStart time.Time
+	// CanceledBy is attendee, trainer, system or unknown for trainings canceled before it was recorded.
+	CanceledBy string
+
+	Cancellations int
+	// LateCancellations were canceled with less than 24 hours notice.
+	LateCancellations int
+	AverageNotice     time.Duration
+}
+
+type TrainingHistoryEntry struct {
+	UUID string
+	// Time is in the timezone requested for the export.
+	Time time.Time
+
+	SessionType string
+	Price       int
+
+	Canceled   bool
+	Attendance *string
+
+	Notes string
+}
+
+// UserDataArchive is everything stored about the user in all bounded contexts.
+type UserDataArchive struct {
+	UserUUID   string
+	ExportedAt time.Time
+
+	// Users is the JSON document exported by the users service.
+	Users     json.RawMessage
+	Trainings UserTrainingsData
+}
+
+type UserTrainingsData struct {
+	Trainings       []UserDataTraining
+	Series          []UserDataSeries
+	WaitlistEntries []UserDataWaitlistEntry
+}
+
+type UserDataTraining struct {
+	UUID        string
+	Time        time.Time
+	SessionType string
+	Price       int
+
+	Notes string
+	// NotesRevisions are revisions of the shared notes, trainer notes are private.
+	NotesRevisions []NotesRevision
+
+	Attendance *string
+	Canceled   bool
+	CanceledAt *time.Time
+
+	// Rating, FeedbackComment and FeedbackReply are nil when the training wasn't rated.
+	Rating          *int
+	FeedbackComment *string
+	FeedbackReply   *string
+
+	CreatedAt time.Time
+}
+
+type UserDataSeries struct {
+	UUID      string
+	Canceled  bool
+	CreatedAt time.Time
+}
+
+type UserDataWaitlistEntry struct {
+	UUID      string
+	Hour      time.Time
+	AutoBook  bool
+	Status    string
+	CreatedAt time.Time
+}
+
+// ClientDetails is the trainer's view of their client.
+type ClientDetails struct {
+	Profile ClientProfile
+	// Trainings are not canceled trainings of the client, past and upcoming.
+	Trainings []Training
+}
+
+// ClientProfile is the client's profile and trainings balance kept by the users service.
+type ClientProfile struct {
+	UUID string
+	Name string
+	// Email and AvatarURL are empty when the client didn't set them.
+	Email     string
+	AvatarURL string
+
+	Deactivated bool
+	Balance     int
+}
+
+// Roster is the trainer's roster kept by the users service.
+type Roster struct {
+	// ClientUUIDs are the attendees assigned to the trainer.
+	ClientUUIDs []string
+	// OtherTrainersClientUUIDs are the attendees assigned only to other trainers.
+	// Attendees not assigned to any trainer are in neither of the lists.
+	OtherTrainersClientUUIDs []string
 }
@@ -0,0 +1,80 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+	"github.com/vaintrub/go-ddd-template/internal/common/errors"
+)
+
+// UserDataExport exports everything stored about the user to answer the data-subject access request.
+type UserDataExport struct {
+	User auth.User
+
+	UserUUID string
+}
+
+type UserDataExportHandler decorator.QueryHandler[UserDataExport, UserDataArchive]
+
+type userDataExportHandler struct {
+	readModel UserDataReadModel
+	users     UserDataExporter
+}
+
+func NewUserDataExportHandler(
+	readModel UserDataReadModel,
+	users UserDataExporter,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) UserDataExportHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+	if users == nil {
+		panic("nil users")
+	}
+
+	return decorator.ApplyQueryDecorators[UserDataExport, UserDataArchive](
+		userDataExportHandler{readModel: readModel, users: users},
+		logger,
+		metricsClient,
+	)
+}
+
+type UserDataReadModel interface {
+	UserTrainingsData(ctx context.Context, userUUID string) (UserTrainingsData, error)
+}
+
+// UserDataExporter exports the user's data stored by the users service.
+type UserDataExporter interface {
+	// ExportUserData returns the JSON document with the user's profile, balance ledger, credit orders and sessions.
+	ExportUserData(ctx context.Context, userUUID string) (json.RawMessage, error)
+}
+
+func (h userDataExportHandler) Handle(ctx context.Context, query UserDataExport) (UserDataArchive, error) {
+	if query.User.Role != "admin" {
+		return UserDataArchive{}, errors.NewForbiddenError("only admin can export user data", "forbidden-to-export-user-data")
+	}
+
+	usersData, err := h.users.ExportUserData(ctx, query.UserUUID)
+	if err != nil {
+		return UserDataArchive{}, err
+	}
+
+	trainingsData, err := h.readModel.UserTrainingsData(ctx, query.UserUUID)
+	if err != nil {
+		return UserDataArchive{}, err
+	}
+
+	return UserDataArchive{
+		UserUUID:   query.UserUUID,
+		ExportedAt: time.Now(),
+		Users:      usersData,
+		Trainings:  trainingsData,
+	}, nil
+}
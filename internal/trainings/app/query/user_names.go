@@ -0,0 +1,89 @@
+package query
+
+import (
+	"context"
+
+	"log/slog"
+)
+
+// UserNames looks up the current names of users in the users service.
+type UserNames interface {
+	// UserNames returns the names of the users by their UUIDs, users which don't exist are left out.
+	UserNames(ctx context.Context, userUUIDs []string) (map[string]string, error)
+}
+
+// currentUserNames replaces the user names snapshotted when the training was booked with the current names.
+// When the users service can't be reached, the snapshotted names are kept, so the trainings can still be listed.
+type currentUserNames struct {
+	users  UserNames
+	logger *slog.Logger
+}
+
+func newCurrentUserNames(users UserNames, logger *slog.Logger) currentUserNames {
+	if users == nil {
+		panic("nil users")
+	}
+
+	return currentUserNames{users: users, logger: logger}
+}
+
+func (n currentUserNames) lookup(ctx context.Context, userUUIDs []string) map[string]string {
+	if len(userUUIDs) == 0 {
+		return nil
+	}
+
+	unique := make([]string, 0, len(userUUIDs))
+	seen := make(map[string]struct{}, len(userUUIDs))
+	for _, userUUID := range userUUIDs {
+		if _, ok := seen[userUUID]; ok {
+			continue
+		}
+		seen[userUUID] = struct{}{}
+		unique = append(unique, userUUID)
+	}
+
+	names, err := n.users.UserNames(ctx, unique)
+	if err != nil {
+		n.logger.WarnContext(ctx, "Unable to get current user names, names from the booking are shown",
+			slog.Any("error", err),
+		)
+		return nil
+	}
+
+	return names
+}
+
+func (n currentUserNames) refreshTrainings(ctx context.Context, trainings []Training) {
+	userUUIDs := make([]string, 0, len(trainings))
+	for _, tr := range trainings {
+		userUUIDs = append(userUUIDs, tr.UserUUID)
+	}
+
+	names := n.lookup(ctx, userUUIDs)
+	for i := range trainings {
+		if name, ok := names[trainings[i].UserUUID]; ok {
+			trainings[i].User = name
+		}
+	}
+}
+
+func (n currentUserNames) refreshSeries(ctx context.Context, series *Series) {
+	names := n.lookup(ctx, []string{series.UserUUID})
+	if name, ok := names[series.UserUUID]; ok {
+		series.User = name
+	}
+}
+
+func (n currentUserNames) refreshFeedback(ctx context.Context, feedback []Feedback) {
+	userUUIDs := make([]string, 0, len(feedback))
+	for _, f := range feedback {
+		userUUIDs = append(userUUIDs, f.UserUUID)
+	}
+
+	names := n.lookup(ctx, userUUIDs)
+	for i := range feedback {
+		if name, ok := names[feedback[i].UserUUID]; ok {
+			feedback[i].User = name
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package query
+
+import (
+	"context"
+
+	"log/slog"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/decorator"
+)
+
+type WaitlistForUser struct {
+	User auth.User
+}
+
+type WaitlistForUserHandler decorator.QueryHandler[WaitlistForUser, []WaitlistEntry]
+
+type waitlistForUserHandler struct {
+	readModel WaitlistForUserReadModel
+}
+
+func NewWaitlistForUserHandler(
+	readModel WaitlistForUserReadModel,
+	logger *slog.Logger,
+	metricsClient decorator.MetricsClient,
+) WaitlistForUserHandler {
+	if readModel == nil {
+		panic("nil readModel")
+	}
+
+	return decorator.ApplyQueryDecorators[WaitlistForUser, []WaitlistEntry](
+		waitlistForUserHandler{readModel: readModel},
+		logger,
+		metricsClient,
+	)
+}
+
+type WaitlistForUserReadModel interface {
+	WaitlistForUser(ctx context.Context, userUUID string) ([]WaitlistEntry, error)
+}
+
+func (h waitlistForUserHandler) Handle(ctx context.Context, query WaitlistForUser) ([]WaitlistEntry, error) {
+	return h.readModel.WaitlistForUser(ctx, query.User.UUID)
+}
@@ -0,0 +1,147 @@
+package training
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Attendance is enum-like type describing what happened with the training after it took place.
+type Attendance struct {
+	s string
+}
+
+func (a Attendance) IsZero() bool {
+	return a == Attendance{}
+}
+
+func (a Attendance) String() string {
+	return a.s
+}
+
+var (
+	Attended = Attendance{"attended"}
+	NoShow   = Attendance{"no_show"}
+	// Completed is set automatically when the trainer didn't record attendance within the grace period.
+	Completed = Attendance{"completed"}
+)
+
+var ErrInvalidAttendance = errors.New("invalid attendance")
+
+func NewAttendanceFromString(attendance string) (Attendance, error) {
+	switch attendance {
+	case "attended":
+		return Attended, nil
+	case "no_show":
+		return NoShow, nil
+	case "completed":
+		return Completed, nil
+	}
+
+	return Attendance{}, fmt.Errorf("%w: %s", ErrInvalidAttendance, attendance)
+}
+
+func (t Training) EndTime() time.Time {
+	return t.time.Add(t.duration)
+}
+
+func (t Training) Attendance() Attendance {
+	return t.attendance
+}
+
+func (t Training) IsAttendanceRecorded() bool {
+	return !t.attendance.IsZero()
+}
+
+var (
+	ErrOnlyTrainerCanRecordAttendance = errors.New("only trainer can record attendance")
+	ErrAttendanceAlreadyRecorded      = errors.New("attendance is already recorded")
+	ErrCanceledTrainingAttendance     = errors.New("can't record attendance of canceled training")
+	ErrTrainingNotStarted             = errors.New("training has not started yet")
+	ErrAttendanceGracePeriodNotOver   = errors.New("attendance grace period is not over yet")
+)
+
+// RecordAttendance is used by the trainer to mark the training as attended or no-show.
+func (t *Training) RecordAttendance(attendance Attendance, recordedBy UserType) error {
+	if recordedBy != Trainer {
+		return ErrOnlyTrainerCanRecordAttendance
+	}
+	if attendance != Attended && attendance != NoShow {
+		return fmt.Errorf("%w: %s", ErrInvalidAttendance, attendance.String())
+	}
+	if err := t.canRecordAttendance(); err != nil {
+		return err
+	}
+	if time.Now().Before(t.time) {
+		return ErrTrainingNotStarted
+	}
+
+	t.attendance = attendance
+	return nil
+}
+
+// Complete marks the training as completed when nothing was recorded by the trainer within gracePeriod
+// after the training ended.
+func (t *Training) Complete(gracePeriod time.Duration) error {
+	if err := t.canRecordAttendance(); err != nil {
+		return err
+	}
+	if time.Now().Before(t.EndTime().Add(gracePeriod)) {
+		return ErrAttendanceGracePeriodNotOver
+	}
+
+	t.attendance = Completed
+	return nil
+}
+
+func (t Training) canRecordAttendance() error {
+	if t.IsCanceled() {
+		return ErrCanceledTrainingAttendance
+	}
+	if t.IsAttendanceRecorded() {
+		return ErrAttendanceAlreadyRecorded
+	}
+
+	return nil
+}
+
+// AttendancePolicy describes balance consequences of the recorded attendance.
+type AttendancePolicy struct {
+	GracePeriod time.Duration
+
+	// NoShowPenalty is the number of credits taken from the attendee who didn't show up.
+	NoShowPenalty int
+	// AttendedBonus is the number of credits given back to the attendee who showed up.
+	AttendedBonus int
+}
+
+func (p AttendancePolicy) Validate() error {
+	if p.GracePeriod < 0 {
+		return fmt.Errorf("GracePeriod can't be negative, but is %s", p.GracePeriod)
+	}
+	if p.NoShowPenalty < 0 {
+		return fmt.Errorf("NoShowPenalty can't be negative, but is %d", p.NoShowPenalty)
+	}
+	if p.AttendedBonus < 0 {
+		return fmt.Errorf("AttendedBonus can't be negative, but is %d", p.AttendedBonus)
+	}
+
+	return nil
+}
+
+// CompletableEndedBefore returns the time before which not recorded trainings have to end to be completed.
+func (p AttendancePolicy) CompletableEndedBefore(now time.Time) time.Time {
+	return now.Add(-p.GracePeriod)
+}
+
+// BalanceDelta returns trainings balance delta that should be adjusted after recording attendance.
+func (p AttendancePolicy) BalanceDelta(attendance Attendance) int {
+	switch attendance {
+	case Attended:
+		return p.AttendedBonus
+	case NoShow:
+		return -p.NoShowPenalty
+	default:
+		return 0
+	}
+}
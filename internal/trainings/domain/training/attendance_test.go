@@ -0,0 +1,188 @@
+package training_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestTraining_RecordAttendance(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name       string
+		Attendance training.Attendance
+	}{
+		{
+			Name:       "attended",
+			Attendance: training.Attended,
+		},
+		{
+			Name:       "no_show",
+			Attendance: training.NoShow,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := newExampleTrainingWithTime(t, time.Now().Add(-2*time.Hour))
+
+			// it's always a good idea to ensure about pre-conditions in the test ;-)
+			assert.False(t, tr.IsAttendanceRecorded())
+
+			err := tr.RecordAttendance(c.Attendance, training.Trainer)
+			require.NoError(t, err)
+
+			assert.True(t, tr.IsAttendanceRecorded())
+			assert.Equal(t, c.Attendance, tr.Attendance())
+		})
+	}
+}
+
+func TestTraining_RecordAttendance_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                string
+		TrainingConstructor func(t *testing.T) *training.Training
+		Attendance          training.Attendance
+		RecordedBy          training.UserType
+		ExpectedErr         error
+	}{
+		{
+			Name: "by_attendee",
+			TrainingConstructor: func(t *testing.T) *training.Training {
+				return newExampleTrainingWithTime(t, time.Now().Add(-2*time.Hour))
+			},
+			Attendance:  training.Attended,
+			RecordedBy:  training.Attendee,
+			ExpectedErr: training.ErrOnlyTrainerCanRecordAttendance,
+		},
+		{
+			Name: "future_training",
+			TrainingConstructor: func(t *testing.T) *training.Training {
+				return newExampleTrainingWithTime(t, time.Now().Add(2*time.Hour))
+			},
+			Attendance:  training.NoShow,
+			RecordedBy:  training.Trainer,
+			ExpectedErr: training.ErrTrainingNotStarted,
+		},
+		{
+			Name: "canceled_training",
+			TrainingConstructor: func(t *testing.T) *training.Training {
+				tr := newExampleTrainingWithTime(t, time.Now().Add(-2*time.Hour))
+				require.NoError(t, tr.Cancel(training.Attendee))
+				return tr
+			},
+			Attendance:  training.Attended,
+			RecordedBy:  training.Trainer,
+			ExpectedErr: training.ErrCanceledTrainingAttendance,
+		},
+		{
+			Name: "already_recorded",
+			TrainingConstructor: func(t *testing.T) *training.Training {
+				tr := newExampleTrainingWithTime(t, time.Now().Add(-2*time.Hour))
+				require.NoError(t, tr.RecordAttendance(training.Attended, training.Trainer))
+				return tr
+			},
+			Attendance:  training.NoShow,
+			RecordedBy:  training.Trainer,
+			ExpectedErr: training.ErrAttendanceAlreadyRecorded,
+		},
+		{
+			Name: "completed_can_be_set_only_automatically",
+			TrainingConstructor: func(t *testing.T) *training.Training {
+				return newExampleTrainingWithTime(t, time.Now().Add(-2*time.Hour))
+			},
+			Attendance:  training.Completed,
+			RecordedBy:  training.Trainer,
+			ExpectedErr: training.ErrInvalidAttendance,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := c.TrainingConstructor(t)
+			attendanceBefore := tr.Attendance()
+
+			err := tr.RecordAttendance(c.Attendance, c.RecordedBy)
+			assert.ErrorIs(t, err, c.ExpectedErr)
+			assert.Equal(t, attendanceBefore, tr.Attendance())
+		})
+	}
+}
+
+func TestTraining_Complete(t *testing.T) {
+	t.Parallel()
+
+	gracePeriod := 24 * time.Hour
+
+	tr := newExampleTrainingWithTime(t, time.Now().Add(-gracePeriod).Add(-2*time.Hour))
+
+	err := tr.Complete(gracePeriod)
+	require.NoError(t, err)
+
+	assert.Equal(t, training.Completed, tr.Attendance())
+}
+
+func TestTraining_Complete_grace_period_not_over(t *testing.T) {
+	t.Parallel()
+
+	gracePeriod := 24 * time.Hour
+
+	tr := newExampleTrainingWithTime(t, time.Now().Add(-2*time.Hour))
+
+	err := tr.Complete(gracePeriod)
+	assert.EqualError(t, err, training.ErrAttendanceGracePeriodNotOver.Error())
+	assert.False(t, tr.IsAttendanceRecorded())
+}
+
+func TestTraining_Complete_shorter_session(t *testing.T) {
+	t.Parallel()
+
+	gracePeriod := time.Hour
+
+	st, err := training.NewSessionType("nutrition-consult", "Nutrition consult", "", 30*time.Minute, 2)
+	require.NoError(t, err)
+
+	// the grace period is counted from the end of the session, not of the hour
+	tr := newExampleTrainingWithTime(t, time.Now().Add(-gracePeriod).Add(-45*time.Minute))
+	require.NoError(t, tr.BookSessionType(*st))
+
+	require.NoError(t, tr.Complete(gracePeriod))
+	assert.Equal(t, training.Completed, tr.Attendance())
+}
+
+func TestTraining_Complete_already_recorded(t *testing.T) {
+	t.Parallel()
+
+	tr := newExampleTrainingWithTime(t, time.Now().AddDate(0, 0, -5))
+	require.NoError(t, tr.RecordAttendance(training.NoShow, training.Trainer))
+
+	err := tr.Complete(time.Hour)
+	assert.EqualError(t, err, training.ErrAttendanceAlreadyRecorded.Error())
+	assert.Equal(t, training.NoShow, tr.Attendance())
+}
+
+func TestAttendancePolicy_BalanceDelta(t *testing.T) {
+	t.Parallel()
+
+	policy := training.AttendancePolicy{
+		GracePeriod:   24 * time.Hour,
+		NoShowPenalty: 2,
+		AttendedBonus: 1,
+	}
+
+	assert.Equal(t, 1, policy.BalanceDelta(training.Attended))
+	assert.Equal(t, -2, policy.BalanceDelta(training.NoShow))
+	assert.Equal(t, 0, policy.BalanceDelta(training.Completed))
+}
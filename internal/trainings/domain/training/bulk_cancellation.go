@@ -0,0 +1,331 @@
+package training
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MaxBulkCancellationRange limits how many trainings can be canceled at once.
+const MaxBulkCancellationRange = 31 * 24 * time.Hour
+
+type BulkCancellationItemStatus struct {
+	s string
+}
+
+func (s BulkCancellationItemStatus) IsZero() bool {
+	return s == BulkCancellationItemStatus{}
+}
+
+func (s BulkCancellationItemStatus) String() string {
+	return s.s
+}
+
+var (
+	// BulkCancellationItemPending is a training which was not canceled yet.
+	BulkCancellationItemPending = BulkCancellationItemStatus{"pending"}
+	// BulkCancellationItemCanceled is a training canceled by the bulk cancellation.
+	BulkCancellationItemCanceled = BulkCancellationItemStatus{"canceled"}
+	// BulkCancellationItemAlreadyCanceled is a training canceled in the meantime, for example by the attendee.
+	BulkCancellationItemAlreadyCanceled = BulkCancellationItemStatus{"already-canceled"}
+)
+
+func NewBulkCancellationItemStatusFromString(status string) (BulkCancellationItemStatus, error) {
+	switch status {
+	case "pending":
+		return BulkCancellationItemPending, nil
+	case "canceled":
+		return BulkCancellationItemCanceled, nil
+	case "already-canceled":
+		return BulkCancellationItemAlreadyCanceled, nil
+	}
+
+	return BulkCancellationItemStatus{}, fmt.Errorf("unknown bulk cancellation item status: %s", status)
+}
+
+// BulkCancellationItem is the progress of canceling a single training.
+type BulkCancellationItem struct {
+	trainingUUID string
+	status       BulkCancellationItemStatus
+
+	trainingTime time.Time
+	attendeeUUID string
+	balanceDelta int
+
+	hourUnavailable bool
+}
+
+// UnmarshalBulkCancellationItemFromDatabase unmarshals BulkCancellationItem from the database.
+//
+// It should be used only for unmarshalling from the database!
+func UnmarshalBulkCancellationItemFromDatabase(
+	trainingUUID string,
+	status BulkCancellationItemStatus,
+	trainingTime time.Time,
+	attendeeUUID string,
+	balanceDelta int,
+	hourUnavailable bool,
+) BulkCancellationItem {
+	return BulkCancellationItem{
+		trainingUUID:    trainingUUID,
+		status:          status,
+		trainingTime:    trainingTime,
+		attendeeUUID:    attendeeUUID,
+		balanceDelta:    balanceDelta,
+		hourUnavailable: hourUnavailable,
+	}
+}
+
+func (i BulkCancellationItem) TrainingUUID() string {
+	return i.trainingUUID
+}
+
+func (i BulkCancellationItem) Status() BulkCancellationItemStatus {
+	return i.status
+}
+
+// TrainingTime returns the time of the training, it's zero while the item is pending.
+func (i BulkCancellationItem) TrainingTime() time.Time {
+	return i.trainingTime
+}
+
+// AttendeeUUID returns the attendee of the training, it's empty while the item is pending.
+func (i BulkCancellationItem) AttendeeUUID() string {
+	return i.attendeeUUID
+}
+
+// BalanceDelta returns the trainings balance change of the attendee, settled when the training was canceled.
+func (i BulkCancellationItem) BalanceDelta() int {
+	return i.balanceDelta
+}
+
+// IsHourUnavailable returns true when the hour of the training was blocked in the trainer's calendar.
+func (i BulkCancellationItem) IsHourUnavailable() bool {
+	return i.hourUnavailable
+}
+
+func (i BulkCancellationItem) isDone() bool {
+	return i.status != BulkCancellationItemPending && i.hourUnavailable
+}
+
+// BulkCancellation cancels all trainings in the time range on the trainer's request, for example when they are sick.
+// Progress of every training is recorded, so a bulk cancellation which failed midway can be resumed
+// without refunding anybody twice.
+type BulkCancellation struct {
+	uuid        string
+	trainerUUID string
+
+	from time.Time
+	to   time.Time
+
+	items []BulkCancellationItem
+
+	rangeBlocked bool
+	completed    bool
+}
+
+var (
+	ErrInvalidBulkCancellationRange   = errors.New("bulk cancellation range should end after it starts")
+	ErrBulkCancellationRangeTooLong   = fmt.Errorf("bulk cancellation range can't be longer than %s", MaxBulkCancellationRange)
+	ErrBulkCancellationCompleted      = errors.New("bulk cancellation is already completed")
+	ErrBulkCancellationNotFinished    = errors.New("not all hours and trainings of the bulk cancellation are finished")
+	ErrBulkCancellationItemNotFound   = errors.New("training is not part of the bulk cancellation")
+	ErrBulkCancellationItemNotPending = errors.New("training of the bulk cancellation is not pending")
+)
+
+func NewBulkCancellation(uuid string, trainerUUID string, from time.Time, to time.Time) (*BulkCancellation, error) {
+	if uuid == "" {
+		return nil, errors.New("empty bulk cancellation uuid")
+	}
+	if trainerUUID == "" {
+		return nil, errors.New("empty trainerUUID")
+	}
+	if from.IsZero() || to.IsZero() || !to.After(from) {
+		return nil, ErrInvalidBulkCancellationRange
+	}
+	if to.Sub(from) > MaxBulkCancellationRange {
+		return nil, ErrBulkCancellationRangeTooLong
+	}
+
+	return &BulkCancellation{
+		uuid:        uuid,
+		trainerUUID: trainerUUID,
+		from:        from,
+		to:          to,
+	}, nil
+}
+
+// UnmarshalBulkCancellationFromDatabase unmarshals BulkCancellation from the database.
+//
+// It should be used only for unmarshalling from the database!
+// You can't use UnmarshalBulkCancellationFromDatabase as constructor - It may put domain into the invalid state!
+func UnmarshalBulkCancellationFromDatabase(
+	uuid string,
+	trainerUUID string,
+	from time.Time,
+	to time.Time,
+	items []BulkCancellationItem,
+	rangeBlocked bool,
+	completed bool,
+) *BulkCancellation {
+	return &BulkCancellation{
+		uuid:         uuid,
+		trainerUUID:  trainerUUID,
+		from:         from,
+		to:           to,
+		items:        items,
+		rangeBlocked: rangeBlocked,
+		completed:    completed,
+	}
+}
+
+func (b BulkCancellation) UUID() string {
+	return b.uuid
+}
+
+func (b BulkCancellation) TrainerUUID() string {
+	return b.trainerUUID
+}
+
+func (b BulkCancellation) From() time.Time {
+	return b.from
+}
+
+func (b BulkCancellation) To() time.Time {
+	return b.to
+}
+
+func (b BulkCancellation) Items() []BulkCancellationItem {
+	items := make([]BulkCancellationItem, len(b.items))
+	copy(items, b.items)
+	return items
+}
+
+// IsRangeBlocked returns true when all free hours in the range were blocked in the trainer's calendar.
+func (b BulkCancellation) IsRangeBlocked() bool {
+	return b.rangeBlocked
+}
+
+func (b BulkCancellation) IsCompleted() bool {
+	return b.completed
+}
+
+// MarkRangeBlocked records that all free hours in the range were blocked in the trainer's calendar,
+// so nobody books them while the trainings are canceled.
+func (b *BulkCancellation) MarkRangeBlocked() error {
+	if b.completed {
+		return ErrBulkCancellationCompleted
+	}
+
+	b.rangeBlocked = true
+
+	return nil
+}
+
+// AddTrainings adds trainings to cancel, trainings which are already part of the bulk cancellation are ignored.
+// It's called on every run, so trainings booked in the range before the cancellation was resumed are canceled too.
+func (b *BulkCancellation) AddTrainings(trainingUUIDs ...string) error {
+	if b.completed {
+		return ErrBulkCancellationCompleted
+	}
+
+	for _, trainingUUID := range trainingUUIDs {
+		if _, ok := b.findItem(trainingUUID); ok {
+			continue
+		}
+
+		b.items = append(b.items, BulkCancellationItem{
+			trainingUUID: trainingUUID,
+			status:       BulkCancellationItemPending,
+		})
+	}
+
+	return nil
+}
+
+// UnfinishedItems returns trainings which are not canceled or whose hour is not blocked yet.
+func (b BulkCancellation) UnfinishedItems() []BulkCancellationItem {
+	var items []BulkCancellationItem
+	for _, item := range b.items {
+		if !item.isDone() {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// MarkTrainingCanceled records the training canceled by the bulk cancellation, with the balance change of the attendee.
+func (b *BulkCancellation) MarkTrainingCanceled(tr Training, balanceDelta int) error {
+	return b.markTraining(tr, BulkCancellationItemCanceled, balanceDelta)
+}
+
+// MarkTrainingAlreadyCanceled records the training canceled before the bulk cancellation got to it.
+// Its hour is still blocked, so nobody books the freed hour.
+func (b *BulkCancellation) MarkTrainingAlreadyCanceled(tr Training) error {
+	return b.markTraining(tr, BulkCancellationItemAlreadyCanceled, 0)
+}
+
+func (b *BulkCancellation) markTraining(tr Training, status BulkCancellationItemStatus, balanceDelta int) error {
+	if b.completed {
+		return ErrBulkCancellationCompleted
+	}
+
+	i, ok := b.findItem(tr.UUID())
+	if !ok {
+		return ErrBulkCancellationItemNotFound
+	}
+	if b.items[i].status != BulkCancellationItemPending {
+		return ErrBulkCancellationItemNotPending
+	}
+
+	b.items[i].status = status
+	b.items[i].trainingTime = tr.Time()
+	b.items[i].attendeeUUID = tr.UserUUID()
+	b.items[i].balanceDelta = balanceDelta
+
+	return nil
+}
+
+// MarkHourUnavailable records that the hour of the canceled training was blocked in the trainer's calendar.
+func (b *BulkCancellation) MarkHourUnavailable(trainingUUID string) error {
+	if b.completed {
+		return ErrBulkCancellationCompleted
+	}
+
+	i, ok := b.findItem(trainingUUID)
+	if !ok {
+		return ErrBulkCancellationItemNotFound
+	}
+	if b.items[i].status == BulkCancellationItemPending {
+		return errors.New("hour can't be blocked before the training is canceled")
+	}
+
+	b.items[i].hourUnavailable = true
+
+	return nil
+}
+
+// Complete finishes the bulk cancellation, when the range and all its trainings are canceled and their hours are blocked.
+func (b *BulkCancellation) Complete() error {
+	if b.completed {
+		return ErrBulkCancellationCompleted
+	}
+	if !b.rangeBlocked || len(b.UnfinishedItems()) > 0 {
+		return ErrBulkCancellationNotFinished
+	}
+
+	b.completed = true
+
+	return nil
+}
+
+func (b BulkCancellation) findItem(trainingUUID string) (int, bool) {
+	for i, item := range b.items {
+		if item.trainingUUID == trainingUUID {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
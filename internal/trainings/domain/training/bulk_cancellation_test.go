@@ -0,0 +1,81 @@
+package training_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestNewBulkCancellation_invalid_range(t *testing.T) {
+	t.Parallel()
+	from := time.Now().Round(time.Hour)
+
+	_, err := training.NewBulkCancellation(uuid.New().String(), uuid.New().String(), from, from)
+	assert.ErrorIs(t, err, training.ErrInvalidBulkCancellationRange)
+
+	_, err = training.NewBulkCancellation(uuid.New().String(), uuid.New().String(), from, from.Add(training.MaxBulkCancellationRange+time.Hour))
+	assert.ErrorIs(t, err, training.ErrBulkCancellationRangeTooLong)
+}
+
+func TestBulkCancellation(t *testing.T) {
+	t.Parallel()
+	b := newExampleBulkCancellation(t)
+
+	canceledTraining := newExampleTraining(t)
+	alreadyCanceledTraining := newCanceledTraining(t)
+
+	require.NoError(t, b.AddTrainings(canceledTraining.UUID(), alreadyCanceledTraining.UUID()))
+	// trainings found again on resume are not duplicated
+	require.NoError(t, b.AddTrainings(canceledTraining.UUID()))
+	require.Len(t, b.Items(), 2)
+
+	require.NoError(t, b.MarkTrainingCanceled(*canceledTraining, 1))
+	require.NoError(t, b.MarkTrainingAlreadyCanceled(*alreadyCanceledTraining))
+	assert.ErrorIs(t, b.MarkTrainingCanceled(*canceledTraining, 1), training.ErrBulkCancellationItemNotPending)
+
+	item := b.Items()[0]
+	assert.Equal(t, training.BulkCancellationItemCanceled, item.Status())
+	assert.Equal(t, canceledTraining.UserUUID(), item.AttendeeUUID())
+	assert.True(t, canceledTraining.Time().Equal(item.TrainingTime()))
+	assert.Equal(t, 1, item.BalanceDelta())
+
+	// hours are not blocked yet
+	assert.Len(t, b.UnfinishedItems(), 2)
+	assert.ErrorIs(t, b.Complete(), training.ErrBulkCancellationNotFinished)
+
+	require.NoError(t, b.MarkHourUnavailable(canceledTraining.UUID()))
+	require.NoError(t, b.MarkHourUnavailable(alreadyCanceledTraining.UUID()))
+	assert.Empty(t, b.UnfinishedItems())
+
+	// free hours of the range are not blocked yet
+	assert.ErrorIs(t, b.Complete(), training.ErrBulkCancellationNotFinished)
+
+	require.NoError(t, b.MarkRangeBlocked())
+	require.NoError(t, b.Complete())
+	assert.True(t, b.IsCompleted())
+	assert.ErrorIs(t, b.AddTrainings(uuid.New().String()), training.ErrBulkCancellationCompleted)
+}
+
+func TestBulkCancellation_MarkHourUnavailable_pending(t *testing.T) {
+	t.Parallel()
+	b := newExampleBulkCancellation(t)
+	tr := newExampleTraining(t)
+
+	require.NoError(t, b.AddTrainings(tr.UUID()))
+
+	assert.Error(t, b.MarkHourUnavailable(tr.UUID()))
+	assert.ErrorIs(t, b.MarkHourUnavailable(uuid.New().String()), training.ErrBulkCancellationItemNotFound)
+}
+
+func newExampleBulkCancellation(t *testing.T) *training.BulkCancellation {
+	from := time.Now().Round(time.Hour)
+
+	b, err := training.NewBulkCancellation(uuid.New().String(), uuid.New().String(), from, from.AddDate(0, 0, 7))
+	require.NoError(t, err)
+
+	return b
+}
@@ -5,21 +5,33 @@ import (
 	"time"
 )
 
-func (t Training) CanBeCanceledForFree() bool {
-	return time.Until(t.time) >= time.Hour*24
-}
-
 var ErrTrainingAlreadyCanceled = errors.New("training is already canceled")
 
-func (t *Training) Cancel() error {
+// Cancel cancels the training, recording who canceled it and when for the cancellation reports.
+func (t *Training) Cancel(canceledBy UserType) error {
 	if t.IsCanceled() {
 		return ErrTrainingAlreadyCanceled
 	}
 
 	t.canceled = true
+	t.canceledBy = canceledBy
+	t.canceledAt = time.Now()
+	// canceled training can't be moved anymore
+	t.clearRescheduleProposal()
+
 	return nil
 }
 
 func (t Training) IsCanceled() bool {
 	return t.canceled
 }
+
+// CanceledBy returns the type of the user who canceled the training.
+// It's zero for trainings which are not canceled or were canceled before it was recorded.
+func (t Training) CanceledBy() UserType {
+	return t.canceledBy
+}
+
+func (t Training) CanceledAt() time.Time {
+	return t.canceledAt
+}
@@ -2,6 +2,7 @@ package training_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,14 +15,16 @@ func TestTraining_Cancel(t *testing.T) {
 	// it's always a good idea to ensure about pre-conditions in the test ;-)
 	assert.False(t, tr.IsCanceled())
 
-	err := tr.Cancel()
+	err := tr.Cancel(training.Trainer)
 	require.NoError(t, err)
 	assert.True(t, tr.IsCanceled())
+	assert.Equal(t, training.Trainer, tr.CanceledBy())
+	assert.WithinDuration(t, time.Now(), tr.CanceledAt(), time.Second)
 }
 
 func TestTraining_Cancel_already_canceled(t *testing.T) {
 	t.Parallel()
 	tr := newCanceledTraining(t)
 
-	assert.EqualError(t, tr.Cancel(), training.ErrTrainingAlreadyCanceled.Error())
+	assert.EqualError(t, tr.Cancel(training.Attendee), training.ErrTrainingAlreadyCanceled.Error())
 }
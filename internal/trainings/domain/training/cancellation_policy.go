@@ -0,0 +1,250 @@
+package training
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DefaultCancellationPolicyVersion is the version of DefaultCancellationPolicy.
+// Trainings booked before policies were versioned are judged by it.
+const DefaultCancellationPolicyVersion = "v1"
+
+// CancellationTier applies when the training is canceled at least MinNotice before it starts.
+// Refunds which are not whole credits are rounded up in favor of the attendee,
+// so a tier with a refund never returns nothing, even for a training priced at a single credit.
+type CancellationTier struct {
+	MinNotice time.Duration
+
+	// AttendeeRefundPercent is the part of the training price returned when the attendee cancels.
+	AttendeeRefundPercent int
+	// TrainerRefundPercent is the part of the training price returned when the trainer cancels,
+	// values above 100 compensate the attendee for a late cancellation.
+	TrainerRefundPercent int
+}
+
+// CancellationPolicy decides how much of the training price is refunded on cancellation,
+// depending on how long before the training it was canceled and who canceled it.
+type CancellationPolicy struct {
+	version string
+
+	// tiers are sorted by MinNotice, from the longest
+	tiers []CancellationTier
+
+	sessionTypeTiers map[string][]CancellationTier
+}
+
+var ErrInvalidCancellationPolicy = errors.New("invalid cancellation policy")
+
+func NewCancellationPolicy(
+	version string,
+	tiers []CancellationTier,
+	sessionTypeOverrides map[string][]CancellationTier,
+) (CancellationPolicy, error) {
+	if version == "" {
+		return CancellationPolicy{}, fmt.Errorf("%w: empty version", ErrInvalidCancellationPolicy)
+	}
+
+	sortedTiers, err := sortCancellationTiers(tiers)
+	if err != nil {
+		return CancellationPolicy{}, fmt.Errorf("%w %s: %w", ErrInvalidCancellationPolicy, version, err)
+	}
+
+	sessionTypeTiers := make(map[string][]CancellationTier, len(sessionTypeOverrides))
+	for sessionType, overrideTiers := range sessionTypeOverrides {
+		sortedOverrideTiers, err := sortCancellationTiers(overrideTiers)
+		if err != nil {
+			return CancellationPolicy{}, fmt.Errorf(
+				"%w %s, session type %s: %w", ErrInvalidCancellationPolicy, version, sessionType, err,
+			)
+		}
+		sessionTypeTiers[sessionType] = sortedOverrideTiers
+	}
+
+	return CancellationPolicy{
+		version:          version,
+		tiers:            sortedTiers,
+		sessionTypeTiers: sessionTypeTiers,
+	}, nil
+}
+
+func sortCancellationTiers(tiers []CancellationTier) ([]CancellationTier, error) {
+	if len(tiers) == 0 {
+		return nil, errors.New("no tiers")
+	}
+
+	sorted := make([]CancellationTier, len(tiers))
+	copy(sorted, tiers)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].MinNotice > sorted[j].MinNotice
+	})
+
+	for i, tier := range sorted {
+		if tier.MinNotice < 0 {
+			return nil, fmt.Errorf("negative min notice %s", tier.MinNotice)
+		}
+		if tier.AttendeeRefundPercent < 0 || tier.TrainerRefundPercent < 0 {
+			return nil, fmt.Errorf("negative refund in tier with min notice %s", tier.MinNotice)
+		}
+		if i > 0 && sorted[i-1].MinNotice == tier.MinNotice {
+			return nil, fmt.Errorf("duplicated tier with min notice %s", tier.MinNotice)
+		}
+	}
+
+	if sorted[len(sorted)-1].MinNotice != 0 {
+		return nil, errors.New("missing tier with zero min notice")
+	}
+
+	return sorted, nil
+}
+
+// DefaultCancellationPolicy is a full refund when canceled at least 24h before the training.
+// Later, the attendee gets nothing back, and the trainer has to return an extra credit as a fine.
+func DefaultCancellationPolicy() CancellationPolicy {
+	policy, err := NewCancellationPolicy(
+		DefaultCancellationPolicyVersion,
+		[]CancellationTier{
+			{MinNotice: 24 * time.Hour, AttendeeRefundPercent: 100, TrainerRefundPercent: 100},
+			{MinNotice: 0, AttendeeRefundPercent: 0, TrainerRefundPercent: 200},
+		},
+		nil,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return policy
+}
+
+func (p CancellationPolicy) Version() string {
+	return p.version
+}
+
+// ForSessionType returns the policy with tiers overridden for the session type, if there are any.
+// Session types with tiers of their own are not judged by it, see SessionType.CancellationTiers.
+func (p CancellationPolicy) ForSessionType(sessionType string) CancellationPolicy {
+	tiers, ok := p.sessionTypeTiers[sessionType]
+	if !ok {
+		return p
+	}
+
+	return p.withTiers(tiers)
+}
+
+func (p CancellationPolicy) withTiers(tiers []CancellationTier) CancellationPolicy {
+	return CancellationPolicy{
+		version:          p.version,
+		tiers:            tiers,
+		sessionTypeTiers: p.sessionTypeTiers,
+	}
+}
+
+// Tiers returns the tiers sorted by MinNotice, from the longest.
+func (p CancellationPolicy) Tiers() []CancellationTier {
+	return copyCancellationTiers(p.tiers)
+}
+
+func copyCancellationTiers(tiers []CancellationTier) []CancellationTier {
+	if len(tiers) == 0 {
+		return nil
+	}
+
+	copied := make([]CancellationTier, len(tiers))
+	copy(copied, tiers)
+
+	return copied
+}
+
+func (p CancellationPolicy) tier(notice time.Duration) CancellationTier {
+	for _, tier := range p.tiers {
+		if notice >= tier.MinNotice {
+			return tier
+		}
+	}
+
+	// canceling after the training started is judged as the latest possible cancellation
+	return p.tiers[len(p.tiers)-1]
+}
+
+// CanBeCanceledForFree returns true when the attendee would get the full training price back,
+// also when a partial refund is rounded up to the whole price.
+func (p CancellationPolicy) CanBeCanceledForFree(tr Training) bool {
+	return refund(tr.Price(), p.tier(time.Until(tr.Time())).AttendeeRefundPercent) >= tr.Price()
+}
+
+// CancelBalanceDelta return trainings balance delta that should be adjusted after training cancellation.
+func (p CancellationPolicy) CancelBalanceDelta(tr Training, cancelingUserType UserType) int {
+	tier := p.tier(time.Until(tr.Time()))
+
+	switch cancelingUserType {
+	case Trainer:
+		return refund(tr.Price(), tier.TrainerRefundPercent)
+	case Attendee:
+		return refund(tr.Price(), tier.AttendeeRefundPercent)
+	default:
+		panic(fmt.Sprintf("not supported user type %s", cancelingUserType))
+	}
+}
+
+// refund returns the percent of the price, rounded up to whole credits.
+func refund(price int, percent int) int {
+	return (price*percent + 99) / 100
+}
+
+// CancellationPolicies holds all versions of the cancellation policy,
+// so every training is judged by the policy in force when it was booked.
+type CancellationPolicies struct {
+	currentVersion string
+	policies       map[string]CancellationPolicy
+}
+
+var ErrUnknownCancellationPolicy = errors.New("unknown cancellation policy version")
+
+func NewCancellationPolicies(currentVersion string, policies ...CancellationPolicy) (CancellationPolicies, error) {
+	byVersion := make(map[string]CancellationPolicy, len(policies))
+	for _, policy := range policies {
+		if _, ok := byVersion[policy.Version()]; ok {
+			return CancellationPolicies{}, fmt.Errorf(
+				"%w: version %s is defined more than once", ErrInvalidCancellationPolicy, policy.Version(),
+			)
+		}
+		byVersion[policy.Version()] = policy
+	}
+
+	if _, ok := byVersion[currentVersion]; !ok {
+		return CancellationPolicies{}, fmt.Errorf("%w: %s", ErrUnknownCancellationPolicy, currentVersion)
+	}
+
+	return CancellationPolicies{currentVersion: currentVersion, policies: byVersion}, nil
+}
+
+// Current returns the policy for newly booked trainings.
+func (p CancellationPolicies) Current() CancellationPolicy {
+	return p.policies[p.currentVersion]
+}
+
+// ForTraining returns the policy the training was booked under, with tiers of its session type.
+// Tiers the training was booked with take precedence over the overrides of the policy,
+// which apply to trainings booked before session types had tiers of their own.
+func (p CancellationPolicies) ForTraining(tr Training) (CancellationPolicy, error) {
+	policy, ok := p.policies[tr.CancellationPolicyVersion()]
+	if !ok {
+		return CancellationPolicy{}, fmt.Errorf("%w: %s", ErrUnknownCancellationPolicy, tr.CancellationPolicyVersion())
+	}
+
+	if len(tr.cancellationTiers) > 0 {
+		return policy.withTiers(tr.cancellationTiers), nil
+	}
+
+	return policy.ForSessionType(tr.SessionType()), nil
+}
+
+func (t Training) CancellationPolicyVersion() string {
+	return t.cancellationPolicyVersion
+}
+
+// BookUnderCancellationPolicy records the policy in force when the training is booked.
+func (t *Training) BookUnderCancellationPolicy(policy CancellationPolicy) {
+	t.cancellationPolicyVersion = policy.Version()
+}
@@ -0,0 +1,275 @@
+package training_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestDefaultCancellationPolicy_CancelBalanceDelta(t *testing.T) {
+	t.Parallel()
+
+	policy := training.DefaultCancellationPolicy()
+
+	testCases := []struct {
+		Name          string
+		TrainingTime  time.Time
+		UserType      training.UserType
+		ExpectedDelta int
+	}{
+		{
+			Name:          "attendee_cancels_early",
+			TrainingTime:  time.Now().Add(48 * time.Hour),
+			UserType:      training.Attendee,
+			ExpectedDelta: 1,
+		},
+		{
+			Name:          "trainer_cancels_early",
+			TrainingTime:  time.Now().Add(48 * time.Hour),
+			UserType:      training.Trainer,
+			ExpectedDelta: 1,
+		},
+		{
+			Name:          "attendee_cancels_late",
+			TrainingTime:  time.Now().Add(12 * time.Hour),
+			UserType:      training.Attendee,
+			ExpectedDelta: 0,
+		},
+		{
+			Name:          "trainer_cancels_late",
+			TrainingTime:  time.Now().Add(12 * time.Hour),
+			UserType:      training.Trainer,
+			ExpectedDelta: 2,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := newExampleTrainingWithTime(t, c.TrainingTime)
+			assert.Equal(t, c.ExpectedDelta, policy.CancelBalanceDelta(*tr, c.UserType))
+		})
+	}
+}
+
+func TestCancellationPolicy_tiers(t *testing.T) {
+	t.Parallel()
+
+	policy, err := training.NewCancellationPolicy(
+		"v2",
+		[]training.CancellationTier{
+			{MinNotice: 0, AttendeeRefundPercent: 0, TrainerRefundPercent: 200},
+			{MinNotice: 48 * time.Hour, AttendeeRefundPercent: 100, TrainerRefundPercent: 100},
+			{MinNotice: 24 * time.Hour, AttendeeRefundPercent: 50, TrainerRefundPercent: 100},
+		},
+		map[string][]training.CancellationTier{
+			"group": {
+				{MinNotice: 2 * time.Hour, AttendeeRefundPercent: 100, TrainerRefundPercent: 100},
+				{MinNotice: 0, AttendeeRefundPercent: 0, TrainerRefundPercent: 100},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	inThreeDays := newExampleTrainingWithTime(t, time.Now().Add(72*time.Hour))
+	assert.True(t, policy.CanBeCanceledForFree(*inThreeDays))
+
+	// partial refund tier, 50% of a single credit is rounded up to the whole price
+	inThirtyHours := newExampleTrainingWithTime(t, time.Now().Add(30*time.Hour))
+	assert.True(t, policy.CanBeCanceledForFree(*inThirtyHours))
+	assert.Equal(t, 1, policy.CancelBalanceDelta(*inThirtyHours, training.Attendee))
+	assert.Equal(t, 1, policy.CancelBalanceDelta(*inThirtyHours, training.Trainer))
+
+	inThreeHours := newExampleTrainingWithTime(t, time.Now().Add(3*time.Hour))
+	assert.Equal(t, 2, policy.CancelBalanceDelta(*inThreeHours, training.Trainer))
+
+	groupPolicy := policy.ForSessionType("group")
+	assert.Equal(t, "v2", groupPolicy.Version())
+	assert.True(t, groupPolicy.CanBeCanceledForFree(*inThreeHours))
+	assert.Equal(t, 1, groupPolicy.CancelBalanceDelta(*inThreeHours, training.Trainer))
+
+	assert.Equal(t, policy, policy.ForSessionType("unknown"))
+}
+
+func TestCancellationPolicy_CancelBalanceDelta_rounding(t *testing.T) {
+	t.Parallel()
+
+	policy, err := training.NewCancellationPolicy(
+		"v2",
+		[]training.CancellationTier{
+			{MinNotice: 24 * time.Hour, AttendeeRefundPercent: 50, TrainerRefundPercent: 150},
+			{MinNotice: 0, AttendeeRefundPercent: 25, TrainerRefundPercent: 100},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		Name          string
+		Price         int
+		Notice        time.Duration
+		UserType      training.UserType
+		ExpectedDelta int
+		// ExpectedFree is whether the attendee would get the whole price back
+		ExpectedFree bool
+	}{
+		{Name: "half_of_single_credit", Price: 1, Notice: 30 * time.Hour, UserType: training.Attendee, ExpectedDelta: 1, ExpectedFree: true},
+		{Name: "quarter_of_single_credit", Price: 1, Notice: time.Hour, UserType: training.Attendee, ExpectedDelta: 1, ExpectedFree: true},
+		{Name: "half_of_odd_price", Price: 3, Notice: 30 * time.Hour, UserType: training.Attendee, ExpectedDelta: 2},
+		{Name: "whole_credits", Price: 4, Notice: 30 * time.Hour, UserType: training.Attendee, ExpectedDelta: 2},
+		{Name: "quarter_of_odd_price", Price: 5, Notice: time.Hour, UserType: training.Attendee, ExpectedDelta: 2},
+		{Name: "trainer_compensation", Price: 3, Notice: 30 * time.Hour, UserType: training.Trainer, ExpectedDelta: 5},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			st, err := training.NewSessionType("assessment", "Assessment", "", time.Hour, c.Price)
+			require.NoError(t, err)
+
+			tr := newExampleTrainingWithTime(t, time.Now().Add(c.Notice))
+			require.NoError(t, tr.BookSessionType(*st))
+
+			assert.Equal(t, c.ExpectedDelta, policy.CancelBalanceDelta(*tr, c.UserType))
+			assert.Equal(t, c.ExpectedFree, policy.CanBeCanceledForFree(*tr))
+		})
+	}
+}
+
+func TestNewCancellationPolicy_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name  string
+		Tiers []training.CancellationTier
+	}{
+		{
+			Name:  "no_tiers",
+			Tiers: nil,
+		},
+		{
+			Name: "missing_zero_notice_tier",
+			Tiers: []training.CancellationTier{
+				{MinNotice: 24 * time.Hour, AttendeeRefundPercent: 100, TrainerRefundPercent: 100},
+			},
+		},
+		{
+			Name: "duplicated_tier",
+			Tiers: []training.CancellationTier{
+				{MinNotice: 0, AttendeeRefundPercent: 100, TrainerRefundPercent: 100},
+				{MinNotice: 0, AttendeeRefundPercent: 0, TrainerRefundPercent: 100},
+			},
+		},
+		{
+			Name: "negative_refund",
+			Tiers: []training.CancellationTier{
+				{MinNotice: 0, AttendeeRefundPercent: -10, TrainerRefundPercent: 100},
+			},
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := training.NewCancellationPolicy("v2", c.Tiers, nil)
+			assert.ErrorIs(t, err, training.ErrInvalidCancellationPolicy)
+		})
+	}
+}
+
+func TestCancellationPolicies_ForTraining(t *testing.T) {
+	t.Parallel()
+
+	v2, err := training.NewCancellationPolicy(
+		"v2",
+		[]training.CancellationTier{
+			{MinNotice: 0, AttendeeRefundPercent: 100, TrainerRefundPercent: 100},
+		},
+		nil,
+	)
+	require.NoError(t, err)
+
+	policies, err := training.NewCancellationPolicies("v2", training.DefaultCancellationPolicy(), v2)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", policies.Current().Version())
+
+	// training booked before v2 was introduced is still judged by v1
+	bookedUnderV1 := newExampleTrainingWithTime(t, time.Now().Add(time.Hour))
+	policy, err := policies.ForTraining(*bookedUnderV1)
+	require.NoError(t, err)
+	assert.Equal(t, training.DefaultCancellationPolicyVersion, policy.Version())
+	assert.Equal(t, 0, policy.CancelBalanceDelta(*bookedUnderV1, training.Attendee))
+
+	bookedUnderV2 := newExampleTrainingWithTime(t, time.Now().Add(time.Hour))
+	bookedUnderV2.BookUnderCancellationPolicy(policies.Current())
+	policy, err = policies.ForTraining(*bookedUnderV2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, policy.CancelBalanceDelta(*bookedUnderV2, training.Attendee))
+}
+
+func TestCancellationPolicies_ForTraining_session_type_tiers(t *testing.T) {
+	t.Parallel()
+
+	policy, err := training.NewCancellationPolicy(
+		"v2",
+		[]training.CancellationTier{
+			{MinNotice: 0, AttendeeRefundPercent: 0, TrainerRefundPercent: 100},
+		},
+		map[string][]training.CancellationTier{
+			"assessment": {{MinNotice: 0, AttendeeRefundPercent: 50, TrainerRefundPercent: 100}},
+		},
+	)
+	require.NoError(t, err)
+	policies, err := training.NewCancellationPolicies("v2", policy)
+	require.NoError(t, err)
+
+	st, err := training.NewSessionType("assessment", "Assessment", "", time.Hour, 4)
+	require.NoError(t, err)
+
+	// without tiers of its own, the session type is judged by the overrides of the policy
+	bookedWithoutTiers := newExampleTrainingWithTime(t, time.Now().Add(time.Hour))
+	bookedWithoutTiers.BookUnderCancellationPolicy(policies.Current())
+	require.NoError(t, bookedWithoutTiers.BookSessionType(*st))
+
+	trainingPolicy, err := policies.ForTraining(*bookedWithoutTiers)
+	require.NoError(t, err)
+	assert.Equal(t, 2, trainingPolicy.CancelBalanceDelta(*bookedWithoutTiers, training.Attendee))
+
+	require.NoError(t, st.SetCancellationTiers([]training.CancellationTier{
+		{MinNotice: 0, AttendeeRefundPercent: 75, TrainerRefundPercent: 100},
+		{MinNotice: 48 * time.Hour, AttendeeRefundPercent: 100, TrainerRefundPercent: 100},
+	}))
+
+	bookedWithTiers := newExampleTrainingWithTime(t, time.Now().Add(time.Hour))
+	bookedWithTiers.BookUnderCancellationPolicy(policies.Current())
+	require.NoError(t, bookedWithTiers.BookSessionType(*st))
+
+	// tiers of the session type are kept by the booked training, even when the catalog changes
+	require.NoError(t, st.SetCancellationTiers(nil))
+	assert.Empty(t, st.CancellationTiers())
+
+	trainingPolicy, err = policies.ForTraining(*bookedWithTiers)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", trainingPolicy.Version())
+	assert.Equal(t, 3, trainingPolicy.CancelBalanceDelta(*bookedWithTiers, training.Attendee))
+	assert.Equal(t, []time.Duration{48 * time.Hour, 0}, []time.Duration{
+		trainingPolicy.Tiers()[0].MinNotice,
+		trainingPolicy.Tiers()[1].MinNotice,
+	})
+}
+
+func TestNewCancellationPolicies_unknown_current_version(t *testing.T) {
+	t.Parallel()
+
+	_, err := training.NewCancellationPolicies("v3", training.DefaultCancellationPolicy())
+	assert.ErrorIs(t, err, training.ErrUnknownCancellationPolicy)
+}
@@ -0,0 +1,136 @@
+package training
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	MinRating = 1
+	MaxRating = 5
+
+	maxFeedbackTextLength = 1000
+)
+
+// Feedback is the attendee's rating of the training, optionally answered by the trainer.
+type Feedback struct {
+	rating    int
+	comment   string
+	createdAt time.Time
+
+	reply     string
+	repliedAt time.Time
+}
+
+// UnmarshalFeedbackFromDatabase unmarshals Feedback from the database.
+//
+// It should be used only for unmarshalling from the database!
+func UnmarshalFeedbackFromDatabase(
+	rating int,
+	comment string,
+	createdAt time.Time,
+	reply string,
+	repliedAt time.Time,
+) Feedback {
+	return Feedback{
+		rating:    rating,
+		comment:   comment,
+		createdAt: createdAt,
+		reply:     reply,
+		repliedAt: repliedAt,
+	}
+}
+
+func (f Feedback) IsZero() bool {
+	return f == Feedback{}
+}
+
+func (f Feedback) Rating() int {
+	return f.rating
+}
+
+func (f Feedback) Comment() string {
+	return f.comment
+}
+
+func (f Feedback) CreatedAt() time.Time {
+	return f.createdAt
+}
+
+func (f Feedback) Reply() string {
+	return f.reply
+}
+
+func (f Feedback) RepliedAt() time.Time {
+	return f.repliedAt
+}
+
+func (f Feedback) IsReplied() bool {
+	return !f.repliedAt.IsZero()
+}
+
+func (t Training) Feedback() Feedback {
+	return t.feedback
+}
+
+var (
+	ErrInvalidRating          = fmt.Errorf("rating should be between %d and %d", MinRating, MaxRating)
+	ErrFeedbackTextTooLong    = fmt.Errorf("feedback text too long (max %d characters)", maxFeedbackTextLength)
+	ErrOnlyAttendeeCanRate    = errors.New("only attendee can rate the training")
+	ErrTrainingNotCompleted   = errors.New("only attended or completed training can be rated")
+	ErrTrainingAlreadyRated   = errors.New("training is already rated")
+	ErrOnlyTrainerCanReply    = errors.New("only trainer can reply to the feedback")
+	ErrNoFeedbackToReply      = errors.New("training has no feedback to reply to")
+	ErrFeedbackAlreadyReplied = errors.New("feedback is already replied")
+	ErrEmptyFeedbackReply     = errors.New("feedback reply can't be empty")
+)
+
+// Rate leaves attendee's feedback for the training. Training can be rated only once.
+func (t *Training) Rate(rating int, comment string, ratedBy UserType) error {
+	if ratedBy != Attendee {
+		return ErrOnlyAttendeeCanRate
+	}
+	if rating < MinRating || rating > MaxRating {
+		return ErrInvalidRating
+	}
+	if len(comment) > maxFeedbackTextLength {
+		return ErrFeedbackTextTooLong
+	}
+	if t.IsCanceled() || (t.attendance != Attended && t.attendance != Completed) {
+		return ErrTrainingNotCompleted
+	}
+	if !t.feedback.IsZero() {
+		return ErrTrainingAlreadyRated
+	}
+
+	t.feedback = Feedback{
+		rating:    rating,
+		comment:   comment,
+		createdAt: time.Now(),
+	}
+	return nil
+}
+
+// ReplyToFeedback is used by the trainer to answer the attendee's feedback.
+func (t *Training) ReplyToFeedback(reply string, repliedBy UserType) error {
+	if repliedBy != Trainer {
+		return ErrOnlyTrainerCanReply
+	}
+	if reply == "" {
+		return ErrEmptyFeedbackReply
+	}
+	if len(reply) > maxFeedbackTextLength {
+		return ErrFeedbackTextTooLong
+	}
+	if t.feedback.IsZero() {
+		return ErrNoFeedbackToReply
+	}
+	if t.feedback.IsReplied() {
+		return ErrFeedbackAlreadyReplied
+	}
+
+	t.feedback.reply = reply
+	t.feedback.repliedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,210 @@
+package training_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestTraining_Rate(t *testing.T) {
+	t.Parallel()
+
+	tr := newAttendedTraining(t)
+
+	err := tr.Rate(5, "great session", training.Attendee)
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, tr.Feedback().Rating())
+	assert.Equal(t, "great session", tr.Feedback().Comment())
+	assert.False(t, tr.Feedback().CreatedAt().IsZero())
+	assert.False(t, tr.Feedback().IsReplied())
+}
+
+func TestTraining_Rate_completed_training(t *testing.T) {
+	t.Parallel()
+
+	tr := newExampleTrainingWithTime(t, time.Now().AddDate(0, 0, -5))
+	require.NoError(t, tr.Complete(24*time.Hour))
+
+	err := tr.Rate(3, "", training.Attendee)
+	require.NoError(t, err)
+	assert.Equal(t, 3, tr.Feedback().Rating())
+}
+
+func TestTraining_Rate_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                string
+		TrainingConstructor func(t *testing.T) *training.Training
+		Rating              int
+		Comment             string
+		RatedBy             training.UserType
+		ExpectedErr         error
+	}{
+		{
+			Name:                "by_trainer",
+			TrainingConstructor: newAttendedTraining,
+			Rating:              5,
+			RatedBy:             training.Trainer,
+			ExpectedErr:         training.ErrOnlyAttendeeCanRate,
+		},
+		{
+			Name:                "rating_too_low",
+			TrainingConstructor: newAttendedTraining,
+			Rating:              0,
+			RatedBy:             training.Attendee,
+			ExpectedErr:         training.ErrInvalidRating,
+		},
+		{
+			Name:                "rating_too_high",
+			TrainingConstructor: newAttendedTraining,
+			Rating:              6,
+			RatedBy:             training.Attendee,
+			ExpectedErr:         training.ErrInvalidRating,
+		},
+		{
+			Name:                "comment_too_long",
+			TrainingConstructor: newAttendedTraining,
+			Rating:              4,
+			Comment:             strings.Repeat("x", 1001),
+			RatedBy:             training.Attendee,
+			ExpectedErr:         training.ErrFeedbackTextTooLong,
+		},
+		{
+			Name: "not_completed",
+			TrainingConstructor: func(t *testing.T) *training.Training {
+				return newExampleTrainingWithTime(t, time.Now().Add(-2*time.Hour))
+			},
+			Rating:      4,
+			RatedBy:     training.Attendee,
+			ExpectedErr: training.ErrTrainingNotCompleted,
+		},
+		{
+			Name: "no_show",
+			TrainingConstructor: func(t *testing.T) *training.Training {
+				tr := newExampleTrainingWithTime(t, time.Now().Add(-2*time.Hour))
+				require.NoError(t, tr.RecordAttendance(training.NoShow, training.Trainer))
+				return tr
+			},
+			Rating:      1,
+			RatedBy:     training.Attendee,
+			ExpectedErr: training.ErrTrainingNotCompleted,
+		},
+		{
+			Name: "already_rated",
+			TrainingConstructor: func(t *testing.T) *training.Training {
+				tr := newAttendedTraining(t)
+				require.NoError(t, tr.Rate(5, "", training.Attendee))
+				return tr
+			},
+			Rating:      1,
+			RatedBy:     training.Attendee,
+			ExpectedErr: training.ErrTrainingAlreadyRated,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := c.TrainingConstructor(t)
+			feedbackBefore := tr.Feedback()
+
+			err := tr.Rate(c.Rating, c.Comment, c.RatedBy)
+			assert.ErrorIs(t, err, c.ExpectedErr)
+			assert.Equal(t, feedbackBefore, tr.Feedback())
+		})
+	}
+}
+
+func TestTraining_ReplyToFeedback(t *testing.T) {
+	t.Parallel()
+
+	tr := newAttendedTraining(t)
+	require.NoError(t, tr.Rate(4, "good", training.Attendee))
+
+	err := tr.ReplyToFeedback("thanks!", training.Trainer)
+	require.NoError(t, err)
+
+	assert.True(t, tr.Feedback().IsReplied())
+	assert.Equal(t, "thanks!", tr.Feedback().Reply())
+	assert.Equal(t, 4, tr.Feedback().Rating())
+}
+
+func TestTraining_ReplyToFeedback_invalid(t *testing.T) {
+	t.Parallel()
+
+	ratedTraining := func(t *testing.T) *training.Training {
+		tr := newAttendedTraining(t)
+		require.NoError(t, tr.Rate(4, "good", training.Attendee))
+		return tr
+	}
+
+	testCases := []struct {
+		Name                string
+		TrainingConstructor func(t *testing.T) *training.Training
+		Reply               string
+		RepliedBy           training.UserType
+		ExpectedErr         error
+	}{
+		{
+			Name:                "by_attendee",
+			TrainingConstructor: ratedTraining,
+			Reply:               "thanks",
+			RepliedBy:           training.Attendee,
+			ExpectedErr:         training.ErrOnlyTrainerCanReply,
+		},
+		{
+			Name:                "empty_reply",
+			TrainingConstructor: ratedTraining,
+			Reply:               "",
+			RepliedBy:           training.Trainer,
+			ExpectedErr:         training.ErrEmptyFeedbackReply,
+		},
+		{
+			Name:                "not_rated",
+			TrainingConstructor: newAttendedTraining,
+			Reply:               "thanks",
+			RepliedBy:           training.Trainer,
+			ExpectedErr:         training.ErrNoFeedbackToReply,
+		},
+		{
+			Name: "already_replied",
+			TrainingConstructor: func(t *testing.T) *training.Training {
+				tr := ratedTraining(t)
+				require.NoError(t, tr.ReplyToFeedback("thanks", training.Trainer))
+				return tr
+			},
+			Reply:       "thanks again",
+			RepliedBy:   training.Trainer,
+			ExpectedErr: training.ErrFeedbackAlreadyReplied,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := c.TrainingConstructor(t)
+			feedbackBefore := tr.Feedback()
+
+			err := tr.ReplyToFeedback(c.Reply, c.RepliedBy)
+			assert.ErrorIs(t, err, c.ExpectedErr)
+			assert.Equal(t, feedbackBefore, tr.Feedback())
+		})
+	}
+}
+
+func newAttendedTraining(t *testing.T) *training.Training {
+	tr := newExampleTrainingWithTime(t, time.Now().Add(-2*time.Hour))
+	require.NoError(t, tr.RecordAttendance(training.Attended, training.Trainer))
+
+	return tr
+}
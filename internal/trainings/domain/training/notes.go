@@ -0,0 +1,126 @@
+package training
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const maxNotesLength = 1000
+
+// NotesKind tells who can see the notes.
+type NotesKind struct {
+	s string
+}
+
+func (k NotesKind) IsZero() bool {
+	return k == NotesKind{}
+}
+
+func (k NotesKind) String() string {
+	return k.s
+}
+
+var (
+	// SharedNotes are visible to both the attendee and the trainer.
+	SharedNotes = NotesKind{"shared"}
+	// TrainerNotes are private notes of the trainer, attendees never see them.
+	TrainerNotes = NotesKind{"trainer"}
+)
+
+func NewNotesKindFromString(kind string) (NotesKind, error) {
+	switch kind {
+	case "shared":
+		return SharedNotes, nil
+	case "trainer":
+		return TrainerNotes, nil
+	}
+
+	return NotesKind{}, fmt.Errorf("unknown notes kind: %s", kind)
+}
+
+// NotesRevision is a single edit of the training notes.
+type NotesRevision struct {
+	kind  NotesKind
+	notes string
+
+	editedBy     string
+	editedByType UserType
+	editedAt     time.Time
+}
+
+func (r NotesRevision) Kind() NotesKind {
+	return r.kind
+}
+
+// Notes returns the notes content after the edit.
+func (r NotesRevision) Notes() string {
+	return r.notes
+}
+
+func (r NotesRevision) EditedBy() string {
+	return r.editedBy
+}
+
+func (r NotesRevision) EditedByType() UserType {
+	return r.editedByType
+}
+
+func (r NotesRevision) EditedAt() time.Time {
+	return r.editedAt
+}
+
+var ErrOnlyTrainerCanEditTrainerNotes = errors.New("only trainer can edit trainer notes")
+
+// EditNotes changes the notes shared with the attendee and records the edit in the notes history.
+// Nothing is recorded when the notes are not changed.
+func (t *Training) EditNotes(notes string, editedBy User, now time.Time) error {
+	if len(notes) > maxNotesLength {
+		return ErrNoteTooLong
+	}
+	if notes == t.notes {
+		return nil
+	}
+
+	t.notes = notes
+	t.recordNotesRevision(SharedNotes, notes, editedBy, now)
+	return nil
+}
+
+// EditTrainerNotes changes the trainer's private notes and records the edit in the notes history.
+// Nothing is recorded when the notes are not changed.
+func (t *Training) EditTrainerNotes(notes string, editedBy User, now time.Time) error {
+	if editedBy.Type() != Trainer {
+		return ErrOnlyTrainerCanEditTrainerNotes
+	}
+	if len(notes) > maxNotesLength {
+		return ErrNoteTooLong
+	}
+	if notes == t.trainerNotes {
+		return nil
+	}
+
+	t.trainerNotes = notes
+	t.recordNotesRevision(TrainerNotes, notes, editedBy, now)
+	return nil
+}
+
+func (t *Training) recordNotesRevision(kind NotesKind, notes string, editedBy User, now time.Time) {
+	t.newNotesRevisions = append(t.newNotesRevisions, NotesRevision{
+		kind:         kind,
+		notes:        notes,
+		editedBy:     editedBy.UUID(),
+		editedByType: editedBy.Type(),
+		editedAt:     now,
+	})
+}
+
+// TrainerNotes returns the trainer's private notes, they must never be shown to the attendee.
+func (t Training) TrainerNotes() string {
+	return t.trainerNotes
+}
+
+// NewNotesRevisions returns notes edits made since the training was loaded, which are not persisted yet.
+func (t Training) NewNotesRevisions() []NotesRevision {
+	return t.newNotesRevisions
+}
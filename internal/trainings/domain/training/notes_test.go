@@ -0,0 +1,67 @@
+package training_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestTraining_EditNotes(t *testing.T) {
+	t.Parallel()
+	tr := newExampleTraining(t)
+	attendee := training.MustNewUser(tr.UserUUID(), training.Attendee)
+	now := time.Now()
+
+	require.NoError(t, tr.EditNotes("leg day", attendee, now))
+	assert.Equal(t, "leg day", tr.Notes())
+
+	// not changed notes are not recorded
+	require.NoError(t, tr.EditNotes("leg day", attendee, now))
+
+	revisions := tr.NewNotesRevisions()
+	require.Len(t, revisions, 1)
+	assert.Equal(t, training.SharedNotes, revisions[0].Kind())
+	assert.Equal(t, "leg day", revisions[0].Notes())
+	assert.Equal(t, attendee.UUID(), revisions[0].EditedBy())
+	assert.Equal(t, training.Attendee, revisions[0].EditedByType())
+	assert.Equal(t, now, revisions[0].EditedAt())
+}
+
+func TestTraining_EditNotes_too_long(t *testing.T) {
+	t.Parallel()
+	tr := newExampleTraining(t)
+	trainer := training.MustNewUser(uuid.New().String(), training.Trainer)
+
+	assert.ErrorIs(t, tr.EditNotes(strings.Repeat("x", 1001), trainer, time.Now()), training.ErrNoteTooLong)
+	assert.ErrorIs(t, tr.EditTrainerNotes(strings.Repeat("x", 1001), trainer, time.Now()), training.ErrNoteTooLong)
+	assert.Empty(t, tr.NewNotesRevisions())
+}
+
+func TestTraining_EditTrainerNotes(t *testing.T) {
+	t.Parallel()
+	tr := newExampleTraining(t)
+	trainer := training.MustNewUser(uuid.New().String(), training.Trainer)
+
+	require.NoError(t, tr.EditTrainerNotes("knee injury", trainer, time.Now()))
+	assert.Equal(t, "knee injury", tr.TrainerNotes())
+	assert.Empty(t, tr.Notes())
+
+	revisions := tr.NewNotesRevisions()
+	require.Len(t, revisions, 1)
+	assert.Equal(t, training.TrainerNotes, revisions[0].Kind())
+}
+
+func TestTraining_EditTrainerNotes_by_attendee(t *testing.T) {
+	t.Parallel()
+	tr := newExampleTraining(t)
+	attendee := training.MustNewUser(tr.UserUUID(), training.Attendee)
+
+	err := tr.EditTrainerNotes("I'm fine", attendee, time.Now())
+	assert.ErrorIs(t, err, training.ErrOnlyTrainerCanEditTrainerNotes)
+	assert.Empty(t, tr.TrainerNotes())
+}
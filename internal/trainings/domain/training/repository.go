@@ -3,6 +3,7 @@ package training
 import (
 	"context"
 	"fmt"
+	"time"
 )
 
 type NotFoundError struct {
@@ -13,6 +14,14 @@ func (e NotFoundError) Error() string {
 	return fmt.Sprintf("training '%s' not found", e.TrainingUUID)
 }
 
+func (e NotFoundError) NotFound() bool {
+	return true
+}
+
+func (e NotFoundError) Slug() string {
+	return "training-not-found"
+}
+
 type Repository interface {
 	AddTraining(ctx context.Context, tr *Training) error
 
@@ -24,4 +33,150 @@ type Repository interface {
 		user User,
 		updateFn func(ctx context.Context, tr *Training) (*Training, error),
 	) error
+
+	// FindTrainingsPendingAttendance returns UUIDs of not canceled trainings ended before endedBefore,
+	// for which attendance is not recorded yet.
+	FindTrainingsPendingAttendance(ctx context.Context, endedBefore time.Time) ([]string, error)
+
+	// FindTrainingsWithExpiredRescheduleProposal returns UUIDs of not canceled trainings
+	// with reschedule proposal which was not answered before now.
+	FindTrainingsWithExpiredRescheduleProposal(ctx context.Context, now time.Time) ([]string, error)
+
+	// FindScheduledTrainings returns UUIDs of not canceled trainings starting from from (inclusive) to to (exclusive),
+	// which the user can see.
+	FindScheduledTrainings(ctx context.Context, from time.Time, to time.Time, user User) ([]string, error)
+}
+
+type SeriesNotFoundError struct {
+	SeriesUUID string
+}
+
+func (e SeriesNotFoundError) Error() string {
+	return fmt.Sprintf("training series '%s' not found", e.SeriesUUID)
+}
+
+func (e SeriesNotFoundError) NotFound() bool {
+	return true
+}
+
+func (e SeriesNotFoundError) Slug() string {
+	return "training-series-not-found"
+}
+
+type SeriesRepository interface {
+	AddSeries(ctx context.Context, s *Series) error
+
+	GetSeries(ctx context.Context, seriesUUID string, user User) (*Series, error)
+
+	UpdateSeries(
+		ctx context.Context,
+		seriesUUID string,
+		user User,
+		updateFn func(ctx context.Context, s *Series) (*Series, error),
+	) error
+}
+
+type WaitlistEntryNotFoundError struct {
+	EntryUUID string
+}
+
+func (e WaitlistEntryNotFoundError) Error() string {
+	return fmt.Sprintf("waitlist entry '%s' not found", e.EntryUUID)
+}
+
+func (e WaitlistEntryNotFoundError) NotFound() bool {
+	return true
+}
+
+func (e WaitlistEntryNotFoundError) Slug() string {
+	return "waitlist-entry-not-found"
+}
+
+type WaitlistRepository interface {
+	AddWaitlistEntry(ctx context.Context, entry *WaitlistEntry) error
+
+	UpdateWaitlistEntry(
+		ctx context.Context,
+		entryUUID string,
+		user User,
+		updateFn func(ctx context.Context, entry *WaitlistEntry) (*WaitlistEntry, error),
+	) error
+
+	// FindWaitingEntries returns UUIDs of entries waiting for the hour, in the order they joined the waitlist.
+	FindWaitingEntries(ctx context.Context, hour time.Time) ([]string, error)
+
+	// FindWaitlistEntriesWithExpiredOffer returns UUIDs of entries which didn't claim the offered hour before now.
+	FindWaitlistEntriesWithExpiredOffer(ctx context.Context, now time.Time) ([]string, error)
+}
+
+type SessionTypeNotFoundError struct {
+	Code string
+}
+
+func (e SessionTypeNotFoundError) Error() string {
+	return fmt.Sprintf("session type '%s' not found", e.Code)
+}
+
+func (e SessionTypeNotFoundError) NotFound() bool {
+	return true
+}
+
+func (e SessionTypeNotFoundError) Slug() string {
+	return "session-type-not-found"
+}
+
+type SessionTypeAlreadyExistsError struct {
+	Code string
+}
+
+func (e SessionTypeAlreadyExistsError) Error() string {
+	return fmt.Sprintf("session type '%s' already exists", e.Code)
+}
+
+func (e SessionTypeAlreadyExistsError) Conflict() bool {
+	return true
+}
+
+func (e SessionTypeAlreadyExistsError) Slug() string {
+	return "session-type-already-exists"
+}
+
+type SessionTypeRepository interface {
+	AddSessionType(ctx context.Context, st *SessionType) error
+
+	GetSessionType(ctx context.Context, code string) (*SessionType, error)
+
+	UpdateSessionType(
+		ctx context.Context,
+		code string,
+		updateFn func(ctx context.Context, st *SessionType) (*SessionType, error),
+	) error
+}
+
+type BulkCancellationNotFoundError struct {
+	BulkCancellationUUID string
+}
+
+func (e BulkCancellationNotFoundError) Error() string {
+	return fmt.Sprintf("bulk cancellation '%s' not found", e.BulkCancellationUUID)
+}
+
+func (e BulkCancellationNotFoundError) NotFound() bool {
+	return true
+}
+
+func (e BulkCancellationNotFoundError) Slug() string {
+	return "bulk-cancellation-not-found"
+}
+
+type BulkCancellationRepository interface {
+	AddBulkCancellation(ctx context.Context, b *BulkCancellation) error
+
+	GetBulkCancellation(ctx context.Context, bulkCancellationUUID string) (*BulkCancellation, error)
+
+	UpdateBulkCancellation(
+		ctx context.Context,
+		bulkCancellationUUID string,
+		updateFn func(ctx context.Context, b *BulkCancellation) (*BulkCancellation, error),
+	) error
 }
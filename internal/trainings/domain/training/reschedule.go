@@ -25,24 +25,94 @@ func (c CantRescheduleBeforeTimeError) Error() string {
 	)
 }
 
-func (t *Training) RescheduleTraining(newTime time.Time) error {
-	if !t.CanBeCanceledForFree() {
+func (t *Training) RescheduleTraining(newTime time.Time, policy CancellationPolicy) error {
+	if !policy.CanBeCanceledForFree(*t) {
 		return CantRescheduleBeforeTimeError{
 			TrainingTime: t.Time(),
 		}
 	}
 
 	t.time = newTime
+	t.rescheduleCount++
 
 	return nil
 }
 
-func (t *Training) ProposeReschedule(newTime time.Time, proposerType UserType) {
+// RescheduleCount returns how many times the training was moved to another time.
+func (t Training) RescheduleCount() int {
+	return t.rescheduleCount
+}
+
+func (t Training) RescheduleProposalExpiresAt() time.Time {
+	return t.proposalExpiresAt
+}
+
+var (
+	ErrProposedTimeInPast        = errors.New("proposed training time is in the past")
+	ErrProposalDeadlineInPast    = errors.New("reschedule proposal deadline is in the past")
+	ErrRescheduleProposalExpired = errors.New("reschedule proposal has expired")
+)
+
+// ProposeReschedule proposes to move the training to newTime. The other side has to answer before expiresAt,
+// which is moved earlier when the training or the proposed time starts before it.
+func (t *Training) ProposeReschedule(newTime time.Time, proposerType UserType, expiresAt time.Time) error {
+	now := time.Now()
+
+	if !newTime.After(now) {
+		return ErrProposedTimeInPast
+	}
+	if !expiresAt.After(now) {
+		return ErrProposalDeadlineInPast
+	}
+
+	if newTime.Before(expiresAt) {
+		expiresAt = newTime
+	}
+	if t.time.After(now) && t.time.Before(expiresAt) {
+		expiresAt = t.time
+	}
+
 	t.moveProposedBy = proposerType
 	t.proposedNewTime = newTime
+	t.proposalExpiresAt = expiresAt
+	// a new proposal replaces the previous one, and its hold has to be released by the caller
+	t.proposedTimeHeld = false
+
+	return nil
+}
+
+var ErrProposedTimeAlreadyHeld = errors.New("proposed training time is already held")
+
+// IsProposedTimeHeld returns true when the proposed hour is reserved in the trainer's calendar
+// until the proposal is approved or rejected.
+func (t Training) IsProposedTimeHeld() bool {
+	return t.proposedTimeHeld
+}
+
+// HoldProposedTime records that the proposed hour was reserved in the trainer's calendar.
+func (t *Training) HoldProposedTime() error {
+	if !t.IsRescheduleProposed() {
+		return ErrNoRescheduleRequested
+	}
+	if t.proposedTimeHeld {
+		return ErrProposedTimeAlreadyHeld
+	}
+
+	t.proposedTimeHeld = true
+	return nil
 }
 
-func (t *Training) IsRescheduleProposed() bool {
+// IsRescheduleProposalExpired returns true when the proposal was not answered in time.
+// Proposals without a deadline, created before deadlines were introduced, never expire.
+func (t Training) IsRescheduleProposalExpired(now time.Time) bool {
+	if !t.IsRescheduleProposed() || t.proposalExpiresAt.IsZero() {
+		return false
+	}
+
+	return !now.Before(t.proposalExpiresAt)
+}
+
+func (t Training) IsRescheduleProposed() bool {
 	return !t.moveProposedBy.IsZero() && !t.proposedNewTime.IsZero()
 }
 
@@ -59,10 +129,17 @@ func (t *Training) ApproveReschedule(userType UserType) error {
 		return fmt.Errorf("%w: %s", ErrSameUserTypeApproval, userType.String())
 	}
 
-	t.time = t.proposedNewTime
+	now := time.Now()
+	if t.IsRescheduleProposalExpired(now) {
+		return ErrRescheduleProposalExpired
+	}
+	if !t.proposedNewTime.After(now) {
+		return ErrProposedTimeInPast
+	}
 
-	t.proposedNewTime = time.Time{}
-	t.moveProposedBy = UserType{}
+	t.time = t.proposedNewTime
+	t.rescheduleCount++
+	t.clearRescheduleProposal()
 
 	return nil
 }
@@ -72,8 +149,14 @@ func (t *Training) RejectReschedule() error {
 		return ErrNoRescheduleRequested
 	}
 
-	t.proposedNewTime = time.Time{}
-	t.moveProposedBy = UserType{}
+	t.clearRescheduleProposal()
 
 	return nil
 }
+
+func (t *Training) clearRescheduleProposal() {
+	t.proposedNewTime = time.Time{}
+	t.moveProposedBy = UserType{}
+	t.proposalExpiresAt = time.Time{}
+	t.proposedTimeHeld = false
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
@@ -19,9 +20,10 @@ func TestTraining_RescheduleTraining(t *testing.T) {
 	// it's always a good idea to ensure about pre-conditions in the test ;-)
 	assert.False(t, oldTime.Equal(newTime))
 
-	err := tr.RescheduleTraining(newTime)
+	err := tr.RescheduleTraining(newTime, training.DefaultCancellationPolicy())
 	assert.NoError(t, err)
 	assert.True(t, tr.Time().Equal(newTime))
+	assert.Equal(t, 1, tr.RescheduleCount())
 }
 
 func TestTraining_RescheduleTraining_less_than_24h_before(t *testing.T) {
@@ -31,7 +33,7 @@ func TestTraining_RescheduleTraining_less_than_24h_before(t *testing.T) {
 
 	tr := newExampleTrainingWithTime(t, originalTime)
 
-	err := tr.RescheduleTraining(rescheduleRequestTime)
+	err := tr.RescheduleTraining(rescheduleRequestTime, training.DefaultCancellationPolicy())
 
 	assert.EqualError(t, err, training.CantRescheduleBeforeTimeError{
 		TrainingTime: tr.Time(),
@@ -67,7 +69,7 @@ func TestTraining_ProposeReschedule_by_attendee(t *testing.T) {
 
 			assert.False(t, tr.IsRescheduleProposed())
 
-			tr.ProposeReschedule(rescheduleRequestTime, c.Proposer)
+			require.NoError(t, tr.ProposeReschedule(rescheduleRequestTime, c.Proposer, time.Now().Add(time.Hour)))
 
 			assert.True(t, tr.IsRescheduleProposed())
 
@@ -76,6 +78,7 @@ func TestTraining_ProposeReschedule_by_attendee(t *testing.T) {
 
 			assert.True(t, tr.Time().Equal(rescheduleRequestTime))
 			assert.False(t, tr.IsRescheduleProposed())
+			assert.Equal(t, 1, tr.RescheduleCount())
 		})
 	}
 }
@@ -101,7 +104,7 @@ func TestTraining_ProposeReschedule_approve_by_proposer(t *testing.T) {
 			rescheduleRequestTime := originalTime.AddDate(0, 0, 5)
 			tr := newExampleTrainingWithTime(t, originalTime)
 
-			tr.ProposeReschedule(rescheduleRequestTime, c.Proposer)
+			require.NoError(t, tr.ProposeReschedule(rescheduleRequestTime, c.Proposer, time.Now().Add(time.Hour)))
 
 			err := tr.ApproveReschedule(c.Proposer)
 			assert.Error(t, err)
@@ -125,11 +128,155 @@ func TestTraining_RejectRescheduleTraining(t *testing.T) {
 	rescheduleRequestTime := originalTime.AddDate(0, 0, 5)
 	tr := newExampleTrainingWithTime(t, originalTime)
 
-	tr.ProposeReschedule(rescheduleRequestTime, training.Attendee)
+	require.NoError(t, tr.ProposeReschedule(rescheduleRequestTime, training.Attendee, time.Now().Add(time.Hour)))
 
 	err := tr.RejectReschedule()
 	assert.NoError(t, err)
 
 	assert.True(t, tr.Time().Equal(originalTime))
 	assert.False(t, tr.IsRescheduleProposed())
+	assert.Equal(t, 0, tr.RescheduleCount())
+}
+
+func TestTraining_ProposeReschedule_deadline(t *testing.T) {
+	t.Parallel()
+	originalTime := time.Now().AddDate(0, 0, 5).Round(time.Hour)
+	tr := newExampleTrainingWithTime(t, originalTime)
+
+	expiresAt := time.Now().Add(48 * time.Hour)
+	err := tr.ProposeReschedule(originalTime.AddDate(0, 0, 1), training.Trainer, expiresAt)
+	require.NoError(t, err)
+
+	assert.True(t, tr.RescheduleProposalExpiresAt().Equal(expiresAt))
+	assert.False(t, tr.IsRescheduleProposalExpired(time.Now()))
+	assert.True(t, tr.IsRescheduleProposalExpired(expiresAt))
+}
+
+func TestTraining_ProposeReschedule_deadline_capped_by_training_time(t *testing.T) {
+	t.Parallel()
+	originalTime := time.Now().Add(10 * time.Hour)
+	tr := newExampleTrainingWithTime(t, originalTime)
+
+	err := tr.ProposeReschedule(originalTime.AddDate(0, 0, 1), training.Attendee, time.Now().Add(48*time.Hour))
+	require.NoError(t, err)
+
+	// nobody can answer after the training already started
+	assert.True(t, tr.RescheduleProposalExpiresAt().Equal(originalTime))
+}
+
+func TestTraining_ProposeReschedule_invalid(t *testing.T) {
+	t.Parallel()
+	tr := newExampleTraining(t)
+
+	err := tr.ProposeReschedule(time.Now().Add(-time.Hour), training.Attendee, time.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, training.ErrProposedTimeInPast)
+
+	err = tr.ProposeReschedule(time.Now().AddDate(0, 0, 7), training.Attendee, time.Now().Add(-time.Hour))
+	assert.ErrorIs(t, err, training.ErrProposalDeadlineInPast)
+
+	assert.False(t, tr.IsRescheduleProposed())
+}
+
+func TestTraining_ApproveReschedule_expired(t *testing.T) {
+	t.Parallel()
+	originalTime := time.Now().AddDate(0, 0, 5).Round(time.Hour)
+	tr := newTrainingWithRescheduleProposal(t, originalTime, originalTime.AddDate(0, 0, 1), time.Now().Add(-time.Minute))
+
+	err := tr.ApproveReschedule(training.Trainer)
+	assert.ErrorIs(t, err, training.ErrRescheduleProposalExpired)
+	assert.True(t, tr.Time().Equal(originalTime))
+
+	// expired proposal can still be rejected
+	require.NoError(t, tr.RejectReschedule())
+	assert.False(t, tr.IsRescheduleProposed())
+}
+
+func TestTraining_ApproveReschedule_proposed_time_in_past(t *testing.T) {
+	t.Parallel()
+	originalTime := time.Now().AddDate(0, 0, 5).Round(time.Hour)
+	// proposal stored before deadlines were introduced
+	tr := newTrainingWithRescheduleProposal(t, originalTime, time.Now().Add(-time.Hour), time.Time{})
+
+	err := tr.ApproveReschedule(training.Trainer)
+	assert.ErrorIs(t, err, training.ErrProposedTimeInPast)
+	assert.True(t, tr.Time().Equal(originalTime))
+}
+
+func newTrainingWithRescheduleProposal(
+	t *testing.T,
+	trainingTime time.Time,
+	proposedTime time.Time,
+	expiresAt time.Time,
+) *training.Training {
+	tr, err := training.UnmarshalTrainingFromDatabase(
+		uuid.New().String(),
+		uuid.New().String(),
+		"user name",
+		trainingTime,
+		"",
+		"",
+		false,
+		training.UserType{},
+		time.Time{},
+		proposedTime,
+		training.Attendee,
+		expiresAt,
+		false,
+		0,
+		training.Attendance{},
+		training.Feedback{},
+		training.DefaultCancellationPolicyVersion,
+		"",
+		training.DefaultSessionTypeCode,
+		1,
+		time.Hour,
+		nil,
+		1,
+	)
+	require.NoError(t, err)
+
+	return tr
+}
+
+func TestTraining_HoldProposedTime(t *testing.T) {
+	t.Parallel()
+	tr := newExampleTraining(t)
+
+	assert.ErrorIs(t, tr.HoldProposedTime(), training.ErrNoRescheduleRequested)
+
+	require.NoError(t, tr.ProposeReschedule(tr.Time().AddDate(0, 0, 1), training.Attendee, time.Now().Add(time.Hour)))
+	require.NoError(t, tr.HoldProposedTime())
+	assert.True(t, tr.IsProposedTimeHeld())
+
+	assert.ErrorIs(t, tr.HoldProposedTime(), training.ErrProposedTimeAlreadyHeld)
+
+	require.NoError(t, tr.RejectReschedule())
+	assert.False(t, tr.IsProposedTimeHeld())
+}
+
+func TestTraining_ProposeReschedule_replaces_held_proposal(t *testing.T) {
+	t.Parallel()
+	tr := newExampleTraining(t)
+
+	require.NoError(t, tr.ProposeReschedule(tr.Time().AddDate(0, 0, 1), training.Attendee, time.Now().Add(time.Hour)))
+	require.NoError(t, tr.HoldProposedTime())
+
+	newProposedTime := tr.Time().AddDate(0, 0, 2)
+	require.NoError(t, tr.ProposeReschedule(newProposedTime, training.Trainer, time.Now().Add(time.Hour)))
+
+	assert.True(t, tr.ProposedNewTime().Equal(newProposedTime))
+	assert.False(t, tr.IsProposedTimeHeld())
+}
+
+func TestTraining_Cancel_clears_reschedule_proposal(t *testing.T) {
+	t.Parallel()
+	tr := newExampleTraining(t)
+
+	require.NoError(t, tr.ProposeReschedule(tr.Time().AddDate(0, 0, 1), training.Attendee, time.Now().Add(time.Hour)))
+	require.NoError(t, tr.HoldProposedTime())
+
+	require.NoError(t, tr.Cancel(training.Attendee))
+
+	assert.False(t, tr.IsRescheduleProposed())
+	assert.False(t, tr.IsProposedTimeHeld())
 }
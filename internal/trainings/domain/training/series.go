@@ -0,0 +1,332 @@
+package training
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MaxSeriesOccurrences limits how far ahead a series can be booked, it's a year of weekly trainings.
+const MaxSeriesOccurrences = 52
+
+type SeriesOccurrenceStatus struct {
+	s string
+}
+
+func (s SeriesOccurrenceStatus) IsZero() bool {
+	return s == SeriesOccurrenceStatus{}
+}
+
+func (s SeriesOccurrenceStatus) String() string {
+	return s.s
+}
+
+var (
+	// SeriesOccurrencePending is an occurrence which was not booked yet.
+	SeriesOccurrencePending = SeriesOccurrenceStatus{"pending"}
+	// SeriesOccurrenceScheduled is an occurrence booked as a training.
+	SeriesOccurrenceScheduled = SeriesOccurrenceStatus{"scheduled"}
+	// SeriesOccurrenceSkipped is an occurrence the attendee asked to skip when booking the series.
+	SeriesOccurrenceSkipped = SeriesOccurrenceStatus{"skipped"}
+	// SeriesOccurrenceFailed is an occurrence which couldn't be booked, for example because the hour was taken.
+	SeriesOccurrenceFailed = SeriesOccurrenceStatus{"failed"}
+)
+
+func NewSeriesOccurrenceStatusFromString(status string) (SeriesOccurrenceStatus, error) {
+	switch status {
+	case "pending":
+		return SeriesOccurrencePending, nil
+	case "scheduled":
+		return SeriesOccurrenceScheduled, nil
+	case "skipped":
+		return SeriesOccurrenceSkipped, nil
+	case "failed":
+		return SeriesOccurrenceFailed, nil
+	}
+
+	return SeriesOccurrenceStatus{}, fmt.Errorf("unknown series occurrence status: %s", status)
+}
+
+// SeriesOccurrence is a single week of the series.
+type SeriesOccurrence struct {
+	time   time.Time
+	status SeriesOccurrenceStatus
+
+	trainingUUID  string
+	failureReason string
+}
+
+// UnmarshalSeriesOccurrenceFromDatabase unmarshals SeriesOccurrence from the database.
+//
+// It should be used only for unmarshalling from the database!
+func UnmarshalSeriesOccurrenceFromDatabase(
+	occurrenceTime time.Time,
+	status SeriesOccurrenceStatus,
+	trainingUUID string,
+	failureReason string,
+) SeriesOccurrence {
+	return SeriesOccurrence{
+		time:          occurrenceTime,
+		status:        status,
+		trainingUUID:  trainingUUID,
+		failureReason: failureReason,
+	}
+}
+
+func (o SeriesOccurrence) Time() time.Time {
+	return o.time
+}
+
+func (o SeriesOccurrence) Status() SeriesOccurrenceStatus {
+	return o.status
+}
+
+// TrainingUUID returns the booked training, it's empty when the occurrence is not scheduled.
+func (o SeriesOccurrence) TrainingUUID() string {
+	return o.trainingUUID
+}
+
+// FailureReason returns the error slug explaining why the occurrence couldn't be booked.
+func (o SeriesOccurrence) FailureReason() string {
+	return o.failureReason
+}
+
+// Series is a set of trainings booked by the attendee in one request, at the same hour every week.
+// Each occurrence is booked, charged and canceled as a separate training.
+type Series struct {
+	uuid string
+
+	userUUID string
+	userName string
+
+	occurrences []SeriesOccurrence
+
+	canceled bool
+}
+
+var (
+	ErrInvalidSeriesOccurrencesCount = fmt.Errorf("series should have from 1 to %d occurrences", MaxSeriesOccurrences)
+	ErrSkippedTimeNotInSeries        = errors.New("skipped time is not an occurrence of the series")
+	ErrAllSeriesOccurrencesSkipped   = errors.New("all series occurrences are skipped")
+)
+
+// NewSeries creates a weekly series starting at firstTrainingTime.
+// Occurrences at skipTimes are not booked.
+func NewSeries(
+	uuid string,
+	userUUID string,
+	userName string,
+	firstTrainingTime time.Time,
+	occurrencesCount int,
+	skipTimes []time.Time,
+) (*Series, error) {
+	if uuid == "" {
+		return nil, errors.New("empty series uuid")
+	}
+	if userUUID == "" {
+		return nil, errors.New("empty userUUID")
+	}
+	if userName == "" {
+		return nil, errors.New("empty userName")
+	}
+	if firstTrainingTime.IsZero() {
+		return nil, errors.New("zero first training time")
+	}
+	if occurrencesCount < 1 || occurrencesCount > MaxSeriesOccurrences {
+		return nil, ErrInvalidSeriesOccurrencesCount
+	}
+
+	occurrences := make([]SeriesOccurrence, 0, occurrencesCount)
+	for i := 0; i < occurrencesCount; i++ {
+		occurrences = append(occurrences, SeriesOccurrence{
+			// AddDate keeps the wall clock hour when the DST changes in the time location
+			time:   firstTrainingTime.AddDate(0, 0, 7*i),
+			status: SeriesOccurrencePending,
+		})
+	}
+
+	s := &Series{
+		uuid:        uuid,
+		userUUID:    userUUID,
+		userName:    userName,
+		occurrences: occurrences,
+	}
+
+	for _, skipTime := range skipTimes {
+		o, err := s.occurrence(skipTime)
+		if err != nil {
+			return nil, ErrSkippedTimeNotInSeries
+		}
+		o.status = SeriesOccurrenceSkipped
+	}
+
+	if len(s.PendingOccurrences()) == 0 {
+		return nil, ErrAllSeriesOccurrencesSkipped
+	}
+
+	return s, nil
+}
+
+// UnmarshalSeriesFromDatabase unmarshals Series from the database.
+//
+// It should be used only for unmarshalling from the database!
+// You can't use UnmarshalSeriesFromDatabase as constructor - It may put domain into the invalid state!
+func UnmarshalSeriesFromDatabase(
+	uuid string,
+	userUUID string,
+	userName string,
+	canceled bool,
+	occurrences []SeriesOccurrence,
+) *Series {
+	return &Series{
+		uuid:        uuid,
+		userUUID:    userUUID,
+		userName:    userName,
+		occurrences: occurrences,
+		canceled:    canceled,
+	}
+}
+
+func (s Series) UUID() string {
+	return s.uuid
+}
+
+func (s Series) UserUUID() string {
+	return s.userUUID
+}
+
+func (s Series) UserName() string {
+	return s.userName
+}
+
+func (s Series) IsCanceled() bool {
+	return s.canceled
+}
+
+func (s Series) Occurrences() []SeriesOccurrence {
+	occurrences := make([]SeriesOccurrence, len(s.occurrences))
+	copy(occurrences, s.occurrences)
+
+	return occurrences
+}
+
+// PendingOccurrences returns times of occurrences which should be booked.
+func (s Series) PendingOccurrences() []time.Time {
+	var times []time.Time
+	for _, o := range s.occurrences {
+		if o.status == SeriesOccurrencePending {
+			times = append(times, o.time)
+		}
+	}
+
+	return times
+}
+
+// ScheduledTrainingUUIDs returns trainings booked for the series.
+func (s Series) ScheduledTrainingUUIDs() []string {
+	var trainingUUIDs []string
+	for _, o := range s.occurrences {
+		if o.status == SeriesOccurrenceScheduled {
+			trainingUUIDs = append(trainingUUIDs, o.trainingUUID)
+		}
+	}
+
+	return trainingUUIDs
+}
+
+var (
+	ErrSeriesOccurrenceNotFound   = errors.New("series occurrence not found")
+	ErrSeriesOccurrenceNotPending = errors.New("series occurrence is already booked or skipped")
+	ErrSeriesAlreadyCanceled      = errors.New("series is already canceled")
+)
+
+// MarkOccurrenceScheduled records that the occurrence was booked as the training.
+func (s *Series) MarkOccurrenceScheduled(occurrenceTime time.Time, trainingUUID string) error {
+	if trainingUUID == "" {
+		return errors.New("empty training uuid")
+	}
+
+	o, err := s.pendingOccurrence(occurrenceTime)
+	if err != nil {
+		return err
+	}
+
+	o.status = SeriesOccurrenceScheduled
+	o.trainingUUID = trainingUUID
+	return nil
+}
+
+// MarkOccurrenceFailed records that the occurrence couldn't be booked.
+func (s *Series) MarkOccurrenceFailed(occurrenceTime time.Time, reason string) error {
+	o, err := s.pendingOccurrence(occurrenceTime)
+	if err != nil {
+		return err
+	}
+
+	o.status = SeriesOccurrenceFailed
+	o.failureReason = reason
+	return nil
+}
+
+// Cancel marks the series as canceled, trainings of the series have to be canceled separately.
+func (s *Series) Cancel() error {
+	if s.canceled {
+		return ErrSeriesAlreadyCanceled
+	}
+
+	s.canceled = true
+	return nil
+}
+
+func (s *Series) pendingOccurrence(occurrenceTime time.Time) (*SeriesOccurrence, error) {
+	if s.canceled {
+		return nil, ErrSeriesAlreadyCanceled
+	}
+
+	o, err := s.occurrence(occurrenceTime)
+	if err != nil {
+		return nil, err
+	}
+	if o.status != SeriesOccurrencePending {
+		return nil, ErrSeriesOccurrenceNotPending
+	}
+
+	return o, nil
+}
+
+func (s *Series) occurrence(occurrenceTime time.Time) (*SeriesOccurrence, error) {
+	for i := range s.occurrences {
+		if s.occurrences[i].time.Equal(occurrenceTime) {
+			return &s.occurrences[i], nil
+		}
+	}
+
+	return nil, ErrSeriesOccurrenceNotFound
+}
+
+func CanUserSeeSeries(user User, series Series) error {
+	if user.CanSeeTrainingsOf(series.UserUUID()) {
+		return nil
+	}
+
+	return ForbiddenToSeeTrainingError{user.UUID(), series.UserUUID()}
+}
+
+var ErrTrainingAlreadyInSeries = errors.New("training already belongs to a series")
+
+// SeriesUUID returns the series the training was booked in, it's empty for trainings booked one by one.
+func (t Training) SeriesUUID() string {
+	return t.seriesUUID
+}
+
+func (t *Training) AssignToSeries(seriesUUID string) error {
+	if seriesUUID == "" {
+		return errors.New("empty series uuid")
+	}
+	if t.seriesUUID != "" {
+		return ErrTrainingAlreadyInSeries
+	}
+
+	t.seriesUUID = seriesUUID
+	return nil
+}
@@ -0,0 +1,143 @@
+package training_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestNewSeries(t *testing.T) {
+	t.Parallel()
+	firstTrainingTime := time.Now().AddDate(0, 0, 1).Round(time.Hour)
+	skippedTime := firstTrainingTime.AddDate(0, 0, 14)
+
+	s, err := training.NewSeries(uuid.New().String(), uuid.New().String(), "user name", firstTrainingTime, 4, []time.Time{skippedTime})
+	require.NoError(t, err)
+
+	occurrences := s.Occurrences()
+	require.Len(t, occurrences, 4)
+	for i, o := range occurrences {
+		assert.True(t, o.Time().Equal(firstTrainingTime.AddDate(0, 0, 7*i)))
+	}
+	assert.Equal(t, training.SeriesOccurrenceSkipped, occurrences[2].Status())
+
+	assert.Equal(t, []time.Time{
+		firstTrainingTime,
+		firstTrainingTime.AddDate(0, 0, 7),
+		firstTrainingTime.AddDate(0, 0, 21),
+	}, s.PendingOccurrences())
+}
+
+func TestNewSeries_invalid(t *testing.T) {
+	t.Parallel()
+	firstTrainingTime := time.Now().AddDate(0, 0, 1).Round(time.Hour)
+
+	testCases := []struct {
+		Name        string
+		Occurrences int
+		SkipTimes   []time.Time
+		ExpectedErr error
+	}{
+		{
+			Name:        "no_occurrences",
+			Occurrences: 0,
+			ExpectedErr: training.ErrInvalidSeriesOccurrencesCount,
+		},
+		{
+			Name:        "too_many_occurrences",
+			Occurrences: training.MaxSeriesOccurrences + 1,
+			ExpectedErr: training.ErrInvalidSeriesOccurrencesCount,
+		},
+		{
+			Name:        "skipped_time_not_in_series",
+			Occurrences: 2,
+			SkipTimes:   []time.Time{firstTrainingTime.Add(time.Hour)},
+			ExpectedErr: training.ErrSkippedTimeNotInSeries,
+		},
+		{
+			Name:        "all_skipped",
+			Occurrences: 1,
+			SkipTimes:   []time.Time{firstTrainingTime},
+			ExpectedErr: training.ErrAllSeriesOccurrencesSkipped,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := training.NewSeries(uuid.New().String(), uuid.New().String(), "user name", firstTrainingTime, c.Occurrences, c.SkipTimes)
+			assert.ErrorIs(t, err, c.ExpectedErr)
+		})
+	}
+}
+
+func TestSeries_MarkOccurrence(t *testing.T) {
+	t.Parallel()
+	firstTrainingTime := time.Now().AddDate(0, 0, 1).Round(time.Hour)
+	secondTrainingTime := firstTrainingTime.AddDate(0, 0, 7)
+
+	s, err := training.NewSeries(uuid.New().String(), uuid.New().String(), "user name", firstTrainingTime, 2, nil)
+	require.NoError(t, err)
+
+	trainingUUID := uuid.New().String()
+	require.NoError(t, s.MarkOccurrenceScheduled(firstTrainingTime, trainingUUID))
+	require.NoError(t, s.MarkOccurrenceFailed(secondTrainingTime, "hour-not-available"))
+
+	assert.Empty(t, s.PendingOccurrences())
+	assert.Equal(t, []string{trainingUUID}, s.ScheduledTrainingUUIDs())
+
+	occurrences := s.Occurrences()
+	assert.Equal(t, training.SeriesOccurrenceScheduled, occurrences[0].Status())
+	assert.Equal(t, trainingUUID, occurrences[0].TrainingUUID())
+	assert.Equal(t, training.SeriesOccurrenceFailed, occurrences[1].Status())
+	assert.Equal(t, "hour-not-available", occurrences[1].FailureReason())
+
+	assert.ErrorIs(t, s.MarkOccurrenceFailed(firstTrainingTime, "foo"), training.ErrSeriesOccurrenceNotPending)
+	assert.ErrorIs(t, s.MarkOccurrenceFailed(firstTrainingTime.Add(time.Hour), "foo"), training.ErrSeriesOccurrenceNotFound)
+}
+
+func TestSeries_Cancel(t *testing.T) {
+	t.Parallel()
+	firstTrainingTime := time.Now().AddDate(0, 0, 1).Round(time.Hour)
+
+	s, err := training.NewSeries(uuid.New().String(), uuid.New().String(), "user name", firstTrainingTime, 2, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Cancel())
+	assert.True(t, s.IsCanceled())
+
+	assert.ErrorIs(t, s.Cancel(), training.ErrSeriesAlreadyCanceled)
+	assert.ErrorIs(t, s.MarkOccurrenceScheduled(firstTrainingTime, uuid.New().String()), training.ErrSeriesAlreadyCanceled)
+}
+
+func TestTraining_AssignToSeries(t *testing.T) {
+	t.Parallel()
+	tr := newExampleTraining(t)
+	seriesUUID := uuid.New().String()
+
+	require.NoError(t, tr.AssignToSeries(seriesUUID))
+	assert.Equal(t, seriesUUID, tr.SeriesUUID())
+
+	assert.ErrorIs(t, tr.AssignToSeries(uuid.New().String()), training.ErrTrainingAlreadyInSeries)
+}
+
+func TestCanUserSeeSeries(t *testing.T) {
+	t.Parallel()
+	ownerUUID := uuid.New().String()
+
+	s, err := training.NewSeries(uuid.New().String(), ownerUUID, "user name", time.Now().AddDate(0, 0, 1).Round(time.Hour), 2, nil)
+	require.NoError(t, err)
+
+	assert.NoError(t, training.CanUserSeeSeries(training.MustNewUser(ownerUUID, training.Attendee), *s))
+	assert.NoError(t, training.CanUserSeeSeries(training.MustNewTrainer(uuid.New().String(), []string{ownerUUID}, nil), *s))
+	// the owner is not assigned to any trainer for trainers created without clients
+	assert.NoError(t, training.CanUserSeeSeries(training.MustNewTrainer(uuid.New().String(), nil, nil), *s))
+	assert.Error(t, training.CanUserSeeSeries(training.MustNewTrainer(uuid.New().String(), nil, []string{ownerUUID}), *s))
+	assert.Error(t, training.CanUserSeeSeries(training.MustNewUser(uuid.New().String(), training.Attendee), *s))
+}
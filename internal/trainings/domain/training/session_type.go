@@ -0,0 +1,220 @@
+package training
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultSessionTypeCode is the session type of trainings booked without choosing one,
+	// and of all trainings booked before the session types catalog existed.
+	DefaultSessionTypeCode = "personal"
+	// defaultSessionTypePrice is the price of trainings booked before the session types catalog existed.
+	defaultSessionTypePrice = 1
+
+	// MaxSessionDuration is the length of the trainer's calendar slot, every session is booked into a single hour.
+	MaxSessionDuration = time.Hour
+
+	maxSessionTypeNameLength        = 100
+	maxSessionTypeDescriptionLength = 1000
+)
+
+// SessionType is an entry of the catalog of sessions attendees can book, like personal training or nutrition consult.
+type SessionType struct {
+	code string
+
+	name        string
+	description string
+
+	duration    time.Duration
+	creditPrice int
+
+	// cancellationTiers replace the tiers of the cancellation policy for trainings booked as the session type,
+	// they are sorted by MinNotice, from the longest. Empty when the policy tiers apply.
+	cancellationTiers []CancellationTier
+
+	archived bool
+}
+
+var (
+	ErrInvalidSessionTypeCode     = errors.New("session type code should be non-empty lowercase letters, digits and dashes")
+	ErrInvalidSessionDuration     = fmt.Errorf("session duration should be positive and at most %s", MaxSessionDuration)
+	ErrInvalidSessionCreditPrice  = errors.New("session credit price should be positive")
+	ErrSessionTypeNameTooLong     = fmt.Errorf("session type name too long (max %d characters)", maxSessionTypeNameLength)
+	ErrSessionTypeDescriptionLong = fmt.Errorf("session type description too long (max %d characters)", maxSessionTypeDescriptionLength)
+	ErrSessionTypeArchived        = errors.New("session type is archived and can't be booked")
+)
+
+func NewSessionType(
+	code string,
+	name string,
+	description string,
+	duration time.Duration,
+	creditPrice int,
+) (*SessionType, error) {
+	if !isValidSessionTypeCode(code) {
+		return nil, ErrInvalidSessionTypeCode
+	}
+
+	st := &SessionType{code: code}
+	if err := st.Update(name, description, duration, creditPrice); err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+// UnmarshalSessionTypeFromDatabase unmarshals SessionType from the database.
+//
+// It should be used only for unmarshalling from the database!
+// You can't use UnmarshalSessionTypeFromDatabase as constructor - It may put domain into the invalid state!
+func UnmarshalSessionTypeFromDatabase(
+	code string,
+	name string,
+	description string,
+	duration time.Duration,
+	creditPrice int,
+	cancellationTiers []CancellationTier,
+	archived bool,
+) *SessionType {
+	return &SessionType{
+		code:              code,
+		name:              name,
+		description:       description,
+		duration:          duration,
+		creditPrice:       creditPrice,
+		cancellationTiers: cancellationTiers,
+		archived:          archived,
+	}
+}
+
+func isValidSessionTypeCode(code string) bool {
+	if code == "" || len(code) > 50 {
+		return false
+	}
+
+	for _, r := range code {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s SessionType) Code() string {
+	return s.code
+}
+
+func (s SessionType) Name() string {
+	return s.name
+}
+
+func (s SessionType) Description() string {
+	return s.description
+}
+
+func (s SessionType) Duration() time.Duration {
+	return s.duration
+}
+
+// CreditPrice is the number of credits taken from the attendee's balance when the session is booked.
+func (s SessionType) CreditPrice() int {
+	return s.creditPrice
+}
+
+// CancellationTiers returns the tiers refunding trainings of the session type, sorted by MinNotice, from the longest.
+// It's empty when the tiers of the cancellation policy apply.
+func (s SessionType) CancellationTiers() []CancellationTier {
+	return copyCancellationTiers(s.cancellationTiers)
+}
+
+// SetCancellationTiers sets the cancellation policy of the session type, no tiers make the policy tiers apply again.
+// Already booked trainings keep the tiers they were booked with.
+func (s *SessionType) SetCancellationTiers(tiers []CancellationTier) error {
+	if len(tiers) == 0 {
+		s.cancellationTiers = nil
+		return nil
+	}
+
+	sortedTiers, err := sortCancellationTiers(tiers)
+	if err != nil {
+		return fmt.Errorf("%w of session type %s: %w", ErrInvalidCancellationPolicy, s.code, err)
+	}
+
+	s.cancellationTiers = sortedTiers
+	return nil
+}
+
+func (s SessionType) IsArchived() bool {
+	return s.archived
+}
+
+// Update changes the catalog entry. Already booked trainings keep the price they were booked for.
+func (s *SessionType) Update(name string, description string, duration time.Duration, creditPrice int) error {
+	if name == "" {
+		return errors.New("empty session type name")
+	}
+	if len(name) > maxSessionTypeNameLength {
+		return ErrSessionTypeNameTooLong
+	}
+	if len(description) > maxSessionTypeDescriptionLength {
+		return ErrSessionTypeDescriptionLong
+	}
+	if duration <= 0 || duration > MaxSessionDuration {
+		return ErrInvalidSessionDuration
+	}
+	if creditPrice <= 0 {
+		return ErrInvalidSessionCreditPrice
+	}
+
+	s.name = name
+	s.description = description
+	s.duration = duration
+	s.creditPrice = creditPrice
+	return nil
+}
+
+// Archive removes the session type from the catalog, already booked trainings are not affected.
+func (s *SessionType) Archive() {
+	s.archived = true
+}
+
+func (s *SessionType) Restore() {
+	s.archived = false
+}
+
+// BookSessionType records the session type of the training together with its current price, duration
+// and cancellation tiers, so later changes of the catalog don't affect booked trainings.
+func (t *Training) BookSessionType(st SessionType) error {
+	if st.IsArchived() {
+		return ErrSessionTypeArchived
+	}
+
+	t.sessionType = st.Code()
+	t.price = st.CreditPrice()
+	t.duration = st.Duration()
+	t.cancellationTiers = st.CancellationTiers()
+	return nil
+}
+
+func (t Training) SessionType() string {
+	return t.sessionType
+}
+
+// Price is the number of credits the attendee paid for the training.
+func (t Training) Price() int {
+	return t.price
+}
+
+// Duration is the length of the booked session, it's never longer than the hour reserved in the trainer's calendar.
+func (t Training) Duration() time.Duration {
+	return t.duration
+}
+
+// CancellationTiers returns the tiers of the session type the training was booked with.
+// It's empty when the tiers of the cancellation policy apply.
+func (t Training) CancellationTiers() []CancellationTier {
+	return copyCancellationTiers(t.cancellationTiers)
+}
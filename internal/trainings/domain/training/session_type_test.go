@@ -0,0 +1,135 @@
+package training_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestNewSessionType_invalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Code        string
+		Description string
+		Duration    time.Duration
+		CreditPrice int
+		ExpectedErr error
+	}{
+		{
+			Name:        "invalid_code",
+			Code:        "Personal Training",
+			Duration:    time.Hour,
+			CreditPrice: 1,
+			ExpectedErr: training.ErrInvalidSessionTypeCode,
+		},
+		{
+			Name:        "zero_duration",
+			Code:        "assessment",
+			Duration:    0,
+			CreditPrice: 1,
+			ExpectedErr: training.ErrInvalidSessionDuration,
+		},
+		{
+			Name:        "longer_than_an_hour",
+			Code:        "assessment",
+			Duration:    90 * time.Minute,
+			CreditPrice: 1,
+			ExpectedErr: training.ErrInvalidSessionDuration,
+		},
+		{
+			Name:        "free_session",
+			Code:        "assessment",
+			Duration:    time.Hour,
+			CreditPrice: 0,
+			ExpectedErr: training.ErrInvalidSessionCreditPrice,
+		},
+		{
+			Name:        "description_too_long",
+			Code:        "assessment",
+			Description: strings.Repeat("x", 1001),
+			Duration:    time.Hour,
+			CreditPrice: 1,
+			ExpectedErr: training.ErrSessionTypeDescriptionLong,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := training.NewSessionType(c.Code, "Assessment", c.Description, c.Duration, c.CreditPrice)
+			assert.ErrorIs(t, err, c.ExpectedErr)
+		})
+	}
+}
+
+func TestTraining_BookSessionType(t *testing.T) {
+	t.Parallel()
+	tr := newExampleTraining(t)
+
+	assert.Equal(t, training.DefaultSessionTypeCode, tr.SessionType())
+	assert.Equal(t, 1, tr.Price())
+
+	st, err := training.NewSessionType("assessment", "Assessment", "", 45*time.Minute, 3)
+	require.NoError(t, err)
+	require.NoError(t, tr.BookSessionType(*st))
+
+	// price changes in the catalog don't affect the booked training
+	require.NoError(t, st.Update("Assessment", "", 45*time.Minute, 5))
+
+	assert.Equal(t, "assessment", tr.SessionType())
+	assert.Equal(t, 3, tr.Price())
+}
+
+func TestTraining_BookSessionType_duration(t *testing.T) {
+	t.Parallel()
+	tr := newExampleTraining(t)
+
+	// trainings booked before the session types catalog existed took the whole hour
+	assert.Equal(t, time.Hour, tr.Duration())
+	assert.Equal(t, tr.Time().Add(time.Hour), tr.EndTime())
+
+	st, err := training.NewSessionType("nutrition-consult", "Nutrition consult", "", 30*time.Minute, 2)
+	require.NoError(t, err)
+	require.NoError(t, tr.BookSessionType(*st))
+
+	require.NoError(t, st.Update("Nutrition consult", "", 45*time.Minute, 2))
+
+	assert.Equal(t, 30*time.Minute, tr.Duration())
+	assert.Equal(t, tr.Time().Add(30*time.Minute), tr.EndTime())
+}
+
+func TestSessionType_SetCancellationTiers_invalid(t *testing.T) {
+	t.Parallel()
+
+	st, err := training.NewSessionType("assessment", "Assessment", "", time.Hour, 3)
+	require.NoError(t, err)
+
+	err = st.SetCancellationTiers([]training.CancellationTier{
+		{MinNotice: 24 * time.Hour, AttendeeRefundPercent: 100, TrainerRefundPercent: 100},
+	})
+	assert.ErrorIs(t, err, training.ErrInvalidCancellationPolicy)
+	assert.Empty(t, st.CancellationTiers())
+}
+
+func TestTraining_BookSessionType_archived(t *testing.T) {
+	t.Parallel()
+	tr := newExampleTraining(t)
+
+	st, err := training.NewSessionType("assessment", "Assessment", "", time.Hour, 3)
+	require.NoError(t, err)
+	st.Archive()
+
+	assert.ErrorIs(t, tr.BookSessionType(*st), training.ErrSessionTypeArchived)
+	assert.Equal(t, training.DefaultSessionTypeCode, tr.SessionType())
+
+	st.Restore()
+	assert.NoError(t, tr.BookSessionType(*st))
+}
@@ -14,10 +14,33 @@ type Training struct {
 	time  time.Time
 	notes string
 
-	proposedNewTime time.Time
-	moveProposedBy  UserType
+	trainerNotes      string
+	newNotesRevisions []NotesRevision
 
-	canceled bool
+	proposedNewTime   time.Time
+	moveProposedBy    UserType
+	proposalExpiresAt time.Time
+	proposedTimeHeld  bool
+	rescheduleCount   int
+
+	canceled   bool
+	canceledBy UserType
+	canceledAt time.Time
+
+	attendance Attendance
+
+	feedback Feedback
+
+	cancellationPolicyVersion string
+
+	seriesUUID string
+
+	sessionType       string
+	price             int
+	duration          time.Duration
+	cancellationTiers []CancellationTier
+
+	version int
 }
 
 func NewTraining(uuid string, userUUID string, userName string, trainingTime time.Time) (*Training, error) {
@@ -39,6 +62,13 @@ func NewTraining(uuid string, userUUID string, userName string, trainingTime tim
 		userUUID: userUUID,
 		userName: userName,
 		time:     trainingTime,
+
+		cancellationPolicyVersion: DefaultCancellationPolicyVersion,
+
+		sessionType: DefaultSessionTypeCode,
+		price:       defaultSessionTypePrice,
+		duration:    MaxSessionDuration,
+		version:     1,
 	}, nil
 }
 
@@ -52,9 +82,24 @@ func UnmarshalTrainingFromDatabase(
 	userName string,
 	trainingTime time.Time,
 	notes string,
+	trainerNotes string,
 	canceled bool,
+	canceledBy UserType,
+	canceledAt time.Time,
 	proposedNewTime time.Time,
 	moveProposedBy UserType,
+	proposalExpiresAt time.Time,
+	proposedTimeHeld bool,
+	rescheduleCount int,
+	attendance Attendance,
+	feedback Feedback,
+	cancellationPolicyVersion string,
+	seriesUUID string,
+	sessionType string,
+	price int,
+	duration time.Duration,
+	cancellationTiers []CancellationTier,
+	version int,
 ) (*Training, error) {
 	tr, err := NewTraining(uuid, userUUID, userName, trainingTime)
 	if err != nil {
@@ -62,9 +107,24 @@ func UnmarshalTrainingFromDatabase(
 	}
 
 	tr.notes = notes
+	tr.trainerNotes = trainerNotes
 	tr.proposedNewTime = proposedNewTime
 	tr.moveProposedBy = moveProposedBy
+	tr.proposalExpiresAt = proposalExpiresAt
+	tr.proposedTimeHeld = proposedTimeHeld
+	tr.rescheduleCount = rescheduleCount
 	tr.canceled = canceled
+	tr.canceledBy = canceledBy
+	tr.canceledAt = canceledAt
+	tr.attendance = attendance
+	tr.feedback = feedback
+	tr.cancellationPolicyVersion = cancellationPolicyVersion
+	tr.seriesUUID = seriesUUID
+	tr.sessionType = sessionType
+	tr.price = price
+	tr.duration = duration
+	tr.cancellationTiers = cancellationTiers
+	tr.version = version
 
 	return tr, nil
 }
@@ -87,8 +147,9 @@ func (t Training) Time() time.Time {
 
 var ErrNoteTooLong = errors.New("note too long (max 1000 characters)")
 
+// UpdateNotes sets the notes without recording the edit, EditNotes should be used for changes of the existing training.
 func (t *Training) UpdateNotes(notes string) error {
-	if len(notes) > 1000 {
+	if len(notes) > maxNotesLength {
 		return ErrNoteTooLong
 	}
 
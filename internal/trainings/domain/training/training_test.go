@@ -68,11 +68,13 @@ func TestTraining_UpdateNotes_too_long(t *testing.T) {
 
 func TestTraining_MoreThanDayUntilTraining(t *testing.T) {
 	t.Parallel()
+	policy := training.DefaultCancellationPolicy()
+
 	trainingNow := newExampleTrainingWithTime(t, time.Now())
-	assert.False(t, trainingNow.CanBeCanceledForFree())
+	assert.False(t, policy.CanBeCanceledForFree(*trainingNow))
 
 	trainingInTwoDays := newExampleTrainingWithTime(t, time.Now().AddDate(0, 0, 2))
-	assert.True(t, trainingInTwoDays.CanBeCanceledForFree())
+	assert.True(t, policy.CanBeCanceledForFree(*trainingInTwoDays))
 }
 
 func newExampleTraining(t *testing.T) *training.Training {
@@ -101,7 +103,7 @@ func newExampleTrainingWithTime(t *testing.T, trainingTime time.Time) *training.
 
 func newCanceledTraining(t *testing.T) *training.Training {
 	tr := newExampleTraining(t)
-	require.NoError(t, tr.Cancel())
+	require.NoError(t, tr.Cancel(training.Attendee))
 
 	return tr
 }
@@ -22,6 +22,8 @@ func (u UserType) String() string {
 var (
 	Trainer  = UserType{"trainer"}
 	Attendee = UserType{"attendee"}
+	// System is used for changes which are not requested by any real user, like background jobs.
+	System = UserType{"system"}
 )
 
 var ErrInvalidUserType = errors.New("invalid user type")
@@ -40,6 +42,13 @@ func NewUserTypeFromString(userType string) (UserType, error) {
 type User struct {
 	userUUID string
 	userType UserType
+
+	// clientUUIDs are the attendees assigned to the trainer, it's empty for other users.
+	clientUUIDs map[string]struct{}
+	// otherTrainersClientUUIDs are the attendees assigned only to other trainers, it's empty for other users.
+	otherTrainersClientUUIDs map[string]struct{}
+	// hasRoster is set for trainers created by NewTrainer, without the roster it's not known whose trainings they can see.
+	hasRoster bool
 }
 
 func (u User) UUID() string {
@@ -51,7 +60,29 @@ func (u User) Type() UserType {
 }
 
 func (u User) IsEmpty() bool {
-	return u == User{}
+	return u.userUUID == "" && u.userType.IsZero()
+}
+
+// CanSeeTrainingsOf checks if the user can see trainings of the attendee:
+// attendees can see only their own trainings and trainers trainings of their clients.
+// Attendees not assigned to any trainer yet are handled by every trainer, so their trainings are not left without one.
+// Trainers created without their roster can't see trainings of anyone.
+func (u User) CanSeeTrainingsOf(attendeeUUID string) bool {
+	switch u.userType {
+	case System:
+		return true
+	case Trainer:
+		if !u.hasRoster {
+			return false
+		}
+		if _, ok := u.clientUUIDs[attendeeUUID]; ok {
+			return true
+		}
+		_, ok := u.otherTrainersClientUUIDs[attendeeUUID]
+		return !ok
+	}
+
+	return u.userUUID == attendeeUUID
 }
 
 func NewUser(userUUID string, userType UserType) (User, error) {
@@ -74,6 +105,41 @@ func MustNewUser(userUUID string, userType UserType) User {
 	return u
 }
 
+// NewTrainer creates the trainer with the attendees assigned to them as clients
+// and the attendees assigned only to other trainers.
+// Trainers created by NewUser have no roster, so they can't see trainings of attendees.
+func NewTrainer(userUUID string, clientUUIDs []string, otherTrainersClientUUIDs []string) (User, error) {
+	u, err := NewUser(userUUID, Trainer)
+	if err != nil {
+		return User{}, err
+	}
+
+	u.clientUUIDs = make(map[string]struct{}, len(clientUUIDs))
+	for _, clientUUID := range clientUUIDs {
+		u.clientUUIDs[clientUUID] = struct{}{}
+	}
+
+	u.otherTrainersClientUUIDs = make(map[string]struct{}, len(otherTrainersClientUUIDs))
+	for _, clientUUID := range otherTrainersClientUUIDs {
+		u.otherTrainersClientUUIDs[clientUUID] = struct{}{}
+	}
+	u.hasRoster = true
+
+	return u, nil
+}
+
+func MustNewTrainer(userUUID string, clientUUIDs []string, otherTrainersClientUUIDs []string) User {
+	u, err := NewTrainer(userUUID, clientUUIDs, otherTrainersClientUUIDs)
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
+
+// SystemUser is the user on whose behalf the service changes trainings by itself.
+var SystemUser = MustNewUser("00000000-0000-0000-0000-000000000000", System)
+
 type ForbiddenToSeeTrainingError struct {
 	RequestingUserUUID string
 	TrainingOwnerUUID  string
@@ -86,11 +152,16 @@ func (f ForbiddenToSeeTrainingError) Error() string {
 	)
 }
 
+func (f ForbiddenToSeeTrainingError) Forbidden() bool {
+	return true
+}
+
+func (f ForbiddenToSeeTrainingError) Slug() string {
+	return "forbidden-to-see-training"
+}
+
 func CanUserSeeTraining(user User, training Training) error {
-	if user.Type() == Trainer {
-		return nil
-	}
-	if user.UUID() == training.UserUUID() {
+	if user.CanSeeTrainingsOf(training.UserUUID()) {
 		return nil
 	}
 
@@ -18,7 +18,10 @@ func TestIsUserAllowedToSeeTraining(t *testing.T) {
 	attendee2, err := training.NewUser(uuid.New().String(), training.Attendee)
 	require.NoError(t, err)
 
-	trainer, err := training.NewUser(uuid.New().String(), training.Trainer)
+	trainer, err := training.NewTrainer(uuid.New().String(), []string{attendee1.UUID()}, nil)
+	require.NoError(t, err)
+
+	anotherTrainer, err := training.NewTrainer(uuid.New().String(), nil, []string{attendee1.UUID()})
 	require.NoError(t, err)
 
 	testCases := []struct {
@@ -73,7 +76,71 @@ func TestIsUserAllowedToSeeTraining(t *testing.T) {
 				return tr
 			},
 			User:              trainer,
-			ExpectedIsAllowed: true, // trainer have access to all trainings
+			ExpectedIsAllowed: true, // trainer have access to trainings of their clients
+		},
+		{
+			Name: "not_trainers_client",
+			CreateTraining: func(t *testing.T) *training.Training {
+				tr, err := training.NewTraining(
+					uuid.New().String(),
+					attendee1.UUID(),
+					"user name",
+					time.Now(),
+				)
+				require.NoError(t, err)
+
+				return tr
+			},
+			User:              anotherTrainer,
+			ExpectedIsAllowed: false,
+		},
+		{
+			Name: "unassigned_attendee",
+			CreateTraining: func(t *testing.T) *training.Training {
+				tr, err := training.NewTraining(
+					uuid.New().String(),
+					attendee2.UUID(),
+					"user name",
+					time.Now(),
+				)
+				require.NoError(t, err)
+
+				return tr
+			},
+			User:              trainer,
+			ExpectedIsAllowed: true, // attendees without a trainer are handled by every trainer
+		},
+		{
+			Name: "trainer_without_roster",
+			CreateTraining: func(t *testing.T) *training.Training {
+				tr, err := training.NewTraining(
+					uuid.New().String(),
+					attendee2.UUID(),
+					"user name",
+					time.Now(),
+				)
+				require.NoError(t, err)
+
+				return tr
+			},
+			User:              training.MustNewUser(uuid.New().String(), training.Trainer),
+			ExpectedIsAllowed: false, // it's not known whose trainings the trainer can see
+		},
+		{
+			Name: "system",
+			CreateTraining: func(t *testing.T) *training.Training {
+				tr, err := training.NewTraining(
+					uuid.New().String(),
+					attendee1.UUID(),
+					"user name",
+					time.Now(),
+				)
+				require.NoError(t, err)
+
+				return tr
+			},
+			User:              training.SystemUser,
+			ExpectedIsAllowed: true,
 		},
 	}
 
@@ -86,7 +153,7 @@ func TestIsUserAllowedToSeeTraining(t *testing.T) {
 			err := training.CanUserSeeTraining(c.User, *tr)
 
 			if c.ExpectedIsAllowed {
-
+				assert.NoError(t, err)
 			} else {
 				assert.EqualError(
 					t,
@@ -0,0 +1,23 @@
+package training
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrTrainingModified is returned when the training was changed since the version the change was based on.
+var ErrTrainingModified = errors.New("training was modified in the meantime")
+
+// Version is incremented with every persisted change of the training.
+func (t Training) Version() int {
+	return t.version
+}
+
+// CheckVersion returns ErrTrainingModified when the training is not in the expected version anymore.
+func (t Training) CheckVersion(expectedVersion int) error {
+	if t.version != expectedVersion {
+		return fmt.Errorf("%w: expected version %d, current version %d", ErrTrainingModified, expectedVersion, t.version)
+	}
+
+	return nil
+}
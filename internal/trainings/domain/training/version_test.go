@@ -0,0 +1,17 @@
+package training_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestTraining_CheckVersion(t *testing.T) {
+	t.Parallel()
+	tr := newExampleTraining(t)
+
+	assert.Equal(t, 1, tr.Version())
+	assert.NoError(t, tr.CheckVersion(1))
+	assert.ErrorIs(t, tr.CheckVersion(2), training.ErrTrainingModified)
+}
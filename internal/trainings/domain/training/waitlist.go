@@ -0,0 +1,252 @@
+package training
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type WaitlistStatus struct {
+	s string
+}
+
+func (s WaitlistStatus) IsZero() bool {
+	return s == WaitlistStatus{}
+}
+
+func (s WaitlistStatus) String() string {
+	return s.s
+}
+
+var (
+	// WaitlistWaiting is an entry waiting for the hour to be freed.
+	WaitlistWaiting = WaitlistStatus{"waiting"}
+	// WaitlistOffered is an entry which was offered the freed hour and can claim it until the offer expires.
+	WaitlistOffered = WaitlistStatus{"offered"}
+	// WaitlistBooked is an entry for which the training was booked, by claiming the offer or automatically.
+	WaitlistBooked = WaitlistStatus{"booked"}
+	// WaitlistExpired is an entry which didn't claim the offered hour in time.
+	WaitlistExpired = WaitlistStatus{"expired"}
+	// WaitlistLeft is an entry removed from the waitlist by the attendee.
+	WaitlistLeft = WaitlistStatus{"left"}
+)
+
+func NewWaitlistStatusFromString(status string) (WaitlistStatus, error) {
+	switch status {
+	case "waiting":
+		return WaitlistWaiting, nil
+	case "offered":
+		return WaitlistOffered, nil
+	case "booked":
+		return WaitlistBooked, nil
+	case "expired":
+		return WaitlistExpired, nil
+	case "left":
+		return WaitlistLeft, nil
+	}
+
+	return WaitlistStatus{}, fmt.Errorf("unknown waitlist status: %s", status)
+}
+
+// WaitlistEntry is the attendee waiting for the taken hour.
+// When the hour is freed, it's offered to the first waiting attendee, or booked right away
+// if the attendee opted in for automatic booking.
+type WaitlistEntry struct {
+	uuid string
+
+	userUUID string
+	userName string
+
+	hour     time.Time
+	autoBook bool
+
+	status         WaitlistStatus
+	offerExpiresAt time.Time
+	trainingUUID   string
+}
+
+var ErrWaitlistHourInPast = errors.New("can't join the waitlist for hour in the past")
+
+func NewWaitlistEntry(uuid string, userUUID string, userName string, hour time.Time, autoBook bool) (*WaitlistEntry, error) {
+	if uuid == "" {
+		return nil, errors.New("empty waitlist entry uuid")
+	}
+	if userUUID == "" {
+		return nil, errors.New("empty userUUID")
+	}
+	if userName == "" {
+		return nil, errors.New("empty userName")
+	}
+	if hour.IsZero() {
+		return nil, errors.New("zero hour")
+	}
+	if !hour.After(time.Now()) {
+		return nil, ErrWaitlistHourInPast
+	}
+
+	return &WaitlistEntry{
+		uuid:     uuid,
+		userUUID: userUUID,
+		userName: userName,
+		hour:     hour,
+		autoBook: autoBook,
+		status:   WaitlistWaiting,
+	}, nil
+}
+
+// UnmarshalWaitlistEntryFromDatabase unmarshals WaitlistEntry from the database.
+//
+// It should be used only for unmarshalling from the database!
+// You can't use UnmarshalWaitlistEntryFromDatabase as constructor - It may put domain into the invalid state!
+func UnmarshalWaitlistEntryFromDatabase(
+	uuid string,
+	userUUID string,
+	userName string,
+	hour time.Time,
+	autoBook bool,
+	status WaitlistStatus,
+	offerExpiresAt time.Time,
+	trainingUUID string,
+) *WaitlistEntry {
+	return &WaitlistEntry{
+		uuid:           uuid,
+		userUUID:       userUUID,
+		userName:       userName,
+		hour:           hour,
+		autoBook:       autoBook,
+		status:         status,
+		offerExpiresAt: offerExpiresAt,
+		trainingUUID:   trainingUUID,
+	}
+}
+
+func (e WaitlistEntry) UUID() string {
+	return e.uuid
+}
+
+func (e WaitlistEntry) UserUUID() string {
+	return e.userUUID
+}
+
+func (e WaitlistEntry) UserName() string {
+	return e.userName
+}
+
+func (e WaitlistEntry) Hour() time.Time {
+	return e.hour
+}
+
+// AutoBook returns true when the attendee wants the freed hour to be booked without claiming it.
+func (e WaitlistEntry) AutoBook() bool {
+	return e.autoBook
+}
+
+func (e WaitlistEntry) Status() WaitlistStatus {
+	return e.status
+}
+
+func (e WaitlistEntry) OfferExpiresAt() time.Time {
+	return e.offerExpiresAt
+}
+
+// TrainingUUID returns the training booked from the waitlist, it's empty until the entry is booked.
+func (e WaitlistEntry) TrainingUUID() string {
+	return e.trainingUUID
+}
+
+func (e WaitlistEntry) IsWaiting() bool {
+	return e.status == WaitlistWaiting
+}
+
+func (e WaitlistEntry) IsOffered() bool {
+	return e.status == WaitlistOffered
+}
+
+func (e WaitlistEntry) IsOfferExpired(now time.Time) bool {
+	return e.IsOffered() && !e.offerExpiresAt.After(now)
+}
+
+var (
+	ErrWaitlistEntryNotWaiting = errors.New("waitlist entry is not waiting for the hour")
+	ErrWaitlistEntryNotOffered = errors.New("the hour was not offered to the waitlist entry")
+	ErrWaitlistOfferExpired    = errors.New("the hour offer has expired")
+	ErrWaitlistEntryClosed     = errors.New("waitlist entry is already booked, expired or left")
+)
+
+// Offer offers the freed hour to the attendee until expiresAt.
+// The offer can't outlive the hour itself.
+func (e *WaitlistEntry) Offer(expiresAt time.Time) error {
+	if !e.IsWaiting() {
+		return ErrWaitlistEntryNotWaiting
+	}
+
+	if expiresAt.After(e.hour) {
+		expiresAt = e.hour
+	}
+
+	e.status = WaitlistOffered
+	e.offerExpiresAt = expiresAt
+	return nil
+}
+
+// Claim accepts the offered hour, the training has to be booked as trainingUUID.
+func (e *WaitlistEntry) Claim(trainingUUID string, now time.Time) error {
+	if trainingUUID == "" {
+		return errors.New("empty training uuid")
+	}
+	if !e.IsOffered() {
+		return ErrWaitlistEntryNotOffered
+	}
+	if e.IsOfferExpired(now) {
+		return ErrWaitlistOfferExpired
+	}
+
+	e.status = WaitlistBooked
+	e.trainingUUID = trainingUUID
+	return nil
+}
+
+// MarkAutoBooked records that the freed hour was booked for the attendee without claiming it.
+func (e *WaitlistEntry) MarkAutoBooked(trainingUUID string) error {
+	if trainingUUID == "" {
+		return errors.New("empty training uuid")
+	}
+	if !e.autoBook {
+		return errors.New("waitlist entry is not opted in for automatic booking")
+	}
+	if !e.IsWaiting() {
+		return ErrWaitlistEntryNotWaiting
+	}
+
+	e.status = WaitlistBooked
+	e.trainingUUID = trainingUUID
+	return nil
+}
+
+// ExpireOffer closes the entry which didn't claim the offered hour in time.
+func (e *WaitlistEntry) ExpireOffer(now time.Time) error {
+	if !e.IsOfferExpired(now) {
+		return errors.New("the hour offer has not expired yet")
+	}
+
+	e.status = WaitlistExpired
+	return nil
+}
+
+// Leave removes the attendee from the waitlist, also declining the offered hour.
+func (e *WaitlistEntry) Leave() error {
+	if !e.IsWaiting() && !e.IsOffered() {
+		return ErrWaitlistEntryClosed
+	}
+
+	e.status = WaitlistLeft
+	return nil
+}
+
+func CanUserSeeWaitlistEntry(user User, entry WaitlistEntry) error {
+	if user.CanSeeTrainingsOf(entry.UserUUID()) {
+		return nil
+	}
+
+	return ForbiddenToSeeTrainingError{user.UUID(), entry.UserUUID()}
+}
@@ -0,0 +1,91 @@
+package training_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestNewWaitlistEntry_hour_in_past(t *testing.T) {
+	t.Parallel()
+
+	_, err := training.NewWaitlistEntry(uuid.New().String(), uuid.New().String(), "user name", time.Now().Add(-time.Hour), false)
+	assert.ErrorIs(t, err, training.ErrWaitlistHourInPast)
+}
+
+func TestWaitlistEntry_Offer(t *testing.T) {
+	t.Parallel()
+	hour := time.Now().Add(time.Hour).Round(time.Hour)
+	entry := newExampleWaitlistEntry(t, hour, false)
+
+	// the offer can't outlive the hour
+	require.NoError(t, entry.Offer(hour.Add(time.Hour)))
+	assert.True(t, entry.IsOffered())
+	assert.Equal(t, hour, entry.OfferExpiresAt())
+
+	assert.ErrorIs(t, entry.Offer(hour), training.ErrWaitlistEntryNotWaiting)
+}
+
+func TestWaitlistEntry_Claim(t *testing.T) {
+	t.Parallel()
+	hour := time.Now().Add(24 * time.Hour).Round(time.Hour)
+	trainingUUID := uuid.New().String()
+
+	entry := newExampleWaitlistEntry(t, hour, false)
+	assert.ErrorIs(t, entry.Claim(trainingUUID, time.Now()), training.ErrWaitlistEntryNotOffered)
+
+	require.NoError(t, entry.Offer(time.Now().Add(time.Hour)))
+	require.NoError(t, entry.Claim(trainingUUID, time.Now()))
+	assert.Equal(t, training.WaitlistBooked, entry.Status())
+	assert.Equal(t, trainingUUID, entry.TrainingUUID())
+}
+
+func TestWaitlistEntry_Claim_expired_offer(t *testing.T) {
+	t.Parallel()
+	hour := time.Now().Add(24 * time.Hour).Round(time.Hour)
+
+	entry := newExampleWaitlistEntry(t, hour, false)
+	require.NoError(t, entry.Offer(time.Now().Add(time.Hour)))
+
+	later := time.Now().Add(2 * time.Hour)
+	assert.ErrorIs(t, entry.Claim(uuid.New().String(), later), training.ErrWaitlistOfferExpired)
+
+	require.NoError(t, entry.ExpireOffer(later))
+	assert.Equal(t, training.WaitlistExpired, entry.Status())
+	assert.ErrorIs(t, entry.Leave(), training.ErrWaitlistEntryClosed)
+}
+
+func TestWaitlistEntry_MarkAutoBooked(t *testing.T) {
+	t.Parallel()
+	hour := time.Now().Add(24 * time.Hour).Round(time.Hour)
+
+	entry := newExampleWaitlistEntry(t, hour, false)
+	assert.Error(t, entry.MarkAutoBooked(uuid.New().String()))
+
+	entry = newExampleWaitlistEntry(t, hour, true)
+	require.NoError(t, entry.MarkAutoBooked(uuid.New().String()))
+	assert.Equal(t, training.WaitlistBooked, entry.Status())
+}
+
+func TestWaitlistEntry_Leave_offered(t *testing.T) {
+	t.Parallel()
+	hour := time.Now().Add(24 * time.Hour).Round(time.Hour)
+
+	entry := newExampleWaitlistEntry(t, hour, false)
+	require.NoError(t, entry.Offer(time.Now().Add(time.Hour)))
+	require.NoError(t, entry.Leave())
+	assert.Equal(t, training.WaitlistLeft, entry.Status())
+}
+
+func newExampleWaitlistEntry(t *testing.T, hour time.Time, autoBook bool) *training.WaitlistEntry {
+	t.Helper()
+
+	entry, err := training.NewWaitlistEntry(uuid.New().String(), uuid.New().String(), "user name", hour, autoBook)
+	require.NoError(t, err)
+
+	return entry
+}
@@ -3,8 +3,12 @@ package main
 import (
 	"context"
 	"net/http"
+	// the production image doesn't ship the timezone database, it's needed for exports in the user's timezone
+	_ "time/tzdata"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	grpcClient "github.com/vaintrub/go-ddd-template/internal/common/client"
 	"github.com/vaintrub/go-ddd-template/internal/common/config"
 	"github.com/vaintrub/go-ddd-template/internal/common/logs"
 	"github.com/vaintrub/go-ddd-template/internal/common/server"
@@ -18,10 +22,15 @@ func main() {
 	cfg := config.MustLoad(ctx)
 	logger := logs.Init(cfg.Logging)
 
-	app, cleanup := service.NewApplication(ctx, cfg)
+	app, usersClient, cleanup := service.NewApplication(ctx, cfg)
 	defer cleanup()
 
+	go ports.RunJobs(ctx, app, cfg.Contexts.Trainings, logger)
+
 	server.RunHTTPServer(cfg.Server, logger, func(router chi.Router) http.Handler {
-		return ports.HandlerFromMux(ports.NewHttpServer(app), router)
+		return ports.HandlerWithOptions(ports.NewHttpServer(app), ports.ChiServerOptions{
+			BaseRouter:  router,
+			Middlewares: []ports.MiddlewareFunc{auth.SessionMiddleware(grpcClient.NewUsersSessionChecker(usersClient))},
+		})
 	})
 }
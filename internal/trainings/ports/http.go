@@ -1,8 +1,17 @@
 package ports
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-chi/render"
 	"github.com/google/uuid"
@@ -33,7 +42,7 @@ func (h HttpServer) GetTrainings(w http.ResponseWriter, r *http.Request) {
 	var appTrainings []query.Training
 
 	if user.Role == "trainer" {
-		appTrainings, err = h.app.Queries.AllTrainings.Handle(r.Context(), query.AllTrainings{})
+		appTrainings, err = h.app.Queries.ClientsTrainings.Handle(r.Context(), query.ClientsTrainings{User: user})
 	} else {
 		appTrainings, err = h.app.Queries.TrainingsForUser.Handle(r.Context(), query.TrainingsForUser{User: user})
 	}
@@ -74,93 +83,800 @@ func (h HttpServer) CreateTraining(w http.ResponseWriter, r *http.Request) {
 		TrainingTime: postTraining.Time,
 		Notes:        postTraining.Notes,
 	}
+	if postTraining.SessionType != nil {
+		cmd.SessionType = *postTraining.SessionType
+	}
 	err = h.app.Commands.ScheduleTraining.Handle(r.Context(), cmd)
 	if err != nil {
 		httperr.RespondWithSlugError(err, w, r)
 		return
 	}
 
-	w.Header().Set("content-location", "/trainings/"+cmd.TrainingUUID)
-	w.WriteHeader(http.StatusNoContent)
+	w.Header().Set("content-location", "/trainings/"+cmd.TrainingUUID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) CreateTrainingSeries(w http.ResponseWriter, r *http.Request) {
+	postSeries := PostTrainingSeries{}
+	if err := render.Decode(r, &postSeries); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	if user.Role != "attendee" {
+		httperr.Unauthorised("invalid-role", nil, w, r)
+		return
+	}
+
+	cmd := command.ScheduleTrainingSeries{
+		SeriesUUID:        uuid.New().String(),
+		UserUUID:          user.UUID,
+		UserName:          user.DisplayName,
+		FirstTrainingTime: postSeries.FirstTime,
+		Occurrences:       postSeries.Occurrences,
+		Notes:             postSeries.Notes,
+	}
+	if postSeries.Skip != nil {
+		cmd.SkipTimes = *postSeries.Skip
+	}
+	if postSeries.SessionType != nil {
+		cmd.SessionType = *postSeries.SessionType
+	}
+
+	err = h.app.Commands.ScheduleTrainingSeries.Handle(r.Context(), cmd)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	series, err := h.app.Queries.TrainingSeries.Handle(r.Context(), query.TrainingSeries{User: user, SeriesUUID: cmd.SeriesUUID})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("content-location", "/trainings/series/"+cmd.SeriesUUID)
+	render.Status(r, http.StatusCreated)
+	render.Respond(w, r, appTrainingSeriesToResponse(series))
+}
+
+func (h HttpServer) GetTrainingSeries(w http.ResponseWriter, r *http.Request, seriesUUID openapi_types.UUID) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	series, err := h.app.Queries.TrainingSeries.Handle(r.Context(), query.TrainingSeries{User: user, SeriesUUID: seriesUUID.String()})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, appTrainingSeriesToResponse(series))
+}
+
+func (h HttpServer) CancelTrainingSeries(w http.ResponseWriter, r *http.Request, seriesUUID openapi_types.UUID) {
+	user, err := h.newDomainUserFromAuthUser(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.CancelTrainingSeries.Handle(r.Context(), command.CancelTrainingSeries{
+		SeriesUUID: seriesUUID.String(),
+		User:       user,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+}
+
+func (h HttpServer) CreateBulkCancellation(w http.ResponseWriter, r *http.Request) {
+	postBulkCancellation := PostBulkCancellation{}
+	if err := render.Decode(r, &postBulkCancellation); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	user, err := h.newDomainUserFromAuthUser(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	cmd := command.BulkCancelTrainings{
+		BulkCancellationUUID: uuid.New().String(),
+		User:                 user,
+		From:                 postBulkCancellation.From.UTC(),
+		To:                   postBulkCancellation.To.UTC(),
+	}
+
+	err = h.app.Commands.BulkCancelTrainings.Handle(r.Context(), cmd)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	h.respondWithBulkCancellation(w, r, cmd.BulkCancellationUUID, http.StatusCreated)
+}
+
+func (h HttpServer) GetBulkCancellation(w http.ResponseWriter, r *http.Request, bulkCancellationUUID openapi_types.UUID) {
+	h.respondWithBulkCancellation(w, r, bulkCancellationUUID.String(), http.StatusOK)
+}
+
+func (h HttpServer) ResumeBulkCancellation(w http.ResponseWriter, r *http.Request, bulkCancellationUUID openapi_types.UUID) {
+	user, err := h.newDomainUserFromAuthUser(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.BulkCancelTrainings.Handle(r.Context(), command.BulkCancelTrainings{
+		BulkCancellationUUID: bulkCancellationUUID.String(),
+		User:                 user,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	h.respondWithBulkCancellation(w, r, bulkCancellationUUID.String(), http.StatusOK)
+}
+
+func (h HttpServer) respondWithBulkCancellation(w http.ResponseWriter, r *http.Request, bulkCancellationUUID string, status int) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	b, err := h.app.Queries.BulkCancellationByUUID.Handle(r.Context(), query.BulkCancellationByUUID{
+		User:                 user,
+		BulkCancellationUUID: bulkCancellationUUID,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("content-location", "/trainings/bulk-cancellations/"+bulkCancellationUUID)
+	render.Status(r, status)
+	render.Respond(w, r, appBulkCancellationToResponse(b))
+}
+
+func (h HttpServer) GetTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	tr, err := h.app.Queries.TrainingByUUID.Handle(r.Context(), query.TrainingByUUID{
+		User:         user,
+		TrainingUUID: trainingUUID.String(),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("ETag", trainingETag(tr.Version))
+	render.Respond(w, r, appTrainingsToResponse([]query.Training{tr})[0])
+}
+
+func (h HttpServer) CancelTraining(
+	w http.ResponseWriter,
+	r *http.Request,
+	trainingUUID openapi_types.UUID,
+	params CancelTrainingParams,
+) {
+	expectedVersion, err := expectedVersionFromIfMatch(params.IfMatch)
+	if err != nil {
+		respondWithIfMatchError(err, w, r)
+		return
+	}
+
+	user, err := h.newDomainUserFromAuthUser(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.CancelTraining.Handle(r.Context(), command.CancelTraining{
+		TrainingUUID:    trainingUUID.String(),
+		User:            user,
+		ExpectedVersion: expectedVersion,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+}
+
+func (h HttpServer) RescheduleTraining(
+	w http.ResponseWriter,
+	r *http.Request,
+	trainingUUID openapi_types.UUID,
+	params RescheduleTrainingParams,
+) {
+	expectedVersion, err := expectedVersionFromIfMatch(params.IfMatch)
+	if err != nil {
+		respondWithIfMatchError(err, w, r)
+		return
+	}
+
+	rescheduleTraining := PostTraining{}
+	if err := render.Decode(r, &rescheduleTraining); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	user, err := h.newDomainUserFromAuthUser(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.RescheduleTraining.Handle(r.Context(), command.RescheduleTraining{
+		User:            user,
+		TrainingUUID:    trainingUUID.String(),
+		NewTime:         rescheduleTraining.Time,
+		NewNotes:        rescheduleTraining.Notes,
+		ExpectedVersion: expectedVersion,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+}
+
+func (h HttpServer) RequestRescheduleTraining(
+	w http.ResponseWriter,
+	r *http.Request,
+	trainingUUID openapi_types.UUID,
+	params RequestRescheduleTrainingParams,
+) {
+	expectedVersion, err := expectedVersionFromIfMatch(params.IfMatch)
+	if err != nil {
+		respondWithIfMatchError(err, w, r)
+		return
+	}
+
+	rescheduleTraining := PostTraining{}
+	if err := render.Decode(r, &rescheduleTraining); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	user, err := h.newDomainUserFromAuthUser(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.RequestTrainingReschedule.Handle(r.Context(), command.RequestTrainingReschedule{
+		User:            user,
+		TrainingUUID:    trainingUUID.String(),
+		NewTime:         rescheduleTraining.Time,
+		NewNotes:        rescheduleTraining.Notes,
+		ExpectedVersion: expectedVersion,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+}
+
+func (h HttpServer) ApproveRescheduleTraining(
+	w http.ResponseWriter,
+	r *http.Request,
+	trainingUUID openapi_types.UUID,
+	params ApproveRescheduleTrainingParams,
+) {
+	expectedVersion, err := expectedVersionFromIfMatch(params.IfMatch)
+	if err != nil {
+		respondWithIfMatchError(err, w, r)
+		return
+	}
+
+	user, err := h.newDomainUserFromAuthUser(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.ApproveTrainingReschedule.Handle(r.Context(), command.ApproveTrainingReschedule{
+		User:            user,
+		TrainingUUID:    trainingUUID.String(),
+		ExpectedVersion: expectedVersion,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+}
+
+func (h HttpServer) RejectRescheduleTraining(
+	w http.ResponseWriter,
+	r *http.Request,
+	trainingUUID openapi_types.UUID,
+	params RejectRescheduleTrainingParams,
+) {
+	expectedVersion, err := expectedVersionFromIfMatch(params.IfMatch)
+	if err != nil {
+		respondWithIfMatchError(err, w, r)
+		return
+	}
+
+	user, err := h.newDomainUserFromAuthUser(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.RejectTrainingReschedule.Handle(r.Context(), command.RejectTrainingReschedule{
+		User:            user,
+		TrainingUUID:    trainingUUID.String(),
+		ExpectedVersion: expectedVersion,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+}
+
+func (h HttpServer) RecordTrainingAttendance(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
+	postAttendance := PostAttendance{}
+	if err := render.Decode(r, &postAttendance); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	attendance, err := training.NewAttendanceFromString(string(postAttendance.Attendance))
+	if err != nil {
+		httperr.BadRequest("invalid-attendance", err, w, r)
+		return
+	}
+
+	user, err := h.newDomainUserFromAuthUser(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.RecordTrainingAttendance.Handle(r.Context(), command.RecordTrainingAttendance{
+		User:         user,
+		TrainingUUID: trainingUUID.String(),
+		Attendance:   attendance,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+}
+
+func (h HttpServer) RateTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
+	postFeedback := PostFeedback{}
+	if err := render.Decode(r, &postFeedback); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	user, err := h.newDomainUserFromAuthUser(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.RateTraining.Handle(r.Context(), command.RateTraining{
+		User:         user,
+		TrainingUUID: trainingUUID.String(),
+		Rating:       postFeedback.Rating,
+		Comment:      postFeedback.Comment,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+}
+
+func (h HttpServer) ReplyToTrainingFeedback(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
+	postReply := PostFeedbackReply{}
+	if err := render.Decode(r, &postReply); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	user, err := h.newDomainUserFromAuthUser(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.ReplyToTrainingFeedback.Handle(r.Context(), command.ReplyToTrainingFeedback{
+		User:         user,
+		TrainingUUID: trainingUUID.String(),
+		Reply:        postReply.Reply,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+}
+
+func (h HttpServer) UpdateTrainingNotes(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
+	putNotes := PutTrainingNotes{}
+	if err := render.Decode(r, &putNotes); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	user, err := h.newDomainUserFromAuthUser(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.UpdateTrainingNotes.Handle(r.Context(), command.UpdateTrainingNotes{
+		TrainingUUID: trainingUUID.String(),
+		User:         user,
+		Notes:        putNotes.Notes,
+		TrainerNotes: putNotes.TrainerNotes,
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+}
+
+func (h HttpServer) GetTrainingNotesHistory(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	history, err := h.app.Queries.TrainingNotesHistory.Handle(r.Context(), query.TrainingNotesHistory{
+		User:         user,
+		TrainingUUID: trainingUUID.String(),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, appNotesHistoryToResponse(history))
+}
+
+func (h HttpServer) GetClient(w http.ResponseWriter, r *http.Request, clientUUID openapi_types.UUID) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	client, err := h.app.Queries.ClientByUUID.Handle(r.Context(), query.ClientByUUID{
+		User:       user,
+		ClientUUID: clientUUID.String(),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, appClientDetailsToResponse(client))
+}
+
+func (h HttpServer) ExportUserData(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	archive, err := h.app.Queries.UserDataExport.Handle(r.Context(), query.UserDataExport{
+		User:     user,
+		UserUUID: userUUID.String(),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	resp, err := appUserDataArchiveToResponse(archive)
+	if err != nil {
+		httperr.InternalError("cannot-export-user-data", err, w, r)
+		return
+	}
+
+	render.Respond(w, r, resp)
+}
+
+func (h HttpServer) EraseUserData(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.EraseUserData.Handle(r.Context(), command.EraseUserData{
+		User:     user,
+		UserUUID: userUUID.String(),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) GetTrainerRating(w http.ResponseWriter, r *http.Request, params GetTrainerRatingParams) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	q := query.TrainerRating{User: user}
+	if params.Limit != nil {
+		q.RecentFeedbackLimit = *params.Limit
+	}
+
+	rating, err := h.app.Queries.TrainerRating.Handle(r.Context(), q)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, appTrainerRatingToResponse(rating))
+}
+
+func (h HttpServer) ExportTrainingHistory(w http.ResponseWriter, r *http.Request, params ExportTrainingHistoryParams) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	format := Json
+	if params.Format != nil {
+		format = *params.Format
+	}
+	export := &trainingHistoryExport{w: w, format: format}
+
+	q := query.TrainingHistory{User: user, Emit: export.write}
+	if params.Timezone != nil {
+		q.Timezone = *params.Timezone
+	}
+
+	_, err = h.app.Queries.TrainingHistory.Handle(r.Context(), q)
+	if err == nil {
+		err = export.finish()
+	}
+	if err != nil {
+		if !export.started {
+			httperr.RespondWithSlugError(err, w, r)
+			return
+		}
+		// part of the history was already sent, aborting the response
+		// lets the client know it's incomplete instead of ending it as if it was the whole history
+		panic(http.ErrAbortHandler)
+	}
+}
+
+func (h HttpServer) GetUtilizationReport(w http.ResponseWriter, r *http.Request, params GetUtilizationReportParams) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	periods, err := h.app.Queries.UtilizationReport.Handle(r.Context(), query.UtilizationReport{
+		User:   user,
+		From:   params.DateFrom,
+		To:     params.DateTo,
+		Period: string(params.Period),
+	})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	if params.Format != nil && *params.Format == Csv {
+		respondWithCSV(w, r, "utilization-report.csv", utilizationReportToCSV(periods))
+		return
+	}
+
+	render.Respond(w, r, UtilizationReport{Periods: appUtilizationReportToResponse(periods)})
 }
 
-func (h HttpServer) CancelTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
-	user, err := newDomainUserFromAuthUser(r.Context())
+func (h HttpServer) GetCancellationReport(w http.ResponseWriter, r *http.Request, params GetCancellationReportParams) {
+	user, err := auth.UserFromCtx(r.Context())
 	if err != nil {
 		httperr.RespondWithSlugError(err, w, r)
 		return
 	}
 
-	err = h.app.Commands.CancelTraining.Handle(r.Context(), command.CancelTraining{
-		TrainingUUID: trainingUUID.String(),
-		User:         user,
+	periods, err := h.app.Queries.CancellationReport.Handle(r.Context(), query.CancellationReport{
+		User:   user,
+		From:   params.DateFrom,
+		To:     params.DateTo,
+		Period: string(params.Period),
 	})
 	if err != nil {
 		httperr.RespondWithSlugError(err, w, r)
 		return
 	}
+
+	if params.Format != nil && *params.Format == Csv {
+		respondWithCSV(w, r, "cancellation-report.csv", cancellationReportToCSV(periods))
+		return
+	}
+
+	render.Respond(w, r, CancellationReport{Periods: appCancellationReportToResponse(periods)})
 }
 
-func (h HttpServer) RescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
-	rescheduleTraining := PostTraining{}
-	if err := render.Decode(r, &rescheduleTraining); err != nil {
+func (h HttpServer) GetSessionTypes(w http.ResponseWriter, r *http.Request) {
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	sessionTypes, err := h.app.Queries.SessionTypes.Handle(r.Context(), query.SessionTypes{User: user})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, SessionTypes{SessionTypes: appSessionTypesToResponse(sessionTypes)})
+}
+
+func (h HttpServer) CreateSessionType(w http.ResponseWriter, r *http.Request) {
+	postSessionType := PostSessionType{}
+	if err := render.Decode(r, &postSessionType); err != nil {
 		httperr.BadRequest("invalid-request", err, w, r)
 		return
 	}
 
-	user, err := newDomainUserFromAuthUser(r.Context())
+	user, err := h.newDomainUserFromAuthUser(r.Context())
 	if err != nil {
 		httperr.RespondWithSlugError(err, w, r)
 		return
 	}
 
-	err = h.app.Commands.RescheduleTraining.Handle(r.Context(), command.RescheduleTraining{
-		User:         user,
-		TrainingUUID: trainingUUID.String(),
-		NewTime:      rescheduleTraining.Time,
-		NewNotes:     rescheduleTraining.Notes,
-	})
+	cmd := command.AddSessionType{
+		Code:        postSessionType.Code,
+		Name:        postSessionType.Name,
+		Duration:    time.Duration(postSessionType.DurationMinutes) * time.Minute,
+		CreditPrice: postSessionType.CreditPrice,
+		User:        user,
+	}
+	if postSessionType.Description != nil {
+		cmd.Description = *postSessionType.Description
+	}
+	if postSessionType.CancellationTiers != nil {
+		cmd.CancellationTiers = cancellationTiersFromRequest(*postSessionType.CancellationTiers)
+	}
+
+	err = h.app.Commands.AddSessionType.Handle(r.Context(), cmd)
 	if err != nil {
 		httperr.RespondWithSlugError(err, w, r)
 		return
 	}
+
+	w.Header().Set("content-location", "/trainings/session-types/"+cmd.Code)
+	w.WriteHeader(http.StatusNoContent)
 }
 
-func (h HttpServer) RequestRescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
-	rescheduleTraining := PostTraining{}
-	if err := render.Decode(r, &rescheduleTraining); err != nil {
+func (h HttpServer) UpdateSessionType(w http.ResponseWriter, r *http.Request, code string) {
+	putSessionType := PutSessionType{}
+	if err := render.Decode(r, &putSessionType); err != nil {
 		httperr.BadRequest("invalid-request", err, w, r)
 		return
 	}
 
-	user, err := newDomainUserFromAuthUser(r.Context())
+	user, err := h.newDomainUserFromAuthUser(r.Context())
 	if err != nil {
 		httperr.RespondWithSlugError(err, w, r)
 		return
 	}
 
-	err = h.app.Commands.RequestTrainingReschedule.Handle(r.Context(), command.RequestTrainingReschedule{
-		User:         user,
-		TrainingUUID: trainingUUID.String(),
-		NewTime:      rescheduleTraining.Time,
-		NewNotes:     rescheduleTraining.Notes,
-	})
+	cmd := command.UpdateSessionType{
+		Code:        code,
+		Name:        putSessionType.Name,
+		Duration:    time.Duration(putSessionType.DurationMinutes) * time.Minute,
+		CreditPrice: putSessionType.CreditPrice,
+		Archived:    putSessionType.Archived,
+		User:        user,
+	}
+	if putSessionType.Description != nil {
+		cmd.Description = *putSessionType.Description
+	}
+	if putSessionType.CancellationTiers != nil {
+		cmd.CancellationTiers = cancellationTiersFromRequest(*putSessionType.CancellationTiers)
+	}
+
+	err = h.app.Commands.UpdateSessionType.Handle(r.Context(), cmd)
 	if err != nil {
 		httperr.RespondWithSlugError(err, w, r)
 		return
 	}
 }
 
-func (h HttpServer) ApproveRescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
-	user, err := newDomainUserFromAuthUser(r.Context())
+func (h HttpServer) GetWaitlist(w http.ResponseWriter, r *http.Request) {
+	user, err := auth.UserFromCtx(r.Context())
 	if err != nil {
 		httperr.RespondWithSlugError(err, w, r)
 		return
 	}
 
-	err = h.app.Commands.ApproveTrainingReschedule.Handle(r.Context(), command.ApproveTrainingReschedule{
-		User:         user,
-		TrainingUUID: trainingUUID.String(),
+	entries, err := h.app.Queries.WaitlistForUser.Handle(r.Context(), query.WaitlistForUser{User: user})
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	render.Respond(w, r, Waitlist{Entries: appWaitlistToResponse(entries)})
+}
+
+func (h HttpServer) JoinWaitlist(w http.ResponseWriter, r *http.Request) {
+	postEntry := PostWaitlistEntry{}
+	if err := render.Decode(r, &postEntry); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	user, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	if user.Role != "attendee" {
+		httperr.Unauthorised("invalid-role", nil, w, r)
+		return
+	}
+
+	cmd := command.JoinWaitlist{
+		EntryUUID: uuid.New().String(),
+		UserUUID:  user.UUID,
+		UserName:  user.DisplayName,
+		Hour:      postEntry.Time,
+	}
+	if postEntry.AutoBook != nil {
+		cmd.AutoBook = *postEntry.AutoBook
+	}
+
+	err = h.app.Commands.JoinWaitlist.Handle(r.Context(), cmd)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	w.Header().Set("content-location", "/trainings/waitlist/"+cmd.EntryUUID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h HttpServer) LeaveWaitlist(w http.ResponseWriter, r *http.Request, entryUUID openapi_types.UUID) {
+	user, err := h.newDomainUserFromAuthUser(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	err = h.app.Commands.LeaveWaitlist.Handle(r.Context(), command.LeaveWaitlist{
+		EntryUUID: entryUUID.String(),
+		User:      user,
 	})
 	if err != nil {
 		httperr.RespondWithSlugError(err, w, r)
@@ -168,21 +884,142 @@ func (h HttpServer) ApproveRescheduleTraining(w http.ResponseWriter, r *http.Req
 	}
 }
 
-func (h HttpServer) RejectRescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
-	user, err := newDomainUserFromAuthUser(r.Context())
+func (h HttpServer) ClaimWaitlistOffer(w http.ResponseWriter, r *http.Request, entryUUID openapi_types.UUID) {
+	postClaim := PostWaitlistClaim{}
+	if err := render.Decode(r, &postClaim); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	user, err := h.newDomainUserFromAuthUser(r.Context())
 	if err != nil {
 		httperr.RespondWithSlugError(err, w, r)
 		return
 	}
 
-	err = h.app.Commands.RejectTrainingReschedule.Handle(r.Context(), command.RejectTrainingReschedule{
+	if user.Type() != training.Attendee {
+		httperr.Unauthorised("invalid-role", nil, w, r)
+		return
+	}
+
+	cmd := command.ClaimWaitlistOffer{
+		EntryUUID:    entryUUID.String(),
+		TrainingUUID: uuid.New().String(),
 		User:         user,
-		TrainingUUID: trainingUUID.String(),
-	})
+		Notes:        postClaim.Notes,
+	}
+	err = h.app.Commands.ClaimWaitlistOffer.Handle(r.Context(), cmd)
 	if err != nil {
 		httperr.RespondWithSlugError(err, w, r)
 		return
 	}
+
+	w.Header().Set("content-location", "/trainings/"+cmd.TrainingUUID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func appTrainerRatingToResponse(rating query.TrainerRatingSummary) TrainerRating {
+	recentFeedback := make([]Feedback, 0, len(rating.RecentFeedback))
+	for _, f := range rating.RecentFeedback {
+		recentFeedback = append(recentFeedback, Feedback{
+			TrainingUuid: uuid.MustParse(f.TrainingUUID),
+			User:         f.User,
+			UserUuid:     uuid.MustParse(f.UserUUID),
+			TrainingTime: f.TrainingTime,
+			Rating:       f.Rating,
+			Comment:      f.Comment,
+			Reply:        f.Reply,
+			CreatedAt:    f.CreatedAt,
+			RepliedAt:    f.RepliedAt,
+		})
+	}
+
+	return TrainerRating{
+		AverageRating:  rating.AverageRating,
+		RatingsCount:   rating.RatingsCount,
+		RecentFeedback: recentFeedback,
+	}
+}
+
+func appNotesHistoryToResponse(history query.NotesHistory) TrainingNotesHistory {
+	return TrainingNotesHistory{Revisions: appNotesRevisionsToResponse(history.Revisions)}
+}
+
+func appNotesRevisionsToResponse(appRevisions []query.NotesRevision) []TrainingNotesRevision {
+	revisions := make([]TrainingNotesRevision, 0, len(appRevisions))
+	for _, revision := range appRevisions {
+		revisions = append(revisions, TrainingNotesRevision{
+			Kind:         TrainingNotesRevisionKind(revision.Kind),
+			Notes:        revision.Notes,
+			EditedBy:     uuid.MustParse(revision.EditedBy),
+			EditedByRole: TrainingNotesRevisionEditedByRole(revision.EditedByRole),
+			EditedAt:     revision.EditedAt,
+		})
+	}
+
+	return revisions
+}
+
+func appUserDataArchiveToResponse(archive query.UserDataArchive) (UserDataArchive, error) {
+	var users map[string]interface{}
+	if err := json.Unmarshal(archive.Users, &users); err != nil {
+		return UserDataArchive{}, fmt.Errorf("invalid users data: %w", err)
+	}
+
+	data := archive.Trainings
+	trainings := make([]UserDataTraining, 0, len(data.Trainings))
+	for _, tr := range data.Trainings {
+		t := UserDataTraining{
+			Uuid:            uuid.MustParse(tr.UUID),
+			Time:            tr.Time,
+			SessionType:     tr.SessionType,
+			Price:           tr.Price,
+			Notes:           tr.Notes,
+			NotesRevisions:  appNotesRevisionsToResponse(tr.NotesRevisions),
+			Canceled:        tr.Canceled,
+			CanceledAt:      tr.CanceledAt,
+			Rating:          tr.Rating,
+			FeedbackComment: tr.FeedbackComment,
+			FeedbackReply:   tr.FeedbackReply,
+			CreatedAt:       tr.CreatedAt,
+		}
+		if tr.Attendance != nil {
+			attendance := UserDataTrainingAttendance(*tr.Attendance)
+			t.Attendance = &attendance
+		}
+		trainings = append(trainings, t)
+	}
+
+	series := make([]UserDataSeries, 0, len(data.Series))
+	for _, s := range data.Series {
+		series = append(series, UserDataSeries{
+			Uuid:      uuid.MustParse(s.UUID),
+			Canceled:  s.Canceled,
+			CreatedAt: s.CreatedAt,
+		})
+	}
+
+	entries := make([]UserDataWaitlistEntry, 0, len(data.WaitlistEntries))
+	for _, e := range data.WaitlistEntries {
+		entries = append(entries, UserDataWaitlistEntry{
+			Uuid:      uuid.MustParse(e.UUID),
+			Time:      e.Hour,
+			AutoBook:  e.AutoBook,
+			Status:    UserDataWaitlistEntryStatus(e.Status),
+			CreatedAt: e.CreatedAt,
+		})
+	}
+
+	return UserDataArchive{
+		UserUuid:   uuid.MustParse(archive.UserUUID),
+		ExportedAt: archive.ExportedAt,
+		Users:      users,
+		Trainings: UserTrainingsData{
+			Trainings:       trainings,
+			Series:          series,
+			WaitlistEntries: entries,
+		},
+	}, nil
 }
 
 func appTrainingsToResponse(appTrainings []query.Training) []Training {
@@ -193,12 +1030,24 @@ func appTrainingsToResponse(appTrainings []query.Training) []Training {
 			MoveProposedBy:     tm.MoveProposedBy,
 			MoveRequiresAccept: tm.CanBeCancelled,
 			Notes:              tm.Notes,
+			TrainerNotes:       tm.TrainerNotes,
+			SessionType:        tm.SessionType,
+			Price:              tm.Price,
 			ProposedTime:       tm.ProposedTime,
+			ProposalExpiresAt:  tm.ProposalExpiresAt,
 			Time:               tm.Time,
 			User:               tm.User,
 			UserUuid:           uuid.MustParse(tm.UserUUID),
 			Uuid:               uuid.MustParse(tm.UUID),
 		}
+		if tm.Attendance != nil {
+			attendance := TrainingAttendance(*tm.Attendance)
+			t.Attendance = &attendance
+		}
+		if tm.SeriesUUID != nil {
+			seriesUUID := uuid.MustParse(*tm.SeriesUUID)
+			t.SeriesUuid = &seriesUUID
+		}
 
 		trainings = append(trainings, t)
 	}
@@ -206,16 +1055,398 @@ func appTrainingsToResponse(appTrainings []query.Training) []Training {
 	return trainings
 }
 
-func newDomainUserFromAuthUser(ctx context.Context) (training.User, error) {
+func appClientDetailsToResponse(client query.ClientDetails) ClientDetails {
+	response := ClientDetails{
+		Client: ClientProfile{
+			Uuid:        uuid.MustParse(client.Profile.UUID),
+			Name:        client.Profile.Name,
+			Deactivated: client.Profile.Deactivated,
+			Balance:     client.Profile.Balance,
+		},
+		Trainings: appTrainingsToResponse(client.Trainings),
+	}
+	if client.Profile.Email != "" {
+		response.Client.Email = &client.Profile.Email
+	}
+	if client.Profile.AvatarURL != "" {
+		response.Client.AvatarUrl = &client.Profile.AvatarURL
+	}
+	if response.Trainings == nil {
+		response.Trainings = []Training{}
+	}
+
+	return response
+}
+
+func appTrainingSeriesToResponse(series query.Series) TrainingSeries {
+	occurrences := make([]TrainingSeriesOccurrence, 0, len(series.Occurrences))
+	for _, o := range series.Occurrences {
+		occurrence := TrainingSeriesOccurrence{
+			Time:          o.Time,
+			Status:        TrainingSeriesOccurrenceStatus(o.Status),
+			FailureReason: o.FailureReason,
+		}
+		if o.TrainingUUID != nil {
+			trainingUUID := uuid.MustParse(*o.TrainingUUID)
+			occurrence.TrainingUuid = &trainingUUID
+		}
+
+		occurrences = append(occurrences, occurrence)
+	}
+
+	return TrainingSeries{
+		Uuid:        uuid.MustParse(series.UUID),
+		User:        series.User,
+		UserUuid:    uuid.MustParse(series.UserUUID),
+		Canceled:    series.Canceled,
+		Occurrences: occurrences,
+	}
+}
+
+func appBulkCancellationToResponse(b query.BulkCancellation) BulkCancellation {
+	trainings := make([]BulkCanceledTraining, 0, len(b.Trainings))
+	for _, tr := range b.Trainings {
+		canceledTraining := BulkCanceledTraining{
+			TrainingUuid:    uuid.MustParse(tr.TrainingUUID),
+			Status:          BulkCanceledTrainingStatus(tr.Status),
+			Time:            tr.Time,
+			BalanceDelta:    tr.BalanceDelta,
+			HourUnavailable: tr.HourUnavailable,
+		}
+		if tr.AttendeeUUID != nil {
+			attendeeUUID := uuid.MustParse(*tr.AttendeeUUID)
+			canceledTraining.AttendeeUuid = &attendeeUUID
+		}
+
+		trainings = append(trainings, canceledTraining)
+	}
+
+	return BulkCancellation{
+		Uuid:                 uuid.MustParse(b.UUID),
+		From:                 b.From,
+		To:                   b.To,
+		Completed:            b.Completed,
+		CanceledCount:        b.CanceledCount,
+		AlreadyCanceledCount: b.AlreadyCanceledCount,
+		PendingCount:         b.PendingCount,
+		RefundedCredits:      b.RefundedCredits,
+		Trainings:            trainings,
+	}
+}
+
+func appSessionTypesToResponse(appSessionTypes []query.SessionType) []SessionType {
+	sessionTypes := make([]SessionType, 0, len(appSessionTypes))
+	for _, st := range appSessionTypes {
+		tiers := make([]CancellationTier, 0, len(st.CancellationTiers))
+		for _, tier := range st.CancellationTiers {
+			tiers = append(tiers, CancellationTier{
+				MinNoticeMinutes:      int(tier.MinNotice / time.Minute),
+				AttendeeRefundPercent: tier.AttendeeRefundPercent,
+				TrainerRefundPercent:  tier.TrainerRefundPercent,
+			})
+		}
+
+		sessionTypes = append(sessionTypes, SessionType{
+			Code:              st.Code,
+			Name:              st.Name,
+			Description:       st.Description,
+			DurationMinutes:   st.DurationMinutes,
+			CreditPrice:       st.CreditPrice,
+			Archived:          st.Archived,
+			CancellationTiers: tiers,
+		})
+	}
+
+	return sessionTypes
+}
+
+func cancellationTiersFromRequest(requestTiers []CancellationTier) []training.CancellationTier {
+	tiers := make([]training.CancellationTier, 0, len(requestTiers))
+	for _, tier := range requestTiers {
+		tiers = append(tiers, training.CancellationTier{
+			MinNotice:             time.Duration(tier.MinNoticeMinutes) * time.Minute,
+			AttendeeRefundPercent: tier.AttendeeRefundPercent,
+			TrainerRefundPercent:  tier.TrainerRefundPercent,
+		})
+	}
+
+	return tiers
+}
+
+func appWaitlistToResponse(appEntries []query.WaitlistEntry) []WaitlistEntry {
+	entries := make([]WaitlistEntry, 0, len(appEntries))
+	for _, e := range appEntries {
+		entries = append(entries, WaitlistEntry{
+			Uuid:           uuid.MustParse(e.UUID),
+			Time:           e.Hour,
+			AutoBook:       e.AutoBook,
+			Status:         WaitlistEntryStatus(e.Status),
+			OfferExpiresAt: e.OfferExpiresAt,
+		})
+	}
+
+	return entries
+}
+
+func appUtilizationReportToResponse(appPeriods []query.UtilizationReportPeriod) []UtilizationReportPeriod {
+	periods := make([]UtilizationReportPeriod, 0, len(appPeriods))
+	for _, p := range appPeriods {
+		periods = append(periods, UtilizationReportPeriod{
+			PeriodStart:     p.PeriodStart,
+			OfferedHours:    p.OfferedHours,
+			BookedHours:     p.BookedHours,
+			UtilizationRate: p.UtilizationRate,
+			Trainings:       p.Trainings,
+			Attended:        p.Attended,
+			NoShows:         p.NoShows,
+			Cancellations:   p.Cancellations,
+			Reschedules:     p.Reschedules,
+		})
+	}
+
+	return periods
+}
+
+func appCancellationReportToResponse(appPeriods []query.CancellationReportPeriod) []CancellationReportPeriod {
+	periods := make([]CancellationReportPeriod, 0, len(appPeriods))
+	for _, p := range appPeriods {
+		periods = append(periods, CancellationReportPeriod{
+			PeriodStart:          p.PeriodStart,
+			CanceledBy:           CancellationReportPeriodCanceledBy(p.CanceledBy),
+			Cancellations:        p.Cancellations,
+			LateCancellations:    p.LateCancellations,
+			AverageNoticeMinutes: int(p.AverageNotice / time.Minute),
+		})
+	}
+
+	return periods
+}
+
+func utilizationReportToCSV(periods []query.UtilizationReportPeriod) [][]string {
+	records := [][]string{{
+		"period_start", "offered_hours", "booked_hours", "utilization_rate",
+		"trainings", "attended", "no_shows", "cancellations", "reschedules",
+	}}
+	for _, p := range periods {
+		records = append(records, []string{
+			p.PeriodStart.Format(time.RFC3339),
+			strconv.Itoa(p.OfferedHours),
+			strconv.Itoa(p.BookedHours),
+			strconv.FormatFloat(p.UtilizationRate, 'f', 4, 64),
+			strconv.Itoa(p.Trainings),
+			strconv.Itoa(p.Attended),
+			strconv.Itoa(p.NoShows),
+			strconv.Itoa(p.Cancellations),
+			strconv.Itoa(p.Reschedules),
+		})
+	}
+
+	return records
+}
+
+func cancellationReportToCSV(periods []query.CancellationReportPeriod) [][]string {
+	records := [][]string{{
+		"period_start", "canceled_by", "cancellations", "late_cancellations", "average_notice_minutes",
+	}}
+	for _, p := range periods {
+		records = append(records, []string{
+			p.PeriodStart.Format(time.RFC3339),
+			p.CanceledBy,
+			strconv.Itoa(p.Cancellations),
+			strconv.Itoa(p.LateCancellations),
+			strconv.Itoa(int(p.AverageNotice / time.Minute)),
+		})
+	}
+
+	return records
+}
+
+// respondWithCSV renders the whole report before writing it, so a failure can still be reported as an error.
+func respondWithCSV(w http.ResponseWriter, r *http.Request, filename string, records [][]string) {
+	var buf bytes.Buffer
+	if err := csv.NewWriter(&buf).WriteAll(records); err != nil {
+		httperr.InternalError("csv-write-failed", err, w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// trainingHistoryExport writes trainings to the response as they are read, without keeping the whole history in memory.
+// The response is started with the first training, so errors returned before can still be sent as a regular error response.
+type trainingHistoryExport struct {
+	w      http.ResponseWriter
+	format ReportFormat
+	csv    *csv.Writer
+
+	started  bool
+	exported int
+}
+
+func (e *trainingHistoryExport) start() error {
+	e.started = true
+
+	filename := "training-history.json"
+	contentType := "application/json"
+	if e.format == Csv {
+		filename = "training-history.csv"
+		contentType = "text/csv; charset=utf-8"
+	}
+
+	e.w.Header().Set("Content-Type", contentType)
+	e.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	e.w.WriteHeader(http.StatusOK)
+
+	if e.format == Csv {
+		e.csv = csv.NewWriter(e.w)
+		return e.csv.Write([]string{"uuid", "time", "session_type", "price", "canceled", "attendance", "notes"})
+	}
+
+	_, err := io.WriteString(e.w, `{"trainings":[`)
+	return err
+}
+
+func (e *trainingHistoryExport) write(entry query.TrainingHistoryEntry) error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+	e.exported++
+
+	if e.format == Csv {
+		attendance := ""
+		if entry.Attendance != nil {
+			attendance = *entry.Attendance
+		}
+
+		return e.csv.Write([]string{
+			entry.UUID,
+			entry.Time.Format(time.RFC3339),
+			entry.SessionType,
+			strconv.Itoa(entry.Price),
+			strconv.FormatBool(entry.Canceled),
+			attendance,
+			entry.Notes,
+		})
+	}
+
+	data, err := json.Marshal(appTrainingHistoryEntryToResponse(entry))
+	if err != nil {
+		return err
+	}
+	if e.exported > 1 {
+		data = append([]byte(","), data...)
+	}
+
+	_, err = e.w.Write(data)
+	return err
+}
+
+func (e *trainingHistoryExport) finish() error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+
+	if e.format == Csv {
+		e.csv.Flush()
+		return e.csv.Error()
+	}
+
+	_, err := io.WriteString(e.w, "]}\n")
+	return err
+}
+
+func appTrainingHistoryEntryToResponse(entry query.TrainingHistoryEntry) TrainingHistoryEntry {
+	e := TrainingHistoryEntry{
+		Uuid:        uuid.MustParse(entry.UUID),
+		Time:        entry.Time,
+		SessionType: entry.SessionType,
+		Price:       entry.Price,
+		Canceled:    entry.Canceled,
+		Notes:       entry.Notes,
+	}
+	if entry.Attendance != nil {
+		attendance := TrainingHistoryEntryAttendance(*entry.Attendance)
+		e.Attendance = &attendance
+	}
+
+	return e
+}
+
+var errIfMatchRequired = errors.New("If-Match header with the ETag of the training is required")
+
+// expectedVersionFromIfMatch parses the training version from If-Match, which is required to change the training.
+// Zero is returned for "*", which matches any version.
+func expectedVersionFromIfMatch(ifMatch *string) (int, error) {
+	if ifMatch == nil || strings.TrimSpace(*ifMatch) == "" {
+		return 0, errIfMatchRequired
+	}
+
+	eTag := strings.TrimSpace(*ifMatch)
+	if eTag == "*" {
+		return 0, nil
+	}
+
+	// weak ETags, added for example by proxies compressing the response, carry the same version
+	eTag = strings.TrimPrefix(eTag, "W/")
+
+	version, err := strconv.Atoi(strings.Trim(eTag, `"`))
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("invalid If-Match header %q", *ifMatch)
+	}
+
+	return version, nil
+}
+
+func respondWithIfMatchError(err error, w http.ResponseWriter, r *http.Request) {
+	if errors.Is(err, errIfMatchRequired) {
+		httperr.PreconditionRequired("if-match-required", err, w, r)
+		return
+	}
+
+	httperr.BadRequest("invalid-if-match", err, w, r)
+}
+
+func trainingETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// userTypeFromRole maps the role of the user to the user type in trainings.
+// Admins manage users and their data, their own trainings are booked and canceled as by any attendee.
+func userTypeFromRole(role string) (training.UserType, error) {
+	if role == "admin" {
+		return training.Attendee, nil
+	}
+
+	return training.NewUserTypeFromString(role)
+}
+
+// newDomainUserFromAuthUser returns the user of the request, trainers are returned with their roster.
+func (h HttpServer) newDomainUserFromAuthUser(ctx context.Context) (training.User, error) {
 	user, err := auth.UserFromCtx(ctx)
 	if err != nil {
 		return training.User{}, err
 	}
 
-	userType, err := training.NewUserTypeFromString(user.Role)
+	userType, err := userTypeFromRole(user.Role)
 	if err != nil {
 		return training.User{}, err
 	}
 
+	if userType == training.Trainer {
+		roster, err := h.app.Queries.TrainerRoster.Handle(ctx, query.TrainerRoster{User: user})
+		if err != nil {
+			return training.User{}, err
+		}
+
+		return training.NewTrainer(user.UUID, roster.ClientUUIDs, roster.OtherTrainersClientUUIDs)
+	}
+
 	return training.NewUser(user.UUID, userType)
 }
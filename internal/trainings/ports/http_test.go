@@ -0,0 +1,79 @@
+package ports
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectedVersionFromIfMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name            string
+		IfMatch         *string
+		ExpectedVersion int
+		ExpectedErr     error
+		ShouldFail      bool
+	}{
+		{
+			Name:        "missing",
+			IfMatch:     nil,
+			ExpectedErr: errIfMatchRequired,
+		},
+		{
+			Name:        "empty",
+			IfMatch:     ptr(""),
+			ExpectedErr: errIfMatchRequired,
+		},
+		{
+			Name:            "any_version",
+			IfMatch:         ptr("*"),
+			ExpectedVersion: 0,
+		},
+		{
+			Name:            "strong",
+			IfMatch:         ptr(`"3"`),
+			ExpectedVersion: 3,
+		},
+		{
+			Name:            "weak",
+			IfMatch:         ptr(`W/"3"`),
+			ExpectedVersion: 3,
+		},
+		{
+			Name:       "not_version",
+			IfMatch:    ptr(`"abc"`),
+			ShouldFail: true,
+		},
+		{
+			Name:       "zero_version",
+			IfMatch:    ptr(`"0"`),
+			ShouldFail: true,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			version, err := expectedVersionFromIfMatch(c.IfMatch)
+			switch {
+			case c.ExpectedErr != nil:
+				assert.ErrorIs(t, err, c.ExpectedErr)
+			case c.ShouldFail:
+				require.Error(t, err)
+				assert.NotErrorIs(t, err, errIfMatchRequired)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, c.ExpectedVersion, version)
+			}
+		})
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
@@ -0,0 +1,56 @@
+package ports
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/config"
+	"github.com/vaintrub/go-ddd-template/internal/common/server"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
+)
+
+type job struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+}
+
+// RunJobs starts background jobs of the trainings service. It blocks until ctx is canceled.
+func RunJobs(ctx context.Context, application app.Application, cfg config.TrainingsConfig, logger *slog.Logger) {
+	jobs := []job{
+		{
+			name:     "complete-trainings",
+			interval: cfg.Attendance.JobInterval,
+			run: func(ctx context.Context) error {
+				return application.Commands.CompleteTrainings.Handle(ctx, command.CompleteTrainings{})
+			},
+		},
+		{
+			name:     "expire-reschedule-proposals",
+			interval: cfg.Reschedule.JobInterval,
+			run: func(ctx context.Context) error {
+				return application.Commands.ExpireRescheduleProposals.Handle(ctx, command.ExpireRescheduleProposals{})
+			},
+		},
+		{
+			name:     "expire-waitlist-offers",
+			interval: cfg.Waitlist.JobInterval,
+			run: func(ctx context.Context) error {
+				return application.Commands.ExpireWaitlistOffers.Handle(ctx, command.ExpireWaitlistOffers{})
+			},
+		},
+	}
+
+	var wg sync.WaitGroup
+	for _, j := range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			server.RunJob(ctx, j.name, j.interval, logger, j.run)
+		}()
+	}
+	wg.Wait()
+}
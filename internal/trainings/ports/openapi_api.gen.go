@@ -22,78 +22,1091 @@ type ServerInterface interface {
 	// (POST /trainings)
 	CreateTraining(w http.ResponseWriter, r *http.Request)
 
+	// (POST /trainings/bulk-cancellations)
+	CreateBulkCancellation(w http.ResponseWriter, r *http.Request)
+
+	// (GET /trainings/bulk-cancellations/{bulkCancellationUUID})
+	GetBulkCancellation(w http.ResponseWriter, r *http.Request, bulkCancellationUUID openapi_types.UUID)
+
+	// (POST /trainings/bulk-cancellations/{bulkCancellationUUID}/resume)
+	ResumeBulkCancellation(w http.ResponseWriter, r *http.Request, bulkCancellationUUID openapi_types.UUID)
+
+	// (GET /trainings/clients/{clientUUID})
+	GetClient(w http.ResponseWriter, r *http.Request, clientUUID openapi_types.UUID)
+
+	// (GET /trainings/export)
+	ExportTrainingHistory(w http.ResponseWriter, r *http.Request, params ExportTrainingHistoryParams)
+
+	// (GET /trainings/feedback)
+	GetTrainerRating(w http.ResponseWriter, r *http.Request, params GetTrainerRatingParams)
+
+	// (GET /trainings/reports/cancellations)
+	GetCancellationReport(w http.ResponseWriter, r *http.Request, params GetCancellationReportParams)
+
+	// (GET /trainings/reports/utilization)
+	GetUtilizationReport(w http.ResponseWriter, r *http.Request, params GetUtilizationReportParams)
+
+	// (POST /trainings/series)
+	CreateTrainingSeries(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /trainings/series/{seriesUUID})
+	CancelTrainingSeries(w http.ResponseWriter, r *http.Request, seriesUUID openapi_types.UUID)
+
+	// (GET /trainings/series/{seriesUUID})
+	GetTrainingSeries(w http.ResponseWriter, r *http.Request, seriesUUID openapi_types.UUID)
+
+	// (GET /trainings/session-types)
+	GetSessionTypes(w http.ResponseWriter, r *http.Request)
+
+	// (POST /trainings/session-types)
+	CreateSessionType(w http.ResponseWriter, r *http.Request)
+
+	// (PUT /trainings/session-types/{code})
+	UpdateSessionType(w http.ResponseWriter, r *http.Request, code string)
+
+	// (GET /trainings/users/{userUUID}/data-export)
+	ExportUserData(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID)
+
+	// (POST /trainings/users/{userUUID}/erasure)
+	EraseUserData(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID)
+
+	// (GET /trainings/waitlist)
+	GetWaitlist(w http.ResponseWriter, r *http.Request)
+
+	// (POST /trainings/waitlist)
+	JoinWaitlist(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /trainings/waitlist/{entryUUID})
+	LeaveWaitlist(w http.ResponseWriter, r *http.Request, entryUUID openapi_types.UUID)
+
+	// (PUT /trainings/waitlist/{entryUUID}/claim)
+	ClaimWaitlistOffer(w http.ResponseWriter, r *http.Request, entryUUID openapi_types.UUID)
+
 	// (DELETE /trainings/{trainingUUID})
-	CancelTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID)
+	CancelTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID, params CancelTrainingParams)
+
+	// (GET /trainings/{trainingUUID})
+	GetTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID)
+
+	// (PUT /trainings/{trainingUUID}/approve-reschedule)
+	ApproveRescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID, params ApproveRescheduleTrainingParams)
+
+	// (PUT /trainings/{trainingUUID}/attendance)
+	RecordTrainingAttendance(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID)
+
+	// (PUT /trainings/{trainingUUID}/feedback)
+	RateTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID)
+
+	// (PUT /trainings/{trainingUUID}/feedback/reply)
+	ReplyToTrainingFeedback(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID)
+
+	// (PUT /trainings/{trainingUUID}/notes)
+	UpdateTrainingNotes(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID)
+
+	// (GET /trainings/{trainingUUID}/notes/history)
+	GetTrainingNotesHistory(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID)
+
+	// (PUT /trainings/{trainingUUID}/reject-reschedule)
+	RejectRescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID, params RejectRescheduleTrainingParams)
+
+	// (PUT /trainings/{trainingUUID}/request-reschedule)
+	RequestRescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID, params RequestRescheduleTrainingParams)
+
+	// (PUT /trainings/{trainingUUID}/reschedule)
+	RescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID, params RescheduleTrainingParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /trainings)
+func (_ Unimplemented) GetTrainings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /trainings)
+func (_ Unimplemented) CreateTraining(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /trainings/bulk-cancellations)
+func (_ Unimplemented) CreateBulkCancellation(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /trainings/bulk-cancellations/{bulkCancellationUUID})
+func (_ Unimplemented) GetBulkCancellation(w http.ResponseWriter, r *http.Request, bulkCancellationUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /trainings/bulk-cancellations/{bulkCancellationUUID}/resume)
+func (_ Unimplemented) ResumeBulkCancellation(w http.ResponseWriter, r *http.Request, bulkCancellationUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /trainings/clients/{clientUUID})
+func (_ Unimplemented) GetClient(w http.ResponseWriter, r *http.Request, clientUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /trainings/export)
+func (_ Unimplemented) ExportTrainingHistory(w http.ResponseWriter, r *http.Request, params ExportTrainingHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /trainings/feedback)
+func (_ Unimplemented) GetTrainerRating(w http.ResponseWriter, r *http.Request, params GetTrainerRatingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /trainings/reports/cancellations)
+func (_ Unimplemented) GetCancellationReport(w http.ResponseWriter, r *http.Request, params GetCancellationReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /trainings/reports/utilization)
+func (_ Unimplemented) GetUtilizationReport(w http.ResponseWriter, r *http.Request, params GetUtilizationReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /trainings/series)
+func (_ Unimplemented) CreateTrainingSeries(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /trainings/series/{seriesUUID})
+func (_ Unimplemented) CancelTrainingSeries(w http.ResponseWriter, r *http.Request, seriesUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /trainings/series/{seriesUUID})
+func (_ Unimplemented) GetTrainingSeries(w http.ResponseWriter, r *http.Request, seriesUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /trainings/session-types)
+func (_ Unimplemented) GetSessionTypes(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /trainings/session-types)
+func (_ Unimplemented) CreateSessionType(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /trainings/session-types/{code})
+func (_ Unimplemented) UpdateSessionType(w http.ResponseWriter, r *http.Request, code string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /trainings/users/{userUUID}/data-export)
+func (_ Unimplemented) ExportUserData(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /trainings/users/{userUUID}/erasure)
+func (_ Unimplemented) EraseUserData(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /trainings/waitlist)
+func (_ Unimplemented) GetWaitlist(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /trainings/waitlist)
+func (_ Unimplemented) JoinWaitlist(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /trainings/waitlist/{entryUUID})
+func (_ Unimplemented) LeaveWaitlist(w http.ResponseWriter, r *http.Request, entryUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /trainings/waitlist/{entryUUID}/claim)
+func (_ Unimplemented) ClaimWaitlistOffer(w http.ResponseWriter, r *http.Request, entryUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /trainings/{trainingUUID})
+func (_ Unimplemented) CancelTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID, params CancelTrainingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /trainings/{trainingUUID})
+func (_ Unimplemented) GetTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /trainings/{trainingUUID}/approve-reschedule)
+func (_ Unimplemented) ApproveRescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID, params ApproveRescheduleTrainingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /trainings/{trainingUUID}/attendance)
+func (_ Unimplemented) RecordTrainingAttendance(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /trainings/{trainingUUID}/feedback)
+func (_ Unimplemented) RateTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /trainings/{trainingUUID}/feedback/reply)
+func (_ Unimplemented) ReplyToTrainingFeedback(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /trainings/{trainingUUID}/notes)
+func (_ Unimplemented) UpdateTrainingNotes(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /trainings/{trainingUUID}/notes/history)
+func (_ Unimplemented) GetTrainingNotesHistory(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /trainings/{trainingUUID}/reject-reschedule)
+func (_ Unimplemented) RejectRescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID, params RejectRescheduleTrainingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /trainings/{trainingUUID}/request-reschedule)
+func (_ Unimplemented) RequestRescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID, params RequestRescheduleTrainingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /trainings/{trainingUUID}/reschedule)
+func (_ Unimplemented) RescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID, params RescheduleTrainingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetTrainings operation middleware
+func (siw *ServerInterfaceWrapper) GetTrainings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrainings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateTraining operation middleware
+func (siw *ServerInterfaceWrapper) CreateTraining(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTraining(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateBulkCancellation operation middleware
+func (siw *ServerInterfaceWrapper) CreateBulkCancellation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBulkCancellation(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBulkCancellation operation middleware
+func (siw *ServerInterfaceWrapper) GetBulkCancellation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "bulkCancellationUUID" -------------
+	var bulkCancellationUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "bulkCancellationUUID", runtime.ParamLocationPath, chi.URLParam(r, "bulkCancellationUUID"), &bulkCancellationUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bulkCancellationUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBulkCancellation(w, r, bulkCancellationUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ResumeBulkCancellation operation middleware
+func (siw *ServerInterfaceWrapper) ResumeBulkCancellation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "bulkCancellationUUID" -------------
+	var bulkCancellationUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "bulkCancellationUUID", runtime.ParamLocationPath, chi.URLParam(r, "bulkCancellationUUID"), &bulkCancellationUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bulkCancellationUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeBulkCancellation(w, r, bulkCancellationUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetClient operation middleware
+func (siw *ServerInterfaceWrapper) GetClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "clientUUID" -------------
+	var clientUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clientUUID", runtime.ParamLocationPath, chi.URLParam(r, "clientUUID"), &clientUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clientUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClient(w, r, clientUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ExportTrainingHistory operation middleware
+func (siw *ServerInterfaceWrapper) ExportTrainingHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportTrainingHistoryParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "timezone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timezone", r.URL.Query(), &params.Timezone)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timezone", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportTrainingHistory(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTrainerRating operation middleware
+func (siw *ServerInterfaceWrapper) GetTrainerRating(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTrainerRatingParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrainerRating(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCancellationReport operation middleware
+func (siw *ServerInterfaceWrapper) GetCancellationReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCancellationReportParams
+
+	// ------------- Required query parameter "dateFrom" -------------
+
+	if paramValue := r.URL.Query().Get("dateFrom"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "dateFrom"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "dateFrom", r.URL.Query(), &params.DateFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dateFrom", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "dateTo" -------------
+
+	if paramValue := r.URL.Query().Get("dateTo"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "dateTo"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "dateTo", r.URL.Query(), &params.DateTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dateTo", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "period" -------------
+
+	if paramValue := r.URL.Query().Get("period"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "period"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCancellationReport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetUtilizationReport operation middleware
+func (siw *ServerInterfaceWrapper) GetUtilizationReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUtilizationReportParams
+
+	// ------------- Required query parameter "dateFrom" -------------
+
+	if paramValue := r.URL.Query().Get("dateFrom"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "dateFrom"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "dateFrom", r.URL.Query(), &params.DateFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dateFrom", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "dateTo" -------------
+
+	if paramValue := r.URL.Query().Get("dateTo"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "dateTo"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "dateTo", r.URL.Query(), &params.DateTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dateTo", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "period" -------------
+
+	if paramValue := r.URL.Query().Get("period"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "period"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUtilizationReport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateTrainingSeries operation middleware
+func (siw *ServerInterfaceWrapper) CreateTrainingSeries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTrainingSeries(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CancelTrainingSeries operation middleware
+func (siw *ServerInterfaceWrapper) CancelTrainingSeries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "seriesUUID" -------------
+	var seriesUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "seriesUUID", runtime.ParamLocationPath, chi.URLParam(r, "seriesUUID"), &seriesUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "seriesUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelTrainingSeries(w, r, seriesUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTrainingSeries operation middleware
+func (siw *ServerInterfaceWrapper) GetTrainingSeries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "seriesUUID" -------------
+	var seriesUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "seriesUUID", runtime.ParamLocationPath, chi.URLParam(r, "seriesUUID"), &seriesUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "seriesUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrainingSeries(w, r, seriesUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetSessionTypes operation middleware
+func (siw *ServerInterfaceWrapper) GetSessionTypes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSessionTypes(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateSessionType operation middleware
+func (siw *ServerInterfaceWrapper) CreateSessionType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSessionType(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateSessionType operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSessionType(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "code", runtime.ParamLocationPath, chi.URLParam(r, "code"), &code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSessionType(w, r, code)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ExportUserData operation middleware
+func (siw *ServerInterfaceWrapper) ExportUserData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userUUID" -------------
+	var userUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, chi.URLParam(r, "userUUID"), &userUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportUserData(w, r, userUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// EraseUserData operation middleware
+func (siw *ServerInterfaceWrapper) EraseUserData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userUUID" -------------
+	var userUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, chi.URLParam(r, "userUUID"), &userUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EraseUserData(w, r, userUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetWaitlist operation middleware
+func (siw *ServerInterfaceWrapper) GetWaitlist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWaitlist(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// JoinWaitlist operation middleware
+func (siw *ServerInterfaceWrapper) JoinWaitlist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.JoinWaitlist(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// LeaveWaitlist operation middleware
+func (siw *ServerInterfaceWrapper) LeaveWaitlist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "entryUUID" -------------
+	var entryUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entryUUID", runtime.ParamLocationPath, chi.URLParam(r, "entryUUID"), &entryUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entryUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LeaveWaitlist(w, r, entryUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ClaimWaitlistOffer operation middleware
+func (siw *ServerInterfaceWrapper) ClaimWaitlistOffer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "entryUUID" -------------
+	var entryUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "entryUUID", runtime.ParamLocationPath, chi.URLParam(r, "entryUUID"), &entryUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entryUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ClaimWaitlistOffer(w, r, entryUUID)
+	}))
 
-	// (PUT /trainings/{trainingUUID}/approve-reschedule)
-	ApproveRescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// (PUT /trainings/{trainingUUID}/reject-reschedule)
-	RejectRescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID)
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	// (PUT /trainings/{trainingUUID}/request-reschedule)
-	RequestRescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID)
+// CancelTraining operation middleware
+func (siw *ServerInterfaceWrapper) CancelTraining(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	// (PUT /trainings/{trainingUUID}/reschedule)
-	RescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID)
-}
+	var err error
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+	// ------------- Path parameter "trainingUUID" -------------
+	var trainingUUID openapi_types.UUID
 
-type Unimplemented struct{}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, chi.URLParam(r, "trainingUUID"), &trainingUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trainingUUID", Err: err})
+		return
+	}
 
-// (GET /trainings)
-func (_ Unimplemented) GetTrainings(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// (POST /trainings)
-func (_ Unimplemented) CreateTraining(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelTrainingParams
 
-// (DELETE /trainings/{trainingUUID})
-func (_ Unimplemented) CancelTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	headers := r.Header
 
-// (PUT /trainings/{trainingUUID}/approve-reschedule)
-func (_ Unimplemented) ApproveRescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
 
-// (PUT /trainings/{trainingUUID}/reject-reschedule)
-func (_ Unimplemented) RejectRescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
 
-// (PUT /trainings/{trainingUUID}/request-reschedule)
-func (_ Unimplemented) RequestRescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelTraining(w, r, trainingUUID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// (PUT /trainings/{trainingUUID}/reschedule)
-func (_ Unimplemented) RescheduleTraining(w http.ResponseWriter, r *http.Request, trainingUUID openapi_types.UUID) {
-	w.WriteHeader(http.StatusNotImplemented)
+// GetTraining operation middleware
+func (siw *ServerInterfaceWrapper) GetTraining(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "trainingUUID" -------------
+	var trainingUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, chi.URLParam(r, "trainingUUID"), &trainingUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trainingUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTraining(w, r, trainingUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// ApproveRescheduleTraining operation middleware
+func (siw *ServerInterfaceWrapper) ApproveRescheduleTraining(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "trainingUUID" -------------
+	var trainingUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, chi.URLParam(r, "trainingUUID"), &trainingUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trainingUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApproveRescheduleTrainingParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveRescheduleTraining(w, r, trainingUUID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// RecordTrainingAttendance operation middleware
+func (siw *ServerInterfaceWrapper) RecordTrainingAttendance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// GetTrainings operation middleware
-func (siw *ServerInterfaceWrapper) GetTrainings(w http.ResponseWriter, r *http.Request) {
+	var err error
+
+	// ------------- Path parameter "trainingUUID" -------------
+	var trainingUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, chi.URLParam(r, "trainingUUID"), &trainingUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trainingUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RecordTrainingAttendance(w, r, trainingUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RateTraining operation middleware
+func (siw *ServerInterfaceWrapper) RateTraining(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "trainingUUID" -------------
+	var trainingUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, chi.URLParam(r, "trainingUUID"), &trainingUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trainingUUID", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTrainings(w, r)
+		siw.Handler.RateTraining(w, r, trainingUUID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -103,14 +1116,25 @@ func (siw *ServerInterfaceWrapper) GetTrainings(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateTraining operation middleware
-func (siw *ServerInterfaceWrapper) CreateTraining(w http.ResponseWriter, r *http.Request) {
+// ReplyToTrainingFeedback operation middleware
+func (siw *ServerInterfaceWrapper) ReplyToTrainingFeedback(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "trainingUUID" -------------
+	var trainingUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "trainingUUID", runtime.ParamLocationPath, chi.URLParam(r, "trainingUUID"), &trainingUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trainingUUID", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTraining(w, r)
+		siw.Handler.ReplyToTrainingFeedback(w, r, trainingUUID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -120,8 +1144,8 @@ func (siw *ServerInterfaceWrapper) CreateTraining(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CancelTraining operation middleware
-func (siw *ServerInterfaceWrapper) CancelTraining(w http.ResponseWriter, r *http.Request) {
+// UpdateTrainingNotes operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTrainingNotes(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -138,7 +1162,7 @@ func (siw *ServerInterfaceWrapper) CancelTraining(w http.ResponseWriter, r *http
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CancelTraining(w, r, trainingUUID)
+		siw.Handler.UpdateTrainingNotes(w, r, trainingUUID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -148,8 +1172,8 @@ func (siw *ServerInterfaceWrapper) CancelTraining(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ApproveRescheduleTraining operation middleware
-func (siw *ServerInterfaceWrapper) ApproveRescheduleTraining(w http.ResponseWriter, r *http.Request) {
+// GetTrainingNotesHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetTrainingNotesHistory(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -166,7 +1190,7 @@ func (siw *ServerInterfaceWrapper) ApproveRescheduleTraining(w http.ResponseWrit
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ApproveRescheduleTraining(w, r, trainingUUID)
+		siw.Handler.GetTrainingNotesHistory(w, r, trainingUUID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -193,8 +1217,32 @@ func (siw *ServerInterfaceWrapper) RejectRescheduleTraining(w http.ResponseWrite
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RejectRescheduleTrainingParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RejectRescheduleTraining(w, r, trainingUUID)
+		siw.Handler.RejectRescheduleTraining(w, r, trainingUUID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -221,8 +1269,32 @@ func (siw *ServerInterfaceWrapper) RequestRescheduleTraining(w http.ResponseWrit
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RequestRescheduleTrainingParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RequestRescheduleTraining(w, r, trainingUUID)
+		siw.Handler.RequestRescheduleTraining(w, r, trainingUUID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -249,8 +1321,32 @@ func (siw *ServerInterfaceWrapper) RescheduleTraining(w http.ResponseWriter, r *
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RescheduleTrainingParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RescheduleTraining(w, r, trainingUUID)
+		siw.Handler.RescheduleTraining(w, r, trainingUUID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -379,12 +1475,90 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/trainings", wrapper.CreateTraining)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trainings/bulk-cancellations", wrapper.CreateBulkCancellation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trainings/bulk-cancellations/{bulkCancellationUUID}", wrapper.GetBulkCancellation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trainings/bulk-cancellations/{bulkCancellationUUID}/resume", wrapper.ResumeBulkCancellation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trainings/clients/{clientUUID}", wrapper.GetClient)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trainings/export", wrapper.ExportTrainingHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trainings/feedback", wrapper.GetTrainerRating)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trainings/reports/cancellations", wrapper.GetCancellationReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trainings/reports/utilization", wrapper.GetUtilizationReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trainings/series", wrapper.CreateTrainingSeries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trainings/series/{seriesUUID}", wrapper.CancelTrainingSeries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trainings/series/{seriesUUID}", wrapper.GetTrainingSeries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trainings/session-types", wrapper.GetSessionTypes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trainings/session-types", wrapper.CreateSessionType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/trainings/session-types/{code}", wrapper.UpdateSessionType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trainings/users/{userUUID}/data-export", wrapper.ExportUserData)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trainings/users/{userUUID}/erasure", wrapper.EraseUserData)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trainings/waitlist", wrapper.GetWaitlist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/trainings/waitlist", wrapper.JoinWaitlist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trainings/waitlist/{entryUUID}", wrapper.LeaveWaitlist)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/trainings/waitlist/{entryUUID}/claim", wrapper.ClaimWaitlistOffer)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/trainings/{trainingUUID}", wrapper.CancelTraining)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trainings/{trainingUUID}", wrapper.GetTraining)
+	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/trainings/{trainingUUID}/approve-reschedule", wrapper.ApproveRescheduleTraining)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/trainings/{trainingUUID}/attendance", wrapper.RecordTrainingAttendance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/trainings/{trainingUUID}/feedback", wrapper.RateTraining)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/trainings/{trainingUUID}/feedback/reply", wrapper.ReplyToTrainingFeedback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/trainings/{trainingUUID}/notes", wrapper.UpdateTrainingNotes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trainings/{trainingUUID}/notes/history", wrapper.GetTrainingNotesHistory)
+	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/trainings/{trainingUUID}/reject-reschedule", wrapper.RejectRescheduleTraining)
 	})
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/config"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
+)
+
+func TestNewCancellationPolicies(t *testing.T) {
+	t.Parallel()
+
+	validTiers := []config.CancellationTierConfig{
+		{MinNotice: 24 * time.Hour, AttendeeRefundPercent: 100, TrainerRefundPercent: 100},
+		{MinNotice: 0, AttendeeRefundPercent: 0, TrainerRefundPercent: 200},
+	}
+
+	testCases := []struct {
+		Name          string
+		Config        config.CancellationConfig
+		ExpectedError error
+	}{
+		{
+			Name: "valid",
+			Config: config.CancellationConfig{
+				CurrentVersion: "v2",
+				Policies: []config.CancellationPolicyConfig{
+					{Version: "v1", Tiers: validTiers},
+					{
+						Version:              "v2",
+						Tiers:                validTiers,
+						SessionTypeOverrides: map[string][]config.CancellationTierConfig{"group": validTiers},
+					},
+				},
+			},
+		},
+		{
+			Name: "missing_zero_notice_tier",
+			Config: config.CancellationConfig{
+				CurrentVersion: "v2",
+				Policies: []config.CancellationPolicyConfig{
+					{Version: "v2", Tiers: validTiers[:1]},
+				},
+			},
+			ExpectedError: training.ErrInvalidCancellationPolicy,
+		},
+		{
+			Name: "missing_zero_notice_tier_in_override",
+			Config: config.CancellationConfig{
+				CurrentVersion: "v2",
+				Policies: []config.CancellationPolicyConfig{
+					{
+						Version:              "v2",
+						Tiers:                validTiers,
+						SessionTypeOverrides: map[string][]config.CancellationTierConfig{"group": validTiers[:1]},
+					},
+				},
+			},
+			ExpectedError: training.ErrInvalidCancellationPolicy,
+		},
+		{
+			Name: "negative_refund",
+			Config: config.CancellationConfig{
+				CurrentVersion: "v2",
+				Policies: []config.CancellationPolicyConfig{
+					{Version: "v2", Tiers: []config.CancellationTierConfig{{AttendeeRefundPercent: -10}}},
+				},
+			},
+			ExpectedError: training.ErrInvalidCancellationPolicy,
+		},
+		{
+			Name: "unknown_current_version",
+			Config: config.CancellationConfig{
+				CurrentVersion: "v3",
+				Policies: []config.CancellationPolicyConfig{
+					{Version: "v2", Tiers: validTiers},
+				},
+			},
+			ExpectedError: training.ErrUnknownCancellationPolicy,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			policies, err := newCancellationPolicies(c.Config)
+
+			if c.ExpectedError != nil {
+				assert.ErrorIs(t, err, c.ExpectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, c.Config.CurrentVersion, policies.Current().Version())
+		})
+	}
+}
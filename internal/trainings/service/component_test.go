@@ -57,6 +57,36 @@ func TestCancelTraining(t *testing.T) {
 	require.NotContains(t, trainingsUUIDs, trainingUUID)
 }
 
+func TestCancelTraining_outdated_etag(t *testing.T) {
+	t.Parallel()
+
+	token := tests.FakeAttendeeJWT(t, uuid.New().String())
+	client := tests.NewTrainingsHTTPClient(t, token)
+
+	hour := tests.RelativeDate(10, 14)
+	trainingUUID := client.CreateTraining(t, "some note", hour)
+
+	eTag := client.GetTrainingETag(t, trainingUUID)
+	require.Equal(t, `"1"`, eTag)
+
+	client.CancelTrainingIfMatch(t, trainingUUID, `"2"`, http.StatusPreconditionFailed)
+	client.CancelTrainingIfMatch(t, trainingUUID, eTag, http.StatusOK)
+}
+
+func TestCancelTraining_if_match_required(t *testing.T) {
+	t.Parallel()
+
+	token := tests.FakeAttendeeJWT(t, uuid.New().String())
+	client := tests.NewTrainingsHTTPClient(t, token)
+
+	hour := tests.RelativeDate(10, 15)
+	trainingUUID := client.CreateTraining(t, "some note", hour)
+
+	client.CancelTrainingIfMatch(t, trainingUUID, "", http.StatusPreconditionRequired)
+	// weak ETags carry the same version
+	client.CancelTrainingIfMatch(t, trainingUUID, "W/"+client.GetTrainingETag(t, trainingUUID), http.StatusOK)
+}
+
 func startService(t *testing.T) bool {
 	app := NewComponentTestApplication(context.Background(), componentTestConfig())
 	logger := logs.Init(config.LoggingConfig{Level: "INFO"})
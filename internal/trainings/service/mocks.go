@@ -2,13 +2,21 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"time"
+
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
 )
 
 type TrainerServiceMock struct {
 }
 
-func (t TrainerServiceMock) ScheduleTraining(ctx context.Context, trainingTime time.Time) error {
+func (t TrainerServiceMock) IsHourAvailable(ctx context.Context, hour time.Time) (bool, error) {
+	return true, nil
+}
+
+func (t TrainerServiceMock) ScheduleTraining(ctx context.Context, trainingTime time.Time, duration time.Duration, attendeeUUID string) error {
 	return nil
 }
 
@@ -16,13 +24,51 @@ func (t TrainerServiceMock) CancelTraining(ctx context.Context, trainingTime tim
 	return nil
 }
 
-func (t TrainerServiceMock) MoveTraining(ctx context.Context, newTime time.Time, originalTrainingTime time.Time) error {
+func (t TrainerServiceMock) MakeHourUnavailable(ctx context.Context, hour time.Time) error {
+	return nil
+}
+
+func (t TrainerServiceMock) MoveTraining(
+	ctx context.Context,
+	newTime time.Time,
+	originalTrainingTime time.Time,
+	duration time.Duration,
+	attendeeUUID string,
+) error {
 	return nil
 }
 
 type UserServiceMock struct {
 }
 
-func (u UserServiceMock) UpdateTrainingBalance(ctx context.Context, userID string, amountChange int) error {
+func (u UserServiceMock) GetTrainingBalance(ctx context.Context, userID string) (int, error) {
+	return 1, nil
+}
+
+func (u UserServiceMock) UpdateTrainingBalance(ctx context.Context, userID string, amountChange int, change command.BalanceChange) error {
 	return nil
 }
+
+func (u UserServiceMock) ExportUserData(ctx context.Context, userID string) (json.RawMessage, error) {
+	return json.RawMessage(`{}`), nil
+}
+
+func (u UserServiceMock) EraseUser(ctx context.Context, userID string) error {
+	return nil
+}
+
+func (u UserServiceMock) UserNames(ctx context.Context, userIDs []string) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+func (u UserServiceMock) TrainerRoster(ctx context.Context, trainerUUID string) (query.Roster, error) {
+	return query.Roster{}, nil
+}
+
+func (u UserServiceMock) UserTimezone(ctx context.Context, userID string) (string, error) {
+	return "", nil
+}
+
+func (u UserServiceMock) ClientProfile(ctx context.Context, clientUUID string) (query.ClientProfile, error) {
+	return query.ClientProfile{UUID: clientUUID}, nil
+}
@@ -2,19 +2,25 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 
 	grpcClient "github.com/vaintrub/go-ddd-template/internal/common/client"
 	"github.com/vaintrub/go-ddd-template/internal/common/config"
 	"github.com/vaintrub/go-ddd-template/internal/common/db"
+	"github.com/vaintrub/go-ddd-template/internal/common/genproto/users"
 	"github.com/vaintrub/go-ddd-template/internal/common/metrics"
 	"github.com/vaintrub/go-ddd-template/internal/trainings/adapters"
 	"github.com/vaintrub/go-ddd-template/internal/trainings/app"
 	"github.com/vaintrub/go-ddd-template/internal/trainings/app/command"
 	"github.com/vaintrub/go-ddd-template/internal/trainings/app/query"
+	"github.com/vaintrub/go-ddd-template/internal/trainings/domain/training"
 )
 
-func NewApplication(ctx context.Context, cfg config.Config) (app.Application, func()) {
+// NewApplication returns the application with the users client it uses,
+// so the HTTP server can check sessions without opening another connection.
+// The returned function closes the clients.
+func NewApplication(ctx context.Context, cfg config.Config) (app.Application, users.UsersServiceClient, func()) {
 	trainerClient, closeTrainerClient, err := grpcClient.NewTrainerClient(cfg.GRPC)
 	if err != nil {
 		panic(err)
@@ -28,6 +34,7 @@ func NewApplication(ctx context.Context, cfg config.Config) (app.Application, fu
 	usersGrpc := adapters.NewUsersGrpc(usersClient)
 
 	return newApplication(ctx, cfg, trainerGrpc, usersGrpc),
+		usersClient,
 		func() {
 			_ = closeTrainerClient()
 			_ = closeUsersClient()
@@ -38,7 +45,18 @@ func NewComponentTestApplication(ctx context.Context, cfg config.Config) app.App
 	return newApplication(ctx, cfg, TrainerServiceMock{}, UserServiceMock{})
 }
 
-func newApplication(ctx context.Context, cfg config.Config, trainerGrpc command.TrainerService, usersGrpc command.UserService) app.Application {
+// usersService is the users bounded context, as seen by commands and queries of trainings.
+type usersService interface {
+	command.UserService
+	command.UserEraser
+	query.UserDataExporter
+	query.UserNames
+	query.TrainerClients
+	query.ClientProfiles
+	query.UserTimezones
+}
+
+func newApplication(ctx context.Context, cfg config.Config, trainerGrpc command.TrainerService, usersGrpc usersService) app.Application {
 	pool := db.MustNewPgxPool(ctx, cfg.Database, cfg.Env)
 
 	// Use PostgreSQL repository instead of Firestore
@@ -47,18 +65,94 @@ func newApplication(ctx context.Context, cfg config.Config, trainerGrpc command.
 	logger := slog.Default()
 	metricsClient := metrics.NoOp{}
 
+	attendanceCfg := cfg.Contexts.Trainings.Attendance
+	attendancePolicy := training.AttendancePolicy{
+		GracePeriod:   attendanceCfg.GracePeriod,
+		NoShowPenalty: attendanceCfg.NoShowPenalty,
+		AttendedBonus: attendanceCfg.AttendedBonus,
+	}
+
+	rescheduleCfg := cfg.Contexts.Trainings.Reschedule
+	claimTTL := cfg.Contexts.Trainings.Waitlist.ClaimTTL
+
+	cancellationPolicies, err := newCancellationPolicies(cfg.Contexts.Trainings.Cancellation)
+	if err != nil {
+		panic(err)
+	}
+
 	return app.Application{
 		Commands: app.Commands{
+			AddSessionType:            command.NewAddSessionTypeHandler(trainingsRepository, logger, metricsClient),
 			ApproveTrainingReschedule: command.NewApproveTrainingRescheduleHandler(trainingsRepository, usersGrpc, trainerGrpc, logger, metricsClient),
-			CancelTraining:            command.NewCancelTrainingHandler(trainingsRepository, usersGrpc, trainerGrpc, logger, metricsClient),
-			RejectTrainingReschedule:  command.NewRejectTrainingRescheduleHandler(trainingsRepository, logger, metricsClient),
-			RescheduleTraining:        command.NewRescheduleTrainingHandler(trainingsRepository, usersGrpc, trainerGrpc, logger, metricsClient),
-			RequestTrainingReschedule: command.NewRequestTrainingRescheduleHandler(trainingsRepository, logger, metricsClient),
-			ScheduleTraining:          command.NewScheduleTrainingHandler(trainingsRepository, usersGrpc, trainerGrpc, logger, metricsClient),
+			BulkCancelTrainings:       command.NewBulkCancelTrainingsHandler(trainingsRepository, trainingsRepository, usersGrpc, trainerGrpc, cancellationPolicies, logger, metricsClient),
+			CancelTraining:            command.NewCancelTrainingHandler(trainingsRepository, trainingsRepository, trainingsRepository, usersGrpc, trainerGrpc, cancellationPolicies, claimTTL, logger, metricsClient),
+			CancelTrainingSeries:      command.NewCancelTrainingSeriesHandler(trainingsRepository, trainingsRepository, trainingsRepository, trainingsRepository, usersGrpc, trainerGrpc, cancellationPolicies, claimTTL, logger, metricsClient),
+			ClaimWaitlistOffer:        command.NewClaimWaitlistOfferHandler(trainingsRepository, trainingsRepository, trainingsRepository, usersGrpc, trainerGrpc, cancellationPolicies, claimTTL, logger, metricsClient),
+			CompleteTrainings:         command.NewCompleteTrainingsHandler(trainingsRepository, attendancePolicy, logger, metricsClient),
+			EraseUserData:             command.NewEraseUserDataHandler(trainingsRepository, usersGrpc, logger, metricsClient),
+			ExpireRescheduleProposals: command.NewExpireRescheduleProposalsHandler(trainingsRepository, trainerGrpc, logger, metricsClient),
+			ExpireWaitlistOffers:      command.NewExpireWaitlistOffersHandler(trainingsRepository, trainingsRepository, trainingsRepository, usersGrpc, trainerGrpc, cancellationPolicies, claimTTL, logger, metricsClient),
+			JoinWaitlist:              command.NewJoinWaitlistHandler(trainingsRepository, trainerGrpc, logger, metricsClient),
+			LeaveWaitlist:             command.NewLeaveWaitlistHandler(trainingsRepository, trainingsRepository, trainingsRepository, usersGrpc, trainerGrpc, cancellationPolicies, claimTTL, logger, metricsClient),
+			RateTraining:              command.NewRateTrainingHandler(trainingsRepository, logger, metricsClient),
+			RecordTrainingAttendance:  command.NewRecordTrainingAttendanceHandler(trainingsRepository, usersGrpc, attendancePolicy, logger, metricsClient),
+			RejectTrainingReschedule:  command.NewRejectTrainingRescheduleHandler(trainingsRepository, trainerGrpc, logger, metricsClient),
+			ReplyToTrainingFeedback:   command.NewReplyToTrainingFeedbackHandler(trainingsRepository, logger, metricsClient),
+			RescheduleTraining:        command.NewRescheduleTrainingHandler(trainingsRepository, usersGrpc, trainerGrpc, cancellationPolicies, logger, metricsClient),
+			RequestTrainingReschedule: command.NewRequestTrainingRescheduleHandler(trainingsRepository, trainerGrpc, rescheduleCfg.ProposalTTL, rescheduleCfg.HoldProposedHour, logger, metricsClient),
+			ScheduleTraining:          command.NewScheduleTrainingHandler(trainingsRepository, trainingsRepository, usersGrpc, trainerGrpc, cancellationPolicies, logger, metricsClient),
+			ScheduleTrainingSeries:    command.NewScheduleTrainingSeriesHandler(trainingsRepository, trainingsRepository, trainingsRepository, usersGrpc, trainerGrpc, cancellationPolicies, logger, metricsClient),
+			UpdateSessionType:         command.NewUpdateSessionTypeHandler(trainingsRepository, logger, metricsClient),
+			UpdateTrainingNotes:       command.NewUpdateTrainingNotesHandler(trainingsRepository, logger, metricsClient),
 		},
 		Queries: app.Queries{
-			AllTrainings:     query.NewAllTrainingsHandler(trainingsRepository, logger, metricsClient),
-			TrainingsForUser: query.NewTrainingsForUserHandler(trainingsRepository, logger, metricsClient),
+			BulkCancellationByUUID: query.NewBulkCancellationByUUIDHandler(trainingsRepository, logger, metricsClient),
+			CancellationReport:     query.NewCancellationReportHandler(trainingsRepository, usersGrpc, logger, metricsClient),
+			ClientByUUID:           query.NewClientByUUIDHandler(trainingsRepository, usersGrpc, usersGrpc, logger, metricsClient),
+			ClientsTrainings:       query.NewClientsTrainingsHandler(trainingsRepository, usersGrpc, usersGrpc, logger, metricsClient),
+			SessionTypes:           query.NewSessionTypesHandler(trainingsRepository, cancellationPolicies, logger, metricsClient),
+			TrainerRating:          query.NewTrainerRatingHandler(trainingsRepository, usersGrpc, logger, metricsClient),
+			TrainerRoster:          query.NewTrainerRosterHandler(usersGrpc, logger, metricsClient),
+			TrainingByUUID:         query.NewTrainingByUUIDHandler(trainingsRepository, usersGrpc, usersGrpc, logger, metricsClient),
+			TrainingHistory:        query.NewTrainingHistoryHandler(trainingsRepository, usersGrpc, logger, metricsClient),
+			TrainingNotesHistory:   query.NewTrainingNotesHistoryHandler(trainingsRepository, usersGrpc, logger, metricsClient),
+			TrainingSeries:         query.NewTrainingSeriesHandler(trainingsRepository, usersGrpc, usersGrpc, logger, metricsClient),
+			TrainingsForUser:       query.NewTrainingsForUserHandler(trainingsRepository, usersGrpc, logger, metricsClient),
+			UserDataExport:         query.NewUserDataExportHandler(trainingsRepository, usersGrpc, logger, metricsClient),
+			UtilizationReport:      query.NewUtilizationReportHandler(trainingsRepository, usersGrpc, logger, metricsClient),
+			WaitlistForUser:        query.NewWaitlistForUserHandler(trainingsRepository, logger, metricsClient),
 		},
 	}
 }
+
+// newCancellationPolicies builds the policies from the config, invalid tiers are reported by the domain.
+func newCancellationPolicies(cfg config.CancellationConfig) (training.CancellationPolicies, error) {
+	policies := make([]training.CancellationPolicy, 0, len(cfg.Policies))
+	for _, policyCfg := range cfg.Policies {
+		overrides := make(map[string][]training.CancellationTier, len(policyCfg.SessionTypeOverrides))
+		for sessionType, tiersCfg := range policyCfg.SessionTypeOverrides {
+			overrides[sessionType] = cancellationTiersFromConfig(tiersCfg)
+		}
+
+		policy, err := training.NewCancellationPolicy(policyCfg.Version, cancellationTiersFromConfig(policyCfg.Tiers), overrides)
+		if err != nil {
+			return training.CancellationPolicies{}, fmt.Errorf("contexts.trainings.cancellation.policies: %w", err)
+		}
+		policies = append(policies, policy)
+	}
+
+	return training.NewCancellationPolicies(cfg.CurrentVersion, policies...)
+}
+
+func cancellationTiersFromConfig(tiersCfg []config.CancellationTierConfig) []training.CancellationTier {
+	tiers := make([]training.CancellationTier, 0, len(tiersCfg))
+	for _, tierCfg := range tiersCfg {
+		tiers = append(tiers, training.CancellationTier{
+			MinNotice:             tierCfg.MinNotice,
+			AttendeeRefundPercent: tierCfg.AttendeeRefundPercent,
+			TrainerRefundPercent:  tierCfg.TrainerRefundPercent,
+		})
+	}
+
+	return tiers
+}
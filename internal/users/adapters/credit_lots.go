@@ -0,0 +1,225 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/db"
+	sqlc_users "github.com/vaintrub/go-ddd-template/internal/users/adapters/sqlc"
+)
+
+// BalanceReasonCreditsExpired is the reason of credits taken away, because their lot expired.
+const BalanceReasonCreditsExpired = "credits-expired"
+
+// ExpireCreditLotsBatchSize limits how many users get their lots expired in a single run of the job.
+const ExpireCreditLotsBatchSize = 100
+
+// CreditExpiration is the amount of the user's credits expiring at the same time.
+type CreditExpiration struct {
+	Credits   int
+	ExpiresAt time.Time
+}
+
+// ExpireCreditLots takes away remaining credits of expired lots of up to ExpireCreditLotsBatchSize users.
+// It returns the number of users whose credits expired.
+func (r *UserPostgresRepository) ExpireCreditLots(ctx context.Context) (int, error) {
+	now := time.Now()
+
+	userIDs, err := sqlc_users.New(r.pool).ListUsersWithExpiredCreditLots(ctx, timestamptz(now), ExpireCreditLotsBatchSize)
+	if err != nil {
+		return 0, db.TranslatePgError(err)
+	}
+
+	for _, userID := range userIDs {
+		if err := r.expireUserCreditLots(ctx, userID, now); err != nil {
+			return 0, fmt.Errorf("unable to expire credit lots of user %s: %w", db.PgtypeToUUID(userID), err)
+		}
+	}
+
+	return len(userIDs), nil
+}
+
+func (r *UserPostgresRepository) expireUserCreditLots(ctx context.Context, userID pgtype.UUID, now time.Time) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback is safe to call even if commit succeeds
+	}()
+
+	queries := sqlc_users.New(tx)
+
+	if _, err := queries.GetUserForUpdate(ctx, userID); err != nil {
+		return db.TranslatePgError(err)
+	}
+
+	transaction, err := expireCreditLots(ctx, queries, userID, now)
+	if err != nil {
+		return err
+	}
+	if transaction != nil {
+		if err := queries.UpdateBalance(ctx, userID, transaction.BalanceAfter); err != nil {
+			return db.TranslatePgError(err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListUpcomingCreditExpirations returns the user's credits which will expire, the soonest first.
+func (r *UserPostgresRepository) ListUpcomingCreditExpirations(ctx context.Context, userID string) ([]CreditExpiration, error) {
+	uid, err := db.StringToPgtypeUUID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	rows, err := sqlc_users.New(r.pool).ListUpcomingCreditExpirations(ctx, uid, timestamptz(time.Now()))
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	expirations := make([]CreditExpiration, 0, len(rows))
+	for _, row := range rows {
+		expirations = append(expirations, CreditExpiration{
+			Credits:   int(row.Credits),
+			ExpiresAt: row.ExpiresAt.Time,
+		})
+	}
+
+	return expirations, nil
+}
+
+// expireCreditLots takes away remaining credits of the user's expired lots, recording every lot in the ledger.
+// It returns the last recorded transaction, or nil when no lot expired. The user has to be locked.
+func expireCreditLots(
+	ctx context.Context,
+	queries *sqlc_users.Queries,
+	userID pgtype.UUID,
+	now time.Time,
+) (*sqlc_users.UsersBalanceTransaction, error) {
+	lots, err := queries.ListExpiredCreditLots(ctx, userID, timestamptz(now))
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	var lastTransaction *sqlc_users.UsersBalanceTransaction
+	for _, lot := range lots {
+		transaction, err := createBalanceTransaction(ctx, queries, userID, BalanceChange{
+			Amount:      -int(lot.Remaining),
+			Reason:      BalanceReasonCreditsExpired,
+			Description: fmt.Sprintf("Credits expired on %s", lot.ExpiresAt.Time.UTC().Format(time.DateOnly)),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := queries.UpdateCreditLotRemaining(ctx, -lot.Remaining, lot.ID); err != nil {
+			return nil, db.TranslatePgError(err)
+		}
+
+		lastTransaction = &transaction
+	}
+
+	return lastTransaction, nil
+}
+
+// consumeCreditLots takes the debited credits from the oldest non-expired lots first,
+// recording how much the debit consumed from every lot, so refunds can return it.
+func consumeCreditLots(
+	ctx context.Context,
+	queries *sqlc_users.Queries,
+	debit sqlc_users.UsersBalanceTransaction,
+	now time.Time,
+) error {
+	lots, err := queries.ListAvailableCreditLots(ctx, debit.UserID, timestamptz(now))
+	if err != nil {
+		return db.TranslatePgError(err)
+	}
+
+	left := -debit.Amount
+	for _, lot := range lots {
+		if left == 0 {
+			break
+		}
+
+		consumed := min(left, lot.Remaining)
+
+		if err := queries.UpdateCreditLotRemaining(ctx, -consumed, lot.ID); err != nil {
+			return db.TranslatePgError(err)
+		}
+		if err := queries.CreateCreditLotConsumption(ctx, debit.ID, lot.ID, consumed); err != nil {
+			return db.TranslatePgError(err)
+		}
+
+		left -= consumed
+	}
+
+	if left > 0 {
+		return ErrInsufficientBalance
+	}
+
+	return nil
+}
+
+// addCreditLots grants the credited credits. Credits of the training's debits are returned to the lots
+// they were consumed from, anything above that is granted as a new lot.
+func addCreditLots(
+	ctx context.Context,
+	queries *sqlc_users.Queries,
+	credit sqlc_users.UsersBalanceTransaction,
+	expiresAt time.Time,
+) error {
+	left := credit.Amount
+
+	if credit.TrainingID.Valid {
+		consumptions, err := queries.ListTrainingCreditLotConsumptions(ctx, credit.UserID, credit.TrainingID)
+		if err != nil {
+			return db.TranslatePgError(err)
+		}
+
+		for _, consumption := range consumptions {
+			if left == 0 {
+				break
+			}
+
+			returned := min(left, consumption.Amount-consumption.Returned)
+
+			if err := queries.UpdateCreditLotRemaining(ctx, returned, consumption.LotID); err != nil {
+				return db.TranslatePgError(err)
+			}
+			if err := queries.ReturnCreditLotConsumption(ctx, returned, consumption.TransactionID, consumption.LotID); err != nil {
+				return db.TranslatePgError(err)
+			}
+
+			left -= returned
+		}
+	}
+
+	if left == 0 {
+		return nil
+	}
+
+	var expires pgtype.Timestamptz
+	if !expiresAt.IsZero() {
+		expires = timestamptz(expiresAt)
+	}
+
+	if err := queries.CreateCreditLot(ctx, db.UUIDToPgtype(uuid.New()), credit.UserID, credit.ID, left, expires); err != nil {
+		return db.TranslatePgError(err)
+	}
+
+	return nil
+}
+
+func timestamptz(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: true}
+}
@@ -0,0 +1,257 @@
+package adapters_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/users/adapters"
+)
+
+func TestUpdateBalance_consumes_oldest_lots_first(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := newPostgresRepository(t, ctx)
+	userUUID := createTestUser(t, ctx, repo)
+
+	expiresLater := time.Now().AddDate(0, 0, 20)
+	expiresSooner := time.Now().AddDate(0, 0, 10)
+
+	grantCredits(t, ctx, repo, userUUID, 2, expiresLater)
+	grantCredits(t, ctx, repo, userUUID, 3, expiresSooner)
+
+	// the older lot is consumed first, even though the newer one expires sooner
+	transaction, err := repo.UpdateBalance(ctx, userUUID, adapters.BalanceChange{
+		Amount:       -3,
+		Reason:       "training-booked",
+		TrainingUUID: uuid.New().String(),
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, transaction.BalanceAfter)
+
+	expirations, err := repo.ListUpcomingCreditExpirations(ctx, userUUID)
+	require.NoError(t, err)
+	require.Len(t, expirations, 1)
+	assert.Equal(t, 2, expirations[0].Credits)
+	assert.WithinDuration(t, expiresSooner, expirations[0].ExpiresAt, time.Millisecond)
+
+	assertBalance(t, ctx, repo, userUUID, 2)
+}
+
+func TestUpdateBalance_refund_returns_credits_to_consumed_lots(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := newPostgresRepository(t, ctx)
+	userUUID := createTestUser(t, ctx, repo)
+
+	firstExpiresAt := time.Now().AddDate(0, 0, 10)
+	secondExpiresAt := time.Now().AddDate(0, 0, 20)
+
+	grantCredits(t, ctx, repo, userUUID, 2, firstExpiresAt)
+	grantCredits(t, ctx, repo, userUUID, 2, secondExpiresAt)
+
+	trainingUUID := uuid.New().String()
+	_, err := repo.UpdateBalance(ctx, userUUID, adapters.BalanceChange{
+		Amount:       -3,
+		Reason:       "training-booked",
+		TrainingUUID: trainingUUID,
+	})
+	require.NoError(t, err)
+
+	// the refund keeps the expiry of the lots the credits were consumed from
+	_, err = repo.UpdateBalance(ctx, userUUID, adapters.BalanceChange{
+		Amount:       3,
+		Reason:       "training-canceled",
+		TrainingUUID: trainingUUID,
+	})
+	require.NoError(t, err)
+
+	expirations, err := repo.ListUpcomingCreditExpirations(ctx, userUUID)
+	require.NoError(t, err)
+	require.Len(t, expirations, 2)
+	assert.Equal(t, 2, expirations[0].Credits)
+	assert.WithinDuration(t, firstExpiresAt, expirations[0].ExpiresAt, time.Millisecond)
+	assert.Equal(t, 2, expirations[1].Credits)
+	assert.WithinDuration(t, secondExpiresAt, expirations[1].ExpiresAt, time.Millisecond)
+
+	// compensation above the consumed credits is granted as a new lot, which never expires
+	_, err = repo.UpdateBalance(ctx, userUUID, adapters.BalanceChange{
+		Amount:       1,
+		Reason:       "training-canceled",
+		TrainingUUID: trainingUUID,
+	})
+	require.NoError(t, err)
+
+	expirations, err = repo.ListUpcomingCreditExpirations(ctx, userUUID)
+	require.NoError(t, err)
+	require.Len(t, expirations, 2)
+	assert.Equal(t, 2, expirations[0].Credits)
+	assert.Equal(t, 2, expirations[1].Credits)
+
+	assertBalance(t, ctx, repo, userUUID, 5)
+}
+
+func TestUpdateBalance_refund_to_expired_lot(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	pool := newPostgresPool(t, ctx)
+	repo := adapters.NewUserPostgresRepository(pool)
+	userUUID := createTestUser(t, ctx, repo)
+
+	grantCredits(t, ctx, repo, userUUID, 2, time.Now().AddDate(0, 0, 10))
+
+	trainingUUID := uuid.New().String()
+	_, err := repo.UpdateBalance(ctx, userUUID, adapters.BalanceChange{
+		Amount:       -1,
+		Reason:       "training-booked",
+		TrainingUUID: trainingUUID,
+	})
+	require.NoError(t, err)
+
+	expireUserCreditLots(t, ctx, pool, userUUID)
+
+	// the credit returned to the expired lot expires right away, together with the rest of the lot
+	_, err = repo.UpdateBalance(ctx, userUUID, adapters.BalanceChange{
+		Amount:       1,
+		Reason:       "training-canceled",
+		TrainingUUID: trainingUUID,
+	})
+	require.NoError(t, err)
+
+	assertBalance(t, ctx, repo, userUUID, 0)
+
+	transactions, err := repo.ListBalanceTransactions(ctx, userUUID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, transactions, 5)
+
+	// the lot expired before the refund and again after the credit was returned to it
+	var expiredCredits, refundedCredits int32
+	for _, transaction := range transactions {
+		switch transaction.Reason {
+		case adapters.BalanceReasonCreditsExpired:
+			expiredCredits += transaction.Amount
+		case "training-canceled":
+			refundedCredits += transaction.Amount
+		}
+	}
+	assert.EqualValues(t, -2, expiredCredits)
+	assert.EqualValues(t, 1, refundedCredits)
+}
+
+func TestUpdateBalance_concurrent_debits_dont_overdraw(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := newPostgresRepository(t, ctx)
+	userUUID := createTestUser(t, ctx, repo)
+
+	grantCredits(t, ctx, repo, userUUID, 1, time.Time{})
+
+	const debits = 5
+
+	var wg sync.WaitGroup
+	errs := make(chan error, debits)
+	for i := 0; i < debits; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := repo.UpdateBalance(ctx, userUUID, adapters.BalanceChange{
+				Amount:       -1,
+				Reason:       "training-booked",
+				TrainingUUID: uuid.New().String(),
+			})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	succeeded := 0
+	for err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.ErrorIs(t, err, adapters.ErrInsufficientBalance)
+	}
+	assert.Equal(t, 1, succeeded)
+
+	assertBalance(t, ctx, repo, userUUID, 0)
+}
+
+func TestUpdateBalance_backfilled_lot_never_expires(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	pool := newPostgresPool(t, ctx)
+	repo := adapters.NewUserPostgresRepository(pool)
+	userUUID := createTestUser(t, ctx, repo)
+
+	// the balance set before the ledger and lots existed, backfilled by migrations as they do it
+	_, err := pool.Exec(ctx, `UPDATE users_users SET balance = 3 WHERE id = $1`, userUUID)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, `
+		INSERT INTO users_balance_transactions (id, user_id, amount, balance_after, reason, created_at)
+		SELECT gen_random_uuid(), id, balance, balance, 'opening-balance', NOW()
+		FROM users_users
+		WHERE id = $1 AND balance <> 0`, userUUID)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, `
+		INSERT INTO users_credit_lots (id, user_id, credits, remaining, created_at)
+		SELECT gen_random_uuid(), id, balance, balance, NOW()
+		FROM users_users
+		WHERE id = $1 AND balance > 0`, userUUID)
+	require.NoError(t, err)
+
+	expiresAt := time.Now().AddDate(0, 0, 5)
+	grantCredits(t, ctx, repo, userUUID, 2, expiresAt)
+
+	expirations, err := repo.ListUpcomingCreditExpirations(ctx, userUUID)
+	require.NoError(t, err)
+	require.Len(t, expirations, 1, "backfilled credits never expire")
+	assert.Equal(t, 2, expirations[0].Credits)
+
+	_, err = repo.ExpireCreditLots(ctx)
+	require.NoError(t, err)
+	assertBalance(t, ctx, repo, userUUID, 5)
+
+	// the backfilled lot is the oldest one, so it's consumed first
+	_, err = repo.UpdateBalance(ctx, userUUID, adapters.BalanceChange{
+		Amount:       -4,
+		Reason:       "training-booked",
+		TrainingUUID: uuid.New().String(),
+	})
+	require.NoError(t, err)
+
+	expirations, err = repo.ListUpcomingCreditExpirations(ctx, userUUID)
+	require.NoError(t, err)
+	require.Len(t, expirations, 1)
+	assert.Equal(t, 1, expirations[0].Credits)
+	assert.WithinDuration(t, expiresAt, expirations[0].ExpiresAt, time.Millisecond)
+
+	assertBalance(t, ctx, repo, userUUID, 1)
+}
+
+// grantCredits credits the user with a new lot, it never expires when expiresAt is zero.
+func grantCredits(t *testing.T, ctx context.Context, repo *adapters.UserPostgresRepository, userUUID string, credits int, expiresAt time.Time) {
+	_, err := repo.UpdateBalance(ctx, userUUID, adapters.BalanceChange{
+		Amount:    credits,
+		Reason:    adapters.BalanceReasonCreditPurchase,
+		ExpiresAt: expiresAt,
+	})
+	require.NoError(t, err)
+}
+
+// expireUserCreditLots moves the expiry of the user's lots to the past, as if they expired.
+func expireUserCreditLots(t *testing.T, ctx context.Context, pool *pgxpool.Pool, userUUID string) {
+	_, err := pool.Exec(
+		ctx,
+		`UPDATE users_credit_lots SET expires_at = NOW() - INTERVAL '1 minute' WHERE user_id = $1 AND expires_at IS NOT NULL`,
+		userUUID,
+	)
+	require.NoError(t, err)
+}
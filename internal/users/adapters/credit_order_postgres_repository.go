@@ -0,0 +1,263 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/db"
+	sqlc_users "github.com/vaintrub/go-ddd-template/internal/users/adapters/sqlc"
+)
+
+// Statuses of credit orders.
+const (
+	CreditOrderPending  = "pending"
+	CreditOrderPaid     = "paid"
+	CreditOrderFailed   = "failed"
+	CreditOrderRefunded = "refunded"
+)
+
+// Reasons of balance changes caused by credit orders.
+const (
+	BalanceReasonCreditPurchase         = "credit-purchase"
+	BalanceReasonCreditPurchaseRefunded = "credit-purchase-refunded"
+)
+
+var (
+	// ErrCreditPackageNotFound is returned when the package doesn't exist or can't be bought anymore.
+	ErrCreditPackageNotFound = errors.New("credit package not found")
+	// ErrCreditOrderNotFound is returned when the order doesn't exist or belongs to another user.
+	ErrCreditOrderNotFound = errors.New("credit order not found")
+	// ErrInvalidCreditOrderTransition is returned when the order can't get to the requested status,
+	// for example a failed order can't be refunded.
+	ErrInvalidCreditOrderTransition = errors.New("invalid credit order status transition")
+)
+
+// creditOrderTransitions lists statuses the order can get to from its current status.
+var creditOrderTransitions = map[string][]string{
+	CreditOrderPending: {CreditOrderPaid, CreditOrderFailed},
+	CreditOrderPaid:    {CreditOrderRefunded},
+}
+
+// ListCreditPackages returns credit packages which can be bought.
+func (r *UserPostgresRepository) ListCreditPackages(ctx context.Context) ([]sqlc_users.UsersCreditPackage, error) {
+	packages, err := sqlc_users.New(r.pool).ListActiveCreditPackages(ctx)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	return packages, nil
+}
+
+// CreateCreditOrder creates a pending order of the credit package.
+// Credits and price are copied from the package, so later catalog changes don't affect the order.
+func (r *UserPostgresRepository) CreateCreditOrder(
+	ctx context.Context,
+	orderUUID string,
+	userID string,
+	packageCode string,
+) (*sqlc_users.UsersCreditOrder, error) {
+	queries := sqlc_users.New(r.pool)
+
+	orderID, err := db.StringToPgtypeUUID(orderUUID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credit order UUID: %w", err)
+	}
+
+	uid, err := db.StringToPgtypeUUID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	creditPackage, err := queries.GetCreditPackage(ctx, packageCode)
+	if db.IsNotFound(db.TranslatePgError(err)) {
+		return nil, ErrCreditPackageNotFound
+	}
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+	if !creditPackage.Active {
+		return nil, ErrCreditPackageNotFound
+	}
+
+	order, err := queries.CreateCreditOrder(
+		ctx,
+		orderID,
+		uid,
+		creditPackage.Code,
+		creditPackage.Credits,
+		creditPackage.PriceAmount,
+		creditPackage.Currency,
+		creditPackage.ValidityDays,
+	)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	return &order, nil
+}
+
+// SetCreditOrderPayment stores the payment started at the payment provider for the order.
+func (r *UserPostgresRepository) SetCreditOrderPayment(
+	ctx context.Context,
+	orderUUID string,
+	paymentID string,
+	checkoutURL string,
+) (*sqlc_users.UsersCreditOrder, error) {
+	orderID, err := db.StringToPgtypeUUID(orderUUID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credit order UUID: %w", err)
+	}
+
+	order, err := sqlc_users.New(r.pool).SetCreditOrderPayment(ctx, orderID, &paymentID, &checkoutURL)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	return &order, nil
+}
+
+// FailCreditOrder marks the order as failed before its payment was started, for example when the provider is down.
+func (r *UserPostgresRepository) FailCreditOrder(ctx context.Context, orderUUID string, failureReason string) error {
+	orderID, err := db.StringToPgtypeUUID(orderUUID)
+	if err != nil {
+		return fmt.Errorf("invalid credit order UUID: %w", err)
+	}
+
+	_, err = sqlc_users.New(r.pool).UpdateCreditOrderStatus(ctx, CreditOrderFailed, &failureReason, pgtype.UUID{}, orderID)
+	if err != nil {
+		return db.TranslatePgError(err)
+	}
+
+	return nil
+}
+
+// GetCreditOrder returns the user's order.
+func (r *UserPostgresRepository) GetCreditOrder(ctx context.Context, userID string, orderUUID string) (*sqlc_users.UsersCreditOrder, error) {
+	orderID, err := db.StringToPgtypeUUID(orderUUID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credit order UUID: %w", err)
+	}
+
+	order, err := sqlc_users.New(r.pool).GetCreditOrder(ctx, orderID)
+	if db.IsNotFound(db.TranslatePgError(err)) {
+		return nil, ErrCreditOrderNotFound
+	}
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	if db.PgtypeToUUID(order.UserID).String() != userID {
+		return nil, ErrCreditOrderNotFound
+	}
+
+	return &order, nil
+}
+
+// ListCreditOrders returns the user's orders, the newest first.
+func (r *UserPostgresRepository) ListCreditOrders(ctx context.Context, userID string, limit int32) ([]sqlc_users.UsersCreditOrder, error) {
+	uid, err := db.StringToPgtypeUUID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	orders, err := sqlc_users.New(r.pool).ListCreditOrders(ctx, uid, limit)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	return orders, nil
+}
+
+// UpdateCreditOrderPaymentStatus moves the order paid by the payment to the status reported by the payment provider.
+// Credits are granted to the ledger when the order is paid and taken back when it's refunded,
+// in the same transaction as the status change.
+// Repeated notifications about the status the order already has are ignored, as providers deliver webhooks at least once.
+func (r *UserPostgresRepository) UpdateCreditOrderPaymentStatus(
+	ctx context.Context,
+	paymentID string,
+	status string,
+	failureReason string,
+) (*sqlc_users.UsersCreditOrder, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback is safe to call even if commit succeeds
+	}()
+
+	queries := sqlc_users.New(tx)
+
+	order, err := queries.GetCreditOrderByPaymentIDForUpdate(ctx, &paymentID)
+	if db.IsNotFound(db.TranslatePgError(err)) {
+		return nil, ErrCreditOrderNotFound
+	}
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	if order.Status == status {
+		return &order, nil
+	}
+	if !isCreditOrderTransitionAllowed(order.Status, status) {
+		return nil, fmt.Errorf("%w: from %s to %s", ErrInvalidCreditOrderTransition, order.Status, status)
+	}
+
+	var reason *string
+	if failureReason != "" {
+		reason = &failureReason
+	}
+
+	var transactionID pgtype.UUID
+	switch status {
+	case CreditOrderPaid:
+		change := BalanceChange{
+			Amount:      int(order.Credits),
+			Reason:      BalanceReasonCreditPurchase,
+			Description: fmt.Sprintf("Credit package %s", order.PackageCode),
+		}
+		if order.ValidityDays != nil {
+			change.ExpiresAt = time.Now().AddDate(0, 0, int(*order.ValidityDays))
+		}
+
+		transaction, err := changeBalance(ctx, queries, order.UserID, change)
+		if err != nil {
+			return nil, err
+		}
+		transactionID = transaction.ID
+	case CreditOrderRefunded:
+		// credits which were already spent can't be taken back, the refund has to be resolved manually
+		if _, err := changeBalance(ctx, queries, order.UserID, BalanceChange{
+			Amount:      -int(order.Credits),
+			Reason:      BalanceReasonCreditPurchaseRefunded,
+			Description: fmt.Sprintf("Credit package %s", order.PackageCode),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	updatedOrder, err := queries.UpdateCreditOrderStatus(ctx, status, reason, transactionID, order.ID)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &updatedOrder, nil
+}
+
+func isCreditOrderTransitionAllowed(from string, to string) bool {
+	for _, allowed := range creditOrderTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,173 @@
+package adapters_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/users/adapters"
+)
+
+func TestUpdateCreditOrderPaymentStatus_paid_and_refunded(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := newPostgresRepository(t, ctx)
+	userUUID := createTestUser(t, ctx, repo)
+
+	_, paymentID := createTestCreditOrder(t, ctx, repo, userUUID, "five-pack")
+
+	order, err := repo.UpdateCreditOrderPaymentStatus(ctx, paymentID, adapters.CreditOrderPaid, "")
+	require.NoError(t, err)
+	assert.Equal(t, adapters.CreditOrderPaid, order.Status)
+	assertBalance(t, ctx, repo, userUUID, 5)
+
+	order, err = repo.UpdateCreditOrderPaymentStatus(ctx, paymentID, adapters.CreditOrderRefunded, "")
+	require.NoError(t, err)
+	assert.Equal(t, adapters.CreditOrderRefunded, order.Status)
+	assertBalance(t, ctx, repo, userUUID, 0)
+}
+
+func TestUpdateCreditOrderPaymentStatus_replayed_events(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := newPostgresRepository(t, ctx)
+	userUUID := createTestUser(t, ctx, repo)
+
+	_, paymentID := createTestCreditOrder(t, ctx, repo, userUUID, "five-pack")
+
+	// providers deliver webhooks at least once, credits must be granted only once
+	for i := 0; i < 3; i++ {
+		order, err := repo.UpdateCreditOrderPaymentStatus(ctx, paymentID, adapters.CreditOrderPaid, "")
+		require.NoError(t, err)
+		assert.Equal(t, adapters.CreditOrderPaid, order.Status)
+	}
+	assertBalance(t, ctx, repo, userUUID, 5)
+
+	for i := 0; i < 3; i++ {
+		order, err := repo.UpdateCreditOrderPaymentStatus(ctx, paymentID, adapters.CreditOrderRefunded, "")
+		require.NoError(t, err)
+		assert.Equal(t, adapters.CreditOrderRefunded, order.Status)
+	}
+	assertBalance(t, ctx, repo, userUUID, 0)
+
+	transactions, err := repo.ListBalanceTransactions(ctx, userUUID, "", 10)
+	require.NoError(t, err)
+	assert.Len(t, transactions, 2)
+}
+
+func TestUpdateCreditOrderPaymentStatus_invalid_transitions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := newPostgresRepository(t, ctx)
+
+	testCases := []struct {
+		Name     string
+		Statuses []string
+		Rejected string
+	}{
+		{
+			Name:     "failed_to_paid",
+			Statuses: []string{adapters.CreditOrderFailed},
+			Rejected: adapters.CreditOrderPaid,
+		},
+		{
+			Name:     "failed_to_refunded",
+			Statuses: []string{adapters.CreditOrderFailed},
+			Rejected: adapters.CreditOrderRefunded,
+		},
+		{
+			Name:     "pending_to_refunded",
+			Rejected: adapters.CreditOrderRefunded,
+		},
+		{
+			Name:     "refunded_to_paid",
+			Statuses: []string{adapters.CreditOrderPaid, adapters.CreditOrderRefunded},
+			Rejected: adapters.CreditOrderPaid,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			userUUID := createTestUser(t, ctx, repo)
+			_, paymentID := createTestCreditOrder(t, ctx, repo, userUUID, "single")
+
+			for _, status := range c.Statuses {
+				_, err := repo.UpdateCreditOrderPaymentStatus(ctx, paymentID, status, "")
+				require.NoError(t, err)
+			}
+			balance := currentBalance(t, ctx, repo, userUUID)
+
+			_, err := repo.UpdateCreditOrderPaymentStatus(ctx, paymentID, c.Rejected, "")
+			assert.ErrorIs(t, err, adapters.ErrInvalidCreditOrderTransition)
+
+			assertBalance(t, ctx, repo, userUUID, balance)
+		})
+	}
+}
+
+func TestUpdateCreditOrderPaymentStatus_refund_of_spent_credits(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := newPostgresRepository(t, ctx)
+	userUUID := createTestUser(t, ctx, repo)
+
+	orderUUID, paymentID := createTestCreditOrder(t, ctx, repo, userUUID, "five-pack")
+
+	_, err := repo.UpdateCreditOrderPaymentStatus(ctx, paymentID, adapters.CreditOrderPaid, "")
+	require.NoError(t, err)
+
+	_, err = repo.UpdateBalance(ctx, userUUID, adapters.BalanceChange{
+		Amount:       -1,
+		Reason:       "training-booked",
+		TrainingUUID: uuid.New().String(),
+	})
+	require.NoError(t, err)
+
+	// the refund is resolved manually, the order stays paid and the balance untouched
+	_, err = repo.UpdateCreditOrderPaymentStatus(ctx, paymentID, adapters.CreditOrderRefunded, "")
+	assert.ErrorIs(t, err, adapters.ErrInsufficientBalance)
+
+	order, err := repo.GetCreditOrder(ctx, userUUID, orderUUID)
+	require.NoError(t, err)
+	assert.Equal(t, adapters.CreditOrderPaid, order.Status)
+	assertBalance(t, ctx, repo, userUUID, 4)
+}
+
+func TestUpdateCreditOrderPaymentStatus_unknown_payment(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := newPostgresRepository(t, ctx)
+
+	_, err := repo.UpdateCreditOrderPaymentStatus(ctx, "fake_"+uuid.NewString(), adapters.CreditOrderPaid, "")
+	assert.ErrorIs(t, err, adapters.ErrCreditOrderNotFound)
+}
+
+// createTestCreditOrder creates the order of the package with a started payment and returns the order and payment IDs.
+func createTestCreditOrder(
+	t *testing.T,
+	ctx context.Context,
+	repo *adapters.UserPostgresRepository,
+	userUUID string,
+	packageCode string,
+) (string, string) {
+	orderUUID := uuid.New().String()
+	_, err := repo.CreateCreditOrder(ctx, orderUUID, userUUID, packageCode)
+	require.NoError(t, err)
+
+	paymentID := "fake_" + uuid.NewString()
+	_, err = repo.SetCreditOrderPayment(ctx, orderUUID, paymentID, "")
+	require.NoError(t, err)
+
+	return orderUUID, paymentID
+}
+
+func currentBalance(t *testing.T, ctx context.Context, repo *adapters.UserPostgresRepository, userUUID string) int {
+	user, err := repo.GetUser(ctx, userUUID)
+	require.NoError(t, err)
+
+	return int(user.Balance)
+}
@@ -0,0 +1,114 @@
+package adapters
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+)
+
+// Statuses of payments reported by payment provider webhooks.
+const (
+	PaymentSucceeded = "succeeded"
+	PaymentFailed    = "failed"
+	PaymentRefunded  = "refunded"
+)
+
+// PaymentSignatureHeader carries the signature of the payment webhook payload.
+const PaymentSignatureHeader = "X-Payment-Signature"
+
+// ErrInvalidWebhookSignature is returned when the webhook was not sent by the payment provider.
+var ErrInvalidWebhookSignature = errors.New("invalid payment webhook signature")
+
+// PaymentRequest describes what the user pays for.
+type PaymentRequest struct {
+	OrderUUID   string
+	Amount      int
+	Currency    string
+	Description string
+}
+
+// Payment is the payment started at the payment provider.
+type Payment struct {
+	ID string
+	// CheckoutURL is where the user completes the payment.
+	CheckoutURL string
+}
+
+// PaymentEvent is the change of the payment status reported by the payment provider.
+type PaymentEvent struct {
+	PaymentID     string `json:"paymentId"`
+	Status        string `json:"status"`
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// FakePaymentProvider is the local payment provider, which doesn't charge anybody.
+// Payments are confirmed by sending the webhook signed with Sign, for example from a local script.
+type FakePaymentProvider struct {
+	webhookSecret []byte
+	checkoutURL   string
+}
+
+func NewFakePaymentProvider(webhookSecret string, checkoutURL string) FakePaymentProvider {
+	if webhookSecret == "" {
+		panic("empty webhookSecret")
+	}
+
+	return FakePaymentProvider{
+		webhookSecret: []byte(webhookSecret),
+		checkoutURL:   checkoutURL,
+	}
+}
+
+func (p FakePaymentProvider) CreatePayment(ctx context.Context, request PaymentRequest) (Payment, error) {
+	paymentID := "fake_" + uuid.NewString()
+
+	checkoutURL := ""
+	if p.checkoutURL != "" {
+		checkoutURL = fmt.Sprintf("%s?paymentId=%s", p.checkoutURL, url.QueryEscape(paymentID))
+	}
+
+	return Payment{ID: paymentID, CheckoutURL: checkoutURL}, nil
+}
+
+// ParseWebhook verifies the signature of the webhook payload and returns the reported payment event.
+func (p FakePaymentProvider) ParseWebhook(payload []byte, header http.Header) (PaymentEvent, error) {
+	signature, err := hex.DecodeString(header.Get(PaymentSignatureHeader))
+	if err != nil || !hmac.Equal(signature, p.sign(payload)) {
+		return PaymentEvent{}, ErrInvalidWebhookSignature
+	}
+
+	var event PaymentEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return PaymentEvent{}, fmt.Errorf("invalid payment webhook payload: %w", err)
+	}
+
+	switch event.Status {
+	case PaymentSucceeded, PaymentFailed, PaymentRefunded:
+	default:
+		return PaymentEvent{}, fmt.Errorf("unknown payment status: %s", event.Status)
+	}
+	if event.PaymentID == "" {
+		return PaymentEvent{}, errors.New("empty payment id")
+	}
+
+	return event, nil
+}
+
+// Sign returns the signature of the webhook payload, to be sent in the PaymentSignatureHeader.
+func (p FakePaymentProvider) Sign(payload []byte) string {
+	return hex.EncodeToString(p.sign(payload))
+}
+
+func (p FakePaymentProvider) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, p.webhookSecret)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
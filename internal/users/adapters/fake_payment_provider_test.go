@@ -0,0 +1,106 @@
+package adapters_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/users/adapters"
+)
+
+func TestFakePaymentProvider_ParseWebhook(t *testing.T) {
+	t.Parallel()
+	provider := adapters.NewFakePaymentProvider("webhook-secret", "")
+
+	payload := []byte(`{"paymentId":"fake_1","status":"succeeded"}`)
+
+	event, err := provider.ParseWebhook(payload, signatureHeader(provider.Sign(payload)))
+	require.NoError(t, err)
+	assert.Equal(t, adapters.PaymentEvent{PaymentID: "fake_1", Status: adapters.PaymentSucceeded}, event)
+}
+
+func TestFakePaymentProvider_ParseWebhook_invalid_signature(t *testing.T) {
+	t.Parallel()
+	provider := adapters.NewFakePaymentProvider("webhook-secret", "")
+	anotherProvider := adapters.NewFakePaymentProvider("another-secret", "")
+
+	payload := []byte(`{"paymentId":"fake_1","status":"succeeded"}`)
+
+	testCases := []struct {
+		Name    string
+		Payload []byte
+		Header  http.Header
+	}{
+		{
+			Name:    "missing_signature",
+			Payload: payload,
+			Header:  http.Header{},
+		},
+		{
+			Name:    "not_hex_signature",
+			Payload: payload,
+			Header:  signatureHeader("not-hex"),
+		},
+		{
+			Name:    "signed_with_another_secret",
+			Payload: payload,
+			Header:  signatureHeader(anotherProvider.Sign(payload)),
+		},
+		{
+			Name:    "tampered_payload",
+			Payload: []byte(`{"paymentId":"fake_2","status":"succeeded"}`),
+			Header:  signatureHeader(provider.Sign(payload)),
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := provider.ParseWebhook(c.Payload, c.Header)
+			assert.ErrorIs(t, err, adapters.ErrInvalidWebhookSignature)
+		})
+	}
+}
+
+func TestFakePaymentProvider_ParseWebhook_invalid_event(t *testing.T) {
+	t.Parallel()
+	provider := adapters.NewFakePaymentProvider("webhook-secret", "")
+
+	testCases := []struct {
+		Name    string
+		Payload []byte
+	}{
+		{
+			Name:    "invalid_json",
+			Payload: []byte(`{"paymentId":`),
+		},
+		{
+			Name:    "unknown_status",
+			Payload: []byte(`{"paymentId":"fake_1","status":"chargeback"}`),
+		},
+		{
+			Name:    "empty_payment_id",
+			Payload: []byte(`{"status":"succeeded"}`),
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := provider.ParseWebhook(c.Payload, signatureHeader(provider.Sign(c.Payload)))
+			require.Error(t, err)
+			assert.NotErrorIs(t, err, adapters.ErrInvalidWebhookSignature)
+		})
+	}
+}
+
+func signatureHeader(signature string) http.Header {
+	header := http.Header{}
+	header.Set(adapters.PaymentSignatureHeader, signature)
+	return header
+}
@@ -0,0 +1,109 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/db"
+	sqlc_users "github.com/vaintrub/go-ddd-template/internal/users/adapters/sqlc"
+)
+
+// ErrSessionNotFound is returned when the session doesn't exist or belongs to another user.
+var ErrSessionNotFound = errors.New("session not found")
+
+// SessionActivity is a request made in the user's session.
+type SessionActivity struct {
+	UserUUID   string
+	SessionKey string
+
+	// AuthMethod, IP and UserAgent are optional.
+	AuthMethod string
+	IP         string
+	UserAgent  string
+}
+
+// RecordSession creates the session on its first use, or refreshes where and when it was seen,
+// and returns the session. The IP of the request is stored also as the last IP of the user.
+func (r *UserPostgresRepository) RecordSession(ctx context.Context, activity SessionActivity) (*sqlc_users.UsersSession, error) {
+	uid, err := db.StringToPgtypeUUID(activity.UserUUID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback is safe to call even if commit succeeds
+	}()
+
+	queries := sqlc_users.New(tx)
+
+	session, err := queries.RecordSession(
+		ctx,
+		db.UUIDToPgtype(uuid.New()),
+		uid,
+		activity.SessionKey,
+		optionalString(activity.AuthMethod),
+		optionalString(activity.IP),
+		optionalString(activity.UserAgent),
+	)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	if activity.IP != "" {
+		if err := queries.UpdateLastIP(ctx, uid, &activity.IP); err != nil {
+			return nil, db.TranslatePgError(err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &session, nil
+}
+
+// ListSessions returns the user's sessions, the most recently used first.
+func (r *UserPostgresRepository) ListSessions(ctx context.Context, userID string, limit int32) ([]sqlc_users.UsersSession, error) {
+	uid, err := db.StringToPgtypeUUID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	sessions, err := sqlc_users.New(r.pool).ListUserSessions(ctx, uid, limit)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession marks the user's session as revoked, so its tokens are rejected.
+// Revoking already revoked session keeps the original revocation time.
+func (r *UserPostgresRepository) RevokeSession(ctx context.Context, userID string, sessionUUID string) (*sqlc_users.UsersSession, error) {
+	uid, err := db.StringToPgtypeUUID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	sessionID, err := db.StringToPgtypeUUID(sessionUUID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session UUID: %w", err)
+	}
+
+	session, err := sqlc_users.New(r.pool).RevokeSession(ctx, sessionID, uid)
+	if db.IsNotFound(db.TranslatePgError(err)) {
+		return nil, ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	return &session, nil
+}
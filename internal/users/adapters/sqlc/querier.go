@@ -12,16 +12,67 @@ import (
 )
 
 type Querier interface {
+	// Assigning already assigned client keeps the original assignment time
+	AssignTrainerClient(ctx context.Context, trainerID pgtype.UUID, clientID pgtype.UUID) (UsersTrainerClient, error)
+	// balance_after is derived from the ledger, the user row has to be locked by GetUserForUpdate
+	CreateBalanceTransaction(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID, amount int32, reason string, description *string, trainingID pgtype.UUID, actorID pgtype.UUID) (UsersBalanceTransaction, error)
+	CreateCreditLot(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID, transactionID pgtype.UUID, credits int32, expiresAt pgtype.Timestamptz) error
+	CreateCreditLotConsumption(ctx context.Context, transactionID pgtype.UUID, lotID pgtype.UUID, amount int32) error
+	CreateCreditOrder(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID, packageCode string, credits int32, priceAmount int32, currency string, validityDays *int32) (UsersCreditOrder, error)
 	// Users Context Queries
 	// Purpose: CRUD operations for users_users table
 	CreateUser(ctx context.Context, iD pgtype.UUID, userType string, name string, email *string, column5 interface{}) (UsersUser, error)
+	// Provisions the user on first login, concurrent first requests of the same user insert a single row.
+	// Nothing is inserted also when the email is taken by another user, so the caller can retry without it.
+	CreateUserIfNotExists(ctx context.Context, iD pgtype.UUID, userType string, name string, email *string) (int64, error)
+	// Deactivating already deactivated user keeps the original deactivation time
+	DeactivateUser(ctx context.Context, id pgtype.UUID) (UsersUser, error)
 	DeleteUser(ctx context.Context, id pgtype.UUID) error
+	DeleteUserSessions(ctx context.Context, userID pgtype.UUID) error
+	// Removes the user from rosters, both as a trainer and as a client
+	DeleteUserTrainerClients(ctx context.Context, trainerID pgtype.UUID) error
+	// Erasing already erased user keeps the original erasure time
+	EraseUser(ctx context.Context, iD pgtype.UUID, name string) (UsersUser, error)
+	GetCreditOrder(ctx context.Context, id pgtype.UUID) (UsersCreditOrder, error)
+	GetCreditOrderByPaymentIDForUpdate(ctx context.Context, paymentID *string) (UsersCreditOrder, error)
+	GetCreditPackage(ctx context.Context, code string) (UsersCreditPackage, error)
 	GetUser(ctx context.Context, id pgtype.UUID) (UsersUser, error)
 	GetUserByEmail(ctx context.Context, email *string) (UsersUser, error)
+	GetUserForUpdate(ctx context.Context, id pgtype.UUID) (UsersUser, error)
+	ListActiveCreditPackages(ctx context.Context) ([]UsersCreditPackage, error)
+	// oldest lots are consumed first
+	ListAvailableCreditLots(ctx context.Context, userID pgtype.UUID, now pgtype.Timestamptz) ([]UsersCreditLot, error)
+	ListBalanceTransactions(ctx context.Context, userID pgtype.UUID, beforeID pgtype.UUID, limit int32) ([]UsersBalanceTransaction, error)
+	ListCreditOrders(ctx context.Context, userID pgtype.UUID, limit int32) ([]UsersCreditOrder, error)
+	ListExpiredCreditLots(ctx context.Context, userID pgtype.UUID, now pgtype.Timestamptz) ([]UsersCreditLot, error)
+	// Attendees assigned only to other trainers, attendees which are also clients of the trainer are left out
+	ListOtherTrainersClientIDs(ctx context.Context, trainerID pgtype.UUID) ([]pgtype.UUID, error)
+	ListTrainerClients(ctx context.Context, trainerID pgtype.UUID) ([]ListTrainerClientsRow, error)
+	// consumptions of the training's debits which were not returned to their lots yet
+	ListTrainingCreditLotConsumptions(ctx context.Context, userID pgtype.UUID, trainingID pgtype.UUID) ([]UsersCreditLotConsumption, error)
+	ListUpcomingCreditExpirations(ctx context.Context, userID pgtype.UUID, now pgtype.Timestamptz) ([]ListUpcomingCreditExpirationsRow, error)
+	ListUserSessions(ctx context.Context, userID pgtype.UUID, limit int32) ([]UsersSession, error)
+	// Keyset pagination by (created_at, id), all filters are optional
+	ListUsers(ctx context.Context, userType *string, deactivated *bool, search *string, afterID pgtype.UUID, limit int32) ([]UsersUser, error)
+	ListUsersByIDs(ctx context.Context, ids []pgtype.UUID) ([]UsersUser, error)
 	ListUsersByType(ctx context.Context, userType string, createdAt time.Time, iD pgtype.UUID, limit int32) ([]UsersUser, error)
+	ListUsersWithExpiredCreditLots(ctx context.Context, now pgtype.Timestamptz, limit int32) ([]pgtype.UUID, error)
+	// Erased users can't be reactivated
+	ReactivateUser(ctx context.Context, id pgtype.UUID) (UsersUser, error)
+	// Creates the session on its first use, later uses only refresh where and when it was seen
+	RecordSession(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID, sessionKey string, authMethod *string, ip *string, userAgent *string) (UsersSession, error)
+	ReturnCreditLotConsumption(ctx context.Context, amount int32, transactionID pgtype.UUID, lotID pgtype.UUID) error
+	// Revoking already revoked session keeps the original revocation time
+	RevokeSession(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID) (UsersSession, error)
+	SetCreditOrderPayment(ctx context.Context, iD pgtype.UUID, paymentID *string, checkoutUrl *string) (UsersCreditOrder, error)
+	UnassignTrainerClient(ctx context.Context, trainerID pgtype.UUID, clientID pgtype.UUID) (int64, error)
 	UpdateBalance(ctx context.Context, iD pgtype.UUID, balance int32) error
+	UpdateCreditLotRemaining(ctx context.Context, amount int32, iD pgtype.UUID) error
+	UpdateCreditOrderStatus(ctx context.Context, status string, failureReason *string, balanceTransactionID pgtype.UUID, iD pgtype.UUID) (UsersCreditOrder, error)
 	UpdateLastIP(ctx context.Context, iD pgtype.UUID, lastIp *string) error
 	UpdateUser(ctx context.Context, iD pgtype.UUID, name string, email *string) error
+	UpdateUserProfile(ctx context.Context, iD pgtype.UUID, name string, email *string, avatarUrl *string, timezone *string, locale *string) (UsersUser, error)
+	UpdateUserType(ctx context.Context, iD pgtype.UUID, userType string) (UsersUser, error)
 }
 
 var _ Querier = (*Queries)(nil)
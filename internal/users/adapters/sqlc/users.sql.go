@@ -12,6 +12,170 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const assignTrainerClient = `-- name: AssignTrainerClient :one
+INSERT INTO users_trainer_clients (
+    trainer_id,
+    client_id,
+    assigned_at
+) VALUES (
+    $1, $2, NOW()
+)
+ON CONFLICT (trainer_id, client_id) DO UPDATE
+SET assigned_at = users_trainer_clients.assigned_at
+RETURNING trainer_id, client_id, assigned_at
+`
+
+// Assigning already assigned client keeps the original assignment time
+func (q *Queries) AssignTrainerClient(ctx context.Context, trainerID pgtype.UUID, clientID pgtype.UUID) (UsersTrainerClient, error) {
+	row := q.db.QueryRow(ctx, assignTrainerClient, trainerID, clientID)
+	var i UsersTrainerClient
+	err := row.Scan(&i.TrainerID, &i.ClientID, &i.AssignedAt)
+	return i, err
+}
+
+const createBalanceTransaction = `-- name: CreateBalanceTransaction :one
+INSERT INTO users_balance_transactions (
+    id,
+    user_id,
+    amount,
+    balance_after,
+    reason,
+    description,
+    training_id,
+    actor_id,
+    created_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    (SELECT COALESCE(SUM(t.amount), 0) FROM users_balance_transactions t WHERE t.user_id = $2)::int + $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    NOW()
+) RETURNING id, user_id, amount, balance_after, reason, description, training_id, actor_id, created_at
+`
+
+// balance_after is derived from the ledger, the user row has to be locked by GetUserForUpdate
+func (q *Queries) CreateBalanceTransaction(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID, amount int32, reason string, description *string, trainingID pgtype.UUID, actorID pgtype.UUID) (UsersBalanceTransaction, error) {
+	row := q.db.QueryRow(ctx, createBalanceTransaction,
+		iD,
+		userID,
+		amount,
+		reason,
+		description,
+		trainingID,
+		actorID,
+	)
+	var i UsersBalanceTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Amount,
+		&i.BalanceAfter,
+		&i.Reason,
+		&i.Description,
+		&i.TrainingID,
+		&i.ActorID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createCreditLot = `-- name: CreateCreditLot :exec
+INSERT INTO users_credit_lots (
+    id,
+    user_id,
+    transaction_id,
+    credits,
+    remaining,
+    expires_at,
+    created_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $4,
+    $5,
+    NOW()
+)
+`
+
+func (q *Queries) CreateCreditLot(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID, transactionID pgtype.UUID, credits int32, expiresAt pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, createCreditLot,
+		iD,
+		userID,
+		transactionID,
+		credits,
+		expiresAt,
+	)
+	return err
+}
+
+const createCreditLotConsumption = `-- name: CreateCreditLotConsumption :exec
+INSERT INTO users_credit_lot_consumptions (
+    transaction_id,
+    lot_id,
+    amount
+) VALUES (
+    $1, $2, $3
+)
+`
+
+func (q *Queries) CreateCreditLotConsumption(ctx context.Context, transactionID pgtype.UUID, lotID pgtype.UUID, amount int32) error {
+	_, err := q.db.Exec(ctx, createCreditLotConsumption, transactionID, lotID, amount)
+	return err
+}
+
+const createCreditOrder = `-- name: CreateCreditOrder :one
+INSERT INTO users_credit_orders (
+    id,
+    user_id,
+    package_code,
+    credits,
+    price_amount,
+    currency,
+    validity_days,
+    status,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, 'pending', NOW(), NOW()
+) RETURNING id, user_id, package_code, credits, price_amount, currency, status, payment_id, checkout_url, failure_reason, balance_transaction_id, created_at, updated_at, validity_days
+`
+
+func (q *Queries) CreateCreditOrder(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID, packageCode string, credits int32, priceAmount int32, currency string, validityDays *int32) (UsersCreditOrder, error) {
+	row := q.db.QueryRow(ctx, createCreditOrder,
+		iD,
+		userID,
+		packageCode,
+		credits,
+		priceAmount,
+		currency,
+		validityDays,
+	)
+	var i UsersCreditOrder
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.PackageCode,
+		&i.Credits,
+		&i.PriceAmount,
+		&i.Currency,
+		&i.Status,
+		&i.PaymentID,
+		&i.CheckoutUrl,
+		&i.FailureReason,
+		&i.BalanceTransactionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ValidityDays,
+	)
+	return i, err
+}
+
 const createUser = `-- name: CreateUser :one
 
 INSERT INTO users_users (
@@ -24,7 +188,7 @@ INSERT INTO users_users (
     updated_at
 ) VALUES (
     $1, $2, $3, $4, COALESCE($5, 0), NOW(), NOW()
-) RETURNING id, user_type, name, email, balance, last_ip, created_at, updated_at
+) RETURNING id, user_type, name, email, balance, last_ip, created_at, updated_at, deactivated_at, avatar_url, timezone, locale, erased_at
 `
 
 // Users Context Queries
@@ -47,6 +211,72 @@ func (q *Queries) CreateUser(ctx context.Context, iD pgtype.UUID, userType strin
 		&i.LastIp,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeactivatedAt,
+		&i.AvatarUrl,
+		&i.Timezone,
+		&i.Locale,
+		&i.ErasedAt,
+	)
+	return i, err
+}
+
+const createUserIfNotExists = `-- name: CreateUserIfNotExists :execrows
+INSERT INTO users_users (
+    id,
+    user_type,
+    name,
+    email,
+    balance,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, 0, NOW(), NOW()
+)
+ON CONFLICT DO NOTHING
+`
+
+// Provisions the user on first login, concurrent first requests of the same user insert a single row.
+// Nothing is inserted also when the email is taken by another user, so the caller can retry without it.
+func (q *Queries) CreateUserIfNotExists(ctx context.Context, iD pgtype.UUID, userType string, name string, email *string) (int64, error) {
+	result, err := q.db.Exec(ctx, createUserIfNotExists,
+		iD,
+		userType,
+		name,
+		email,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deactivateUser = `-- name: DeactivateUser :one
+UPDATE users_users
+SET
+    deactivated_at = COALESCE(deactivated_at, NOW()),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, user_type, name, email, balance, last_ip, created_at, updated_at, deactivated_at, avatar_url, timezone, locale, erased_at
+`
+
+// Deactivating already deactivated user keeps the original deactivation time
+func (q *Queries) DeactivateUser(ctx context.Context, id pgtype.UUID) (UsersUser, error) {
+	row := q.db.QueryRow(ctx, deactivateUser, id)
+	var i UsersUser
+	err := row.Scan(
+		&i.ID,
+		&i.UserType,
+		&i.Name,
+		&i.Email,
+		&i.Balance,
+		&i.LastIp,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeactivatedAt,
+		&i.AvatarUrl,
+		&i.Timezone,
+		&i.Locale,
+		&i.ErasedAt,
 	)
 	return i, err
 }
@@ -61,8 +291,144 @@ func (q *Queries) DeleteUser(ctx context.Context, id pgtype.UUID) error {
 	return err
 }
 
+const deleteUserSessions = `-- name: DeleteUserSessions :exec
+DELETE FROM users_sessions
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserSessions(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUserSessions, userID)
+	return err
+}
+
+const deleteUserTrainerClients = `-- name: DeleteUserTrainerClients :exec
+DELETE FROM users_trainer_clients
+WHERE trainer_id = $1 OR client_id = $1
+`
+
+// Removes the user from rosters, both as a trainer and as a client
+func (q *Queries) DeleteUserTrainerClients(ctx context.Context, trainerID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUserTrainerClients, trainerID)
+	return err
+}
+
+const eraseUser = `-- name: EraseUser :one
+UPDATE users_users
+SET
+    name = $2,
+    email = NULL,
+    avatar_url = NULL,
+    timezone = NULL,
+    locale = NULL,
+    last_ip = NULL,
+    deactivated_at = COALESCE(deactivated_at, NOW()),
+    erased_at = COALESCE(erased_at, NOW()),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, user_type, name, email, balance, last_ip, created_at, updated_at, deactivated_at, avatar_url, timezone, locale, erased_at
+`
+
+// Erasing already erased user keeps the original erasure time
+func (q *Queries) EraseUser(ctx context.Context, iD pgtype.UUID, name string) (UsersUser, error) {
+	row := q.db.QueryRow(ctx, eraseUser, iD, name)
+	var i UsersUser
+	err := row.Scan(
+		&i.ID,
+		&i.UserType,
+		&i.Name,
+		&i.Email,
+		&i.Balance,
+		&i.LastIp,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeactivatedAt,
+		&i.AvatarUrl,
+		&i.Timezone,
+		&i.Locale,
+		&i.ErasedAt,
+	)
+	return i, err
+}
+
+const getCreditOrder = `-- name: GetCreditOrder :one
+SELECT id, user_id, package_code, credits, price_amount, currency, status, payment_id, checkout_url, failure_reason, balance_transaction_id, created_at, updated_at, validity_days FROM users_credit_orders
+WHERE id = $1
+`
+
+func (q *Queries) GetCreditOrder(ctx context.Context, id pgtype.UUID) (UsersCreditOrder, error) {
+	row := q.db.QueryRow(ctx, getCreditOrder, id)
+	var i UsersCreditOrder
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.PackageCode,
+		&i.Credits,
+		&i.PriceAmount,
+		&i.Currency,
+		&i.Status,
+		&i.PaymentID,
+		&i.CheckoutUrl,
+		&i.FailureReason,
+		&i.BalanceTransactionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ValidityDays,
+	)
+	return i, err
+}
+
+const getCreditOrderByPaymentIDForUpdate = `-- name: GetCreditOrderByPaymentIDForUpdate :one
+SELECT id, user_id, package_code, credits, price_amount, currency, status, payment_id, checkout_url, failure_reason, balance_transaction_id, created_at, updated_at, validity_days FROM users_credit_orders
+WHERE payment_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetCreditOrderByPaymentIDForUpdate(ctx context.Context, paymentID *string) (UsersCreditOrder, error) {
+	row := q.db.QueryRow(ctx, getCreditOrderByPaymentIDForUpdate, paymentID)
+	var i UsersCreditOrder
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.PackageCode,
+		&i.Credits,
+		&i.PriceAmount,
+		&i.Currency,
+		&i.Status,
+		&i.PaymentID,
+		&i.CheckoutUrl,
+		&i.FailureReason,
+		&i.BalanceTransactionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ValidityDays,
+	)
+	return i, err
+}
+
+const getCreditPackage = `-- name: GetCreditPackage :one
+SELECT code, name, credits, price_amount, currency, active, created_at, updated_at, validity_days FROM users_credit_packages
+WHERE code = $1
+`
+
+func (q *Queries) GetCreditPackage(ctx context.Context, code string) (UsersCreditPackage, error) {
+	row := q.db.QueryRow(ctx, getCreditPackage, code)
+	var i UsersCreditPackage
+	err := row.Scan(
+		&i.Code,
+		&i.Name,
+		&i.Credits,
+		&i.PriceAmount,
+		&i.Currency,
+		&i.Active,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ValidityDays,
+	)
+	return i, err
+}
+
 const getUser = `-- name: GetUser :one
-SELECT id, user_type, name, email, balance, last_ip, created_at, updated_at FROM users_users
+SELECT id, user_type, name, email, balance, last_ip, created_at, updated_at, deactivated_at, avatar_url, timezone, locale, erased_at FROM users_users
 WHERE id = $1
 `
 
@@ -78,12 +444,17 @@ func (q *Queries) GetUser(ctx context.Context, id pgtype.UUID) (UsersUser, error
 		&i.LastIp,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeactivatedAt,
+		&i.AvatarUrl,
+		&i.Timezone,
+		&i.Locale,
+		&i.ErasedAt,
 	)
 	return i, err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, user_type, name, email, balance, last_ip, created_at, updated_at FROM users_users
+SELECT id, user_type, name, email, balance, last_ip, created_at, updated_at, deactivated_at, avatar_url, timezone, locale, erased_at FROM users_users
 WHERE email = $1
 `
 
@@ -99,42 +470,67 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email *string) (UsersUser,
 		&i.LastIp,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeactivatedAt,
+		&i.AvatarUrl,
+		&i.Timezone,
+		&i.Locale,
+		&i.ErasedAt,
 	)
 	return i, err
 }
 
-const listUsersByType = `-- name: ListUsersByType :many
-SELECT id, user_type, name, email, balance, last_ip, created_at, updated_at FROM users_users
-WHERE user_type = $1
-  AND (created_at > $2 OR $2 IS NULL)
-  AND (id > $3 OR $3 IS NULL)
-ORDER BY created_at, id
-LIMIT $4
+const getUserForUpdate = `-- name: GetUserForUpdate :one
+SELECT id, user_type, name, email, balance, last_ip, created_at, updated_at, deactivated_at, avatar_url, timezone, locale, erased_at FROM users_users
+WHERE id = $1
+FOR UPDATE
 `
 
-func (q *Queries) ListUsersByType(ctx context.Context, userType string, createdAt time.Time, iD pgtype.UUID, limit int32) ([]UsersUser, error) {
-	rows, err := q.db.Query(ctx, listUsersByType,
-		userType,
-		createdAt,
-		iD,
-		limit,
+func (q *Queries) GetUserForUpdate(ctx context.Context, id pgtype.UUID) (UsersUser, error) {
+	row := q.db.QueryRow(ctx, getUserForUpdate, id)
+	var i UsersUser
+	err := row.Scan(
+		&i.ID,
+		&i.UserType,
+		&i.Name,
+		&i.Email,
+		&i.Balance,
+		&i.LastIp,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeactivatedAt,
+		&i.AvatarUrl,
+		&i.Timezone,
+		&i.Locale,
+		&i.ErasedAt,
 	)
+	return i, err
+}
+
+const listActiveCreditPackages = `-- name: ListActiveCreditPackages :many
+SELECT code, name, credits, price_amount, currency, active, created_at, updated_at, validity_days FROM users_credit_packages
+WHERE active
+ORDER BY credits, code
+`
+
+func (q *Queries) ListActiveCreditPackages(ctx context.Context) ([]UsersCreditPackage, error) {
+	rows, err := q.db.Query(ctx, listActiveCreditPackages)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []UsersUser
+	var items []UsersCreditPackage
 	for rows.Next() {
-		var i UsersUser
+		var i UsersCreditPackage
 		if err := rows.Scan(
-			&i.ID,
-			&i.UserType,
+			&i.Code,
 			&i.Name,
-			&i.Email,
-			&i.Balance,
-			&i.LastIp,
+			&i.Credits,
+			&i.PriceAmount,
+			&i.Currency,
+			&i.Active,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ValidityDays,
 		); err != nil {
 			return nil, err
 		}
@@ -146,42 +542,845 @@ func (q *Queries) ListUsersByType(ctx context.Context, userType string, createdA
 	return items, nil
 }
 
-const updateBalance = `-- name: UpdateBalance :exec
-UPDATE users_users
-SET
-    balance = balance + $2,
-    updated_at = NOW()
-WHERE id = $1
+const listAvailableCreditLots = `-- name: ListAvailableCreditLots :many
+SELECT id, user_id, transaction_id, credits, remaining, expires_at, created_at FROM users_credit_lots
+WHERE user_id = $1
+  AND remaining > 0
+  AND (expires_at IS NULL OR expires_at > $2)
+ORDER BY created_at, id
+FOR UPDATE
 `
 
-func (q *Queries) UpdateBalance(ctx context.Context, iD pgtype.UUID, balance int32) error {
-	_, err := q.db.Exec(ctx, updateBalance, iD, balance)
-	return err
+// oldest lots are consumed first
+func (q *Queries) ListAvailableCreditLots(ctx context.Context, userID pgtype.UUID, now pgtype.Timestamptz) ([]UsersCreditLot, error) {
+	rows, err := q.db.Query(ctx, listAvailableCreditLots, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UsersCreditLot
+	for rows.Next() {
+		var i UsersCreditLot
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TransactionID,
+			&i.Credits,
+			&i.Remaining,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const updateLastIP = `-- name: UpdateLastIP :exec
-UPDATE users_users
-SET
-    last_ip = $2,
-    updated_at = NOW()
-WHERE id = $1
+const listBalanceTransactions = `-- name: ListBalanceTransactions :many
+SELECT t.id, t.user_id, t.amount, t.balance_after, t.reason, t.description, t.training_id, t.actor_id, t.created_at FROM users_balance_transactions t
+WHERE t.user_id = $1
+  AND (
+    $2::uuid IS NULL
+    OR (t.created_at, t.id) < (
+        SELECT b.created_at, b.id FROM users_balance_transactions b
+        WHERE b.id = $2 AND b.user_id = $1
+    )
+  )
+ORDER BY t.created_at DESC, t.id DESC
+LIMIT $3
 `
 
-func (q *Queries) UpdateLastIP(ctx context.Context, iD pgtype.UUID, lastIp *string) error {
-	_, err := q.db.Exec(ctx, updateLastIP, iD, lastIp)
-	return err
+func (q *Queries) ListBalanceTransactions(ctx context.Context, userID pgtype.UUID, beforeID pgtype.UUID, limit int32) ([]UsersBalanceTransaction, error) {
+	rows, err := q.db.Query(ctx, listBalanceTransactions, userID, beforeID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UsersBalanceTransaction
+	for rows.Next() {
+		var i UsersBalanceTransaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Amount,
+			&i.BalanceAfter,
+			&i.Reason,
+			&i.Description,
+			&i.TrainingID,
+			&i.ActorID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const updateUser = `-- name: UpdateUser :exec
-UPDATE users_users
-SET
-    name = COALESCE($2, name),
-    email = COALESCE($3, email),
-    updated_at = NOW()
-WHERE id = $1
+const listCreditOrders = `-- name: ListCreditOrders :many
+SELECT id, user_id, package_code, credits, price_amount, currency, status, payment_id, checkout_url, failure_reason, balance_transaction_id, created_at, updated_at, validity_days FROM users_credit_orders
+WHERE user_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2
 `
 
-func (q *Queries) UpdateUser(ctx context.Context, iD pgtype.UUID, name string, email *string) error {
-	_, err := q.db.Exec(ctx, updateUser, iD, name, email)
-	return err
+func (q *Queries) ListCreditOrders(ctx context.Context, userID pgtype.UUID, limit int32) ([]UsersCreditOrder, error) {
+	rows, err := q.db.Query(ctx, listCreditOrders, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UsersCreditOrder
+	for rows.Next() {
+		var i UsersCreditOrder
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.PackageCode,
+			&i.Credits,
+			&i.PriceAmount,
+			&i.Currency,
+			&i.Status,
+			&i.PaymentID,
+			&i.CheckoutUrl,
+			&i.FailureReason,
+			&i.BalanceTransactionID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ValidityDays,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpiredCreditLots = `-- name: ListExpiredCreditLots :many
+SELECT id, user_id, transaction_id, credits, remaining, expires_at, created_at FROM users_credit_lots
+WHERE user_id = $1
+  AND remaining > 0
+  AND expires_at <= $2
+ORDER BY expires_at, id
+FOR UPDATE
+`
+
+func (q *Queries) ListExpiredCreditLots(ctx context.Context, userID pgtype.UUID, now pgtype.Timestamptz) ([]UsersCreditLot, error) {
+	rows, err := q.db.Query(ctx, listExpiredCreditLots, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UsersCreditLot
+	for rows.Next() {
+		var i UsersCreditLot
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.TransactionID,
+			&i.Credits,
+			&i.Remaining,
+			&i.ExpiresAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOtherTrainersClientIDs = `-- name: ListOtherTrainersClientIDs :many
+SELECT DISTINCT other.client_id
+FROM users_trainer_clients AS other
+WHERE other.trainer_id <> $1
+  AND other.client_id NOT IN (
+    SELECT own.client_id FROM users_trainer_clients AS own WHERE own.trainer_id = $1
+  )
+ORDER BY other.client_id
+`
+
+// Attendees assigned only to other trainers, attendees which are also clients of the trainer are left out
+func (q *Queries) ListOtherTrainersClientIDs(ctx context.Context, trainerID pgtype.UUID) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listOtherTrainersClientIDs, trainerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var client_id pgtype.UUID
+		if err := rows.Scan(&client_id); err != nil {
+			return nil, err
+		}
+		items = append(items, client_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrainerClients = `-- name: ListTrainerClients :many
+SELECT users_users.id, users_users.user_type, users_users.name, users_users.email, users_users.balance, users_users.last_ip, users_users.created_at, users_users.updated_at, users_users.deactivated_at, users_users.avatar_url, users_users.timezone, users_users.locale, users_users.erased_at, users_trainer_clients.assigned_at
+FROM users_trainer_clients
+JOIN users_users ON users_users.id = users_trainer_clients.client_id
+WHERE users_trainer_clients.trainer_id = $1
+ORDER BY users_users.name, users_users.id
+`
+
+type ListTrainerClientsRow struct {
+	UsersUser  UsersUser `json:"users_user"`
+	AssignedAt time.Time `json:"assigned_at"`
+}
+
+func (q *Queries) ListTrainerClients(ctx context.Context, trainerID pgtype.UUID) ([]ListTrainerClientsRow, error) {
+	rows, err := q.db.Query(ctx, listTrainerClients, trainerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTrainerClientsRow
+	for rows.Next() {
+		var i ListTrainerClientsRow
+		if err := rows.Scan(
+			&i.UsersUser.ID,
+			&i.UsersUser.UserType,
+			&i.UsersUser.Name,
+			&i.UsersUser.Email,
+			&i.UsersUser.Balance,
+			&i.UsersUser.LastIp,
+			&i.UsersUser.CreatedAt,
+			&i.UsersUser.UpdatedAt,
+			&i.UsersUser.DeactivatedAt,
+			&i.UsersUser.AvatarUrl,
+			&i.UsersUser.Timezone,
+			&i.UsersUser.Locale,
+			&i.UsersUser.ErasedAt,
+			&i.AssignedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrainingCreditLotConsumptions = `-- name: ListTrainingCreditLotConsumptions :many
+SELECT c.transaction_id, c.lot_id, c.amount, c.returned FROM users_credit_lot_consumptions c
+JOIN users_balance_transactions t ON t.id = c.transaction_id
+JOIN users_credit_lots l ON l.id = c.lot_id
+WHERE t.user_id = $1
+  AND t.training_id = $2
+  AND c.returned < c.amount
+ORDER BY t.created_at, l.created_at, l.id
+FOR UPDATE OF c
+`
+
+// consumptions of the training's debits which were not returned to their lots yet
+func (q *Queries) ListTrainingCreditLotConsumptions(ctx context.Context, userID pgtype.UUID, trainingID pgtype.UUID) ([]UsersCreditLotConsumption, error) {
+	rows, err := q.db.Query(ctx, listTrainingCreditLotConsumptions, userID, trainingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UsersCreditLotConsumption
+	for rows.Next() {
+		var i UsersCreditLotConsumption
+		if err := rows.Scan(
+			&i.TransactionID,
+			&i.LotID,
+			&i.Amount,
+			&i.Returned,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUpcomingCreditExpirations = `-- name: ListUpcomingCreditExpirations :many
+SELECT
+    expires_at::timestamptz AS expires_at,
+    SUM(remaining)::int AS credits
+FROM users_credit_lots
+WHERE user_id = $1
+  AND remaining > 0
+  AND expires_at > $2
+GROUP BY expires_at
+ORDER BY expires_at
+`
+
+type ListUpcomingCreditExpirationsRow struct {
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	Credits   int32              `json:"credits"`
+}
+
+func (q *Queries) ListUpcomingCreditExpirations(ctx context.Context, userID pgtype.UUID, now pgtype.Timestamptz) ([]ListUpcomingCreditExpirationsRow, error) {
+	rows, err := q.db.Query(ctx, listUpcomingCreditExpirations, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUpcomingCreditExpirationsRow
+	for rows.Next() {
+		var i ListUpcomingCreditExpirationsRow
+		if err := rows.Scan(&i.ExpiresAt, &i.Credits); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserSessions = `-- name: ListUserSessions :many
+SELECT id, user_id, session_key, auth_method, ip, user_agent, first_seen_at, last_seen_at, revoked_at FROM users_sessions
+WHERE user_id = $1
+ORDER BY last_seen_at DESC, id
+LIMIT $2
+`
+
+func (q *Queries) ListUserSessions(ctx context.Context, userID pgtype.UUID, limit int32) ([]UsersSession, error) {
+	rows, err := q.db.Query(ctx, listUserSessions, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UsersSession
+	for rows.Next() {
+		var i UsersSession
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.SessionKey,
+			&i.AuthMethod,
+			&i.Ip,
+			&i.UserAgent,
+			&i.FirstSeenAt,
+			&i.LastSeenAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsers = `-- name: ListUsers :many
+SELECT id, user_type, name, email, balance, last_ip, created_at, updated_at, deactivated_at, avatar_url, timezone, locale, erased_at FROM users_users
+WHERE ($1::text IS NULL OR user_type = $1)
+  AND ($2::boolean IS NULL OR (deactivated_at IS NOT NULL) = $2)
+  AND (
+    $3::text IS NULL
+    OR name ILIKE '%' || $3 || '%'
+    OR email ILIKE '%' || $3 || '%'
+  )
+  AND (
+    $4::uuid IS NULL
+    OR (created_at, id) > (SELECT u.created_at, u.id FROM users_users u WHERE u.id = $4)
+  )
+ORDER BY created_at, id
+LIMIT $5
+`
+
+// Keyset pagination by (created_at, id), all filters are optional
+func (q *Queries) ListUsers(ctx context.Context, userType *string, deactivated *bool, search *string, afterID pgtype.UUID, limit int32) ([]UsersUser, error) {
+	rows, err := q.db.Query(ctx, listUsers,
+		userType,
+		deactivated,
+		search,
+		afterID,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UsersUser
+	for rows.Next() {
+		var i UsersUser
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserType,
+			&i.Name,
+			&i.Email,
+			&i.Balance,
+			&i.LastIp,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeactivatedAt,
+			&i.AvatarUrl,
+			&i.Timezone,
+			&i.Locale,
+			&i.ErasedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersByIDs = `-- name: ListUsersByIDs :many
+SELECT id, user_type, name, email, balance, last_ip, created_at, updated_at, deactivated_at, avatar_url, timezone, locale, erased_at FROM users_users
+WHERE id = ANY($1::uuid[])
+ORDER BY id
+`
+
+func (q *Queries) ListUsersByIDs(ctx context.Context, ids []pgtype.UUID) ([]UsersUser, error) {
+	rows, err := q.db.Query(ctx, listUsersByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UsersUser
+	for rows.Next() {
+		var i UsersUser
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserType,
+			&i.Name,
+			&i.Email,
+			&i.Balance,
+			&i.LastIp,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeactivatedAt,
+			&i.AvatarUrl,
+			&i.Timezone,
+			&i.Locale,
+			&i.ErasedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersByType = `-- name: ListUsersByType :many
+SELECT id, user_type, name, email, balance, last_ip, created_at, updated_at, deactivated_at, avatar_url, timezone, locale, erased_at FROM users_users
+WHERE user_type = $1
+  AND (created_at > $2 OR $2 IS NULL)
+  AND (id > $3 OR $3 IS NULL)
+ORDER BY created_at, id
+LIMIT $4
+`
+
+func (q *Queries) ListUsersByType(ctx context.Context, userType string, createdAt time.Time, iD pgtype.UUID, limit int32) ([]UsersUser, error) {
+	rows, err := q.db.Query(ctx, listUsersByType,
+		userType,
+		createdAt,
+		iD,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UsersUser
+	for rows.Next() {
+		var i UsersUser
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserType,
+			&i.Name,
+			&i.Email,
+			&i.Balance,
+			&i.LastIp,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeactivatedAt,
+			&i.AvatarUrl,
+			&i.Timezone,
+			&i.Locale,
+			&i.ErasedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersWithExpiredCreditLots = `-- name: ListUsersWithExpiredCreditLots :many
+SELECT DISTINCT user_id FROM users_credit_lots
+WHERE remaining > 0
+  AND expires_at <= $1
+LIMIT $2
+`
+
+func (q *Queries) ListUsersWithExpiredCreditLots(ctx context.Context, now pgtype.Timestamptz, limit int32) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listUsersWithExpiredCreditLots, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var user_id pgtype.UUID
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reactivateUser = `-- name: ReactivateUser :one
+UPDATE users_users
+SET
+    deactivated_at = NULL,
+    updated_at = NOW()
+WHERE id = $1 AND erased_at IS NULL
+RETURNING id, user_type, name, email, balance, last_ip, created_at, updated_at, deactivated_at, avatar_url, timezone, locale, erased_at
+`
+
+// Erased users can't be reactivated
+func (q *Queries) ReactivateUser(ctx context.Context, id pgtype.UUID) (UsersUser, error) {
+	row := q.db.QueryRow(ctx, reactivateUser, id)
+	var i UsersUser
+	err := row.Scan(
+		&i.ID,
+		&i.UserType,
+		&i.Name,
+		&i.Email,
+		&i.Balance,
+		&i.LastIp,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeactivatedAt,
+		&i.AvatarUrl,
+		&i.Timezone,
+		&i.Locale,
+		&i.ErasedAt,
+	)
+	return i, err
+}
+
+const recordSession = `-- name: RecordSession :one
+INSERT INTO users_sessions (
+    id,
+    user_id,
+    session_key,
+    auth_method,
+    ip,
+    user_agent,
+    first_seen_at,
+    last_seen_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, NOW(), NOW()
+)
+ON CONFLICT (user_id, session_key) DO UPDATE
+SET
+    ip = EXCLUDED.ip,
+    user_agent = EXCLUDED.user_agent,
+    last_seen_at = NOW()
+RETURNING id, user_id, session_key, auth_method, ip, user_agent, first_seen_at, last_seen_at, revoked_at
+`
+
+// Creates the session on its first use, later uses only refresh where and when it was seen
+func (q *Queries) RecordSession(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID, sessionKey string, authMethod *string, ip *string, userAgent *string) (UsersSession, error) {
+	row := q.db.QueryRow(ctx, recordSession,
+		iD,
+		userID,
+		sessionKey,
+		authMethod,
+		ip,
+		userAgent,
+	)
+	var i UsersSession
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.SessionKey,
+		&i.AuthMethod,
+		&i.Ip,
+		&i.UserAgent,
+		&i.FirstSeenAt,
+		&i.LastSeenAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const returnCreditLotConsumption = `-- name: ReturnCreditLotConsumption :exec
+UPDATE users_credit_lot_consumptions
+SET returned = returned + $1
+WHERE transaction_id = $2
+  AND lot_id = $3
+`
+
+func (q *Queries) ReturnCreditLotConsumption(ctx context.Context, amount int32, transactionID pgtype.UUID, lotID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, returnCreditLotConsumption, amount, transactionID, lotID)
+	return err
+}
+
+const revokeSession = `-- name: RevokeSession :one
+UPDATE users_sessions
+SET revoked_at = COALESCE(revoked_at, NOW())
+WHERE id = $1 AND user_id = $2
+RETURNING id, user_id, session_key, auth_method, ip, user_agent, first_seen_at, last_seen_at, revoked_at
+`
+
+// Revoking already revoked session keeps the original revocation time
+func (q *Queries) RevokeSession(ctx context.Context, iD pgtype.UUID, userID pgtype.UUID) (UsersSession, error) {
+	row := q.db.QueryRow(ctx, revokeSession, iD, userID)
+	var i UsersSession
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.SessionKey,
+		&i.AuthMethod,
+		&i.Ip,
+		&i.UserAgent,
+		&i.FirstSeenAt,
+		&i.LastSeenAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const setCreditOrderPayment = `-- name: SetCreditOrderPayment :one
+UPDATE users_credit_orders
+SET
+    payment_id = $2,
+    checkout_url = $3,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, user_id, package_code, credits, price_amount, currency, status, payment_id, checkout_url, failure_reason, balance_transaction_id, created_at, updated_at, validity_days
+`
+
+func (q *Queries) SetCreditOrderPayment(ctx context.Context, iD pgtype.UUID, paymentID *string, checkoutUrl *string) (UsersCreditOrder, error) {
+	row := q.db.QueryRow(ctx, setCreditOrderPayment, iD, paymentID, checkoutUrl)
+	var i UsersCreditOrder
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.PackageCode,
+		&i.Credits,
+		&i.PriceAmount,
+		&i.Currency,
+		&i.Status,
+		&i.PaymentID,
+		&i.CheckoutUrl,
+		&i.FailureReason,
+		&i.BalanceTransactionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ValidityDays,
+	)
+	return i, err
+}
+
+const unassignTrainerClient = `-- name: UnassignTrainerClient :execrows
+DELETE FROM users_trainer_clients
+WHERE trainer_id = $1 AND client_id = $2
+`
+
+func (q *Queries) UnassignTrainerClient(ctx context.Context, trainerID pgtype.UUID, clientID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, unassignTrainerClient, trainerID, clientID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateBalance = `-- name: UpdateBalance :exec
+UPDATE users_users
+SET
+    balance = $2,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) UpdateBalance(ctx context.Context, iD pgtype.UUID, balance int32) error {
+	_, err := q.db.Exec(ctx, updateBalance, iD, balance)
+	return err
+}
+
+const updateCreditLotRemaining = `-- name: UpdateCreditLotRemaining :exec
+UPDATE users_credit_lots
+SET remaining = remaining + $1
+WHERE id = $2
+`
+
+func (q *Queries) UpdateCreditLotRemaining(ctx context.Context, amount int32, iD pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, updateCreditLotRemaining, amount, iD)
+	return err
+}
+
+const updateCreditOrderStatus = `-- name: UpdateCreditOrderStatus :one
+UPDATE users_credit_orders
+SET
+    status = $1,
+    failure_reason = COALESCE($2, failure_reason),
+    balance_transaction_id = COALESCE($3, balance_transaction_id),
+    updated_at = NOW()
+WHERE id = $4
+RETURNING id, user_id, package_code, credits, price_amount, currency, status, payment_id, checkout_url, failure_reason, balance_transaction_id, created_at, updated_at, validity_days
+`
+
+func (q *Queries) UpdateCreditOrderStatus(ctx context.Context, status string, failureReason *string, balanceTransactionID pgtype.UUID, iD pgtype.UUID) (UsersCreditOrder, error) {
+	row := q.db.QueryRow(ctx, updateCreditOrderStatus,
+		status,
+		failureReason,
+		balanceTransactionID,
+		iD,
+	)
+	var i UsersCreditOrder
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.PackageCode,
+		&i.Credits,
+		&i.PriceAmount,
+		&i.Currency,
+		&i.Status,
+		&i.PaymentID,
+		&i.CheckoutUrl,
+		&i.FailureReason,
+		&i.BalanceTransactionID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ValidityDays,
+	)
+	return i, err
+}
+
+const updateLastIP = `-- name: UpdateLastIP :exec
+UPDATE users_users
+SET
+    last_ip = $2,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) UpdateLastIP(ctx context.Context, iD pgtype.UUID, lastIp *string) error {
+	_, err := q.db.Exec(ctx, updateLastIP, iD, lastIp)
+	return err
+}
+
+const updateUser = `-- name: UpdateUser :exec
+UPDATE users_users
+SET
+    name = COALESCE($2, name),
+    email = COALESCE($3, email),
+    updated_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) UpdateUser(ctx context.Context, iD pgtype.UUID, name string, email *string) error {
+	_, err := q.db.Exec(ctx, updateUser, iD, name, email)
+	return err
+}
+
+const updateUserProfile = `-- name: UpdateUserProfile :one
+UPDATE users_users
+SET
+    name = $2,
+    email = $3,
+    avatar_url = $4,
+    timezone = $5,
+    locale = $6,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, user_type, name, email, balance, last_ip, created_at, updated_at, deactivated_at, avatar_url, timezone, locale, erased_at
+`
+
+func (q *Queries) UpdateUserProfile(ctx context.Context, iD pgtype.UUID, name string, email *string, avatarUrl *string, timezone *string, locale *string) (UsersUser, error) {
+	row := q.db.QueryRow(ctx, updateUserProfile,
+		iD,
+		name,
+		email,
+		avatarUrl,
+		timezone,
+		locale,
+	)
+	var i UsersUser
+	err := row.Scan(
+		&i.ID,
+		&i.UserType,
+		&i.Name,
+		&i.Email,
+		&i.Balance,
+		&i.LastIp,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeactivatedAt,
+		&i.AvatarUrl,
+		&i.Timezone,
+		&i.Locale,
+		&i.ErasedAt,
+	)
+	return i, err
+}
+
+const updateUserType = `-- name: UpdateUserType :one
+UPDATE users_users
+SET
+    user_type = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, user_type, name, email, balance, last_ip, created_at, updated_at, deactivated_at, avatar_url, timezone, locale, erased_at
+`
+
+func (q *Queries) UpdateUserType(ctx context.Context, iD pgtype.UUID, userType string) (UsersUser, error) {
+	row := q.db.QueryRow(ctx, updateUserType, iD, userType)
+	var i UsersUser
+	err := row.Scan(
+		&i.ID,
+		&i.UserType,
+		&i.Name,
+		&i.Email,
+		&i.Balance,
+		&i.LastIp,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeactivatedAt,
+		&i.AvatarUrl,
+		&i.Timezone,
+		&i.Locale,
+		&i.ErasedAt,
+	)
+	return i, err
 }
@@ -0,0 +1,128 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/db"
+	sqlc_users "github.com/vaintrub/go-ddd-template/internal/users/adapters/sqlc"
+)
+
+var (
+	// ErrNotTrainer is returned when clients would be assigned to a user who is not a trainer.
+	ErrNotTrainer = errors.New("user is not a trainer")
+	// ErrInvalidClient is returned when the assigned client is not an active attendee.
+	ErrInvalidClient = errors.New("client must be an active attendee")
+	// ErrTrainerClientNotFound is returned when the client is not assigned to the trainer.
+	ErrTrainerClientNotFound = errors.New("client is not assigned to the trainer")
+)
+
+// AssignTrainerClient assigns the attendee to the trainer and returns the client.
+// Assigning already assigned client keeps the original assignment time.
+func (r *UserPostgresRepository) AssignTrainerClient(ctx context.Context, trainerID, clientID string) (*sqlc_users.ListTrainerClientsRow, error) {
+	trainerUID, err := db.StringToPgtypeUUID(trainerID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trainer UUID: %w", err)
+	}
+
+	clientUID, err := db.StringToPgtypeUUID(clientID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid client UUID: %w", err)
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback is safe to call even if commit succeeds
+	}()
+
+	queries := sqlc_users.New(tx)
+
+	trainer, err := queries.GetUser(ctx, trainerUID)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+	if trainer.UserType != "trainer" {
+		return nil, ErrNotTrainer
+	}
+
+	client, err := queries.GetUser(ctx, clientUID)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+	if client.UserType != "attendee" || client.DeactivatedAt.Valid {
+		return nil, ErrInvalidClient
+	}
+
+	assignment, err := queries.AssignTrainerClient(ctx, trainerUID, clientUID)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &sqlc_users.ListTrainerClientsRow{UsersUser: client, AssignedAt: assignment.AssignedAt}, nil
+}
+
+// UnassignTrainerClient removes the client from the trainer's roster.
+func (r *UserPostgresRepository) UnassignTrainerClient(ctx context.Context, trainerID, clientID string) error {
+	trainerUID, err := db.StringToPgtypeUUID(trainerID)
+	if err != nil {
+		return fmt.Errorf("invalid trainer UUID: %w", err)
+	}
+
+	clientUID, err := db.StringToPgtypeUUID(clientID)
+	if err != nil {
+		return fmt.Errorf("invalid client UUID: %w", err)
+	}
+
+	deleted, err := sqlc_users.New(r.pool).UnassignTrainerClient(ctx, trainerUID, clientUID)
+	if err != nil {
+		return db.TranslatePgError(err)
+	}
+	if deleted == 0 {
+		return ErrTrainerClientNotFound
+	}
+
+	return nil
+}
+
+// ListTrainerClients returns the clients assigned to the trainer, ordered by name.
+func (r *UserPostgresRepository) ListTrainerClients(ctx context.Context, trainerID string) ([]sqlc_users.ListTrainerClientsRow, error) {
+	trainerUID, err := db.StringToPgtypeUUID(trainerID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trainer UUID: %w", err)
+	}
+
+	clients, err := sqlc_users.New(r.pool).ListTrainerClients(ctx, trainerUID)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	return clients, nil
+}
+
+// ListOtherTrainersClientIDs returns IDs of the attendees assigned only to other trainers than the trainer.
+func (r *UserPostgresRepository) ListOtherTrainersClientIDs(ctx context.Context, trainerID string) ([]string, error) {
+	trainerUID, err := db.StringToPgtypeUUID(trainerID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trainer UUID: %w", err)
+	}
+
+	clientUIDs, err := sqlc_users.New(r.pool).ListOtherTrainersClientIDs(ctx, trainerUID)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	clientIDs := make([]string, 0, len(clientUIDs))
+	for _, clientUID := range clientUIDs {
+		clientIDs = append(clientIDs, db.PgtypeToUUID(clientUID).String())
+	}
+
+	return clientIDs, nil
+}
@@ -2,9 +2,13 @@ package adapters
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
@@ -12,6 +16,20 @@ import (
 	sqlc_users "github.com/vaintrub/go-ddd-template/internal/users/adapters/sqlc"
 )
 
+var (
+	// ErrInsufficientBalance is returned when the debit would make the trainings balance negative.
+	ErrInsufficientBalance = errors.New("insufficient trainings balance")
+	// ErrUserErased is returned when the erased user would be reactivated.
+	ErrUserErased = errors.New("user data was erased")
+)
+
+const (
+	// BalanceReasonOpeningBalance is the reason of the balance the user was created with.
+	BalanceReasonOpeningBalance = "opening-balance"
+	// BalanceReasonStarterCredits is the reason of credits granted to users provisioned on their first login.
+	BalanceReasonStarterCredits = "starter-credits"
+)
+
 // UserPostgresRepository implements user repository using PostgreSQL.
 // It wraps SQLC-generated code to provide a clean repository interface.
 type UserPostgresRepository struct {
@@ -29,9 +47,8 @@ func (r *UserPostgresRepository) CreateUser(ctx context.Context, id, userType, n
 }
 
 // CreateUserWithBalance persists a new user with initial balance to the database.
+// Non-zero initial balance is recorded in the ledger as the opening balance.
 func (r *UserPostgresRepository) CreateUserWithBalance(ctx context.Context, id, userType, name, email string, balance int) error {
-	queries := sqlc_users.New(r.pool)
-
 	uid, err := db.StringToPgtypeUUID(id)
 	if err != nil {
 		return fmt.Errorf("invalid user UUID: %w", err)
@@ -42,19 +59,104 @@ func (r *UserPostgresRepository) CreateUserWithBalance(ctx context.Context, id,
 		emailPtr = &email
 	}
 
-	var balancePtr *int32
-	// #nosec G115 - balance is a domain-validated value, overflow unlikely
-	bal := int32(balance)
-	balancePtr = &bal
-
-	_, err = queries.CreateUser(ctx, uid, userType, name, emailPtr, balancePtr)
+	tx, err := r.pool.Begin(ctx)
 	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback is safe to call even if commit succeeds
+	}()
+
+	queries := sqlc_users.New(tx)
+
+	// the balance is derived from the ledger below
+	var noBalance int32
+	if _, err := queries.CreateUser(ctx, uid, userType, name, emailPtr, &noBalance); err != nil {
 		return db.TranslatePgError(err)
 	}
 
+	if balance != 0 {
+		if _, err := changeBalance(ctx, queries, uid, BalanceChange{Amount: balance, Reason: BalanceReasonOpeningBalance}); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return nil
 }
 
+// ProvisionUser creates the user unless it already exists and returns the user.
+// When the email is already taken by another user, the user is created without email.
+// Starter credits with non-zero amount are granted only to the created user,
+// so concurrent first requests of the same user grant them once.
+func (r *UserPostgresRepository) ProvisionUser(
+	ctx context.Context,
+	id, userType, name, email string,
+	starterCredits BalanceChange,
+) (*sqlc_users.UsersUser, error) {
+	uid, err := db.StringToPgtypeUUID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	// existing users are only read, so provisioning can run on every request
+	user, err := sqlc_users.New(r.pool).GetUser(ctx, uid)
+	if err == nil {
+		return &user, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, db.TranslatePgError(err)
+	}
+
+	var emailPtr *string
+	if email != "" {
+		emailPtr = &email
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback is safe to call even if commit succeeds
+	}()
+
+	queries := sqlc_users.New(tx)
+
+	created, err := queries.CreateUserIfNotExists(ctx, uid, userType, name, emailPtr)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+	if created == 0 && emailPtr != nil {
+		// the email can be already taken by another user, who set it in their profile,
+		// the user is then provisioned without it instead of being locked out
+		created, err = queries.CreateUserIfNotExists(ctx, uid, userType, name, nil)
+		if err != nil {
+			return nil, db.TranslatePgError(err)
+		}
+	}
+
+	if created > 0 && starterCredits.Amount != 0 {
+		if _, err := changeBalance(ctx, queries, uid, starterCredits); err != nil {
+			return nil, err
+		}
+	}
+
+	user, err = queries.GetUser(ctx, uid)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &user, nil
+}
+
 // GetUser retrieves a user by UUID.
 func (r *UserPostgresRepository) GetUser(ctx context.Context, userID string) (*sqlc_users.UsersUser, error) {
 	queries := sqlc_users.New(r.pool)
@@ -72,6 +174,25 @@ func (r *UserPostgresRepository) GetUser(ctx context.Context, userID string) (*s
 	return &user, nil
 }
 
+// GetUsers retrieves the users by UUIDs, users which don't exist are left out.
+func (r *UserPostgresRepository) GetUsers(ctx context.Context, userIDs []string) ([]sqlc_users.UsersUser, error) {
+	ids := make([]pgtype.UUID, 0, len(userIDs))
+	for _, userID := range userIDs {
+		uid, err := db.StringToPgtypeUUID(userID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user UUID: %w", err)
+		}
+		ids = append(ids, uid)
+	}
+
+	users, err := sqlc_users.New(r.pool).ListUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	return users, nil
+}
+
 // GetUserByEmail retrieves a user by email address.
 func (r *UserPostgresRepository) GetUserByEmail(ctx context.Context, email string) (*sqlc_users.UsersUser, error) {
 	queries := sqlc_users.New(r.pool)
@@ -118,6 +239,47 @@ func (r *UserPostgresRepository) UpdateUser(ctx context.Context, userID, name, e
 	return nil
 }
 
+// UserProfile contains user's data editable by the user, empty values are stored as NULL.
+type UserProfile struct {
+	Name      string
+	Email     string
+	AvatarURL string
+	Timezone  string
+	Locale    string
+}
+
+// UpdateUserProfile replaces the user's profile.
+func (r *UserPostgresRepository) UpdateUserProfile(ctx context.Context, userID string, profile UserProfile) (*sqlc_users.UsersUser, error) {
+	queries := sqlc_users.New(r.pool)
+
+	uid, err := db.StringToPgtypeUUID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	user, err := queries.UpdateUserProfile(
+		ctx,
+		uid,
+		profile.Name,
+		optionalString(profile.Email),
+		optionalString(profile.AvatarURL),
+		optionalString(profile.Timezone),
+		optionalString(profile.Locale),
+	)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	return &user, nil
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
 // DeleteUser removes a user from the database.
 func (r *UserPostgresRepository) DeleteUser(ctx context.Context, userID string) error {
 	queries := sqlc_users.New(r.pool)
@@ -135,27 +297,331 @@ func (r *UserPostgresRepository) DeleteUser(ctx context.Context, userID string)
 	return nil
 }
 
+// UserFilter narrows down users listed by ListUsers, zero values don't filter.
+type UserFilter struct {
+	UserType string
+	// Search matches users whose name or email contains it, case-insensitively.
+	Search      string
+	Deactivated *bool
+
+	// AfterUUID continues the listing after the user with this UUID.
+	AfterUUID string
+	Limit     int32
+}
+
+// ListUsers retrieves users matching the filter, ordered by creation time.
+func (r *UserPostgresRepository) ListUsers(ctx context.Context, filter UserFilter) ([]sqlc_users.UsersUser, error) {
+	queries := sqlc_users.New(r.pool)
+
+	var userType *string
+	if filter.UserType != "" {
+		userType = &filter.UserType
+	}
+
+	var search *string
+	if filter.Search != "" {
+		escaped := likePatternEscaper.Replace(filter.Search)
+		search = &escaped
+	}
+
+	var afterID pgtype.UUID
+	if filter.AfterUUID != "" {
+		id, err := db.StringToPgtypeUUID(filter.AfterUUID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user UUID: %w", err)
+		}
+		afterID = id
+	}
+
+	users, err := queries.ListUsers(ctx, userType, filter.Deactivated, search, afterID, filter.Limit)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	return users, nil
+}
+
+// likePatternEscaper escapes wildcards, so searched text is matched literally by ILIKE.
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// UpdateUserType changes the user's role.
+func (r *UserPostgresRepository) UpdateUserType(ctx context.Context, userID, userType string) (*sqlc_users.UsersUser, error) {
+	queries := sqlc_users.New(r.pool)
+
+	uid, err := db.StringToPgtypeUUID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	user, err := queries.UpdateUserType(ctx, uid, userType)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	return &user, nil
+}
+
+// DeactivateUser marks the user's account as deactivated, deactivating it again keeps the original time.
+func (r *UserPostgresRepository) DeactivateUser(ctx context.Context, userID string) (*sqlc_users.UsersUser, error) {
+	queries := sqlc_users.New(r.pool)
+
+	uid, err := db.StringToPgtypeUUID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	user, err := queries.DeactivateUser(ctx, uid)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	return &user, nil
+}
+
+// ReactivateUser makes the deactivated user's account active again.
+// It returns ErrUserErased for users whose data was erased.
+func (r *UserPostgresRepository) ReactivateUser(ctx context.Context, userID string) (*sqlc_users.UsersUser, error) {
+	queries := sqlc_users.New(r.pool)
+
+	uid, err := db.StringToPgtypeUUID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	user, err := queries.ReactivateUser(ctx, uid)
+	if db.IsNotFound(db.TranslatePgError(err)) {
+		if _, getErr := queries.GetUser(ctx, uid); getErr == nil {
+			return nil, ErrUserErased
+		}
+	}
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	return &user, nil
+}
+
+// EraseUser replaces the user's name with erasedName, removes the rest of the profile, the sessions
+// and the trainer-client assignments, and deactivates the account. The balance ledger and credit orders are kept for accounting.
+func (r *UserPostgresRepository) EraseUser(ctx context.Context, userID string, erasedName string) (*sqlc_users.UsersUser, error) {
+	uid, err := db.StringToPgtypeUUID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback is safe to call even if commit succeeds
+	}()
+
+	queries := sqlc_users.New(tx)
+
+	user, err := queries.EraseUser(ctx, uid, erasedName)
+	if err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	if err := queries.DeleteUserSessions(ctx, uid); err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	if err := queries.DeleteUserTrainerClients(ctx, uid); err != nil {
+		return nil, db.TranslatePgError(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &user, nil
+}
+
 // GetByID retrieves a user by UUID (alias for GetUser for compatibility).
 func (r *UserPostgresRepository) GetByID(ctx context.Context, userID string) (*sqlc_users.UsersUser, error) {
 	return r.GetUser(ctx, userID)
 }
 
-// UpdateBalance updates a user's balance by adding the specified amount (can be negative).
-func (r *UserPostgresRepository) UpdateBalance(ctx context.Context, userID string, amountChange int) error {
+// BalanceChange describes a single change of the user's trainings balance recorded in the ledger.
+type BalanceChange struct {
+	Amount int
+	Reason string
+
+	// Description, TrainingUUID and ActorUUID are optional.
+	Description  string
+	TrainingUUID string
+	ActorUUID    string
+
+	// ExpiresAt is when the credited credits expire, they never expire when it's zero.
+	// Credits returned to the lots consumed by the training keep the expiry of their lots.
+	ExpiresAt time.Time
+}
+
+// UpdateBalance records the balance change in the ledger and derives the new balance from it.
+// The user is locked until the change is committed, so concurrent changes are applied one by one.
+// Debits which would overdraw the balance are rejected with ErrInsufficientBalance.
+func (r *UserPostgresRepository) UpdateBalance(ctx context.Context, userID string, change BalanceChange) (*sqlc_users.UsersBalanceTransaction, error) {
+	uid, err := db.StringToPgtypeUUID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx) // Rollback is safe to call even if commit succeeds
+	}()
+
+	transaction, err := changeBalance(ctx, sqlc_users.New(tx), uid, change)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &transaction, nil
+}
+
+// changeBalance locks the user, records the change in the ledger and updates the balance derived from it.
+// Credits are kept in lots: debits consume the oldest non-expired lots first and credits are granted as lots,
+// so the balance is always the sum of remaining credits of non-expired lots.
+// It has to be called in a transaction, so the lock is held until the change is committed.
+func changeBalance(
+	ctx context.Context,
+	queries *sqlc_users.Queries,
+	userID pgtype.UUID,
+	change BalanceChange,
+) (sqlc_users.UsersBalanceTransaction, error) {
+	if _, err := queries.GetUserForUpdate(ctx, userID); err != nil {
+		return sqlc_users.UsersBalanceTransaction{}, db.TranslatePgError(err)
+	}
+
+	now := time.Now()
+
+	// expired credits can't be spent, even if the expiry job didn't take them away yet
+	if _, err := expireCreditLots(ctx, queries, userID, now); err != nil {
+		return sqlc_users.UsersBalanceTransaction{}, err
+	}
+
+	transaction, err := createBalanceTransaction(ctx, queries, userID, change)
+	if err != nil {
+		return sqlc_users.UsersBalanceTransaction{}, err
+	}
+
+	if change.Amount < 0 {
+		// the user is locked, so concurrent debits can't overdraw the balance together
+		if transaction.BalanceAfter < 0 {
+			return sqlc_users.UsersBalanceTransaction{}, ErrInsufficientBalance
+		}
+		if err := consumeCreditLots(ctx, queries, transaction, now); err != nil {
+			return sqlc_users.UsersBalanceTransaction{}, err
+		}
+	} else {
+		if err := addCreditLots(ctx, queries, transaction, change.ExpiresAt); err != nil {
+			return sqlc_users.UsersBalanceTransaction{}, err
+		}
+	}
+
+	balanceAfter := transaction.BalanceAfter
+
+	// credits returned to a lot which expired in the meantime expire right away
+	expired, err := expireCreditLots(ctx, queries, userID, now)
+	if err != nil {
+		return sqlc_users.UsersBalanceTransaction{}, err
+	}
+	if expired != nil {
+		balanceAfter = expired.BalanceAfter
+	}
+
+	if err := queries.UpdateBalance(ctx, userID, balanceAfter); err != nil {
+		return sqlc_users.UsersBalanceTransaction{}, db.TranslatePgError(err)
+	}
+
+	return transaction, nil
+}
+
+// ListBalanceTransactions returns the user's balance changes, the newest first.
+// When beforeUUID is not empty, only transactions older than it are returned.
+func (r *UserPostgresRepository) ListBalanceTransactions(
+	ctx context.Context,
+	userID string,
+	beforeUUID string,
+	limit int32,
+) ([]sqlc_users.UsersBalanceTransaction, error) {
 	queries := sqlc_users.New(r.pool)
 
 	uid, err := db.StringToPgtypeUUID(userID)
 	if err != nil {
-		return fmt.Errorf("invalid user UUID: %w", err)
+		return nil, fmt.Errorf("invalid user UUID: %w", err)
 	}
 
-	// #nosec G115 - amountChange is a domain-validated value, overflow unlikely
-	err = queries.UpdateBalance(ctx, uid, int32(amountChange))
+	var beforeID pgtype.UUID
+	if beforeUUID != "" {
+		beforeID, err = db.StringToPgtypeUUID(beforeUUID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid balance transaction UUID: %w", err)
+		}
+	}
+
+	transactions, err := queries.ListBalanceTransactions(ctx, uid, beforeID, limit)
 	if err != nil {
-		return db.TranslatePgError(err)
+		return nil, db.TranslatePgError(err)
 	}
 
-	return nil
+	return transactions, nil
+}
+
+func createBalanceTransaction(
+	ctx context.Context,
+	queries *sqlc_users.Queries,
+	userID pgtype.UUID,
+	change BalanceChange,
+) (sqlc_users.UsersBalanceTransaction, error) {
+	var description *string
+	if change.Description != "" {
+		description = &change.Description
+	}
+
+	var trainingID pgtype.UUID
+	if change.TrainingUUID != "" {
+		id, err := db.StringToPgtypeUUID(change.TrainingUUID)
+		if err != nil {
+			return sqlc_users.UsersBalanceTransaction{}, fmt.Errorf("invalid training UUID: %w", err)
+		}
+		trainingID = id
+	}
+
+	var actorID pgtype.UUID
+	if change.ActorUUID != "" {
+		id, err := db.StringToPgtypeUUID(change.ActorUUID)
+		if err != nil {
+			return sqlc_users.UsersBalanceTransaction{}, fmt.Errorf("invalid actor UUID: %w", err)
+		}
+		actorID = id
+	}
+
+	transaction, err := queries.CreateBalanceTransaction(
+		ctx,
+		db.UUIDToPgtype(uuid.New()),
+		userID,
+		// #nosec G115 - amount is a domain-validated value, overflow unlikely
+		int32(change.Amount),
+		change.Reason,
+		description,
+		trainingID,
+		actorID,
+	)
+	if err != nil {
+		return sqlc_users.UsersBalanceTransaction{}, db.TranslatePgError(err)
+	}
+
+	return transaction, nil
 }
 
 // UpdateLastIP updates the last IP address for a user.
@@ -0,0 +1,158 @@
+package adapters_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/config"
+	"github.com/vaintrub/go-ddd-template/internal/common/db"
+	"github.com/vaintrub/go-ddd-template/internal/common/tests"
+	"github.com/vaintrub/go-ddd-template/internal/users/adapters"
+)
+
+var (
+	postgresURL       string
+	terminatePostgres func(context.Context) error
+)
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+	dsn, terminate, err := tests.StartPostgresContainer(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "skipping users adapters tests: %v\n", err)
+		os.Exit(0)
+	}
+	postgresURL = dsn
+	terminatePostgres = terminate
+
+	code := m.Run()
+
+	if terminatePostgres != nil {
+		_ = terminatePostgres(context.Background())
+	}
+	os.Exit(code)
+}
+
+func TestProvisionUser_taken_email(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := newPostgresRepository(t, ctx)
+
+	email := fmt.Sprintf("%s@example.com", uuid.New().String())
+
+	// the email was set in the profile of another user, before its owner logged in for the first time
+	otherUserUUID := uuid.New().String()
+	require.NoError(t, repo.CreateUser(ctx, otherUserUUID, "attendee", "Other User", email))
+
+	userUUID := uuid.New().String()
+	starterCredits := adapters.BalanceChange{Amount: 2, Reason: adapters.BalanceReasonStarterCredits}
+
+	user, err := repo.ProvisionUser(ctx, userUUID, "attendee", "New User", email, starterCredits)
+	require.NoError(t, err)
+
+	assert.Equal(t, userUUID, db.PgtypeToUUID(user.ID).String())
+	assert.Nil(t, user.Email, "user is provisioned without the taken email")
+	assert.EqualValues(t, 2, user.Balance)
+
+	// the user is provisioned once, next requests only read it
+	user, err = repo.ProvisionUser(ctx, userUUID, "attendee", "New User", email, starterCredits)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, user.Balance)
+
+	otherUser, err := repo.GetUser(ctx, otherUserUUID)
+	require.NoError(t, err)
+	require.NotNil(t, otherUser.Email)
+	assert.Equal(t, email, *otherUser.Email)
+}
+
+func TestProvisionUser_with_email(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := newPostgresRepository(t, ctx)
+
+	email := fmt.Sprintf("%s@example.com", uuid.New().String())
+
+	user, err := repo.ProvisionUser(ctx, uuid.New().String(), "attendee", "New User", email, adapters.BalanceChange{})
+	require.NoError(t, err)
+
+	require.NotNil(t, user.Email)
+	assert.Equal(t, email, *user.Email)
+}
+
+func TestUpdateBalance_ledger(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo := newPostgresRepository(t, ctx)
+
+	userUUID := uuid.New().String()
+	require.NoError(t, repo.CreateUserWithBalance(ctx, userUUID, "attendee", "Attendee", "", 5))
+	assertBalance(t, ctx, repo, userUUID, 5)
+
+	actorUUID := uuid.New().String()
+	transaction, err := repo.UpdateBalance(ctx, userUUID, adapters.BalanceChange{
+		Amount:      -2,
+		Reason:      "admin-adjustment",
+		Description: "Duplicated booking",
+		ActorUUID:   actorUUID,
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, -2, transaction.Amount)
+	assert.EqualValues(t, 3, transaction.BalanceAfter)
+	require.NotNil(t, transaction.Description)
+	assert.Equal(t, "Duplicated booking", *transaction.Description)
+
+	// the rejected debit is not recorded
+	_, err = repo.UpdateBalance(ctx, userUUID, adapters.BalanceChange{
+		Amount: -4,
+		Reason: "training-booked",
+	})
+	require.ErrorIs(t, err, adapters.ErrInsufficientBalance)
+	assertBalance(t, ctx, repo, userUUID, 3)
+
+	transactions, err := repo.ListBalanceTransactions(ctx, userUUID, "", 10)
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+	assert.Equal(t, "admin-adjustment", transactions[0].Reason)
+	assert.Equal(t, adapters.BalanceReasonOpeningBalance, transactions[1].Reason)
+	assert.EqualValues(t, 5, transactions[1].BalanceAfter)
+}
+
+func newPostgresRepository(t *testing.T, ctx context.Context) *adapters.UserPostgresRepository {
+	return adapters.NewUserPostgresRepository(newPostgresPool(t, ctx))
+}
+
+func newPostgresPool(t *testing.T, ctx context.Context) *pgxpool.Pool {
+	cfg := config.Config{
+		Env: config.EnvConfig{
+			Name: os.Getenv("ENV"),
+		},
+		Database: config.DatabaseConfig{
+			URL: postgresURL,
+		},
+	}
+
+	pool, err := db.NewPgxPool(ctx, cfg.Database, cfg.Env)
+	require.NoError(t, err)
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
+func createTestUser(t *testing.T, ctx context.Context, repo *adapters.UserPostgresRepository) string {
+	userUUID := uuid.New().String()
+	require.NoError(t, repo.CreateUser(ctx, userUUID, "attendee", "Attendee", ""))
+
+	return userUUID
+}
+
+func assertBalance(t *testing.T, ctx context.Context, repo *adapters.UserPostgresRepository, userUUID string, expected int) {
+	user, err := repo.GetUser(ctx, userUUID)
+	require.NoError(t, err)
+	assert.EqualValues(t, expected, user.Balance)
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/config"
+	commondb "github.com/vaintrub/go-ddd-template/internal/common/db"
+	"github.com/vaintrub/go-ddd-template/internal/users/adapters"
+	sqlc_users "github.com/vaintrub/go-ddd-template/internal/users/adapters/sqlc"
+)
+
+// PaymentProvider charges users for credit packages.
+// Payments are confirmed asynchronously, by webhooks sent by the provider.
+type PaymentProvider interface {
+	CreatePayment(ctx context.Context, request adapters.PaymentRequest) (adapters.Payment, error)
+	// ParseWebhook verifies the webhook was sent by the provider and returns the reported payment event.
+	ParseWebhook(payload []byte, header http.Header) (adapters.PaymentEvent, error)
+}
+
+// newPaymentProvider returns the configured payment provider, or nil when credit packages can't be bought.
+func newPaymentProvider(cfg config.PaymentsConfig) PaymentProvider {
+	switch cfg.Provider {
+	case "":
+		return nil
+	case "fake":
+		return adapters.NewFakePaymentProvider(cfg.WebhookSecret, cfg.CheckoutURL)
+	default:
+		panic(fmt.Sprintf("payment provider '%s' is not supported", cfg.Provider))
+	}
+}
+
+// creditOrderStatusByPaymentStatus maps statuses of payments reported by the provider to statuses of orders.
+var creditOrderStatusByPaymentStatus = map[string]string{
+	adapters.PaymentSucceeded: adapters.CreditOrderPaid,
+	adapters.PaymentFailed:    adapters.CreditOrderFailed,
+	adapters.PaymentRefunded:  adapters.CreditOrderRefunded,
+}
+
+// CreditPackageModel represents a credit package from the catalog.
+type CreditPackageModel struct {
+	Code        string
+	Name        string
+	Credits     int
+	PriceAmount int
+	Currency    string
+	// ValidityDays is zero when bought credits never expire.
+	ValidityDays int
+}
+
+// CreditOrderModel represents the user's order of a credit package.
+type CreditOrderModel struct {
+	UUID          string
+	PackageCode   string
+	Credits       int
+	PriceAmount   int
+	Currency      string
+	Status        string
+	CheckoutURL   string
+	FailureReason string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (p *postgresDB) CreditPackages(ctx context.Context) ([]CreditPackageModel, error) {
+	packages, err := p.repo.ListCreditPackages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]CreditPackageModel, 0, len(packages))
+	for _, creditPackage := range packages {
+		model := CreditPackageModel{
+			Code:        creditPackage.Code,
+			Name:        creditPackage.Name,
+			Credits:     int(creditPackage.Credits),
+			PriceAmount: int(creditPackage.PriceAmount),
+			Currency:    creditPackage.Currency,
+		}
+		if creditPackage.ValidityDays != nil {
+			model.ValidityDays = int(*creditPackage.ValidityDays)
+		}
+		models = append(models, model)
+	}
+	return models, nil
+}
+
+func (p *postgresDB) CreateCreditOrder(ctx context.Context, orderUUID string, userID string, packageCode string) (*CreditOrderModel, error) {
+	order, err := p.repo.CreateCreditOrder(ctx, orderUUID, userID, packageCode)
+	if err != nil {
+		return nil, err
+	}
+
+	model := creditOrderFromDB(*order)
+	return &model, nil
+}
+
+func (p *postgresDB) SetCreditOrderPayment(ctx context.Context, orderUUID string, payment adapters.Payment) (*CreditOrderModel, error) {
+	order, err := p.repo.SetCreditOrderPayment(ctx, orderUUID, payment.ID, payment.CheckoutURL)
+	if err != nil {
+		return nil, err
+	}
+
+	model := creditOrderFromDB(*order)
+	return &model, nil
+}
+
+func (p *postgresDB) FailCreditOrder(ctx context.Context, orderUUID string, failureReason string) error {
+	return p.repo.FailCreditOrder(ctx, orderUUID, failureReason)
+}
+
+func (p *postgresDB) CreditOrder(ctx context.Context, userID string, orderUUID string) (*CreditOrderModel, error) {
+	order, err := p.repo.GetCreditOrder(ctx, userID, orderUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	model := creditOrderFromDB(*order)
+	return &model, nil
+}
+
+func (p *postgresDB) CreditOrders(ctx context.Context, userID string, limit int) ([]CreditOrderModel, error) {
+	// #nosec G115 - limit is validated by the HTTP handler, overflow unlikely
+	orders, err := p.repo.ListCreditOrders(ctx, userID, int32(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]CreditOrderModel, 0, len(orders))
+	for _, order := range orders {
+		models = append(models, creditOrderFromDB(order))
+	}
+	return models, nil
+}
+
+func (p *postgresDB) UpdateCreditOrderPayment(ctx context.Context, event adapters.PaymentEvent) (*CreditOrderModel, error) {
+	status, ok := creditOrderStatusByPaymentStatus[event.Status]
+	if !ok {
+		return nil, fmt.Errorf("unknown payment status: %s", event.Status)
+	}
+
+	order, err := p.repo.UpdateCreditOrderPaymentStatus(ctx, event.PaymentID, status, event.FailureReason)
+	if err != nil {
+		return nil, err
+	}
+
+	model := creditOrderFromDB(*order)
+	return &model, nil
+}
+
+func creditOrderFromDB(order sqlc_users.UsersCreditOrder) CreditOrderModel {
+	model := CreditOrderModel{
+		UUID:        commondb.PgtypeToUUID(order.ID).String(),
+		PackageCode: order.PackageCode,
+		Credits:     int(order.Credits),
+		PriceAmount: int(order.PriceAmount),
+		Currency:    order.Currency,
+		Status:      order.Status,
+		CreatedAt:   order.CreatedAt,
+		UpdatedAt:   order.UpdatedAt,
+	}
+	if order.CheckoutUrl != nil {
+		model.CheckoutURL = *order.CheckoutUrl
+	}
+	if order.FailureReason != nil {
+		model.FailureReason = *order.FailureReason
+	}
+	return model
+}
@@ -2,17 +2,84 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	commondb "github.com/vaintrub/go-ddd-template/internal/common/db"
 	"github.com/vaintrub/go-ddd-template/internal/common/genproto/users"
+	"github.com/vaintrub/go-ddd-template/internal/users/adapters"
+)
+
+const (
+	// insufficientBalanceReason is the ErrorInfo reason of debits rejected, because they would overdraw the balance.
+	insufficientBalanceReason = "insufficient-balance"
+	// maxBatchGetUsers is the maximum number of users requested by one BatchGetUsers call.
+	maxBatchGetUsers = 500
 )
 
 type GrpcServer struct {
-	db db
+	db          db
+	provisioner userProvisioner
+	sessions    sessionChecker
+}
+
+func (g GrpcServer) GetUser(ctx context.Context, req *users.GetUserRequest) (*users.User, error) {
+	if _, err := uuid.Parse(req.UserId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	user, err := g.db.GetUser(ctx, req.UserId)
+	if commondb.IsNotFound(err) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get user: %s", err))
+	}
+
+	return userToProto(*user), nil
+}
+
+func (g GrpcServer) BatchGetUsers(ctx context.Context, req *users.BatchGetUsersRequest) (*users.BatchGetUsersResponse, error) {
+	if len(req.UserIds) > maxBatchGetUsers {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("at most %d users can be requested at once", maxBatchGetUsers))
+	}
+	for _, userID := range req.UserIds {
+		if _, err := uuid.Parse(userID); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid user id %q", userID))
+		}
+	}
+
+	models, err := g.db.GetUsers(ctx, req.UserIds)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get users: %s", err))
+	}
+
+	resp := &users.BatchGetUsersResponse{Users: make([]*users.User, 0, len(models))}
+	for _, model := range models {
+		resp.Users = append(resp.Users, userToProto(model))
+	}
+
+	return resp, nil
+}
+
+func userToProto(user UserModel) *users.User {
+	return &users.User{
+		UserId:      user.UUID,
+		DisplayName: user.Name,
+		Role:        user.Role,
+		Email:       user.Email,
+		AvatarUrl:   user.AvatarURL,
+		Timezone:    user.Timezone,
+		Locale:      user.Locale,
+		Deactivated: user.DeactivatedAt != nil,
+	}
 }
 
 func (g GrpcServer) GetTrainingBalance(ctx context.Context, request *users.GetTrainingBalanceRequest) (*users.GetTrainingBalanceResponse, error) {
@@ -28,10 +95,128 @@ func (g GrpcServer) UpdateTrainingBalance(
 	ctx context.Context,
 	req *users.UpdateTrainingBalanceRequest,
 ) (*empty.Empty, error) {
-	err := g.db.UpdateBalance(ctx, req.UserId, int(req.AmountChange))
+	if req.AmountChange == 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount change can't be zero")
+	}
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason of the balance change is required")
+	}
+
+	_, err := g.db.UpdateBalance(ctx, req.UserId, BalanceChange{
+		Amount:       int(req.AmountChange),
+		Reason:       req.Reason,
+		TrainingUUID: req.TrainingUuid,
+		ActorUUID:    req.ActorId,
+	})
+	if errors.Is(err, adapters.ErrInsufficientBalance) {
+		return nil, insufficientBalanceStatus()
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to update balance: %s", err))
 	}
 
 	return &empty.Empty{}, nil
 }
+
+func (g GrpcServer) CheckSession(ctx context.Context, req *users.CheckSessionRequest) (*users.CheckSessionResponse, error) {
+	if req.UserId == "" || req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user and session are required")
+	}
+
+	user := auth.User{
+		UUID:        req.UserId,
+		Email:       req.Email,
+		Role:        req.Role,
+		DisplayName: req.DisplayName,
+		SessionID:   req.SessionId,
+		AuthMethod:  req.AuthMethod,
+	}
+
+	provisioned, err := g.provisioner.Provision(ctx, user)
+	if errors.Is(err, auth.ErrUserDeactivated) {
+		return &users.CheckSessionResponse{UserDeactivated: true}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to provision user: %s", err))
+	}
+
+	_, err = g.sessions.CheckSession(ctx, auth.SessionActivity{
+		User:      user,
+		IP:        req.Ip,
+		UserAgent: req.UserAgent,
+	})
+	if errors.Is(err, auth.ErrSessionRevoked) {
+		return &users.CheckSessionResponse{Revoked: true}, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to check session: %s", err))
+	}
+
+	return &users.CheckSessionResponse{Role: provisioned.Role}, nil
+}
+
+func (g GrpcServer) ExportUserData(ctx context.Context, req *users.ExportUserDataRequest) (*users.ExportUserDataResponse, error) {
+	data, err := exportUserData(ctx, g.db, req.UserId)
+	if commondb.IsNotFound(err) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to export user data: %s", err))
+	}
+
+	return &users.ExportUserDataResponse{Data: data}, nil
+}
+
+func (g GrpcServer) EraseUser(ctx context.Context, req *users.EraseUserRequest) (*empty.Empty, error) {
+	_, err := g.db.EraseUser(ctx, req.UserId)
+	if commondb.IsNotFound(err) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to erase user: %s", err))
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (g GrpcServer) ListTrainerClients(ctx context.Context, req *users.ListTrainerClientsRequest) (*users.ListTrainerClientsResponse, error) {
+	if _, err := uuid.Parse(req.TrainerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid trainer id")
+	}
+
+	clients, err := g.db.TrainerClients(ctx, req.TrainerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list trainer clients: %s", err))
+	}
+
+	otherTrainersClientIDs, err := g.db.OtherTrainersClientIDs(ctx, req.TrainerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to list clients of other trainers: %s", err))
+	}
+
+	resp := &users.ListTrainerClientsResponse{
+		ClientIds:              make([]string, 0, len(clients)),
+		OtherTrainersClientIds: otherTrainersClientIDs,
+	}
+	for _, client := range clients {
+		resp.ClientIds = append(resp.ClientIds, client.Client.UUID)
+	}
+
+	return resp, nil
+}
+
+// insufficientBalanceStatus is returned when the debit would overdraw the balance.
+// Clients recognize it by the reason of its ErrorInfo detail.
+func insufficientBalanceStatus() error {
+	st := status.New(codes.FailedPrecondition, "insufficient trainings balance")
+
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: insufficientBalanceReason,
+		Domain: "users",
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
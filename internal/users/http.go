@@ -2,18 +2,27 @@ package main
 
 import (
 	"errors"
-	"net"
+	"fmt"
+	"io"
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/render"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/vaintrub/go-ddd-template/internal/common/auth"
 	casdoorauth "github.com/vaintrub/go-ddd-template/internal/common/auth/casdoor"
+	commondb "github.com/vaintrub/go-ddd-template/internal/common/db"
+	commonerrors "github.com/vaintrub/go-ddd-template/internal/common/errors"
 	"github.com/vaintrub/go-ddd-template/internal/common/server/httperr"
+	"github.com/vaintrub/go-ddd-template/internal/users/adapters"
 )
 
 type HttpServer struct {
-	db      db
-	casdoor *casdoorauth.Service
+	db          db
+	casdoor     *casdoorauth.Service
+	payments    PaymentProvider
+	provisioner userProvisioner
 }
 
 func (h HttpServer) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
@@ -23,13 +32,26 @@ func (h HttpServer) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	host, _, err := net.SplitHostPort(r.RemoteAddr)
-	if err == nil {
-		err = h.db.UpdateLastIP(r.Context(), authUser.UUID, host)
-		if err != nil {
-			httperr.InternalError("internal-server-error", err, w, r)
-			return
-		}
+	user, err := h.db.GetUser(r.Context(), authUser.UUID)
+	if err != nil {
+		httperr.InternalError("cannot-get-user", err, w, r)
+		return
+	}
+
+	h.respondWithCurrentUser(*user, w, r)
+}
+
+func (h HttpServer) UpdateCurrentUser(w http.ResponseWriter, r *http.Request) {
+	authUser, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	patch := PatchUserProfile{}
+	if err := render.Decode(r, &patch); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
 	}
 
 	user, err := h.db.GetUser(r.Context(), authUser.UUID)
@@ -38,15 +60,64 @@ func (h HttpServer) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	profile, err := applyProfilePatch(profileFromUser(*user), patch)
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	user, err = h.db.UpdateProfile(r.Context(), authUser.UUID, profile)
+	if commondb.IsConflict(err) {
+		httperr.RespondWithSlugError(
+			commonerrors.NewConflictError("email is already used by another user", "email-already-used"),
+			w, r,
+		)
+		return
+	}
+	if err != nil {
+		httperr.InternalError("cannot-update-user", err, w, r)
+		return
+	}
+
+	h.respondWithCurrentUser(*user, w, r)
+}
+
+func (h HttpServer) respondWithCurrentUser(user UserModel, w http.ResponseWriter, r *http.Request) {
+	expirations, err := h.db.UpcomingCreditExpirations(r.Context(), user.UUID)
+	if err != nil {
+		httperr.InternalError("cannot-get-credit-expirations", err, w, r)
+		return
+	}
+
+	upcomingExpirations := make([]CreditExpiration, 0, len(expirations))
+	for _, expiration := range expirations {
+		upcomingExpirations = append(upcomingExpirations, CreditExpiration{
+			Credits:   expiration.Credits,
+			ExpiresAt: expiration.ExpiresAt,
+		})
+	}
+
 	userResponse := User{
-		DisplayName: authUser.DisplayName,
-		Balance:     user.Balance,
-		Role:        authUser.Role,
+		DisplayName:         user.Name,
+		Balance:             user.Balance,
+		Role:                user.Role,
+		Email:               optionalString(user.Email),
+		AvatarUrl:           optionalString(user.AvatarURL),
+		Timezone:            optionalString(user.Timezone),
+		Locale:              optionalString(user.Locale),
+		UpcomingExpirations: &upcomingExpirations,
 	}
 
 	render.Respond(w, r, userResponse)
 }
 
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
 func (h HttpServer) CasdoorCallback(w http.ResponseWriter, r *http.Request, params CasdoorCallbackParams) {
 	if h.casdoor == nil {
 		httperr.BadRequest("casdoor-not-configured", errors.New("casdoor integration is disabled"), w, r)
@@ -59,6 +130,18 @@ func (h HttpServer) CasdoorCallback(w http.ResponseWriter, r *http.Request, para
 		return
 	}
 
+	if callbackResult.User.Id != nil {
+		_, err := h.provisioner.Provision(r.Context(), casdoorCallbackUser(callbackResult.User))
+		if errors.Is(err, auth.ErrUserDeactivated) {
+			httperr.RespondWithSlugError(err, w, r)
+			return
+		}
+		if err != nil {
+			httperr.InternalError("cannot-provision-user", err, w, r)
+			return
+		}
+	}
+
 	response := CasdoorOAuthResponse{
 		AccessToken: callbackResult.AccessToken,
 		TokenType:   callbackResult.TokenType,
@@ -89,3 +172,635 @@ func (h HttpServer) CasdoorCallback(w http.ResponseWriter, r *http.Request, para
 
 	render.Respond(w, r, response)
 }
+
+// casdoorCallbackUser maps the user signed in through Casdoor to the identity used for provisioning.
+func casdoorCallbackUser(user casdoorauth.CallbackUser) auth.User {
+	authUser := auth.User{
+		UUID:        *user.Id,
+		Role:        userTypeFromRoles(user.Roles),
+		DisplayName: user.Name,
+	}
+	if user.DisplayName != nil {
+		authUser.DisplayName = *user.DisplayName
+	}
+	if user.Email != nil {
+		authUser.Email = *user.Email
+	}
+	return authUser
+}
+
+const (
+	defaultListLimit = 50
+	maxListLimit     = 200
+
+	// maxPaymentWebhookSize limits the payload of payment webhooks read into memory.
+	maxPaymentWebhookSize = 1 << 20
+)
+
+func (h HttpServer) GetCurrentUserBalanceHistory(w http.ResponseWriter, r *http.Request, params GetCurrentUserBalanceHistoryParams) {
+	authUser, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	limit, err := listLimit(params.Limit)
+	if err != nil {
+		httperr.BadRequest("invalid-limit", err, w, r)
+		return
+	}
+
+	var before string
+	if params.Before != nil {
+		before = params.Before.String()
+	}
+
+	transactions, err := h.db.BalanceHistory(r.Context(), authUser.UUID, before, limit)
+	if err != nil {
+		httperr.InternalError("cannot-get-balance-history", err, w, r)
+		return
+	}
+
+	response := BalanceHistory{Transactions: make([]BalanceTransaction, 0, len(transactions))}
+	for _, transaction := range transactions {
+		response.Transactions = append(response.Transactions, balanceTransactionToResponse(transaction))
+	}
+
+	render.Respond(w, r, response)
+}
+
+func (h HttpServer) AdjustUserBalance(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID) {
+	authUser, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	if !isAdmin(r.Context()) {
+		httperr.RespondWithSlugError(
+			commonerrors.NewForbiddenError("only admin can adjust balance", "forbidden-to-adjust-balance"),
+			w, r,
+		)
+		return
+	}
+
+	postAdjustment := PostBalanceAdjustment{}
+	if err := render.Decode(r, &postAdjustment); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	if postAdjustment.Amount == 0 {
+		httperr.BadRequest("invalid-amount", errors.New("amount can't be zero"), w, r)
+		return
+	}
+	if strings.TrimSpace(postAdjustment.Reason) == "" {
+		httperr.BadRequest("empty-reason", errors.New("reason of the adjustment is required"), w, r)
+		return
+	}
+
+	transaction, err := h.db.UpdateBalance(r.Context(), userUUID.String(), BalanceChange{
+		Amount:      postAdjustment.Amount,
+		Reason:      BalanceReasonAdminAdjustment,
+		Description: postAdjustment.Reason,
+		ActorUUID:   authUser.UUID,
+	})
+	if commondb.IsNotFound(err) {
+		httperr.RespondWithSlugError(commonerrors.NewNotFoundError("user not found", "user-not-found"), w, r)
+		return
+	}
+	if errors.Is(err, adapters.ErrInsufficientBalance) {
+		httperr.RespondWithSlugError(
+			commonerrors.NewIncorrectInputError("adjustment would make the balance negative", "insufficient-balance"),
+			w, r,
+		)
+		return
+	}
+	if err != nil {
+		httperr.InternalError("cannot-adjust-balance", err, w, r)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.Respond(w, r, balanceTransactionToResponse(*transaction))
+}
+
+func (h HttpServer) GetCurrentUserSessions(w http.ResponseWriter, r *http.Request, params GetCurrentUserSessionsParams) {
+	authUser, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	limit, err := listLimit(params.Limit)
+	if err != nil {
+		httperr.BadRequest("invalid-limit", err, w, r)
+		return
+	}
+
+	sessions, err := h.db.Sessions(r.Context(), authUser.UUID, limit)
+	if err != nil {
+		httperr.InternalError("cannot-get-sessions", err, w, r)
+		return
+	}
+
+	response := Sessions{Sessions: make([]Session, 0, len(sessions))}
+	for _, session := range sessions {
+		response.Sessions = append(response.Sessions, sessionToResponse(session, authUser.SessionID))
+	}
+
+	render.Respond(w, r, response)
+}
+
+// sessionToResponse converts the session, currentSessionKey is the key of the session the request was made in.
+func sessionToResponse(session SessionModel, currentSessionKey string) Session {
+	return Session{
+		Uuid:        uuid.MustParse(session.UUID),
+		AuthMethod:  optionalString(session.AuthMethod),
+		Ip:          optionalString(session.IP),
+		UserAgent:   optionalString(session.UserAgent),
+		FirstSeenAt: session.FirstSeenAt,
+		LastSeenAt:  session.LastSeenAt,
+		RevokedAt:   session.RevokedAt,
+		Current:     session.SessionKey == currentSessionKey,
+	}
+}
+
+func (h HttpServer) RevokeCurrentUserSession(w http.ResponseWriter, r *http.Request, sessionUUID openapi_types.UUID) {
+	authUser, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	_, err = h.db.RevokeSession(r.Context(), authUser.UUID, sessionUUID.String())
+	if errors.Is(err, adapters.ErrSessionNotFound) {
+		httperr.RespondWithSlugError(commonerrors.NewNotFoundError(err.Error(), "session-not-found"), w, r)
+		return
+	}
+	if err != nil {
+		httperr.InternalError("cannot-revoke-session", err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+var errAdminOnly = commonerrors.NewForbiddenError("only admin can manage users", "forbidden-to-manage-users")
+
+func (h HttpServer) GetUsers(w http.ResponseWriter, r *http.Request, params GetUsersParams) {
+	if !isAdmin(r.Context()) {
+		httperr.RespondWithSlugError(errAdminOnly, w, r)
+		return
+	}
+
+	limit, err := listLimit(params.Limit)
+	if err != nil {
+		httperr.BadRequest("invalid-limit", err, w, r)
+		return
+	}
+
+	// #nosec G115 - limit is validated above
+	filter := UserFilter{Limit: int32(limit)}
+	if params.Role != nil {
+		filter.UserType = string(*params.Role)
+	}
+	if params.Status != nil {
+		deactivated := *params.Status == Deactivated
+		filter.Deactivated = &deactivated
+	}
+	if params.Search != nil {
+		filter.Search = strings.TrimSpace(*params.Search)
+	}
+	if params.After != nil {
+		filter.AfterUUID = params.After.String()
+	}
+
+	users, err := h.db.Users(r.Context(), filter)
+	if err != nil {
+		httperr.InternalError("cannot-get-users", err, w, r)
+		return
+	}
+
+	response := AdminUsers{Users: make([]AdminUser, 0, len(users))}
+	for _, user := range users {
+		response.Users = append(response.Users, adminUserToResponse(user))
+	}
+
+	render.Respond(w, r, response)
+}
+
+func (h HttpServer) GetUser(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID) {
+	if !isAdmin(r.Context()) {
+		httperr.RespondWithSlugError(errAdminOnly, w, r)
+		return
+	}
+
+	user, err := h.db.GetUser(r.Context(), userUUID.String())
+	respondWithAdminUser(user, err, "cannot-get-user", w, r)
+}
+
+func (h HttpServer) UpdateUserRole(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID) {
+	if !isAdmin(r.Context()) {
+		httperr.RespondWithSlugError(errAdminOnly, w, r)
+		return
+	}
+
+	putRole := PutUserRole{}
+	if err := render.Decode(r, &putRole); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	switch putRole.Role {
+	case Trainer, Attendee, Admin:
+	default:
+		httperr.BadRequest("invalid-role", fmt.Errorf("unknown role %q", putRole.Role), w, r)
+		return
+	}
+
+	// admins can't take away their own admin role, so at least one admin is always left
+	if isCurrentUser(r, userUUID) {
+		httperr.RespondWithSlugError(
+			commonerrors.NewIncorrectInputError("admin can't change own role", "cannot-change-own-role"),
+			w, r,
+		)
+		return
+	}
+
+	user, err := h.db.UpdateUserRole(r.Context(), userUUID.String(), string(putRole.Role))
+	respondWithAdminUser(user, err, "cannot-update-user-role", w, r)
+}
+
+func (h HttpServer) DeactivateUser(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID) {
+	if !isAdmin(r.Context()) {
+		httperr.RespondWithSlugError(errAdminOnly, w, r)
+		return
+	}
+
+	if isCurrentUser(r, userUUID) {
+		httperr.RespondWithSlugError(
+			commonerrors.NewIncorrectInputError("admin can't deactivate own account", "cannot-deactivate-yourself"),
+			w, r,
+		)
+		return
+	}
+
+	user, err := h.db.DeactivateUser(r.Context(), userUUID.String())
+	respondWithAdminUser(user, err, "cannot-deactivate-user", w, r)
+}
+
+func (h HttpServer) ReactivateUser(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID) {
+	if !isAdmin(r.Context()) {
+		httperr.RespondWithSlugError(errAdminOnly, w, r)
+		return
+	}
+
+	user, err := h.db.ReactivateUser(r.Context(), userUUID.String())
+	if errors.Is(err, adapters.ErrUserErased) {
+		httperr.RespondWithSlugError(commonerrors.NewConflictError("erased user can't be reactivated", "user-erased"), w, r)
+		return
+	}
+	respondWithAdminUser(user, err, "cannot-reactivate-user", w, r)
+}
+
+func respondWithAdminUser(user *UserModel, err error, errSlug string, w http.ResponseWriter, r *http.Request) {
+	if commondb.IsNotFound(err) {
+		httperr.RespondWithSlugError(commonerrors.NewNotFoundError("user not found", "user-not-found"), w, r)
+		return
+	}
+	if err != nil {
+		httperr.InternalError(errSlug, err, w, r)
+		return
+	}
+
+	render.Respond(w, r, adminUserToResponse(*user))
+}
+
+func isCurrentUser(r *http.Request, userUUID openapi_types.UUID) bool {
+	user, ok := currentUserFromCtx(r.Context())
+	return ok && user.UUID == userUUID.String()
+}
+
+func adminUserToResponse(user UserModel) AdminUser {
+	return AdminUser{
+		Uuid:          uuid.MustParse(user.UUID),
+		Role:          UserRole(user.Role),
+		Name:          user.Name,
+		Email:         optionalString(user.Email),
+		Balance:       user.Balance,
+		LastIp:        optionalString(user.LastIP),
+		AvatarUrl:     optionalString(user.AvatarURL),
+		Timezone:      optionalString(user.Timezone),
+		Locale:        optionalString(user.Locale),
+		CreatedAt:     user.CreatedAt,
+		DeactivatedAt: user.DeactivatedAt,
+		ErasedAt:      user.ErasedAt,
+	}
+}
+
+func balanceTransactionToResponse(transaction BalanceTransactionModel) BalanceTransaction {
+	response := BalanceTransaction{
+		Uuid:         uuid.MustParse(transaction.UUID),
+		Amount:       transaction.Amount,
+		BalanceAfter: transaction.BalanceAfter,
+		Reason:       transaction.Reason,
+		CreatedAt:    transaction.CreatedAt,
+	}
+	if transaction.Description != "" {
+		response.Description = &transaction.Description
+	}
+	if transaction.TrainingUUID != "" {
+		trainingUUID := uuid.MustParse(transaction.TrainingUUID)
+		response.TrainingUuid = &trainingUUID
+	}
+	if transaction.ActorUUID != "" {
+		actorUUID := uuid.MustParse(transaction.ActorUUID)
+		response.ActorUuid = &actorUUID
+	}
+
+	return response
+}
+
+func (h HttpServer) GetTrainerClients(w http.ResponseWriter, r *http.Request, trainerUUID openapi_types.UUID) {
+	if !isAdmin(r.Context()) && !isCurrentUser(r, trainerUUID) {
+		httperr.RespondWithSlugError(
+			commonerrors.NewForbiddenError("only admin and the trainer can see trainer's clients", "forbidden-to-see-trainer-clients"),
+			w, r,
+		)
+		return
+	}
+
+	clients, err := h.db.TrainerClients(r.Context(), trainerUUID.String())
+	if err != nil {
+		httperr.InternalError("cannot-get-trainer-clients", err, w, r)
+		return
+	}
+
+	response := TrainerClients{Clients: make([]TrainerClient, 0, len(clients))}
+	for _, client := range clients {
+		response.Clients = append(response.Clients, trainerClientToResponse(client))
+	}
+
+	render.Respond(w, r, response)
+}
+
+func (h HttpServer) AssignTrainerClient(w http.ResponseWriter, r *http.Request, trainerUUID openapi_types.UUID, clientUUID openapi_types.UUID) {
+	if !isAdmin(r.Context()) {
+		httperr.RespondWithSlugError(errAdminOnly, w, r)
+		return
+	}
+
+	client, err := h.db.AssignTrainerClient(r.Context(), trainerUUID.String(), clientUUID.String())
+	if commondb.IsNotFound(err) {
+		httperr.RespondWithSlugError(commonerrors.NewNotFoundError("user not found", "user-not-found"), w, r)
+		return
+	}
+	if errors.Is(err, adapters.ErrNotTrainer) {
+		httperr.RespondWithSlugError(commonerrors.NewIncorrectInputError(err.Error(), "user-not-trainer"), w, r)
+		return
+	}
+	if errors.Is(err, adapters.ErrInvalidClient) {
+		httperr.RespondWithSlugError(commonerrors.NewIncorrectInputError(err.Error(), "invalid-trainer-client"), w, r)
+		return
+	}
+	if err != nil {
+		httperr.InternalError("cannot-assign-trainer-client", err, w, r)
+		return
+	}
+
+	render.Respond(w, r, trainerClientToResponse(*client))
+}
+
+func (h HttpServer) UnassignTrainerClient(w http.ResponseWriter, r *http.Request, trainerUUID openapi_types.UUID, clientUUID openapi_types.UUID) {
+	if !isAdmin(r.Context()) {
+		httperr.RespondWithSlugError(errAdminOnly, w, r)
+		return
+	}
+
+	err := h.db.UnassignTrainerClient(r.Context(), trainerUUID.String(), clientUUID.String())
+	if errors.Is(err, adapters.ErrTrainerClientNotFound) {
+		httperr.RespondWithSlugError(commonerrors.NewNotFoundError(err.Error(), "trainer-client-not-found"), w, r)
+		return
+	}
+	if err != nil {
+		httperr.InternalError("cannot-unassign-trainer-client", err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func trainerClientToResponse(client TrainerClientModel) TrainerClient {
+	return TrainerClient{
+		Uuid:        uuid.MustParse(client.Client.UUID),
+		Name:        client.Client.Name,
+		Email:       optionalString(client.Client.Email),
+		AvatarUrl:   optionalString(client.Client.AvatarURL),
+		Deactivated: client.Client.DeactivatedAt != nil,
+		AssignedAt:  client.AssignedAt,
+	}
+}
+
+func (h HttpServer) GetCreditPackages(w http.ResponseWriter, r *http.Request) {
+	packages, err := h.db.CreditPackages(r.Context())
+	if err != nil {
+		httperr.InternalError("cannot-get-credit-packages", err, w, r)
+		return
+	}
+
+	response := CreditPackages{Packages: make([]CreditPackage, 0, len(packages))}
+	for _, creditPackage := range packages {
+		packageResponse := CreditPackage{
+			Code:        creditPackage.Code,
+			Name:        creditPackage.Name,
+			Credits:     creditPackage.Credits,
+			PriceAmount: creditPackage.PriceAmount,
+			Currency:    creditPackage.Currency,
+		}
+		if creditPackage.ValidityDays != 0 {
+			packageResponse.ValidityDays = &creditPackage.ValidityDays
+		}
+		response.Packages = append(response.Packages, packageResponse)
+	}
+
+	render.Respond(w, r, response)
+}
+
+func (h HttpServer) CreateCreditOrder(w http.ResponseWriter, r *http.Request) {
+	authUser, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	if h.payments == nil {
+		httperr.BadRequest("payments-not-configured", errors.New("credit packages can't be bought"), w, r)
+		return
+	}
+
+	postOrder := PostCreditOrder{}
+	if err := render.Decode(r, &postOrder); err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	order, err := h.db.CreateCreditOrder(r.Context(), uuid.New().String(), authUser.UUID, postOrder.PackageCode)
+	if errors.Is(err, adapters.ErrCreditPackageNotFound) {
+		httperr.RespondWithSlugError(commonerrors.NewIncorrectInputError(err.Error(), "unknown-credit-package"), w, r)
+		return
+	}
+	if err != nil {
+		httperr.InternalError("cannot-create-credit-order", err, w, r)
+		return
+	}
+
+	payment, err := h.payments.CreatePayment(r.Context(), adapters.PaymentRequest{
+		OrderUUID:   order.UUID,
+		Amount:      order.PriceAmount,
+		Currency:    order.Currency,
+		Description: fmt.Sprintf("%d training credits", order.Credits),
+	})
+	if err != nil {
+		if failErr := h.db.FailCreditOrder(r.Context(), order.UUID, err.Error()); failErr != nil {
+			err = fmt.Errorf("%w, and unable to mark order as failed: %s", err, failErr.Error())
+		}
+		httperr.InternalError("create-payment-failed", err, w, r)
+		return
+	}
+
+	order, err = h.db.SetCreditOrderPayment(r.Context(), order.UUID, payment)
+	if err != nil {
+		httperr.InternalError("cannot-create-credit-order", err, w, r)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.Respond(w, r, creditOrderToResponse(*order))
+}
+
+func (h HttpServer) GetCurrentUserCreditOrders(w http.ResponseWriter, r *http.Request, params GetCurrentUserCreditOrdersParams) {
+	authUser, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	limit, err := listLimit(params.Limit)
+	if err != nil {
+		httperr.BadRequest("invalid-limit", err, w, r)
+		return
+	}
+
+	orders, err := h.db.CreditOrders(r.Context(), authUser.UUID, limit)
+	if err != nil {
+		httperr.InternalError("cannot-get-credit-orders", err, w, r)
+		return
+	}
+
+	response := CreditOrders{Orders: make([]CreditOrder, 0, len(orders))}
+	for _, order := range orders {
+		response.Orders = append(response.Orders, creditOrderToResponse(order))
+	}
+
+	render.Respond(w, r, response)
+}
+
+func (h HttpServer) GetCurrentUserCreditOrder(w http.ResponseWriter, r *http.Request, orderUUID openapi_types.UUID) {
+	authUser, err := auth.UserFromCtx(r.Context())
+	if err != nil {
+		httperr.RespondWithSlugError(err, w, r)
+		return
+	}
+
+	order, err := h.db.CreditOrder(r.Context(), authUser.UUID, orderUUID.String())
+	if errors.Is(err, adapters.ErrCreditOrderNotFound) {
+		httperr.RespondWithSlugError(commonerrors.NewNotFoundError(err.Error(), "credit-order-not-found"), w, r)
+		return
+	}
+	if err != nil {
+		httperr.InternalError("cannot-get-credit-order", err, w, r)
+		return
+	}
+
+	render.Respond(w, r, creditOrderToResponse(*order))
+}
+
+// HandlePaymentWebhook grants or takes back credits of the order, when the payment provider reports the payment status.
+func (h HttpServer) HandlePaymentWebhook(w http.ResponseWriter, r *http.Request) {
+	if h.payments == nil {
+		httperr.BadRequest("payments-not-configured", errors.New("payments are not configured"), w, r)
+		return
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPaymentWebhookSize))
+	if err != nil {
+		httperr.BadRequest("invalid-request", err, w, r)
+		return
+	}
+
+	event, err := h.payments.ParseWebhook(payload, r.Header)
+	if errors.Is(err, adapters.ErrInvalidWebhookSignature) {
+		httperr.Unauthorised("invalid-webhook-signature", err, w, r)
+		return
+	}
+	if err != nil {
+		httperr.BadRequest("invalid-payment-event", err, w, r)
+		return
+	}
+
+	_, err = h.db.UpdateCreditOrderPayment(r.Context(), event)
+	switch {
+	case errors.Is(err, adapters.ErrCreditOrderNotFound):
+		httperr.RespondWithSlugError(commonerrors.NewNotFoundError(err.Error(), "credit-order-not-found"), w, r)
+		return
+	case errors.Is(err, adapters.ErrInvalidCreditOrderTransition):
+		httperr.RespondWithSlugError(commonerrors.NewConflictError(err.Error(), "invalid-credit-order-transition"), w, r)
+		return
+	case errors.Is(err, adapters.ErrInsufficientBalance):
+		httperr.RespondWithSlugError(
+			commonerrors.NewConflictError("credits of the refunded order were already spent", "refunded-credits-spent"),
+			w, r,
+		)
+		return
+	case err != nil:
+		httperr.InternalError("cannot-update-credit-order", err, w, r)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listLimit returns the requested page size, or the default one.
+func listLimit(limit *int) (int, error) {
+	if limit == nil {
+		return defaultListLimit, nil
+	}
+	if *limit < 1 || *limit > maxListLimit {
+		return 0, fmt.Errorf("limit should be between 1 and %d", maxListLimit)
+	}
+
+	return *limit, nil
+}
+
+func creditOrderToResponse(order CreditOrderModel) CreditOrder {
+	response := CreditOrder{
+		Uuid:        uuid.MustParse(order.UUID),
+		PackageCode: order.PackageCode,
+		Credits:     order.Credits,
+		PriceAmount: order.PriceAmount,
+		Currency:    order.Currency,
+		Status:      CreditOrderStatus(order.Status),
+		CreatedAt:   order.CreatedAt,
+		UpdatedAt:   order.UpdatedAt,
+	}
+	// the checkout is useful only until the order is paid
+	if order.CheckoutURL != "" && order.Status == adapters.CreditOrderPending {
+		response.CheckoutUrl = &order.CheckoutURL
+	}
+	if order.FailureReason != "" {
+		response.FailureReason = &order.FailureReason
+	}
+
+	return response
+}
@@ -0,0 +1,313 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/vaintrub/go-ddd-template/internal/users/adapters"
+)
+
+func TestHandlePaymentWebhook(t *testing.T) {
+	t.Parallel()
+	provider := adapters.NewFakePaymentProvider("webhook-secret", "")
+
+	payload := []byte(`{"paymentId":"fake_1","status":"succeeded"}`)
+
+	testCases := []struct {
+		Name           string
+		Signature      string
+		UpdateErr      error
+		ExpectedStatus int
+		ExpectedUpdate bool
+	}{
+		{
+			Name:           "paid",
+			Signature:      provider.Sign(payload),
+			ExpectedStatus: http.StatusNoContent,
+			ExpectedUpdate: true,
+		},
+		{
+			Name:           "invalid_signature",
+			Signature:      adapters.NewFakePaymentProvider("another-secret", "").Sign(payload),
+			ExpectedStatus: http.StatusUnauthorized,
+		},
+		{
+			Name:           "unknown_order",
+			Signature:      provider.Sign(payload),
+			UpdateErr:      adapters.ErrCreditOrderNotFound,
+			ExpectedStatus: http.StatusNotFound,
+			ExpectedUpdate: true,
+		},
+		{
+			Name:           "invalid_transition",
+			Signature:      provider.Sign(payload),
+			UpdateErr:      fmt.Errorf("%w: from failed to paid", adapters.ErrInvalidCreditOrderTransition),
+			ExpectedStatus: http.StatusConflict,
+			ExpectedUpdate: true,
+		},
+		{
+			Name:           "refunded_credits_spent",
+			Signature:      provider.Sign(payload),
+			UpdateErr:      adapters.ErrInsufficientBalance,
+			ExpectedStatus: http.StatusConflict,
+			ExpectedUpdate: true,
+		},
+		{
+			Name:           "database_error",
+			Signature:      provider.Sign(payload),
+			UpdateErr:      errors.New("connection refused"),
+			ExpectedStatus: http.StatusInternalServerError,
+			ExpectedUpdate: true,
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			db := &creditOrdersDBMock{err: c.UpdateErr}
+			server := HttpServer{db: db, payments: provider}
+
+			req := httptest.NewRequest(http.MethodPost, "/payments/webhook", bytes.NewReader(payload))
+			req.Header.Set(adapters.PaymentSignatureHeader, c.Signature)
+			rec := httptest.NewRecorder()
+
+			server.HandlePaymentWebhook(rec, req)
+
+			assert.Equal(t, c.ExpectedStatus, rec.Code)
+			if c.ExpectedUpdate {
+				assert.Equal(t, []adapters.PaymentEvent{{PaymentID: "fake_1", Status: adapters.PaymentSucceeded}}, db.events)
+			} else {
+				assert.Empty(t, db.events)
+			}
+		})
+	}
+}
+
+func TestHandlePaymentWebhook_payments_not_configured(t *testing.T) {
+	t.Parallel()
+
+	db := &creditOrdersDBMock{}
+	server := HttpServer{db: db}
+
+	req := httptest.NewRequest(http.MethodPost, "/payments/webhook", bytes.NewReader([]byte(`{}`)))
+	rec := httptest.NewRecorder()
+
+	server.HandlePaymentWebhook(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Empty(t, db.events)
+}
+
+// creditOrdersDBMock records payment events passed to the database, other methods are not implemented.
+type creditOrdersDBMock struct {
+	db
+
+	events []adapters.PaymentEvent
+	err    error
+}
+
+func (m *creditOrdersDBMock) UpdateCreditOrderPayment(ctx context.Context, event adapters.PaymentEvent) (*CreditOrderModel, error) {
+	m.events = append(m.events, event)
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	return &CreditOrderModel{Status: creditOrderStatusByPaymentStatus[event.Status]}, nil
+}
+
+func TestAdminUserEndpoints(t *testing.T) {
+	t.Parallel()
+
+	adminUUID := uuid.New()
+	userUUID := uuid.New()
+	erasedUserUUID := uuid.New()
+
+	getUsers := func(h HttpServer, w http.ResponseWriter, r *http.Request, _ openapi_types.UUID) {
+		h.GetUsers(w, r, GetUsersParams{})
+	}
+
+	testCases := []struct {
+		Name       string
+		CallerRole string
+		Handle     func(h HttpServer, w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID)
+		UserUUID   uuid.UUID
+		Body       string
+
+		ExpectedStatus int
+		ExpectedSlug   string
+		ExpectedCalls  []string
+	}{
+		{
+			Name:           "get_users",
+			CallerRole:     userTypeAdmin,
+			Handle:         getUsers,
+			ExpectedStatus: http.StatusOK,
+			ExpectedCalls:  []string{"Users"},
+		},
+		{
+			Name:           "get_users_as_trainer",
+			CallerRole:     "trainer",
+			Handle:         getUsers,
+			ExpectedStatus: http.StatusForbidden,
+			ExpectedSlug:   "forbidden-to-manage-users",
+		},
+		{
+			Name:           "update_role",
+			CallerRole:     userTypeAdmin,
+			Handle:         HttpServer.UpdateUserRole,
+			UserUUID:       userUUID,
+			Body:           `{"role":"trainer"}`,
+			ExpectedStatus: http.StatusOK,
+			ExpectedCalls:  []string{"UpdateUserRole"},
+		},
+		{
+			Name:           "update_role_as_trainer",
+			CallerRole:     "trainer",
+			Handle:         HttpServer.UpdateUserRole,
+			UserUUID:       userUUID,
+			Body:           `{"role":"admin"}`,
+			ExpectedStatus: http.StatusForbidden,
+			ExpectedSlug:   "forbidden-to-manage-users",
+		},
+		{
+			Name:           "update_role_unknown_role",
+			CallerRole:     userTypeAdmin,
+			Handle:         HttpServer.UpdateUserRole,
+			UserUUID:       userUUID,
+			Body:           `{"role":"owner"}`,
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedSlug:   "invalid-role",
+		},
+		{
+			Name:           "update_own_role",
+			CallerRole:     userTypeAdmin,
+			Handle:         HttpServer.UpdateUserRole,
+			UserUUID:       adminUUID,
+			Body:           `{"role":"attendee"}`,
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedSlug:   "cannot-change-own-role",
+		},
+		{
+			Name:           "deactivate",
+			CallerRole:     userTypeAdmin,
+			Handle:         HttpServer.DeactivateUser,
+			UserUUID:       userUUID,
+			ExpectedStatus: http.StatusOK,
+			ExpectedCalls:  []string{"DeactivateUser"},
+		},
+		{
+			Name:           "deactivate_as_trainer",
+			CallerRole:     "trainer",
+			Handle:         HttpServer.DeactivateUser,
+			UserUUID:       userUUID,
+			ExpectedStatus: http.StatusForbidden,
+			ExpectedSlug:   "forbidden-to-manage-users",
+		},
+		{
+			Name:           "deactivate_yourself",
+			CallerRole:     userTypeAdmin,
+			Handle:         HttpServer.DeactivateUser,
+			UserUUID:       adminUUID,
+			ExpectedStatus: http.StatusBadRequest,
+			ExpectedSlug:   "cannot-deactivate-yourself",
+		},
+		{
+			Name:           "reactivate",
+			CallerRole:     userTypeAdmin,
+			Handle:         HttpServer.ReactivateUser,
+			UserUUID:       userUUID,
+			ExpectedStatus: http.StatusOK,
+			ExpectedCalls:  []string{"ReactivateUser"},
+		},
+		{
+			Name:           "reactivate_as_trainer",
+			CallerRole:     "trainer",
+			Handle:         HttpServer.ReactivateUser,
+			UserUUID:       userUUID,
+			ExpectedStatus: http.StatusForbidden,
+			ExpectedSlug:   "forbidden-to-manage-users",
+		},
+		{
+			Name:           "reactivate_erased_user",
+			CallerRole:     userTypeAdmin,
+			Handle:         HttpServer.ReactivateUser,
+			UserUUID:       erasedUserUUID,
+			ExpectedStatus: http.StatusConflict,
+			ExpectedSlug:   "user-erased",
+			ExpectedCalls:  []string{"ReactivateUser"},
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			db := &adminUsersDBMock{erasedUserUUID: erasedUserUUID.String()}
+			server := HttpServer{db: db}
+
+			req := httptest.NewRequest(http.MethodPut, "/admin/users", strings.NewReader(c.Body))
+			req.Header.Set("Content-Type", "application/json")
+			req = req.WithContext(context.WithValue(req.Context(), currentUserCtxKey{}, UserModel{
+				UUID: adminUUID.String(),
+				Role: c.CallerRole,
+			}))
+			rec := httptest.NewRecorder()
+
+			c.Handle(server, rec, req, c.UserUUID)
+
+			assert.Equal(t, c.ExpectedStatus, rec.Code)
+			if c.ExpectedSlug != "" {
+				assert.Equal(t, c.ExpectedSlug, responseSlug(t, rec))
+			}
+			assert.Equal(t, c.ExpectedCalls, db.calls)
+		})
+	}
+}
+
+// adminUsersDBMock records calls of the admin endpoints, other methods are not implemented.
+type adminUsersDBMock struct {
+	db
+
+	erasedUserUUID string
+	calls          []string
+}
+
+func (m *adminUsersDBMock) user(userID string, role string) *UserModel {
+	return &UserModel{UUID: userID, Role: role, Name: "User"}
+}
+
+func (m *adminUsersDBMock) Users(ctx context.Context, filter UserFilter) ([]UserModel, error) {
+	m.calls = append(m.calls, "Users")
+	return []UserModel{*m.user(uuid.New().String(), "attendee")}, nil
+}
+
+func (m *adminUsersDBMock) UpdateUserRole(ctx context.Context, userID string, role string) (*UserModel, error) {
+	m.calls = append(m.calls, "UpdateUserRole")
+	return m.user(userID, role), nil
+}
+
+func (m *adminUsersDBMock) DeactivateUser(ctx context.Context, userID string) (*UserModel, error) {
+	m.calls = append(m.calls, "DeactivateUser")
+	return m.user(userID, "attendee"), nil
+}
+
+func (m *adminUsersDBMock) ReactivateUser(ctx context.Context, userID string) (*UserModel, error) {
+	m.calls = append(m.calls, "ReactivateUser")
+	if userID == m.erasedUserUUID {
+		return nil, adapters.ErrUserErased
+	}
+
+	return m.user(userID, "attendee"), nil
+}
@@ -6,8 +6,11 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
+	_ "time/tzdata" // time zones of user profiles are validated also in containers without tzdata
 
 	"github.com/go-chi/chi/v5"
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
 	casdoorauth "github.com/vaintrub/go-ddd-template/internal/common/auth/casdoor"
 	"github.com/vaintrub/go-ddd-template/internal/common/config"
 	commondb "github.com/vaintrub/go-ddd-template/internal/common/db"
@@ -15,19 +18,89 @@ import (
 	"github.com/vaintrub/go-ddd-template/internal/common/logs"
 	"github.com/vaintrub/go-ddd-template/internal/common/server"
 	"github.com/vaintrub/go-ddd-template/internal/users/adapters"
+	sqlc_users "github.com/vaintrub/go-ddd-template/internal/users/adapters/sqlc"
 	"google.golang.org/grpc"
 )
 
 // db interface defines the database operations needed by the users service.
 type db interface {
+	ProvisionUser(ctx context.Context, userID, userType, name, email string, starterCredits BalanceChange) (*UserModel, error)
 	GetUser(ctx context.Context, userID string) (*UserModel, error)
-	UpdateBalance(ctx context.Context, userID string, amountChange int) error
-	UpdateLastIP(ctx context.Context, userID string, ip string) error
+	GetUsers(ctx context.Context, userIDs []string) ([]UserModel, error)
+	UpdateProfile(ctx context.Context, userID string, profile UserProfile) (*UserModel, error)
+	Users(ctx context.Context, filter UserFilter) ([]UserModel, error)
+	UpdateUserRole(ctx context.Context, userID string, role string) (*UserModel, error)
+	DeactivateUser(ctx context.Context, userID string) (*UserModel, error)
+	ReactivateUser(ctx context.Context, userID string) (*UserModel, error)
+	EraseUser(ctx context.Context, userID string) (*UserModel, error)
+	UpcomingCreditExpirations(ctx context.Context, userID string) ([]CreditExpirationModel, error)
+	UpdateBalance(ctx context.Context, userID string, change BalanceChange) (*BalanceTransactionModel, error)
+	BalanceHistory(ctx context.Context, userID string, beforeUUID string, limit int) ([]BalanceTransactionModel, error)
+
+	AssignTrainerClient(ctx context.Context, trainerID, clientID string) (*TrainerClientModel, error)
+	UnassignTrainerClient(ctx context.Context, trainerID, clientID string) error
+	TrainerClients(ctx context.Context, trainerID string) ([]TrainerClientModel, error)
+	OtherTrainersClientIDs(ctx context.Context, trainerID string) ([]string, error)
+
+	RecordSession(ctx context.Context, activity SessionActivity) (*SessionModel, error)
+	Sessions(ctx context.Context, userID string, limit int) ([]SessionModel, error)
+	RevokeSession(ctx context.Context, userID string, sessionUUID string) (*SessionModel, error)
+
+	CreditPackages(ctx context.Context) ([]CreditPackageModel, error)
+	CreateCreditOrder(ctx context.Context, orderUUID string, userID string, packageCode string) (*CreditOrderModel, error)
+	SetCreditOrderPayment(ctx context.Context, orderUUID string, payment adapters.Payment) (*CreditOrderModel, error)
+	FailCreditOrder(ctx context.Context, orderUUID string, failureReason string) error
+	CreditOrder(ctx context.Context, userID string, orderUUID string) (*CreditOrderModel, error)
+	CreditOrders(ctx context.Context, userID string, limit int) ([]CreditOrderModel, error)
+	UpdateCreditOrderPayment(ctx context.Context, event adapters.PaymentEvent) (*CreditOrderModel, error)
 }
 
 // UserModel represents the user data returned from the database.
 type UserModel struct {
+	UUID    string
+	Role    string
+	Name    string
+	Email   string
 	Balance int
+	LastIP  string
+
+	AvatarURL string
+	Timezone  string
+	Locale    string
+
+	CreatedAt time.Time
+	// DeactivatedAt is nil for active accounts.
+	DeactivatedAt *time.Time
+	// ErasedAt is nil for users whose personal data wasn't erased.
+	ErasedAt *time.Time
+}
+
+// UserProfile contains user's data editable by the user.
+type UserProfile = adapters.UserProfile
+
+// UserFilter narrows down the listed users.
+type UserFilter = adapters.UserFilter
+
+// CreditExpirationModel represents the user's credits expiring at the same time.
+type CreditExpirationModel = adapters.CreditExpiration
+
+// BalanceReasonAdminAdjustment is the reason of balance changes made manually by admins.
+const BalanceReasonAdminAdjustment = "admin-adjustment"
+
+// BalanceChange describes a change of the user's trainings balance.
+// Every change is recorded in the balance ledger, the balance is derived from it.
+type BalanceChange = adapters.BalanceChange
+
+// BalanceTransactionModel represents a single change of the user's trainings balance recorded in the ledger.
+type BalanceTransactionModel struct {
+	UUID         string
+	Amount       int
+	BalanceAfter int
+	Reason       string
+	Description  string
+	TrainingUUID string
+	ActorUUID    string
+	CreatedAt    time.Time
 }
 
 // postgresDB implements the db interface using PostgreSQL repository.
@@ -35,20 +108,144 @@ type postgresDB struct {
 	repo *adapters.UserPostgresRepository
 }
 
+func (p *postgresDB) ProvisionUser(ctx context.Context, userID, userType, name, email string, starterCredits BalanceChange) (*UserModel, error) {
+	return userModelOrError(p.repo.ProvisionUser(ctx, userID, userType, name, email, starterCredits))
+}
+
 func (p *postgresDB) GetUser(ctx context.Context, userID string) (*UserModel, error) {
-	user, err := p.repo.GetByID(ctx, userID)
+	return userModelOrError(p.repo.GetByID(ctx, userID))
+}
+
+func (p *postgresDB) GetUsers(ctx context.Context, userIDs []string) ([]UserModel, error) {
+	users, err := p.repo.GetUsers(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]UserModel, 0, len(users))
+	for _, user := range users {
+		models = append(models, userFromDB(user))
+	}
+	return models, nil
+}
+
+func (p *postgresDB) UpdateProfile(ctx context.Context, userID string, profile UserProfile) (*UserModel, error) {
+	return userModelOrError(p.repo.UpdateUserProfile(ctx, userID, profile))
+}
+
+func (p *postgresDB) Users(ctx context.Context, filter UserFilter) ([]UserModel, error) {
+	users, err := p.repo.ListUsers(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
-	return &UserModel{Balance: int(user.Balance)}, nil
+
+	models := make([]UserModel, 0, len(users))
+	for _, user := range users {
+		models = append(models, userFromDB(user))
+	}
+	return models, nil
 }
 
-func (p *postgresDB) UpdateBalance(ctx context.Context, userID string, amountChange int) error {
-	return p.repo.UpdateBalance(ctx, userID, amountChange)
+func (p *postgresDB) UpdateUserRole(ctx context.Context, userID string, role string) (*UserModel, error) {
+	return userModelOrError(p.repo.UpdateUserType(ctx, userID, role))
 }
 
-func (p *postgresDB) UpdateLastIP(ctx context.Context, userID string, ip string) error {
-	return p.repo.UpdateLastIP(ctx, userID, ip)
+func (p *postgresDB) DeactivateUser(ctx context.Context, userID string) (*UserModel, error) {
+	return userModelOrError(p.repo.DeactivateUser(ctx, userID))
+}
+
+func (p *postgresDB) ReactivateUser(ctx context.Context, userID string) (*UserModel, error) {
+	return userModelOrError(p.repo.ReactivateUser(ctx, userID))
+}
+
+func userModelOrError(user *sqlc_users.UsersUser, err error) (*UserModel, error) {
+	if err != nil {
+		return nil, err
+	}
+	model := userFromDB(*user)
+	return &model, nil
+}
+
+func userFromDB(user sqlc_users.UsersUser) UserModel {
+	model := UserModel{
+		UUID:      commondb.PgtypeToUUID(user.ID).String(),
+		Role:      user.UserType,
+		Name:      user.Name,
+		Balance:   int(user.Balance),
+		CreatedAt: user.CreatedAt,
+	}
+	if user.Email != nil {
+		model.Email = *user.Email
+	}
+	if user.LastIp != nil {
+		model.LastIP = *user.LastIp
+	}
+	if user.AvatarUrl != nil {
+		model.AvatarURL = *user.AvatarUrl
+	}
+	if user.Timezone != nil {
+		model.Timezone = *user.Timezone
+	}
+	if user.Locale != nil {
+		model.Locale = *user.Locale
+	}
+	if user.DeactivatedAt.Valid {
+		deactivatedAt := user.DeactivatedAt.Time
+		model.DeactivatedAt = &deactivatedAt
+	}
+	if user.ErasedAt.Valid {
+		erasedAt := user.ErasedAt.Time
+		model.ErasedAt = &erasedAt
+	}
+	return model
+}
+
+func (p *postgresDB) UpcomingCreditExpirations(ctx context.Context, userID string) ([]CreditExpirationModel, error) {
+	return p.repo.ListUpcomingCreditExpirations(ctx, userID)
+}
+
+func (p *postgresDB) UpdateBalance(ctx context.Context, userID string, change BalanceChange) (*BalanceTransactionModel, error) {
+	transaction, err := p.repo.UpdateBalance(ctx, userID, change)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceTransaction := balanceTransactionFromDB(*transaction)
+	return &balanceTransaction, nil
+}
+
+func (p *postgresDB) BalanceHistory(ctx context.Context, userID string, beforeUUID string, limit int) ([]BalanceTransactionModel, error) {
+	// #nosec G115 - limit is validated by the HTTP handler, overflow unlikely
+	transactions, err := p.repo.ListBalanceTransactions(ctx, userID, beforeUUID, int32(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]BalanceTransactionModel, 0, len(transactions))
+	for _, transaction := range transactions {
+		history = append(history, balanceTransactionFromDB(transaction))
+	}
+	return history, nil
+}
+
+func balanceTransactionFromDB(transaction sqlc_users.UsersBalanceTransaction) BalanceTransactionModel {
+	balanceTransaction := BalanceTransactionModel{
+		UUID:         commondb.PgtypeToUUID(transaction.ID).String(),
+		Amount:       int(transaction.Amount),
+		BalanceAfter: int(transaction.BalanceAfter),
+		Reason:       transaction.Reason,
+		CreatedAt:    transaction.CreatedAt,
+	}
+	if transaction.Description != nil {
+		balanceTransaction.Description = *transaction.Description
+	}
+	if transaction.TrainingID.Valid {
+		balanceTransaction.TrainingUUID = commondb.PgtypeToUUID(transaction.TrainingID).String()
+	}
+	if transaction.ActorID.Valid {
+		balanceTransaction.ActorUUID = commondb.PgtypeToUUID(transaction.ActorID).String()
+	}
+	return balanceTransaction
 }
 
 func main() {
@@ -67,21 +264,50 @@ func main() {
 		panic(err)
 	}
 
+	provisioner := newUserProvisioner(postgresDB, cfg.Contexts.Users.Credits)
+	sessions := newSessionChecker(postgresDB)
+
 	serverType := strings.ToLower(os.Getenv("SERVER_TO_RUN"))
 	switch serverType {
 	case "http":
 		// TODO: Update loadFixtures() to work with PostgreSQL instead of Firebase
 		// go loadFixtures()
 
+		go server.RunJob(ctx, "expire-credit-lots", cfg.Contexts.Users.Credits.ExpiryJobInterval, logger, func(ctx context.Context) error {
+			return expireCreditLots(ctx, userRepo)
+		})
+
 		server.RunHTTPServer(cfg.Server, logger, func(router chi.Router) http.Handler {
-			return HandlerFromMux(HttpServer{db: postgresDB, casdoor: casdoorSvc}, router)
+			return HandlerWithOptions(HttpServer{
+				db:          postgresDB,
+				casdoor:     casdoorSvc,
+				payments:    newPaymentProvider(cfg.Contexts.Users.Payments),
+				provisioner: provisioner,
+			}, ChiServerOptions{
+				BaseRouter: router,
+				// the last middleware runs first, the user has to be provisioned before its session is recorded
+				Middlewares: []MiddlewareFunc{auth.SessionMiddleware(sessions), provisioner.Middleware},
+			})
 		})
 	case "grpc":
 		server.RunGRPCServer(cfg.Server, logger, func(server *grpc.Server) {
-			svc := GrpcServer{postgresDB}
+			svc := GrpcServer{db: postgresDB, provisioner: provisioner, sessions: sessions}
 			users.RegisterUsersServiceServer(server, svc)
 		})
 	default:
 		panic(fmt.Sprintf("server type '%s' is not supported", serverType))
 	}
 }
+
+// expireCreditLots takes away remaining credits of expired lots, batch by batch until no expired lot is left.
+func expireCreditLots(ctx context.Context, repo *adapters.UserPostgresRepository) error {
+	for {
+		expiredUsers, err := repo.ExpireCreditLots(ctx)
+		if err != nil {
+			return err
+		}
+		if expiredUsers < adapters.ExpireCreditLotsBatchSize {
+			return nil
+		}
+	}
+}
@@ -10,32 +10,177 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /admin/users)
+	GetUsers(w http.ResponseWriter, r *http.Request, params GetUsersParams)
+
+	// (GET /admin/users/{userUUID})
+	GetUser(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID)
+
+	// (POST /admin/users/{userUUID}/balance-adjustments)
+	AdjustUserBalance(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID)
+
+	// (POST /admin/users/{userUUID}/deactivate)
+	DeactivateUser(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID)
+
+	// (POST /admin/users/{userUUID}/reactivate)
+	ReactivateUser(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID)
+
+	// (PUT /admin/users/{userUUID}/role)
+	UpdateUserRole(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID)
+
 	// (GET /auth/casdoor/callback)
 	CasdoorCallback(w http.ResponseWriter, r *http.Request, params CasdoorCallbackParams)
 
+	// (GET /credit-packages)
+	GetCreditPackages(w http.ResponseWriter, r *http.Request)
+
+	// (POST /payments/webhook)
+	HandlePaymentWebhook(w http.ResponseWriter, r *http.Request)
+
+	// (GET /trainers/{trainerUUID}/clients)
+	GetTrainerClients(w http.ResponseWriter, r *http.Request, trainerUUID openapi_types.UUID)
+
+	// (DELETE /trainers/{trainerUUID}/clients/{clientUUID})
+	UnassignTrainerClient(w http.ResponseWriter, r *http.Request, trainerUUID openapi_types.UUID, clientUUID openapi_types.UUID)
+
+	// (PUT /trainers/{trainerUUID}/clients/{clientUUID})
+	AssignTrainerClient(w http.ResponseWriter, r *http.Request, trainerUUID openapi_types.UUID, clientUUID openapi_types.UUID)
+
 	// (GET /users/current)
 	GetCurrentUser(w http.ResponseWriter, r *http.Request)
+
+	// (PATCH /users/current)
+	UpdateCurrentUser(w http.ResponseWriter, r *http.Request)
+
+	// (GET /users/current/balance-history)
+	GetCurrentUserBalanceHistory(w http.ResponseWriter, r *http.Request, params GetCurrentUserBalanceHistoryParams)
+
+	// (GET /users/current/credit-orders)
+	GetCurrentUserCreditOrders(w http.ResponseWriter, r *http.Request, params GetCurrentUserCreditOrdersParams)
+
+	// (POST /users/current/credit-orders)
+	CreateCreditOrder(w http.ResponseWriter, r *http.Request)
+
+	// (GET /users/current/credit-orders/{orderUUID})
+	GetCurrentUserCreditOrder(w http.ResponseWriter, r *http.Request, orderUUID openapi_types.UUID)
+
+	// (GET /users/current/sessions)
+	GetCurrentUserSessions(w http.ResponseWriter, r *http.Request, params GetCurrentUserSessionsParams)
+
+	// (DELETE /users/current/sessions/{sessionUUID})
+	RevokeCurrentUserSession(w http.ResponseWriter, r *http.Request, sessionUUID openapi_types.UUID)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// (GET /admin/users)
+func (_ Unimplemented) GetUsers(w http.ResponseWriter, r *http.Request, params GetUsersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /admin/users/{userUUID})
+func (_ Unimplemented) GetUser(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /admin/users/{userUUID}/balance-adjustments)
+func (_ Unimplemented) AdjustUserBalance(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /admin/users/{userUUID}/deactivate)
+func (_ Unimplemented) DeactivateUser(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /admin/users/{userUUID}/reactivate)
+func (_ Unimplemented) ReactivateUser(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /admin/users/{userUUID}/role)
+func (_ Unimplemented) UpdateUserRole(w http.ResponseWriter, r *http.Request, userUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /auth/casdoor/callback)
 func (_ Unimplemented) CasdoorCallback(w http.ResponseWriter, r *http.Request, params CasdoorCallbackParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /credit-packages)
+func (_ Unimplemented) GetCreditPackages(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /payments/webhook)
+func (_ Unimplemented) HandlePaymentWebhook(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /trainers/{trainerUUID}/clients)
+func (_ Unimplemented) GetTrainerClients(w http.ResponseWriter, r *http.Request, trainerUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /trainers/{trainerUUID}/clients/{clientUUID})
+func (_ Unimplemented) UnassignTrainerClient(w http.ResponseWriter, r *http.Request, trainerUUID openapi_types.UUID, clientUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /trainers/{trainerUUID}/clients/{clientUUID})
+func (_ Unimplemented) AssignTrainerClient(w http.ResponseWriter, r *http.Request, trainerUUID openapi_types.UUID, clientUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /users/current)
 func (_ Unimplemented) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (PATCH /users/current)
+func (_ Unimplemented) UpdateCurrentUser(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users/current/balance-history)
+func (_ Unimplemented) GetCurrentUserBalanceHistory(w http.ResponseWriter, r *http.Request, params GetCurrentUserBalanceHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users/current/credit-orders)
+func (_ Unimplemented) GetCurrentUserCreditOrders(w http.ResponseWriter, r *http.Request, params GetCurrentUserCreditOrdersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /users/current/credit-orders)
+func (_ Unimplemented) CreateCreditOrder(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users/current/credit-orders/{orderUUID})
+func (_ Unimplemented) GetCurrentUserCreditOrder(w http.ResponseWriter, r *http.Request, orderUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /users/current/sessions)
+func (_ Unimplemented) GetCurrentUserSessions(w http.ResponseWriter, r *http.Request, params GetCurrentUserSessionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /users/current/sessions/{sessionUUID})
+func (_ Unimplemented) RevokeCurrentUserSession(w http.ResponseWriter, r *http.Request, sessionUUID openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -45,6 +190,208 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// GetUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersParams
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", r.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetUser operation middleware
+func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userUUID" -------------
+	var userUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, chi.URLParam(r, "userUUID"), &userUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUser(w, r, userUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AdjustUserBalance operation middleware
+func (siw *ServerInterfaceWrapper) AdjustUserBalance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userUUID" -------------
+	var userUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, chi.URLParam(r, "userUUID"), &userUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdjustUserBalance(w, r, userUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeactivateUser operation middleware
+func (siw *ServerInterfaceWrapper) DeactivateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userUUID" -------------
+	var userUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, chi.URLParam(r, "userUUID"), &userUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeactivateUser(w, r, userUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ReactivateUser operation middleware
+func (siw *ServerInterfaceWrapper) ReactivateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userUUID" -------------
+	var userUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, chi.URLParam(r, "userUUID"), &userUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReactivateUser(w, r, userUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UpdateUserRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUserRole(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userUUID" -------------
+	var userUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "userUUID", runtime.ParamLocationPath, chi.URLParam(r, "userUUID"), &userUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateUserRole(w, r, userUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // CasdoorCallback operation middleware
 func (siw *ServerInterfaceWrapper) CasdoorCallback(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -97,6 +444,140 @@ func (siw *ServerInterfaceWrapper) CasdoorCallback(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// GetCreditPackages operation middleware
+func (siw *ServerInterfaceWrapper) GetCreditPackages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCreditPackages(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// HandlePaymentWebhook operation middleware
+func (siw *ServerInterfaceWrapper) HandlePaymentWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HandlePaymentWebhook(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTrainerClients operation middleware
+func (siw *ServerInterfaceWrapper) GetTrainerClients(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "trainerUUID" -------------
+	var trainerUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "trainerUUID", runtime.ParamLocationPath, chi.URLParam(r, "trainerUUID"), &trainerUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trainerUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrainerClients(w, r, trainerUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UnassignTrainerClient operation middleware
+func (siw *ServerInterfaceWrapper) UnassignTrainerClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "trainerUUID" -------------
+	var trainerUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "trainerUUID", runtime.ParamLocationPath, chi.URLParam(r, "trainerUUID"), &trainerUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trainerUUID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clientUUID" -------------
+	var clientUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clientUUID", runtime.ParamLocationPath, chi.URLParam(r, "clientUUID"), &clientUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clientUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UnassignTrainerClient(w, r, trainerUUID, clientUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AssignTrainerClient operation middleware
+func (siw *ServerInterfaceWrapper) AssignTrainerClient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "trainerUUID" -------------
+	var trainerUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "trainerUUID", runtime.ParamLocationPath, chi.URLParam(r, "trainerUUID"), &trainerUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trainerUUID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "clientUUID" -------------
+	var clientUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "clientUUID", runtime.ParamLocationPath, chi.URLParam(r, "clientUUID"), &clientUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "clientUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AssignTrainerClient(w, r, trainerUUID, clientUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // GetCurrentUser operation middleware
 func (siw *ServerInterfaceWrapper) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -114,6 +595,194 @@ func (siw *ServerInterfaceWrapper) GetCurrentUser(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// UpdateCurrentUser operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCurrentUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCurrentUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCurrentUserBalanceHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUserBalanceHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCurrentUserBalanceHistoryParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", r.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "before", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCurrentUserBalanceHistory(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCurrentUserCreditOrders operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUserCreditOrders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCurrentUserCreditOrdersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCurrentUserCreditOrders(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateCreditOrder operation middleware
+func (siw *ServerInterfaceWrapper) CreateCreditOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCreditOrder(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCurrentUserCreditOrder operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUserCreditOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "orderUUID" -------------
+	var orderUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "orderUUID", runtime.ParamLocationPath, chi.URLParam(r, "orderUUID"), &orderUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "orderUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCurrentUserCreditOrder(w, r, orderUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCurrentUserSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUserSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCurrentUserSessionsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCurrentUserSessions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RevokeCurrentUserSession operation middleware
+func (siw *ServerInterfaceWrapper) RevokeCurrentUserSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "sessionUUID" -------------
+	var sessionUUID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "sessionUUID", runtime.ParamLocationPath, chi.URLParam(r, "sessionUUID"), &sessionUUID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionUUID", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeCurrentUserSession(w, r, sessionUUID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -227,12 +896,66 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/users", wrapper.GetUsers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/users/{userUUID}", wrapper.GetUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/users/{userUUID}/balance-adjustments", wrapper.AdjustUserBalance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/users/{userUUID}/deactivate", wrapper.DeactivateUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/users/{userUUID}/reactivate", wrapper.ReactivateUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/admin/users/{userUUID}/role", wrapper.UpdateUserRole)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/auth/casdoor/callback", wrapper.CasdoorCallback)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/credit-packages", wrapper.GetCreditPackages)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/payments/webhook", wrapper.HandlePaymentWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trainers/{trainerUUID}/clients", wrapper.GetTrainerClients)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/trainers/{trainerUUID}/clients/{clientUUID}", wrapper.UnassignTrainerClient)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/trainers/{trainerUUID}/clients/{clientUUID}", wrapper.AssignTrainerClient)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/current", wrapper.GetCurrentUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/users/current", wrapper.UpdateCurrentUser)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/current/balance-history", wrapper.GetCurrentUserBalanceHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/current/credit-orders", wrapper.GetCurrentUserCreditOrders)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/current/credit-orders", wrapper.CreateCreditOrder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/current/credit-orders/{orderUUID}", wrapper.GetCurrentUserCreditOrder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/current/sessions", wrapper.GetCurrentUserSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/current/sessions/{sessionUUID}", wrapper.RevokeCurrentUserSession)
+	})
 
 	return r
 }
@@ -3,10 +3,86 @@
 // Code generated by github.com/deepmap/oapi-codegen version v1.16.2 DO NOT EDIT.
 package main
 
+import (
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
 const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for CreditOrderStatus.
+const (
+	CreditOrderStatusFailed   CreditOrderStatus = "failed"
+	CreditOrderStatusPaid     CreditOrderStatus = "paid"
+	CreditOrderStatusPending  CreditOrderStatus = "pending"
+	CreditOrderStatusRefunded CreditOrderStatus = "refunded"
+)
+
+// Defines values for PaymentEventStatus.
+const (
+	PaymentEventStatusFailed    PaymentEventStatus = "failed"
+	PaymentEventStatusRefunded  PaymentEventStatus = "refunded"
+	PaymentEventStatusSucceeded PaymentEventStatus = "succeeded"
+)
+
+// Defines values for UserRole.
+const (
+	Admin    UserRole = "admin"
+	Attendee UserRole = "attendee"
+	Trainer  UserRole = "trainer"
+)
+
+// Defines values for GetUsersParamsStatus.
+const (
+	Active      GetUsersParamsStatus = "active"
+	Deactivated GetUsersParamsStatus = "deactivated"
+)
+
+// AdminUser defines model for AdminUser.
+type AdminUser struct {
+	AvatarUrl *string   `json:"avatarUrl,omitempty"`
+	Balance   int       `json:"balance"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DeactivatedAt When the account was deactivated, missing for active accounts.
+	DeactivatedAt *time.Time `json:"deactivatedAt,omitempty"`
+	Email         *string    `json:"email,omitempty"`
+
+	// ErasedAt When the personal data of the user was erased, erased accounts can't be reactivated.
+	ErasedAt *time.Time         `json:"erasedAt,omitempty"`
+	LastIp   *string            `json:"lastIp,omitempty"`
+	Locale   *string            `json:"locale,omitempty"`
+	Name     string             `json:"name"`
+	Role     UserRole           `json:"role"`
+	Timezone *string            `json:"timezone,omitempty"`
+	Uuid     openapi_types.UUID `json:"uuid"`
+}
+
+// AdminUsers defines model for AdminUsers.
+type AdminUsers struct {
+	Users []AdminUser `json:"users"`
+}
+
+// BalanceHistory defines model for BalanceHistory.
+type BalanceHistory struct {
+	Transactions []BalanceTransaction `json:"transactions"`
+}
+
+// BalanceTransaction defines model for BalanceTransaction.
+type BalanceTransaction struct {
+	ActorUuid    *openapi_types.UUID `json:"actorUuid,omitempty"`
+	Amount       int                 `json:"amount"`
+	BalanceAfter int                 `json:"balanceAfter"`
+	CreatedAt    time.Time           `json:"createdAt"`
+	Description  *string             `json:"description,omitempty"`
+	Reason       string              `json:"reason"`
+	TrainingUuid *openapi_types.UUID `json:"trainingUuid,omitempty"`
+	Uuid         openapi_types.UUID  `json:"uuid"`
+}
+
 // CasdoorOAuthResponse defines model for CasdoorOAuthResponse.
 type CasdoorOAuthResponse struct {
 	AccessToken string `json:"accessToken"`
@@ -28,21 +104,221 @@ type CasdoorUser struct {
 	Owner       *string `json:"owner,omitempty"`
 }
 
-// Error defines model for Error.
+// CreditExpiration defines model for CreditExpiration.
+type CreditExpiration struct {
+	Credits   int       `json:"credits"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// CreditOrder defines model for CreditOrder.
+type CreditOrder struct {
+	// CheckoutUrl Where the user completes the payment of the pending order.
+	CheckoutUrl   *string            `json:"checkoutUrl,omitempty"`
+	CreatedAt     time.Time          `json:"createdAt"`
+	Credits       int                `json:"credits"`
+	Currency      string             `json:"currency"`
+	FailureReason *string            `json:"failureReason,omitempty"`
+	PackageCode   string             `json:"packageCode"`
+	PriceAmount   int                `json:"priceAmount"`
+	Status        CreditOrderStatus  `json:"status"`
+	UpdatedAt     time.Time          `json:"updatedAt"`
+	Uuid          openapi_types.UUID `json:"uuid"`
+}
+
+// CreditOrderStatus defines model for CreditOrder.Status.
+type CreditOrderStatus string
+
+// CreditOrders defines model for CreditOrders.
+type CreditOrders struct {
+	Orders []CreditOrder `json:"orders"`
+}
+
+// CreditPackage defines model for CreditPackage.
+type CreditPackage struct {
+	Code     string `json:"code"`
+	Credits  int    `json:"credits"`
+	Currency string `json:"currency"`
+	Name     string `json:"name"`
+
+	// PriceAmount Price in the smallest currency unit, for example cents.
+	PriceAmount int `json:"priceAmount"`
+
+	// ValidityDays Days the bought credits can be used for, they never expire when it's missing.
+	ValidityDays *int `json:"validityDays,omitempty"`
+}
+
+// CreditPackages defines model for CreditPackages.
+type CreditPackages struct {
+	Packages []CreditPackage `json:"packages"`
+}
+
+// Error Problem details (RFC 7807) extended with the error slug.
 type Error struct {
-	Message string `json:"message"`
-	Slug    string `json:"slug"`
+	Detail   *string                 `json:"detail,omitempty"`
+	Details  *map[string]interface{} `json:"details,omitempty"`
+	Instance *string                 `json:"instance,omitempty"`
+	Message  string                  `json:"message"`
+	Slug     string                  `json:"slug"`
+	Status   int                     `json:"status"`
+	Title    string                  `json:"title"`
+	Type     string                  `json:"type"`
+}
+
+// PatchUserProfile defines model for PatchUserProfile.
+type PatchUserProfile struct {
+	// AvatarUrl HTTP(S) URL of the avatar image, empty to remove it.
+	AvatarUrl *string `json:"avatarUrl,omitempty"`
+
+	// Email Email address, empty to remove it.
+	Email *string `json:"email,omitempty"`
+
+	// Locale BCP 47 language tag, e.g. en-GB, empty to remove it.
+	Locale *string `json:"locale,omitempty"`
+
+	// Name Display name, it can't be empty.
+	Name *string `json:"name,omitempty"`
+
+	// Timezone IANA time zone, e.g. Europe/Warsaw, empty to remove it.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// PaymentEvent defines model for PaymentEvent.
+type PaymentEvent struct {
+	FailureReason *string            `json:"failureReason,omitempty"`
+	PaymentId     string             `json:"paymentId"`
+	Status        PaymentEventStatus `json:"status"`
+}
+
+// PaymentEventStatus defines model for PaymentEvent.Status.
+type PaymentEventStatus string
+
+// PostBalanceAdjustment defines model for PostBalanceAdjustment.
+type PostBalanceAdjustment struct {
+	// Amount Non-zero balance change, negative to take credits away.
+	Amount int `json:"amount"`
+
+	// Reason Why the balance is adjusted, it's shown in the user's credit history.
+	Reason string `json:"reason"`
+}
+
+// PostCreditOrder defines model for PostCreditOrder.
+type PostCreditOrder struct {
+	PackageCode string `json:"packageCode"`
+}
+
+// PutUserRole defines model for PutUserRole.
+type PutUserRole struct {
+	Role UserRole `json:"role"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	AuthMethod *string `json:"authMethod,omitempty"`
+
+	// Current Whether the request was made in this session.
+	Current bool `json:"current"`
+
+	// FirstSeenAt When the session was used for the first time.
+	FirstSeenAt time.Time `json:"firstSeenAt"`
+
+	// Ip IP address of the last request made in the session.
+	Ip         *string    `json:"ip,omitempty"`
+	LastSeenAt time.Time  `json:"lastSeenAt"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+
+	// UserAgent User agent of the last request made in the session.
+	UserAgent *string            `json:"userAgent,omitempty"`
+	Uuid      openapi_types.UUID `json:"uuid"`
+}
+
+// Sessions defines model for Sessions.
+type Sessions struct {
+	Sessions []Session `json:"sessions"`
+}
+
+// TrainerClient defines model for TrainerClient.
+type TrainerClient struct {
+	AssignedAt  time.Time          `json:"assignedAt"`
+	AvatarUrl   *string            `json:"avatarUrl,omitempty"`
+	Deactivated bool               `json:"deactivated"`
+	Email       *string            `json:"email,omitempty"`
+	Name        string             `json:"name"`
+	Uuid        openapi_types.UUID `json:"uuid"`
+}
+
+// TrainerClients defines model for TrainerClients.
+type TrainerClients struct {
+	Clients []TrainerClient `json:"clients"`
 }
 
 // User defines model for User.
 type User struct {
-	Balance     int    `json:"balance"`
-	DisplayName string `json:"displayName"`
-	Role        string `json:"role"`
+	AvatarUrl   *string `json:"avatarUrl,omitempty"`
+	Balance     int     `json:"balance"`
+	DisplayName string  `json:"displayName"`
+	Email       *string `json:"email,omitempty"`
+	Locale      *string `json:"locale,omitempty"`
+	Role        string  `json:"role"`
+	Timezone    *string `json:"timezone,omitempty"`
+
+	// UpcomingExpirations Credits which will expire, the soonest first.
+	UpcomingExpirations *[]CreditExpiration `json:"upcomingExpirations,omitempty"`
 }
 
+// UserRole defines model for UserRole.
+type UserRole string
+
+// GetUsersParams defines parameters for GetUsers.
+type GetUsersParams struct {
+	Role   *UserRole             `form:"role,omitempty" json:"role,omitempty"`
+	Status *GetUsersParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Search Return only users whose name or email contains this text, case-insensitively.
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// After Return only users created after the user with this UUID.
+	After *openapi_types.UUID `form:"after,omitempty" json:"after,omitempty"`
+}
+
+// GetUsersParamsStatus defines parameters for GetUsers.
+type GetUsersParamsStatus string
+
 // CasdoorCallbackParams defines parameters for CasdoorCallback.
 type CasdoorCallbackParams struct {
 	Code  string `form:"code" json:"code"`
 	State string `form:"state" json:"state"`
 }
+
+// GetCurrentUserBalanceHistoryParams defines parameters for GetCurrentUserBalanceHistory.
+type GetCurrentUserBalanceHistoryParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Before Return only transactions older than the balance transaction with this UUID.
+	Before *openapi_types.UUID `form:"before,omitempty" json:"before,omitempty"`
+}
+
+// GetCurrentUserCreditOrdersParams defines parameters for GetCurrentUserCreditOrders.
+type GetCurrentUserCreditOrdersParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetCurrentUserSessionsParams defines parameters for GetCurrentUserSessions.
+type GetCurrentUserSessionsParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// AdjustUserBalanceJSONRequestBody defines body for AdjustUserBalance for application/json ContentType.
+type AdjustUserBalanceJSONRequestBody = PostBalanceAdjustment
+
+// UpdateUserRoleJSONRequestBody defines body for UpdateUserRole for application/json ContentType.
+type UpdateUserRoleJSONRequestBody = PutUserRole
+
+// HandlePaymentWebhookJSONRequestBody defines body for HandlePaymentWebhook for application/json ContentType.
+type HandlePaymentWebhookJSONRequestBody = PaymentEvent
+
+// UpdateCurrentUserJSONRequestBody defines body for UpdateCurrentUser for application/json ContentType.
+type UpdateCurrentUserJSONRequestBody = PatchUserProfile
+
+// CreateCreditOrderJSONRequestBody defines body for CreateCreditOrder for application/json ContentType.
+type CreateCreditOrderJSONRequestBody = PostCreditOrder
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	commonerrors "github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"golang.org/x/text/language"
+)
+
+const (
+	maxNameLength      = 100
+	maxAvatarURLLength = 2048
+)
+
+// applyProfilePatch returns the profile with the patched fields changed, all patched values are validated.
+// Email, avatar URL, time zone and locale are removed when patched with an empty value.
+func applyProfilePatch(profile UserProfile, patch PatchUserProfile) (UserProfile, error) {
+	if patch.Name != nil {
+		name := strings.TrimSpace(*patch.Name)
+		if name == "" || utf8.RuneCountInString(name) > maxNameLength {
+			return UserProfile{}, commonerrors.NewIncorrectInputError(
+				"name can't be empty or longer than 100 characters", "invalid-name",
+			)
+		}
+		profile.Name = name
+	}
+
+	if patch.Email != nil {
+		email := strings.TrimSpace(*patch.Email)
+		if email != "" {
+			address, err := mail.ParseAddress(email)
+			if err != nil || address.Address != email {
+				return UserProfile{}, commonerrors.NewIncorrectInputError("invalid email address", "invalid-email")
+			}
+		}
+		profile.Email = email
+	}
+
+	if patch.AvatarUrl != nil {
+		avatarURL := strings.TrimSpace(*patch.AvatarUrl)
+		if avatarURL != "" && !isValidAvatarURL(avatarURL) {
+			return UserProfile{}, commonerrors.NewIncorrectInputError(
+				"avatar URL has to be an absolute HTTP(S) URL", "invalid-avatar-url",
+			)
+		}
+		profile.AvatarURL = avatarURL
+	}
+
+	if patch.Timezone != nil {
+		timezone := strings.TrimSpace(*patch.Timezone)
+		if timezone != "" {
+			// Local depends on the server, so it's not a time zone of the user
+			if _, err := time.LoadLocation(timezone); err != nil || timezone == "Local" {
+				return UserProfile{}, commonerrors.NewIncorrectInputError("unknown time zone", "invalid-timezone")
+			}
+		}
+		profile.Timezone = timezone
+	}
+
+	if patch.Locale != nil {
+		locale := strings.TrimSpace(*patch.Locale)
+		if locale != "" {
+			tag, err := language.Parse(locale)
+			if err != nil {
+				return UserProfile{}, commonerrors.NewIncorrectInputError("invalid locale", "invalid-locale")
+			}
+			locale = tag.String()
+		}
+		profile.Locale = locale
+	}
+
+	return profile, nil
+}
+
+func isValidAvatarURL(avatarURL string) bool {
+	if len(avatarURL) > maxAvatarURLLength {
+		return false
+	}
+
+	parsed, err := url.Parse(avatarURL)
+	if err != nil {
+		return false
+	}
+
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+func profileFromUser(user UserModel) UserProfile {
+	return UserProfile{
+		Name:      user.Name,
+		Email:     user.Email,
+		AvatarURL: user.AvatarURL,
+		Timezone:  user.Timezone,
+		Locale:    user.Locale,
+	}
+}
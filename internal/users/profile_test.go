@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	commondb "github.com/vaintrub/go-ddd-template/internal/common/db"
+	commonerrors "github.com/vaintrub/go-ddd-template/internal/common/errors"
+	"github.com/vaintrub/go-ddd-template/internal/common/tests"
+)
+
+func TestApplyProfilePatch(t *testing.T) {
+	t.Parallel()
+
+	profile := UserProfile{
+		Name:      "Attendee",
+		Email:     "attendee@threedots.tech",
+		AvatarURL: "https://threedots.tech/avatar.png",
+		Timezone:  "Europe/Warsaw",
+		Locale:    "pl-PL",
+	}
+
+	value := func(s string) *string {
+		return &s
+	}
+
+	testCases := []struct {
+		Name            string
+		Patch           PatchUserProfile
+		ExpectedProfile UserProfile
+		ExpectedSlug    string
+	}{
+		{
+			Name:            "empty_patch",
+			ExpectedProfile: profile,
+		},
+		{
+			Name: "trimmed_values",
+			Patch: PatchUserProfile{
+				Name:     value("  New Name "),
+				Timezone: value(" America/New_York "),
+				Locale:   value("en-us"),
+			},
+			ExpectedProfile: UserProfile{
+				Name:      "New Name",
+				Email:     profile.Email,
+				AvatarURL: profile.AvatarURL,
+				Timezone:  "America/New_York",
+				Locale:    "en-US",
+			},
+		},
+		{
+			Name: "clear_fields",
+			Patch: PatchUserProfile{
+				Email:     value(""),
+				AvatarUrl: value(" "),
+				Timezone:  value(""),
+				Locale:    value(""),
+			},
+			ExpectedProfile: UserProfile{Name: profile.Name},
+		},
+		{
+			Name:         "empty_name",
+			Patch:        PatchUserProfile{Name: value(" ")},
+			ExpectedSlug: "invalid-name",
+		},
+		{
+			Name:         "too_long_name",
+			Patch:        PatchUserProfile{Name: value(strings.Repeat("a", maxNameLength+1))},
+			ExpectedSlug: "invalid-name",
+		},
+		{
+			Name:         "invalid_email",
+			Patch:        PatchUserProfile{Email: value("Attendee <attendee@threedots.tech>")},
+			ExpectedSlug: "invalid-email",
+		},
+		{
+			Name:         "invalid_avatar_url",
+			Patch:        PatchUserProfile{AvatarUrl: value("ftp://threedots.tech/avatar.png")},
+			ExpectedSlug: "invalid-avatar-url",
+		},
+		{
+			Name:         "unknown_timezone",
+			Patch:        PatchUserProfile{Timezone: value("Mars/Olympus_Mons")},
+			ExpectedSlug: "invalid-timezone",
+		},
+		{
+			Name:         "local_timezone",
+			Patch:        PatchUserProfile{Timezone: value("Local")},
+			ExpectedSlug: "invalid-timezone",
+		},
+		{
+			Name:         "invalid_locale",
+			Patch:        PatchUserProfile{Locale: value("not a locale")},
+			ExpectedSlug: "invalid-locale",
+		},
+	}
+
+	for _, c := range testCases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+
+			patched, err := applyProfilePatch(profile, c.Patch)
+
+			if c.ExpectedSlug != "" {
+				var slugErr commonerrors.SlugError
+				require.ErrorAs(t, err, &slugErr)
+				assert.Equal(t, c.ExpectedSlug, slugErr.Slug())
+				assert.Equal(t, commonerrors.ErrorTypeIncorrectInput, slugErr.ErrorType())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, c.ExpectedProfile, patched)
+		})
+	}
+}
+
+func TestIsValidAvatarURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		AvatarURL string
+		Valid     bool
+	}{
+		{AvatarURL: "https://threedots.tech/avatar.png", Valid: true},
+		{AvatarURL: "http://threedots.tech/avatar.png", Valid: true},
+		{AvatarURL: "ftp://threedots.tech/avatar.png"},
+		{AvatarURL: "javascript:alert(1)"},
+		{AvatarURL: "data:image/png;base64,iVBORw0KGgo="},
+		{AvatarURL: "/avatar.png"},
+		{AvatarURL: "https://"},
+		{AvatarURL: "https://threedots.tech/" + strings.Repeat("a", maxAvatarURLLength)},
+	}
+
+	for _, c := range testCases {
+		assert.Equal(t, c.Valid, isValidAvatarURL(c.AvatarURL), c.AvatarURL)
+	}
+}
+
+func TestUpdateCurrentUser_email_already_used(t *testing.T) {
+	t.Parallel()
+
+	userUUID := uuid.New().String()
+	db := &profileDBMock{usedEmail: "trainer@threedots.tech"}
+	router := HandlerWithOptions(HttpServer{db: db}, ChiServerOptions{
+		BaseRouter:  chi.NewRouter(),
+		Middlewares: []MiddlewareFunc{auth.HttpMockMiddleware},
+	})
+
+	req := httptest.NewRequest(http.MethodPatch, "/users/current", strings.NewReader(`{"email":"trainer@threedots.tech"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+tests.FakeAttendeeJWT(t, userUUID))
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, "email-already-used", responseSlug(t, rec))
+}
+
+// profileDBMock keeps the profile of a single user, other methods are not implemented.
+type profileDBMock struct {
+	db
+
+	usedEmail string
+}
+
+func (m *profileDBMock) GetUser(ctx context.Context, userID string) (*UserModel, error) {
+	return &UserModel{UUID: userID, Name: "Attendee", Role: "attendee"}, nil
+}
+
+func (m *profileDBMock) UpdateProfile(ctx context.Context, userID string, profile UserProfile) (*UserModel, error) {
+	if profile.Email == m.usedEmail {
+		return nil, commondb.ErrConflict
+	}
+
+	return &UserModel{UUID: userID, Name: profile.Name, Email: profile.Email, Role: "attendee"}, nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/config"
+	"github.com/vaintrub/go-ddd-template/internal/common/server/httperr"
+	"github.com/vaintrub/go-ddd-template/internal/users/adapters"
+)
+
+const (
+	userTypeTrainer  = "trainer"
+	userTypeAttendee = "attendee"
+	userTypeAdmin    = "admin"
+)
+
+type currentUserCtxKey struct{}
+
+// currentUserFromCtx returns the user provisioned for the request by userProvisioner.Middleware.
+func currentUserFromCtx(ctx context.Context) (UserModel, bool) {
+	user, ok := ctx.Value(currentUserCtxKey{}).(UserModel)
+	return user, ok
+}
+
+// isAdmin checks the role stored by the users service, so role changes made by admins apply right away.
+func isAdmin(ctx context.Context) bool {
+	user, ok := currentUserFromCtx(ctx)
+	return ok && user.Role == userTypeAdmin
+}
+
+// userProvisioner creates users on their first login from their identity claims.
+type userProvisioner struct {
+	db                     db
+	starterCredits         int
+	starterCreditsValidity time.Duration
+}
+
+func newUserProvisioner(db db, cfg config.CreditsConfig) userProvisioner {
+	if db == nil {
+		panic("missing db")
+	}
+
+	return userProvisioner{
+		db:                     db,
+		starterCredits:         cfg.StarterCredits,
+		starterCreditsValidity: cfg.StarterCreditsValidity,
+	}
+}
+
+// Provision creates the user unless it already exists, granting it the starter credits.
+// Users with deactivated account are rejected with auth.ErrUserDeactivated.
+func (p userProvisioner) Provision(ctx context.Context, user auth.User) (UserModel, error) {
+	starterCredits := BalanceChange{
+		Amount: p.starterCredits,
+		Reason: adapters.BalanceReasonStarterCredits,
+	}
+	if p.starterCreditsValidity > 0 {
+		starterCredits.ExpiresAt = time.Now().Add(p.starterCreditsValidity)
+	}
+
+	provisioned, err := p.db.ProvisionUser(ctx, user.UUID, userTypeFromRole(user.Role), user.DisplayName, user.Email, starterCredits)
+	if err != nil {
+		return UserModel{}, err
+	}
+	if provisioned.DeactivatedAt != nil {
+		return UserModel{}, auth.ErrUserDeactivated
+	}
+
+	return *provisioned, nil
+}
+
+// Middleware provisions the authenticated user before the request is handled and stores it in the context,
+// so handlers can rely on the user's row to exist. Anonymous requests are passed through.
+func (p userProvisioner) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authUser, err := auth.UserFromCtx(r.Context())
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		user, err := p.Provision(r.Context(), authUser)
+		if errors.Is(err, auth.ErrUserDeactivated) {
+			httperr.RespondWithSlugError(err, w, r)
+			return
+		}
+		if err != nil {
+			httperr.InternalError("cannot-provision-user", err, w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), currentUserCtxKey{}, user)))
+	})
+}
+
+// userTypeFromRole maps the role claim to the user type, users without the trainer or admin role are attendees.
+func userTypeFromRole(role string) string {
+	switch role {
+	case userTypeTrainer, userTypeAdmin:
+		return role
+	default:
+		return userTypeAttendee
+	}
+}
+
+// userTypeFromRoles maps the roles assigned in the identity provider to the user type, admin role takes precedence.
+func userTypeFromRoles(roles []string) string {
+	switch {
+	case slices.Contains(roles, userTypeAdmin):
+		return userTypeAdmin
+	case slices.Contains(roles, userTypeTrainer):
+		return userTypeTrainer
+	default:
+		return userTypeAttendee
+	}
+}
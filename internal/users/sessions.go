@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	commondb "github.com/vaintrub/go-ddd-template/internal/common/db"
+	"github.com/vaintrub/go-ddd-template/internal/users/adapters"
+	sqlc_users "github.com/vaintrub/go-ddd-template/internal/users/adapters/sqlc"
+)
+
+// SessionActivity is a request made in the user's session.
+type SessionActivity = adapters.SessionActivity
+
+// SessionModel represents a session the user signed in with.
+type SessionModel struct {
+	UUID       string
+	SessionKey string
+	AuthMethod string
+	IP         string
+	UserAgent  string
+
+	FirstSeenAt time.Time
+	LastSeenAt  time.Time
+	// RevokedAt is nil for sessions which weren't revoked.
+	RevokedAt *time.Time
+}
+
+func (p *postgresDB) RecordSession(ctx context.Context, activity SessionActivity) (*SessionModel, error) {
+	return sessionModelOrError(p.repo.RecordSession(ctx, activity))
+}
+
+func (p *postgresDB) Sessions(ctx context.Context, userID string, limit int) ([]SessionModel, error) {
+	// #nosec G115 - limit is validated by the HTTP handler, overflow unlikely
+	sessions, err := p.repo.ListSessions(ctx, userID, int32(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]SessionModel, 0, len(sessions))
+	for _, session := range sessions {
+		models = append(models, sessionFromDB(session))
+	}
+	return models, nil
+}
+
+func (p *postgresDB) RevokeSession(ctx context.Context, userID string, sessionUUID string) (*SessionModel, error) {
+	return sessionModelOrError(p.repo.RevokeSession(ctx, userID, sessionUUID))
+}
+
+func sessionModelOrError(session *sqlc_users.UsersSession, err error) (*SessionModel, error) {
+	if err != nil {
+		return nil, err
+	}
+	model := sessionFromDB(*session)
+	return &model, nil
+}
+
+func sessionFromDB(session sqlc_users.UsersSession) SessionModel {
+	model := SessionModel{
+		UUID:        commondb.PgtypeToUUID(session.ID).String(),
+		SessionKey:  session.SessionKey,
+		FirstSeenAt: session.FirstSeenAt,
+		LastSeenAt:  session.LastSeenAt,
+	}
+	if session.AuthMethod != nil {
+		model.AuthMethod = *session.AuthMethod
+	}
+	if session.Ip != nil {
+		model.IP = *session.Ip
+	}
+	if session.UserAgent != nil {
+		model.UserAgent = *session.UserAgent
+	}
+	if session.RevokedAt.Valid {
+		revokedAt := session.RevokedAt.Time
+		model.RevokedAt = &revokedAt
+	}
+	return model
+}
+
+// sessionChecker records requests of users in their sessions and rejects requests made in revoked sessions.
+// The user has to be provisioned before its session is checked.
+type sessionChecker struct {
+	db db
+}
+
+func newSessionChecker(db db) sessionChecker {
+	if db == nil {
+		panic("missing db")
+	}
+
+	return sessionChecker{db: db}
+}
+
+func (s sessionChecker) CheckSession(ctx context.Context, activity auth.SessionActivity) (auth.User, error) {
+	session, err := s.db.RecordSession(ctx, SessionActivity{
+		UserUUID:   activity.User.UUID,
+		SessionKey: activity.User.SessionID,
+		AuthMethod: activity.User.AuthMethod,
+		IP:         activity.IP,
+		UserAgent:  activity.UserAgent,
+	})
+	if err != nil {
+		return auth.User{}, err
+	}
+
+	if session.RevokedAt != nil {
+		return auth.User{}, auth.ErrSessionRevoked
+	}
+
+	user := activity.User
+	if provisioned, ok := currentUserFromCtx(ctx); ok {
+		user.Role = provisioned.Role
+	}
+
+	return user, nil
+}
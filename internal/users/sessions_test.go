@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vaintrub/go-ddd-template/internal/common/auth"
+	"github.com/vaintrub/go-ddd-template/internal/common/tests"
+	"github.com/vaintrub/go-ddd-template/internal/users/adapters"
+)
+
+func TestGetCurrentUserSessions_current(t *testing.T) {
+	t.Parallel()
+
+	db := newSessionsDBMock()
+	router := newSessionsRouter(db)
+	userUUID := uuid.New().String()
+
+	sendSessionsRequest(t, router, http.MethodGet, "/users/current/sessions", userUUID, "laptop")
+	rec := sendSessionsRequest(t, router, http.MethodGet, "/users/current/sessions", userUUID, "phone")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response Sessions
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Sessions, 2)
+
+	phoneSession := db.session(userUUID, "phone")
+	for _, session := range response.Sessions {
+		assert.Equal(t, phoneSession.UUID == session.Uuid.String(), session.Current)
+	}
+}
+
+func TestRevokeCurrentUserSession(t *testing.T) {
+	t.Parallel()
+
+	db := newSessionsDBMock()
+	router := newSessionsRouter(db)
+
+	userUUID := uuid.New().String()
+	anotherUserUUID := uuid.New().String()
+
+	sendSessionsRequest(t, router, http.MethodGet, "/users/current/sessions", userUUID, "laptop")
+	laptopSession := db.session(userUUID, "laptop")
+	require.NotNil(t, laptopSession)
+
+	t.Run("another_users_session", func(t *testing.T) {
+		rec := sendSessionsRequest(t, router, http.MethodDelete, "/users/current/sessions/"+laptopSession.UUID, anotherUserUUID, "tablet")
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, "session-not-found", responseSlug(t, rec))
+		assert.Nil(t, db.session(userUUID, "laptop").RevokedAt)
+	})
+
+	t.Run("own_session", func(t *testing.T) {
+		rec := sendSessionsRequest(t, router, http.MethodDelete, "/users/current/sessions/"+laptopSession.UUID, userUUID, "phone")
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.NotNil(t, db.session(userUUID, "laptop").RevokedAt)
+	})
+
+	t.Run("request_in_revoked_session", func(t *testing.T) {
+		rec := sendSessionsRequest(t, router, http.MethodGet, "/users/current/sessions", userUUID, "laptop")
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Equal(t, "session-revoked", responseSlug(t, rec))
+	})
+
+	t.Run("request_in_other_session", func(t *testing.T) {
+		rec := sendSessionsRequest(t, router, http.MethodGet, "/users/current/sessions", userUUID, "phone")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func newSessionsRouter(db *sessionsDBMock) http.Handler {
+	return HandlerWithOptions(HttpServer{db: db}, ChiServerOptions{
+		BaseRouter:  chi.NewRouter(),
+		Middlewares: []MiddlewareFunc{auth.SessionMiddleware(newSessionChecker(db)), auth.HttpMockMiddleware},
+	})
+}
+
+func sendSessionsRequest(t *testing.T, router http.Handler, method string, path string, userUUID string, sessionID string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, nil)
+	req.Header.Set("Authorization", "Bearer "+tests.FakeAttendeeSessionJWT(t, userUUID, sessionID))
+	rec := httptest.NewRecorder()
+
+	router.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func responseSlug(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+
+	var response struct {
+		Slug string `json:"slug"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+	return response.Slug
+}
+
+// sessionsDBMock keeps sessions in memory, other methods are not implemented.
+type sessionsDBMock struct {
+	db
+
+	lock     sync.Mutex
+	sessions map[string][]*SessionModel
+}
+
+func newSessionsDBMock() *sessionsDBMock {
+	return &sessionsDBMock{sessions: map[string][]*SessionModel{}}
+}
+
+func (m *sessionsDBMock) session(userID string, sessionKey string) *SessionModel {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, session := range m.sessions[userID] {
+		if session.SessionKey == sessionKey {
+			s := *session
+			return &s
+		}
+	}
+
+	return nil
+}
+
+func (m *sessionsDBMock) RecordSession(ctx context.Context, activity SessionActivity) (*SessionModel, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	now := time.Now()
+	for _, session := range m.sessions[activity.UserUUID] {
+		if session.SessionKey == activity.SessionKey {
+			session.LastSeenAt = now
+			s := *session
+			return &s, nil
+		}
+	}
+
+	session := &SessionModel{
+		UUID:        uuid.New().String(),
+		SessionKey:  activity.SessionKey,
+		AuthMethod:  activity.AuthMethod,
+		IP:          activity.IP,
+		UserAgent:   activity.UserAgent,
+		FirstSeenAt: now,
+		LastSeenAt:  now,
+	}
+	m.sessions[activity.UserUUID] = append(m.sessions[activity.UserUUID], session)
+
+	s := *session
+	return &s, nil
+}
+
+func (m *sessionsDBMock) Sessions(ctx context.Context, userID string, limit int) ([]SessionModel, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	sessions := make([]SessionModel, 0, len(m.sessions[userID]))
+	for _, session := range m.sessions[userID] {
+		sessions = append(sessions, *session)
+	}
+
+	return sessions, nil
+}
+
+func (m *sessionsDBMock) RevokeSession(ctx context.Context, userID string, sessionUUID string) (*SessionModel, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, session := range m.sessions[userID] {
+		if session.UUID == sessionUUID {
+			revokedAt := time.Now()
+			session.RevokedAt = &revokedAt
+			s := *session
+			return &s, nil
+		}
+	}
+
+	return nil, adapters.ErrSessionNotFound
+}
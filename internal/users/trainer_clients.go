@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	sqlc_users "github.com/vaintrub/go-ddd-template/internal/users/adapters/sqlc"
+)
+
+// TrainerClientModel represents the attendee assigned to the trainer.
+type TrainerClientModel struct {
+	Client     UserModel
+	AssignedAt time.Time
+}
+
+func (p *postgresDB) AssignTrainerClient(ctx context.Context, trainerID, clientID string) (*TrainerClientModel, error) {
+	client, err := p.repo.AssignTrainerClient(ctx, trainerID, clientID)
+	if err != nil {
+		return nil, err
+	}
+	model := trainerClientFromDB(*client)
+	return &model, nil
+}
+
+func (p *postgresDB) UnassignTrainerClient(ctx context.Context, trainerID, clientID string) error {
+	return p.repo.UnassignTrainerClient(ctx, trainerID, clientID)
+}
+
+func (p *postgresDB) TrainerClients(ctx context.Context, trainerID string) ([]TrainerClientModel, error) {
+	clients, err := p.repo.ListTrainerClients(ctx, trainerID)
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]TrainerClientModel, 0, len(clients))
+	for _, client := range clients {
+		models = append(models, trainerClientFromDB(client))
+	}
+	return models, nil
+}
+
+func (p *postgresDB) OtherTrainersClientIDs(ctx context.Context, trainerID string) ([]string, error) {
+	return p.repo.ListOtherTrainersClientIDs(ctx, trainerID)
+}
+
+func trainerClientFromDB(client sqlc_users.ListTrainerClientsRow) TrainerClientModel {
+	return TrainerClientModel{
+		Client:     userFromDB(client.UsersUser),
+		AssignedAt: client.AssignedAt,
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// erasedUserName replaces the name of the user whose personal data was erased.
+const erasedUserName = "Erased user"
+
+func (p *postgresDB) EraseUser(ctx context.Context, userID string) (*UserModel, error) {
+	return userModelOrError(p.repo.EraseUser(ctx, userID, erasedUserName))
+}
+
+// userDataExport is the document with everything the users service stores about the user,
+// exported on the user's data access request.
+type userDataExport struct {
+	Profile             AdminUser            `json:"profile"`
+	BalanceTransactions []BalanceTransaction `json:"balanceTransactions"`
+	CreditOrders        []CreditOrder        `json:"creditOrders"`
+	Sessions            []Session            `json:"sessions"`
+}
+
+// exportUserData returns the JSON document with the user's profile, balance ledger, credit orders and sessions.
+func exportUserData(ctx context.Context, db db, userID string) ([]byte, error) {
+	user, err := db.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := db.BalanceHistory(ctx, userID, "", math.MaxInt32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance history: %w", err)
+	}
+
+	orders, err := db.CreditOrders(ctx, userID, math.MaxInt32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credit orders: %w", err)
+	}
+
+	sessions, err := db.Sessions(ctx, userID, math.MaxInt32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sessions: %w", err)
+	}
+
+	export := userDataExport{
+		Profile:             adminUserToResponse(*user),
+		BalanceTransactions: make([]BalanceTransaction, 0, len(transactions)),
+		CreditOrders:        make([]CreditOrder, 0, len(orders)),
+		Sessions:            make([]Session, 0, len(sessions)),
+	}
+	for _, transaction := range transactions {
+		export.BalanceTransactions = append(export.BalanceTransactions, balanceTransactionToResponse(transaction))
+	}
+	for _, order := range orders {
+		export.CreditOrders = append(export.CreditOrders, creditOrderToResponse(order))
+	}
+	for _, session := range sessions {
+		export.Sessions = append(export.Sessions, sessionToResponse(session, ""))
+	}
+
+	return json.Marshal(export)
+}
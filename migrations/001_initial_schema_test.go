@@ -216,8 +216,8 @@ func TestMigration001_SchemaMigrationsTableExists(t *testing.T) {
 	defer cleanup()
 	defer container.Terminate(context.Background())
 
-	// Run migrations
-	err := runMigrations(t, connStr, -1)
+	// Run migrations up to 001 only, later migrations bump the tracked version
+	err := runMigrations(t, connStr, 1)
 	require.NoError(t, err, "failed to run up migrations")
 
 	// Verify schema_migrations table exists
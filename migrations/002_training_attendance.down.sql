@@ -0,0 +1,9 @@
+-- Rollback Training Attendance
+-- Created: 2026-10-18
+-- Purpose: Remove attendance tracking added in 002_training_attendance.up.sql
+
+DROP INDEX IF EXISTS trainings_trainings_pending_attendance_idx;
+
+ALTER TABLE trainings_trainings
+    DROP CONSTRAINT IF EXISTS attendance_check,
+    DROP COLUMN IF EXISTS attendance;
@@ -0,0 +1,13 @@
+-- Training Attendance
+-- Created: 2026-10-18
+-- Purpose: Track whether the attendee showed up for the training
+
+ALTER TABLE trainings_trainings
+    ADD COLUMN attendance TEXT,
+    ADD CONSTRAINT attendance_check CHECK (attendance IN ('attended', 'no_show', 'completed'));
+
+-- Used by the job that auto-completes trainings without recorded attendance
+CREATE INDEX trainings_trainings_pending_attendance_idx ON trainings_trainings(training_time)
+    WHERE attendance IS NULL AND canceled = false;
+
+COMMENT ON COLUMN trainings_trainings.attendance IS 'Attendance outcome: attended, no_show or completed (set automatically after grace period)';
@@ -0,0 +1,5 @@
+-- Rollback Training Feedback
+-- Created: 2026-10-18
+-- Purpose: Remove table created in 003_training_feedback.up.sql
+
+DROP TABLE IF EXISTS trainings_feedback;
@@ -0,0 +1,27 @@
+-- Training Feedback
+-- Created: 2026-10-18
+-- Purpose: Store attendee ratings of trainings and trainer replies
+
+CREATE TABLE trainings_feedback (
+    training_id UUID PRIMARY KEY REFERENCES trainings_trainings(id) ON DELETE CASCADE,
+    rating INTEGER NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    reply TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    replied_at TIMESTAMP WITH TIME ZONE,
+
+    -- Constraints
+    CONSTRAINT rating_check CHECK (rating BETWEEN 1 AND 5),
+    CONSTRAINT comment_length_check CHECK (LENGTH(comment) <= 1000),
+    CONSTRAINT reply_length_check CHECK (LENGTH(reply) <= 1000)
+);
+
+-- Indexes for common query patterns
+CREATE INDEX trainings_feedback_created_at_idx ON trainings_feedback(created_at DESC);
+
+-- Comments for documentation
+COMMENT ON TABLE trainings_feedback IS 'Attendee ratings of trainings, at most one per training';
+COMMENT ON COLUMN trainings_feedback.training_id IS 'Rated training';
+COMMENT ON COLUMN trainings_feedback.rating IS 'Rating from 1 to 5';
+COMMENT ON COLUMN trainings_feedback.comment IS 'Attendee comment, max 1000 characters';
+COMMENT ON COLUMN trainings_feedback.reply IS 'Trainer reply to the feedback, max 1000 characters';
@@ -0,0 +1,6 @@
+-- Rollback Training Cancellation Policy
+-- Created: 2026-10-18
+-- Purpose: Remove column added in 004_training_cancellation_policy.up.sql
+
+ALTER TABLE trainings_trainings
+    DROP COLUMN IF EXISTS cancellation_policy_version;
@@ -0,0 +1,9 @@
+-- Training Cancellation Policy
+-- Created: 2026-10-18
+-- Purpose: Remember which cancellation policy version was in force when the training was booked
+
+-- Existing trainings were booked under the original 24h rule, which is policy v1
+ALTER TABLE trainings_trainings
+    ADD COLUMN cancellation_policy_version TEXT NOT NULL DEFAULT 'v1';
+
+COMMENT ON COLUMN trainings_trainings.cancellation_policy_version IS 'Version of the cancellation policy in force at booking time';
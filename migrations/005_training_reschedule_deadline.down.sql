@@ -0,0 +1,8 @@
+-- Rollback Training Reschedule Deadline
+-- Created: 2026-10-18
+-- Purpose: Remove column added in 005_training_reschedule_deadline.up.sql
+
+DROP INDEX IF EXISTS trainings_trainings_proposal_expires_at_idx;
+
+ALTER TABLE trainings_trainings
+    DROP COLUMN IF EXISTS proposal_expires_at;
@@ -0,0 +1,12 @@
+-- Training Reschedule Deadline
+-- Created: 2026-10-18
+-- Purpose: Reschedule proposals have to be answered before a deadline
+
+ALTER TABLE trainings_trainings
+    ADD COLUMN proposal_expires_at TIMESTAMP WITH TIME ZONE;
+
+-- Used by the job that rejects expired reschedule proposals
+CREATE INDEX trainings_trainings_proposal_expires_at_idx ON trainings_trainings(proposal_expires_at)
+    WHERE proposal_expires_at IS NOT NULL;
+
+COMMENT ON COLUMN trainings_trainings.proposal_expires_at IS 'Deadline for answering the reschedule proposal';
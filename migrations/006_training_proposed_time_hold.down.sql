@@ -0,0 +1,6 @@
+-- Rollback Training Proposed Time Hold
+-- Created: 2026-10-18
+-- Purpose: Remove column added in 006_training_proposed_time_hold.up.sql
+
+ALTER TABLE trainings_trainings
+    DROP COLUMN IF EXISTS proposed_time_held;
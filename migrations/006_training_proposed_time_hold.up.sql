@@ -0,0 +1,8 @@
+-- Training Proposed Time Hold
+-- Created: 2026-10-18
+-- Purpose: Remember whether the proposed reschedule hour is reserved in the trainer's calendar
+
+ALTER TABLE trainings_trainings
+    ADD COLUMN proposed_time_held BOOLEAN NOT NULL DEFAULT false;
+
+COMMENT ON COLUMN trainings_trainings.proposed_time_held IS 'Proposed reschedule hour is reserved until the proposal is answered';
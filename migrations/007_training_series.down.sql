@@ -0,0 +1,9 @@
+-- Rollback Training Series
+-- Created: 2026-10-18
+-- Purpose: Remove tables and column added in 007_training_series.up.sql
+
+ALTER TABLE trainings_trainings
+    DROP COLUMN IF EXISTS series_id;
+
+DROP TABLE IF EXISTS trainings_series_occurrences;
+DROP TABLE IF EXISTS trainings_series;
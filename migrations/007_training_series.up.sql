@@ -0,0 +1,39 @@
+-- Training Series
+-- Created: 2026-10-18
+-- Purpose: Store weekly series of trainings booked by attendees in one request
+
+CREATE TABLE trainings_series (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    user_name TEXT NOT NULL,
+    canceled BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE trainings_series_occurrences (
+    series_id UUID NOT NULL REFERENCES trainings_series(id) ON DELETE CASCADE,
+    occurrence_time TIMESTAMP WITH TIME ZONE NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    training_id UUID REFERENCES trainings_trainings(id) ON DELETE SET NULL,
+    failure_reason VARCHAR(255),
+
+    PRIMARY KEY (series_id, occurrence_time),
+
+    -- Constraints
+    CONSTRAINT status_check CHECK (status IN ('pending', 'scheduled', 'skipped', 'failed'))
+);
+
+ALTER TABLE trainings_trainings
+    ADD COLUMN series_id UUID REFERENCES trainings_series(id) ON DELETE SET NULL;
+
+-- Indexes for common query patterns
+CREATE INDEX trainings_series_user_id_idx ON trainings_series(user_id);
+CREATE INDEX trainings_trainings_series_id_idx ON trainings_trainings(series_id) WHERE series_id IS NOT NULL;
+
+-- Comments for documentation
+COMMENT ON TABLE trainings_series IS 'Weekly series of trainings booked in one request';
+COMMENT ON TABLE trainings_series_occurrences IS 'Booking result of every week of the series';
+COMMENT ON COLUMN trainings_series_occurrences.status IS 'pending, scheduled, skipped or failed';
+COMMENT ON COLUMN trainings_series_occurrences.failure_reason IS 'Error slug explaining why the occurrence could not be booked';
+COMMENT ON COLUMN trainings_trainings.series_id IS 'Series the training was booked in, NULL for trainings booked one by one';
@@ -0,0 +1,5 @@
+-- Rollback Training Waitlist
+-- Created: 2026-10-18
+-- Purpose: Remove table added in 008_training_waitlist.up.sql
+
+DROP TABLE IF EXISTS trainings_waitlist;
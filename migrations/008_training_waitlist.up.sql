@@ -0,0 +1,34 @@
+-- Training Waitlist
+-- Created: 2026-10-18
+-- Purpose: Store attendees waiting for taken hours, freed hours are offered to them in order
+
+CREATE TABLE trainings_waitlist (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    user_name TEXT NOT NULL,
+    hour TIMESTAMP WITH TIME ZONE NOT NULL,
+    auto_book BOOLEAN NOT NULL DEFAULT false,
+    status VARCHAR(20) NOT NULL,
+    offer_expires_at TIMESTAMP WITH TIME ZONE,
+    training_id UUID REFERENCES trainings_trainings(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- Constraints
+    CONSTRAINT status_check CHECK (status IN ('waiting', 'offered', 'booked', 'expired', 'left'))
+);
+
+-- The attendee can wait for the hour only once at a time
+CREATE UNIQUE INDEX trainings_waitlist_active_user_hour_idx ON trainings_waitlist(user_id, hour)
+    WHERE status IN ('waiting', 'offered');
+
+-- Indexes for common query patterns
+CREATE INDEX trainings_waitlist_waiting_idx ON trainings_waitlist(hour, created_at) WHERE status = 'waiting';
+CREATE INDEX trainings_waitlist_offer_expires_at_idx ON trainings_waitlist(offer_expires_at) WHERE status = 'offered';
+
+-- Comments for documentation
+COMMENT ON TABLE trainings_waitlist IS 'Attendees waiting for taken hours';
+COMMENT ON COLUMN trainings_waitlist.auto_book IS 'Book the freed hour right away if the attendee has enough balance, instead of offering it';
+COMMENT ON COLUMN trainings_waitlist.status IS 'waiting, offered, booked, expired or left';
+COMMENT ON COLUMN trainings_waitlist.offer_expires_at IS 'Deadline for claiming the offered hour';
+COMMENT ON COLUMN trainings_waitlist.training_id IS 'Training booked from the waitlist';
@@ -0,0 +1,8 @@
+-- Rollback Training Notes
+-- Created: 2026-10-18
+-- Purpose: Remove table and column added in 009_training_notes.up.sql
+
+DROP TABLE IF EXISTS trainings_notes_history;
+
+ALTER TABLE trainings_trainings
+    DROP COLUMN IF EXISTS trainer_notes;
@@ -0,0 +1,29 @@
+-- Training Notes
+-- Created: 2026-10-18
+-- Purpose: Store trainer-private notes and edit history of training notes
+
+ALTER TABLE trainings_trainings
+    ADD COLUMN trainer_notes TEXT,
+    ADD CONSTRAINT trainer_notes_length_check CHECK (LENGTH(trainer_notes) <= 1000);
+
+CREATE TABLE trainings_notes_history (
+    id BIGSERIAL PRIMARY KEY,
+    training_id UUID NOT NULL REFERENCES trainings_trainings(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL,
+    notes TEXT NOT NULL,
+    edited_by UUID NOT NULL,
+    edited_by_role VARCHAR(20) NOT NULL,
+    edited_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    -- Constraints
+    CONSTRAINT kind_check CHECK (kind IN ('shared', 'trainer')),
+    CONSTRAINT notes_length_check CHECK (LENGTH(notes) <= 1000)
+);
+
+-- Indexes for common query patterns
+CREATE INDEX trainings_notes_history_training_id_idx ON trainings_notes_history(training_id, edited_at);
+
+-- Comments for documentation
+COMMENT ON COLUMN trainings_trainings.trainer_notes IS 'Notes visible only to the trainer';
+COMMENT ON TABLE trainings_notes_history IS 'Every edit of training notes, with the new content';
+COMMENT ON COLUMN trainings_notes_history.kind IS 'shared (visible to the attendee) or trainer (visible only to the trainer)';
@@ -0,0 +1,9 @@
+-- Rollback Training Session Types
+-- Created: 2026-10-18
+-- Purpose: Remove table and columns added in 010_training_session_types.up.sql
+
+ALTER TABLE trainings_trainings
+    DROP COLUMN IF EXISTS price,
+    DROP COLUMN IF EXISTS session_type;
+
+DROP TABLE IF EXISTS trainings_session_types;
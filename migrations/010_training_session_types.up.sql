@@ -0,0 +1,32 @@
+-- Training Session Types
+-- Created: 2026-10-18
+-- Purpose: Store the catalog of bookable session types and the session type and price of every training
+
+CREATE TABLE trainings_session_types (
+    code VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    duration_minutes INTEGER NOT NULL,
+    credit_price INTEGER NOT NULL,
+    archived BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- Constraints
+    CONSTRAINT duration_minutes_check CHECK (duration_minutes > 0 AND duration_minutes <= 60),
+    CONSTRAINT credit_price_check CHECK (credit_price > 0)
+);
+
+-- Every training booked so far was a one hour personal session for a single credit
+INSERT INTO trainings_session_types (code, name, description, duration_minutes, credit_price)
+VALUES ('personal', 'Personal training', 'One hour session with the trainer', 60, 1);
+
+ALTER TABLE trainings_trainings
+    ADD COLUMN session_type VARCHAR(50) NOT NULL DEFAULT 'personal' REFERENCES trainings_session_types(code),
+    ADD COLUMN price INTEGER NOT NULL DEFAULT 1;
+
+-- Comments for documentation
+COMMENT ON TABLE trainings_session_types IS 'Catalog of session types attendees can book';
+COMMENT ON COLUMN trainings_session_types.archived IS 'Archived session types can not be booked anymore, booked trainings are not affected';
+COMMENT ON COLUMN trainings_trainings.session_type IS 'Code of the booked session type';
+COMMENT ON COLUMN trainings_trainings.price IS 'Credits paid for the training, refunds are based on it rather than on the current catalog price';
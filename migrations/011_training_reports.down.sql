@@ -0,0 +1,9 @@
+-- Rollback Training Reports
+-- Created: 2026-10-18
+-- Purpose: Remove columns added in 011_training_reports.up.sql
+
+ALTER TABLE trainings_trainings
+    DROP CONSTRAINT IF EXISTS reschedule_count_check,
+    DROP COLUMN IF EXISTS reschedule_count,
+    DROP COLUMN IF EXISTS canceled_at,
+    DROP COLUMN IF EXISTS canceled_by;
@@ -0,0 +1,14 @@
+-- Training Reports
+-- Created: 2026-10-18
+-- Purpose: Record who canceled a training, when, and how many times it was rescheduled for the utilization reports
+
+ALTER TABLE trainings_trainings
+    ADD COLUMN canceled_by VARCHAR(20),
+    ADD COLUMN canceled_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN reschedule_count INTEGER NOT NULL DEFAULT 0,
+    ADD CONSTRAINT reschedule_count_check CHECK (reschedule_count >= 0);
+
+-- Comments for documentation
+COMMENT ON COLUMN trainings_trainings.canceled_by IS 'User type which canceled the training, NULL for trainings canceled before it was recorded';
+COMMENT ON COLUMN trainings_trainings.canceled_at IS 'When the training was canceled, used to report late cancellations';
+COMMENT ON COLUMN trainings_trainings.reschedule_count IS 'How many times the training was moved to another time';
@@ -0,0 +1,7 @@
+-- Rollback Training Version
+-- Created: 2026-10-18
+-- Purpose: Remove column added in 012_training_version.up.sql
+
+ALTER TABLE trainings_trainings
+    DROP CONSTRAINT IF EXISTS version_check,
+    DROP COLUMN IF EXISTS version;
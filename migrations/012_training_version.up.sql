@@ -0,0 +1,10 @@
+-- Training Version
+-- Created: 2026-10-18
+-- Purpose: Detect concurrent changes of a training with optimistic locking
+
+ALTER TABLE trainings_trainings
+    ADD COLUMN version INTEGER NOT NULL DEFAULT 1,
+    ADD CONSTRAINT version_check CHECK (version > 0);
+
+-- Comments for documentation
+COMMENT ON COLUMN trainings_trainings.version IS 'Incremented on every update, exposed to clients as the ETag of the training';
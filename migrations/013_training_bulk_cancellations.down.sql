@@ -0,0 +1,6 @@
+-- Rollback Training Bulk Cancellations
+-- Created: 2026-10-18
+-- Purpose: Remove tables added in 013_training_bulk_cancellations.up.sql
+
+DROP TABLE IF EXISTS trainings_bulk_cancellation_items;
+DROP TABLE IF EXISTS trainings_bulk_cancellations;
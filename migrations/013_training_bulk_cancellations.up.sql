@@ -0,0 +1,41 @@
+-- Training Bulk Cancellations
+-- Created: 2026-10-18
+-- Purpose: Track progress of canceling all trainings in a time range, so it can be resumed when it fails midway
+
+CREATE TABLE trainings_bulk_cancellations (
+    id UUID PRIMARY KEY,
+    trainer_id UUID NOT NULL,
+    range_from TIMESTAMP WITH TIME ZONE NOT NULL,
+    range_to TIMESTAMP WITH TIME ZONE NOT NULL,
+    completed BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- Constraints
+    CONSTRAINT range_check CHECK (range_to > range_from)
+);
+
+CREATE TABLE trainings_bulk_cancellation_items (
+    bulk_cancellation_id UUID NOT NULL REFERENCES trainings_bulk_cancellations(id) ON DELETE CASCADE,
+    training_id UUID NOT NULL REFERENCES trainings_trainings(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL,
+    training_time TIMESTAMP WITH TIME ZONE,
+    attendee_id UUID,
+    balance_delta INTEGER NOT NULL DEFAULT 0,
+    hour_unavailable BOOLEAN NOT NULL DEFAULT false,
+
+    PRIMARY KEY (bulk_cancellation_id, training_id),
+
+    -- Constraints
+    CONSTRAINT status_check CHECK (status IN ('pending', 'canceled', 'already-canceled'))
+);
+
+-- Indexes for common query patterns
+CREATE INDEX trainings_bulk_cancellations_trainer_id_idx ON trainings_bulk_cancellations(trainer_id);
+
+-- Comments for documentation
+COMMENT ON TABLE trainings_bulk_cancellations IS 'Cancellations of all trainings in a time range requested by the trainer';
+COMMENT ON TABLE trainings_bulk_cancellation_items IS 'Progress of canceling every training of the bulk cancellation';
+COMMENT ON COLUMN trainings_bulk_cancellation_items.status IS 'pending, canceled or already-canceled';
+COMMENT ON COLUMN trainings_bulk_cancellation_items.balance_delta IS 'Trainings balance change of the attendee settled when the training was canceled';
+COMMENT ON COLUMN trainings_bulk_cancellation_items.hour_unavailable IS 'Whether the hour of the training was blocked in the trainer calendar';
@@ -0,0 +1,5 @@
+-- Rollback Users Balance Ledger
+-- Created: 2026-10-18
+-- Purpose: Remove table added in 014_users_balance_ledger.up.sql
+
+DROP TABLE IF EXISTS users_balance_transactions;
@@ -0,0 +1,37 @@
+-- Users Balance Ledger
+-- Created: 2026-10-18
+-- Purpose: Record every trainings balance change, the balance is derived from the ledger
+
+CREATE TABLE users_balance_transactions (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users_users(id) ON DELETE CASCADE,
+    amount INTEGER NOT NULL,
+    balance_after INTEGER NOT NULL,
+    reason VARCHAR(50) NOT NULL,
+    description TEXT,
+    training_id UUID,
+    actor_id UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- Constraints
+    CONSTRAINT amount_check CHECK (amount <> 0)
+);
+
+-- balances set before the ledger existed are recorded as opening transactions
+INSERT INTO users_balance_transactions (id, user_id, amount, balance_after, reason, created_at)
+SELECT gen_random_uuid(), id, balance, balance, 'opening-balance', NOW()
+FROM users_users
+WHERE balance <> 0;
+
+-- Indexes for common query patterns
+CREATE INDEX users_balance_transactions_user_id_created_at_idx ON users_balance_transactions(user_id, created_at, id);
+
+-- Comments for documentation
+COMMENT ON TABLE users_balance_transactions IS 'Append-only ledger of trainings balance changes';
+COMMENT ON COLUMN users_balance_transactions.amount IS 'Balance change, negative for debits';
+COMMENT ON COLUMN users_balance_transactions.balance_after IS 'Balance of the user after the transaction';
+COMMENT ON COLUMN users_balance_transactions.reason IS 'Why the balance changed, for example training-booked or admin-adjustment';
+COMMENT ON COLUMN users_balance_transactions.description IS 'Free-text explanation, required for admin adjustments';
+COMMENT ON COLUMN users_balance_transactions.training_id IS 'Training the change relates to, NULL for changes not related to a training';
+COMMENT ON COLUMN users_balance_transactions.actor_id IS 'User who caused the change, NULL when the system changed it by itself';
+COMMENT ON COLUMN users_users.balance IS 'Sum of the balance transactions, updated together with the ledger';
@@ -0,0 +1,6 @@
+-- Rollback Users Credit Orders
+-- Created: 2026-10-18
+-- Purpose: Remove tables added in 015_users_credit_orders.up.sql
+
+DROP TABLE IF EXISTS users_credit_orders;
+DROP TABLE IF EXISTS users_credit_packages;
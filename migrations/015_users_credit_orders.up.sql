@@ -0,0 +1,58 @@
+-- Users Credit Orders
+-- Created: 2026-10-18
+-- Purpose: Store the catalog of credit packages and orders of credits paid through the payment provider
+
+CREATE TABLE users_credit_packages (
+    code VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    credits INTEGER NOT NULL,
+    price_amount INTEGER NOT NULL,
+    currency CHAR(3) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- Constraints
+    CONSTRAINT credits_check CHECK (credits > 0),
+    CONSTRAINT price_amount_check CHECK (price_amount > 0)
+);
+
+INSERT INTO users_credit_packages (code, name, credits, price_amount, currency)
+VALUES
+    ('single', 'Single training', 1, 3000, 'EUR'),
+    ('five-pack', '5 trainings', 5, 14000, 'EUR'),
+    ('ten-pack', '10 trainings', 10, 25000, 'EUR');
+
+CREATE TABLE users_credit_orders (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users_users(id) ON DELETE CASCADE,
+    package_code VARCHAR(50) NOT NULL REFERENCES users_credit_packages(code),
+    credits INTEGER NOT NULL,
+    price_amount INTEGER NOT NULL,
+    currency CHAR(3) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    payment_id VARCHAR(255) UNIQUE,
+    checkout_url TEXT,
+    failure_reason TEXT,
+    balance_transaction_id UUID REFERENCES users_balance_transactions(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- Constraints
+    CONSTRAINT status_check CHECK (status IN ('pending', 'paid', 'failed', 'refunded')),
+    CONSTRAINT credits_check CHECK (credits > 0),
+    CONSTRAINT price_amount_check CHECK (price_amount > 0)
+);
+
+-- Indexes for common query patterns
+CREATE INDEX users_credit_orders_user_id_created_at_idx ON users_credit_orders(user_id, created_at);
+
+-- Comments for documentation
+COMMENT ON TABLE users_credit_packages IS 'Catalog of credit packages users can buy';
+COMMENT ON COLUMN users_credit_packages.price_amount IS 'Price in the smallest currency unit, for example cents';
+COMMENT ON COLUMN users_credit_packages.active IS 'Inactive packages can not be bought anymore, existing orders are not affected';
+COMMENT ON TABLE users_credit_orders IS 'Orders of credit packages, credits are granted when the payment is confirmed';
+COMMENT ON COLUMN users_credit_orders.credits IS 'Credits of the package at the time of the order';
+COMMENT ON COLUMN users_credit_orders.price_amount IS 'Price charged in the smallest currency unit, it does not change with the catalog';
+COMMENT ON COLUMN users_credit_orders.payment_id IS 'Payment identifier assigned by the payment provider';
+COMMENT ON COLUMN users_credit_orders.balance_transaction_id IS 'Ledger transaction granting the credits, set when the order is paid';
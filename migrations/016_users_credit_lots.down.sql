@@ -0,0 +1,9 @@
+-- Rollback Users Credit Lots
+-- Created: 2026-10-18
+-- Purpose: Remove tables and columns added in 016_users_credit_lots.up.sql
+
+DROP TABLE IF EXISTS users_credit_lot_consumptions;
+DROP TABLE IF EXISTS users_credit_lots;
+
+ALTER TABLE users_credit_orders DROP COLUMN IF EXISTS validity_days;
+ALTER TABLE users_credit_packages DROP COLUMN IF EXISTS validity_days;
@@ -0,0 +1,65 @@
+-- Users Credit Lots
+-- Created: 2026-10-18
+-- Purpose: Store credits in dated lots with expiry, consumed oldest first
+
+ALTER TABLE users_credit_packages
+    ADD COLUMN validity_days INTEGER,
+    ADD CONSTRAINT validity_days_check CHECK (validity_days > 0);
+
+-- packages expire 3 months after they are bought
+UPDATE users_credit_packages SET validity_days = 90;
+
+ALTER TABLE users_credit_orders ADD COLUMN validity_days INTEGER;
+
+UPDATE users_credit_orders o
+SET validity_days = p.validity_days
+FROM users_credit_packages p
+WHERE p.code = o.package_code;
+
+CREATE TABLE users_credit_lots (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users_users(id) ON DELETE CASCADE,
+    transaction_id UUID REFERENCES users_balance_transactions(id),
+    credits INTEGER NOT NULL,
+    remaining INTEGER NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- Constraints
+    CONSTRAINT credits_check CHECK (credits > 0),
+    CONSTRAINT remaining_check CHECK (remaining >= 0 AND remaining <= credits)
+);
+
+CREATE TABLE users_credit_lot_consumptions (
+    transaction_id UUID NOT NULL REFERENCES users_balance_transactions(id) ON DELETE CASCADE,
+    lot_id UUID NOT NULL REFERENCES users_credit_lots(id) ON DELETE CASCADE,
+    amount INTEGER NOT NULL,
+    returned INTEGER NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (transaction_id, lot_id),
+
+    -- Constraints
+    CONSTRAINT amount_check CHECK (amount > 0),
+    CONSTRAINT returned_check CHECK (returned >= 0 AND returned <= amount)
+);
+
+-- balances from before lots existed never expire
+INSERT INTO users_credit_lots (id, user_id, credits, remaining, created_at)
+SELECT gen_random_uuid(), id, balance, balance, NOW()
+FROM users_users
+WHERE balance > 0;
+
+-- Indexes for common query patterns
+CREATE INDEX users_credit_lots_user_id_created_at_idx ON users_credit_lots(user_id, created_at) WHERE remaining > 0;
+CREATE INDEX users_credit_lots_expires_at_idx ON users_credit_lots(expires_at) WHERE remaining > 0;
+CREATE INDEX users_credit_lot_consumptions_lot_id_idx ON users_credit_lot_consumptions(lot_id);
+
+-- Comments for documentation
+COMMENT ON COLUMN users_credit_packages.validity_days IS 'Days bought credits can be used for, NULL when they never expire';
+COMMENT ON COLUMN users_credit_orders.validity_days IS 'Validity of the package at the time of the order';
+COMMENT ON TABLE users_credit_lots IS 'Credits granted together, debits consume the oldest non-expired lot first';
+COMMENT ON COLUMN users_credit_lots.transaction_id IS 'Ledger transaction which granted the lot, NULL for balances from before lots existed';
+COMMENT ON COLUMN users_credit_lots.remaining IS 'Credits which were not consumed or expired yet';
+COMMENT ON COLUMN users_credit_lots.expires_at IS 'When the remaining credits expire, NULL when they never expire';
+COMMENT ON TABLE users_credit_lot_consumptions IS 'Credits of the lot consumed by the debit, refunds return them to the lot';
+COMMENT ON COLUMN users_credit_lot_consumptions.returned IS 'Credits returned to the lot by refunds of the debit';
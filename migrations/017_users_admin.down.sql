@@ -0,0 +1,16 @@
+-- Rollback Users Admin Management
+-- Created: 2026-10-18
+-- Purpose: Remove admin role and deactivation added in 017_users_admin.up.sql
+
+DROP INDEX IF EXISTS users_users_name_lower_idx;
+
+ALTER TABLE users_users DROP COLUMN IF EXISTS deactivated_at;
+
+-- admins become attendees, the role is not supported anymore
+UPDATE users_users SET user_type = 'attendee' WHERE user_type = 'admin';
+
+ALTER TABLE users_users DROP CONSTRAINT user_type_check;
+ALTER TABLE users_users
+    ADD CONSTRAINT user_type_check CHECK (user_type IN ('trainer', 'attendee'));
+
+COMMENT ON COLUMN users_users.user_type IS 'User role: trainer or attendee';
@@ -0,0 +1,16 @@
+-- Users Admin Management
+-- Created: 2026-10-18
+-- Purpose: Support admin role and deactivation of user accounts
+
+ALTER TABLE users_users DROP CONSTRAINT user_type_check;
+ALTER TABLE users_users
+    ADD CONSTRAINT user_type_check CHECK (user_type IN ('trainer', 'attendee', 'admin'));
+
+ALTER TABLE users_users ADD COLUMN deactivated_at TIMESTAMP WITH TIME ZONE;
+
+-- Indexes for admin search
+CREATE INDEX users_users_name_lower_idx ON users_users(LOWER(name));
+
+-- Comments for documentation
+COMMENT ON COLUMN users_users.user_type IS 'User role: trainer, attendee or admin';
+COMMENT ON COLUMN users_users.deactivated_at IS 'When the account was deactivated by an admin, NULL for active accounts';
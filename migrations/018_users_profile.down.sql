@@ -0,0 +1,10 @@
+-- Rollback Users Profile
+-- Created: 2026-10-18
+-- Purpose: Remove columns added in 018_users_profile.up.sql
+
+ALTER TABLE users_users
+    DROP COLUMN IF EXISTS locale,
+    DROP COLUMN IF EXISTS timezone,
+    DROP COLUMN IF EXISTS avatar_url;
+
+COMMENT ON COLUMN users_users.name IS 'User full name';
@@ -0,0 +1,14 @@
+-- Users Profile
+-- Created: 2026-10-18
+-- Purpose: Store profile settings users can edit themselves
+
+ALTER TABLE users_users
+    ADD COLUMN avatar_url TEXT,
+    ADD COLUMN timezone TEXT,
+    ADD COLUMN locale TEXT;
+
+-- Comments for documentation
+COMMENT ON COLUMN users_users.name IS 'User display name, editable by the user';
+COMMENT ON COLUMN users_users.avatar_url IS 'URL of the user avatar image';
+COMMENT ON COLUMN users_users.timezone IS 'IANA time zone of the user, e.g. Europe/Warsaw';
+COMMENT ON COLUMN users_users.locale IS 'BCP 47 language tag of the user, e.g. en-GB';
@@ -0,0 +1,7 @@
+-- Rollback Users Sessions
+-- Created: 2026-10-18
+-- Purpose: Remove table added in 019_users_sessions.up.sql
+
+DROP TABLE IF EXISTS users_sessions;
+
+COMMENT ON COLUMN users_users.last_ip IS NULL;
@@ -0,0 +1,32 @@
+-- Users Sessions
+-- Created: 2026-10-18
+-- Purpose: Keep history of user sessions and allow revoking them
+
+CREATE TABLE users_sessions (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users_users(id) ON DELETE CASCADE,
+    session_key TEXT NOT NULL,
+    auth_method TEXT,
+    ip TEXT,
+    user_agent TEXT,
+    first_seen_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMP WITH TIME ZONE,
+
+    -- Constraints
+    CONSTRAINT users_sessions_user_session_key_unique UNIQUE (user_id, session_key)
+);
+
+-- Indexes for common query patterns
+CREATE INDEX users_sessions_user_id_last_seen_at_idx ON users_sessions(user_id, last_seen_at DESC);
+
+-- Comments for documentation
+COMMENT ON TABLE users_sessions IS 'Sessions users signed in with, recorded when their tokens are used';
+COMMENT ON COLUMN users_sessions.session_key IS 'Session identifier taken from the token (sid or jti claim, or the token hash)';
+COMMENT ON COLUMN users_sessions.auth_method IS 'How the user signed in, NULL when the token does not tell';
+COMMENT ON COLUMN users_sessions.ip IS 'IP address of the last request made in the session';
+COMMENT ON COLUMN users_sessions.user_agent IS 'User agent of the last request made in the session';
+COMMENT ON COLUMN users_sessions.first_seen_at IS 'When the session was used for the first time, i.e. the login time';
+COMMENT ON COLUMN users_sessions.last_seen_at IS 'When the session was used for the last time';
+COMMENT ON COLUMN users_sessions.revoked_at IS 'When the user revoked the session, tokens of revoked sessions are rejected';
+COMMENT ON COLUMN users_users.last_ip IS 'IP address of the last request of the user, the history is in users_sessions';
@@ -0,0 +1,6 @@
+-- Rollback Users Erasure
+-- Created: 2026-10-18
+-- Purpose: Remove column added in 020_users_erasure.up.sql
+
+ALTER TABLE users_users
+    DROP COLUMN IF EXISTS erased_at;
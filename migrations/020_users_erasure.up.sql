@@ -0,0 +1,9 @@
+-- Users Erasure
+-- Created: 2026-10-18
+-- Purpose: Mark users whose personal data was erased on their request
+
+ALTER TABLE users_users
+    ADD COLUMN erased_at TIMESTAMP WITH TIME ZONE;
+
+-- Comments for documentation
+COMMENT ON COLUMN users_users.erased_at IS 'When the personal data of the user was erased, erased accounts stay deactivated; the ledger and credit orders are kept for accounting';
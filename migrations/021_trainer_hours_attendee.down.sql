@@ -0,0 +1,6 @@
+-- Rollback Trainer Hours Attendee
+-- Created: 2026-10-18
+-- Purpose: Remove column added in 021_trainer_hours_attendee.up.sql
+
+ALTER TABLE trainer_hours
+    DROP COLUMN IF EXISTS attendee_id;
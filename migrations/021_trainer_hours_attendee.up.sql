@@ -0,0 +1,9 @@
+-- Trainer Hours Attendee
+-- Created: 2026-10-18
+-- Purpose: Remember who booked the hour, so the trainer calendar can show it
+
+ALTER TABLE trainer_hours
+    ADD COLUMN attendee_id UUID;
+
+-- Comments for documentation
+COMMENT ON COLUMN trainer_hours.attendee_id IS 'Attendee the hour is booked or held for, NULL when no training is scheduled or the attendee is unknown';
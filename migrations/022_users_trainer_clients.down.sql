@@ -0,0 +1,5 @@
+-- Rollback Users Trainer Clients
+-- Created: 2026-10-18
+-- Purpose: Remove table added in 022_users_trainer_clients.up.sql
+
+DROP TABLE IF EXISTS users_trainer_clients;
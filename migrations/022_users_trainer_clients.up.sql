@@ -0,0 +1,22 @@
+-- Users Trainer Clients
+-- Created: 2026-10-18
+-- Purpose: Assign clients to trainers, trainers see only trainings of their clients
+
+CREATE TABLE users_trainer_clients (
+    trainer_id UUID NOT NULL REFERENCES users_users(id) ON DELETE CASCADE,
+    client_id UUID NOT NULL REFERENCES users_users(id) ON DELETE CASCADE,
+    assigned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    -- Constraints
+    PRIMARY KEY (trainer_id, client_id),
+    CONSTRAINT users_trainer_clients_not_self CHECK (trainer_id <> client_id)
+);
+
+-- Indexes for common query patterns
+CREATE INDEX users_trainer_clients_client_id_idx ON users_trainer_clients(client_id);
+
+-- Comments for documentation
+COMMENT ON TABLE users_trainer_clients IS 'Clients of trainers, a client can have more trainers';
+COMMENT ON COLUMN users_trainer_clients.trainer_id IS 'Trainer who can see the trainings of the client';
+COMMENT ON COLUMN users_trainer_clients.client_id IS 'Attendee assigned to the trainer';
+COMMENT ON COLUMN users_trainer_clients.assigned_at IS 'When the client was assigned to the trainer';
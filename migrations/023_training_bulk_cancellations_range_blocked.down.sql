@@ -0,0 +1,5 @@
+-- Rollback Training Bulk Cancellations Range Blocked
+-- Created: 2026-10-18
+-- Purpose: Remove column added in 023_training_bulk_cancellations_range_blocked.up.sql
+
+ALTER TABLE trainings_bulk_cancellations DROP COLUMN IF EXISTS range_blocked;
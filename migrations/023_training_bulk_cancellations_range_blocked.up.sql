@@ -0,0 +1,9 @@
+-- Training Bulk Cancellations Range Blocked
+-- Created: 2026-10-18
+-- Purpose: Track blocking of all free hours in the range of the bulk cancellation, so it can be resumed
+
+ALTER TABLE trainings_bulk_cancellations
+    ADD COLUMN range_blocked BOOLEAN NOT NULL DEFAULT false;
+
+-- Comments for documentation
+COMMENT ON COLUMN trainings_bulk_cancellations.range_blocked IS 'Whether all free hours in the range were blocked in the trainer calendar';
@@ -0,0 +1,11 @@
+-- Rollback Training Session Duration And Tiers
+-- Created: 2026-10-18
+-- Purpose: Remove columns added in 024_training_session_duration_and_tiers.up.sql
+
+ALTER TABLE trainer_hours DROP COLUMN IF EXISTS training_duration_minutes;
+
+ALTER TABLE trainings_trainings
+    DROP COLUMN IF EXISTS cancellation_tiers,
+    DROP COLUMN IF EXISTS duration_minutes;
+
+ALTER TABLE trainings_session_types DROP COLUMN IF EXISTS cancellation_tiers;
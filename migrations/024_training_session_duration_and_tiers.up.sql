@@ -0,0 +1,21 @@
+-- Training Session Duration And Tiers
+-- Created: 2026-10-18
+-- Purpose: Store the duration and cancellation tiers of session types on booked trainings and in the trainer calendar
+
+ALTER TABLE trainings_session_types
+    ADD COLUMN cancellation_tiers JSONB;
+
+ALTER TABLE trainings_trainings
+    ADD COLUMN duration_minutes INTEGER NOT NULL DEFAULT 60,
+    ADD COLUMN cancellation_tiers JSONB,
+    ADD CONSTRAINT duration_minutes_check CHECK (duration_minutes > 0 AND duration_minutes <= 60);
+
+ALTER TABLE trainer_hours
+    ADD COLUMN training_duration_minutes INTEGER,
+    ADD CONSTRAINT training_duration_minutes_check CHECK (training_duration_minutes > 0 AND training_duration_minutes <= 60);
+
+-- Comments for documentation
+COMMENT ON COLUMN trainings_session_types.cancellation_tiers IS 'Cancellation tiers of the session type, NULL when the tiers of the cancellation policy apply';
+COMMENT ON COLUMN trainings_trainings.duration_minutes IS 'Duration of the booked session, trainings booked before session types had durations took the whole hour';
+COMMENT ON COLUMN trainings_trainings.cancellation_tiers IS 'Cancellation tiers of the session type when the training was booked, NULL when the tiers of the cancellation policy apply';
+COMMENT ON COLUMN trainer_hours.training_duration_minutes IS 'Duration of the training scheduled at the hour, NULL when no training is scheduled';
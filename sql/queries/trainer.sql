@@ -28,6 +28,15 @@ SET
     updated_at = NOW()
 WHERE id = $1;
 
+-- name: UpdateHourBooking :exec
+UPDATE trainer_hours
+SET
+    availability = $2,
+    attendee_id = $3,
+    training_duration_minutes = $4,
+    updated_at = NOW()
+WHERE id = $1;
+
 -- name: ListHours :many
 SELECT * FROM trainer_hours
 WHERE (created_at > $1 OR $1 IS NULL)
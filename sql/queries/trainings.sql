@@ -11,25 +11,66 @@ INSERT INTO trainings_trainings (
     proposed_new_time,
     move_proposed_by,
     canceled,
+    cancellation_policy_version,
+    series_id,
+    session_type,
+    price,
+    duration_minutes,
+    cancellation_tiers,
     created_at,
     updated_at
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW()
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, NOW(), NOW()
 ) RETURNING *;
 
 -- name: GetTraining :one
 SELECT * FROM trainings_trainings
 WHERE id = $1;
 
--- name: UpdateTraining :exec
+-- name: GetTrainingForUpdate :one
+-- Locks the training, so concurrent changes are applied one after another and see each other's version.
+SELECT * FROM trainings_trainings
+WHERE id = $1
+FOR UPDATE;
+
+-- name: UpdateTraining :execrows
+-- Overwrites all mutable columns, so cleared values (like a rejected reschedule proposal) are persisted too.
+-- Nothing is updated when the training was changed since it was read with the given version.
+-- Every call bumps the version, so it shouldn't be called for unchanged trainings.
 UPDATE trainings_trainings
 SET
-    notes = COALESCE($2, notes),
-    proposed_new_time = COALESCE($3, proposed_new_time),
-    move_proposed_by = COALESCE($4, move_proposed_by),
-    canceled = COALESCE($5, canceled),
+    training_time = $2,
+    notes = $3,
+    proposed_new_time = $4,
+    move_proposed_by = $5,
+    proposal_expires_at = $6,
+    proposed_time_held = $7,
+    canceled = $8,
+    attendance = $9,
+    trainer_notes = $10,
+    canceled_by = $11,
+    canceled_at = $12,
+    reschedule_count = $13,
+    version = version + 1,
     updated_at = NOW()
-WHERE id = $1;
+WHERE id = $1 AND version = $14;
+
+-- name: CreateTrainingNotesRevision :exec
+INSERT INTO trainings_notes_history (
+    training_id,
+    kind,
+    notes,
+    edited_by,
+    edited_by_role,
+    edited_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+);
+
+-- name: ListTrainingNotesHistory :many
+SELECT * FROM trainings_notes_history
+WHERE training_id = $1
+ORDER BY edited_at, id;
 
 -- name: DeleteTraining :exec
 DELETE FROM trainings_trainings
@@ -44,7 +85,437 @@ WHERE user_id = $1
 ORDER BY created_at, id
 LIMIT $4;
 
--- name: ListAllTrainings :many
+-- name: ListTrainingHistoryByUser :many
+-- Keyset pagination by (training_time, id), canceled trainings are included.
 SELECT * FROM trainings_trainings
-WHERE canceled = false
+WHERE user_id = sqlc.arg(user_id)
+  AND (
+    sqlc.narg(after_time)::timestamptz IS NULL
+    OR (training_time, id) > (sqlc.narg(after_time)::timestamptz, sqlc.narg(after_id)::uuid)
+  )
+ORDER BY training_time, id
+LIMIT sqlc.arg(page_size);
+
+-- name: ListTrainingsForRoster :many
+-- Trainings of the trainer's clients and of attendees not assigned to any trainer
+SELECT * FROM trainings_trainings
+WHERE (
+    user_id = ANY(sqlc.arg(client_ids)::uuid[])
+    OR NOT user_id = ANY(sqlc.arg(other_trainers_client_ids)::uuid[])
+  )
+  AND canceled = false
 ORDER BY created_at DESC, id;
+
+-- name: ListTrainingsPendingAttendance :many
+SELECT id FROM trainings_trainings
+WHERE canceled = false
+  AND attendance IS NULL
+  AND training_time + make_interval(mins => duration_minutes) < sqlc.arg(ended_before)
+ORDER BY training_time, id;
+
+-- name: GetTrainingFeedback :one
+SELECT * FROM trainings_feedback
+WHERE training_id = $1;
+
+-- name: UpsertTrainingFeedback :exec
+INSERT INTO trainings_feedback (
+    training_id,
+    rating,
+    comment,
+    reply,
+    created_at,
+    replied_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (training_id) DO UPDATE SET
+    reply = EXCLUDED.reply,
+    replied_at = EXCLUDED.replied_at;
+
+-- name: GetTrainingsRatingSummary :one
+SELECT
+    COALESCE(AVG(rating), 0)::float8 AS average_rating,
+    COUNT(*) AS ratings_count
+FROM trainings_feedback;
+
+-- name: ListRecentTrainingsFeedback :many
+SELECT
+    f.training_id,
+    f.rating,
+    f.comment,
+    f.reply,
+    f.created_at,
+    f.replied_at,
+    t.user_id,
+    t.user_name,
+    t.training_time
+FROM trainings_feedback f
+JOIN trainings_trainings t ON t.id = f.training_id
+WHERE (sqlc.narg('user_id')::uuid IS NULL OR t.user_id = sqlc.narg('user_id'))
+ORDER BY f.created_at DESC, f.training_id
+LIMIT sqlc.arg('limit');
+
+-- name: ListTrainingsWithExpiredRescheduleProposal :many
+SELECT id FROM trainings_trainings
+WHERE canceled = false
+  AND proposal_expires_at IS NOT NULL
+  AND proposal_expires_at <= $1
+ORDER BY proposal_expires_at, id;
+
+-- name: ListScheduledTrainings :many
+SELECT id, user_id FROM trainings_trainings
+WHERE canceled = false
+  AND training_time >= sqlc.arg('from')
+  AND training_time < sqlc.arg('to')
+ORDER BY training_time, id;
+
+-- name: CreateTrainingSeries :exec
+INSERT INTO trainings_series (
+    id,
+    user_id,
+    user_name,
+    canceled,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, NOW(), NOW()
+);
+
+-- name: GetTrainingSeries :one
+SELECT * FROM trainings_series
+WHERE id = $1;
+
+-- name: UpdateTrainingSeries :exec
+UPDATE trainings_series
+SET
+    canceled = $2,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: ListTrainingSeriesOccurrences :many
+SELECT * FROM trainings_series_occurrences
+WHERE series_id = $1
+ORDER BY occurrence_time;
+
+-- name: UpsertTrainingSeriesOccurrence :exec
+INSERT INTO trainings_series_occurrences (
+    series_id,
+    occurrence_time,
+    status,
+    training_id,
+    failure_reason
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+ON CONFLICT (series_id, occurrence_time) DO UPDATE SET
+    status = EXCLUDED.status,
+    training_id = EXCLUDED.training_id,
+    failure_reason = EXCLUDED.failure_reason;
+
+-- name: ListTrainingSeriesOccurrencesWithTrainings :many
+SELECT
+    o.occurrence_time,
+    o.status,
+    o.training_id,
+    o.failure_reason,
+    t.canceled AS training_canceled
+FROM trainings_series_occurrences o
+LEFT JOIN trainings_trainings t ON t.id = o.training_id
+WHERE o.series_id = $1
+ORDER BY o.occurrence_time;
+
+-- name: CreateWaitlistEntry :exec
+INSERT INTO trainings_waitlist (
+    id,
+    user_id,
+    user_name,
+    hour,
+    auto_book,
+    status,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, NOW(), NOW()
+);
+
+-- name: GetWaitlistEntryForUpdate :one
+-- Locks the entry, so claiming the offer and its expiration can't both succeed.
+SELECT * FROM trainings_waitlist
+WHERE id = $1
+FOR UPDATE;
+
+-- name: UpdateWaitlistEntry :exec
+UPDATE trainings_waitlist
+SET
+    status = $2,
+    offer_expires_at = $3,
+    training_id = $4,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: ListWaitingWaitlistEntries :many
+SELECT id FROM trainings_waitlist
+WHERE status = 'waiting'
+  AND hour = $1
+ORDER BY created_at, id;
+
+-- name: ListWaitlistEntriesWithExpiredOffer :many
+SELECT id FROM trainings_waitlist
+WHERE status = 'offered'
+  AND offer_expires_at <= $1
+ORDER BY offer_expires_at, id;
+
+-- name: ListWaitlistEntriesByUser :many
+SELECT * FROM trainings_waitlist
+WHERE user_id = $1
+  AND status IN ('waiting', 'offered')
+ORDER BY hour, created_at;
+
+-- name: CreateSessionType :exec
+INSERT INTO trainings_session_types (
+    code,
+    name,
+    description,
+    duration_minutes,
+    credit_price,
+    cancellation_tiers,
+    archived
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+);
+
+-- name: GetSessionType :one
+SELECT * FROM trainings_session_types
+WHERE code = $1;
+
+-- name: GetSessionTypeForUpdate :one
+SELECT * FROM trainings_session_types
+WHERE code = $1
+FOR UPDATE;
+
+-- name: UpdateSessionType :exec
+UPDATE trainings_session_types
+SET
+    name = $2,
+    description = $3,
+    duration_minutes = $4,
+    credit_price = $5,
+    cancellation_tiers = $6,
+    archived = $7,
+    updated_at = NOW()
+WHERE code = $1;
+
+-- name: ListSessionTypes :many
+SELECT * FROM trainings_session_types
+ORDER BY name, code;
+
+-- name: TrainerHoursReport :many
+-- Hours offered by the trainer (available or booked) and booked ones, grouped by week or month.
+-- Hours never made available have no row in trainer_hours, so they are not counted.
+-- Unless all_attendees is set, hours booked by clients of other trainers are left out,
+-- the same as trainings in ListTrainingsForRoster. Hours booked by unknown attendees are kept.
+SELECT
+    date_trunc(sqlc.arg(period)::text, hour_time, 'UTC')::timestamptz AS period_start,
+    COUNT(*) FILTER (WHERE availability IN ('available', 'training_scheduled')) AS offered_hours,
+    COUNT(*) FILTER (WHERE availability = 'training_scheduled') AS booked_hours
+FROM trainer_hours
+WHERE hour_time >= sqlc.arg(from_time) AND hour_time < sqlc.arg(to_time)
+  AND (
+    sqlc.arg(all_attendees)::boolean
+    OR availability <> 'training_scheduled'
+    OR attendee_id IS NULL
+    OR attendee_id = ANY(sqlc.arg(client_ids)::uuid[])
+    OR NOT attendee_id = ANY(sqlc.arg(other_trainers_client_ids)::uuid[])
+  )
+GROUP BY period_start
+ORDER BY period_start;
+
+-- name: TrainingsActivityReport :many
+-- Trainings are grouped by their current time, so reschedules are counted in the period the training was moved to.
+-- Unless all_attendees is set, only trainings of the trainer's clients and of attendees without a trainer are counted.
+SELECT
+    date_trunc(sqlc.arg(period)::text, training_time, 'UTC')::timestamptz AS period_start,
+    COUNT(*) FILTER (WHERE NOT canceled) AS trainings,
+    COUNT(*) FILTER (WHERE attendance IN ('attended', 'completed')) AS attended,
+    COUNT(*) FILTER (WHERE attendance = 'no_show') AS no_shows,
+    COUNT(*) FILTER (WHERE canceled) AS cancellations,
+    COALESCE(SUM(reschedule_count), 0)::bigint AS reschedules
+FROM trainings_trainings
+WHERE training_time >= sqlc.arg(from_time) AND training_time < sqlc.arg(to_time)
+  AND (
+    sqlc.arg(all_attendees)::boolean
+    OR user_id = ANY(sqlc.arg(client_ids)::uuid[])
+    OR NOT user_id = ANY(sqlc.arg(other_trainers_client_ids)::uuid[])
+  )
+GROUP BY period_start
+ORDER BY period_start;
+
+-- name: TrainingsCancellationReport :many
+-- Cancellations canceled before canceled_by and canceled_at were recorded are reported as canceled by 'unknown'.
+-- Unless all_attendees is set, only trainings of the trainer's clients and of attendees without a trainer are counted.
+SELECT
+    date_trunc(sqlc.arg(period)::text, training_time, 'UTC')::timestamptz AS period_start,
+    COALESCE(canceled_by, 'unknown')::text AS canceled_by,
+    COUNT(*) AS cancellations,
+    COUNT(*) FILTER (
+        WHERE canceled_at > training_time - make_interval(secs => sqlc.arg(late_notice_seconds)::float8)
+    ) AS late_cancellations,
+    COALESCE(AVG(EXTRACT(EPOCH FROM training_time - canceled_at)), 0)::float8 AS average_notice_seconds
+FROM trainings_trainings
+WHERE canceled AND training_time >= sqlc.arg(from_time) AND training_time < sqlc.arg(to_time)
+  AND (
+    sqlc.arg(all_attendees)::boolean
+    OR user_id = ANY(sqlc.arg(client_ids)::uuid[])
+    OR NOT user_id = ANY(sqlc.arg(other_trainers_client_ids)::uuid[])
+  )
+GROUP BY period_start, canceled_by
+ORDER BY period_start, canceled_by;
+
+-- name: CreateBulkCancellation :exec
+INSERT INTO trainings_bulk_cancellations (
+    id,
+    trainer_id,
+    range_from,
+    range_to,
+    range_blocked,
+    completed,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, NOW(), NOW()
+);
+
+-- name: GetBulkCancellation :one
+SELECT * FROM trainings_bulk_cancellations
+WHERE id = $1;
+
+-- name: GetBulkCancellationForUpdate :one
+SELECT * FROM trainings_bulk_cancellations
+WHERE id = $1
+FOR UPDATE;
+
+-- name: UpdateBulkCancellation :exec
+UPDATE trainings_bulk_cancellations
+SET
+    range_blocked = $2,
+    completed = $3,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: ListBulkCancellationItems :many
+SELECT * FROM trainings_bulk_cancellation_items
+WHERE bulk_cancellation_id = $1
+ORDER BY training_time NULLS LAST, training_id;
+
+-- name: UpsertBulkCancellationItem :exec
+INSERT INTO trainings_bulk_cancellation_items (
+    bulk_cancellation_id,
+    training_id,
+    status,
+    training_time,
+    attendee_id,
+    balance_delta,
+    hour_unavailable
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (bulk_cancellation_id, training_id) DO UPDATE SET
+    status = EXCLUDED.status,
+    training_time = EXCLUDED.training_time,
+    attendee_id = EXCLUDED.attendee_id,
+    balance_delta = EXCLUDED.balance_delta,
+    hour_unavailable = EXCLUDED.hour_unavailable;
+
+-- name: ListUserDataTrainings :many
+-- All trainings of the user with their feedback, exported for data-subject requests.
+SELECT
+    t.id,
+    t.training_time,
+    t.session_type,
+    t.price,
+    t.notes,
+    t.attendance,
+    t.canceled,
+    t.canceled_at,
+    t.created_at,
+    f.rating,
+    f.comment AS feedback_comment,
+    f.reply AS feedback_reply
+FROM trainings_trainings t
+LEFT JOIN trainings_feedback f ON f.training_id = t.id
+WHERE t.user_id = $1
+ORDER BY t.training_time, t.id;
+
+-- name: ListUserDataNotesRevisions :many
+-- Trainer notes are private, so only revisions of shared notes are exported.
+SELECT h.* FROM trainings_notes_history h
+JOIN trainings_trainings t ON t.id = h.training_id
+WHERE t.user_id = $1
+  AND h.kind = 'shared'
+ORDER BY h.training_id, h.edited_at, h.id;
+
+-- name: ListUserDataSeries :many
+SELECT * FROM trainings_series
+WHERE user_id = $1
+ORDER BY created_at, id;
+
+-- name: ListUserDataWaitlistEntries :many
+SELECT * FROM trainings_waitlist
+WHERE user_id = $1
+ORDER BY created_at, id;
+
+-- name: CountUserActiveBookings :one
+-- Bookings which have to be canceled before the user's data can be erased.
+SELECT
+    (
+        SELECT COUNT(*) FROM trainings_trainings t
+        WHERE t.user_id = sqlc.arg(user_id) AND t.canceled = false AND t.training_time > sqlc.arg(now)
+    ) AS upcoming_trainings,
+    (
+        SELECT COUNT(*) FROM trainings_series s
+        WHERE s.user_id = sqlc.arg(user_id) AND s.canceled = false
+          AND EXISTS (
+            SELECT 1 FROM trainings_series_occurrences o
+            WHERE o.series_id = s.id AND o.status = 'pending'
+          )
+    ) AS active_series,
+    (
+        SELECT COUNT(*) FROM trainings_waitlist w
+        WHERE w.user_id = sqlc.arg(user_id) AND w.status IN ('waiting', 'offered')
+    ) AS active_waitlist_entries;
+
+-- name: AnonymizeUserTrainings :exec
+UPDATE trainings_trainings
+SET
+    user_name = $2,
+    notes = NULL,
+    trainer_notes = NULL,
+    version = version + 1,
+    updated_at = NOW()
+WHERE user_id = $1;
+
+-- name: DeleteUserTrainingsNotesHistory :exec
+DELETE FROM trainings_notes_history
+WHERE training_id IN (SELECT id FROM trainings_trainings WHERE user_id = $1);
+
+-- name: AnonymizeUserTrainingsFeedback :exec
+-- Ratings are kept for the trainer's rating, only the texts are removed.
+UPDATE trainings_feedback
+SET
+    comment = '',
+    reply = NULL
+WHERE training_id IN (SELECT id FROM trainings_trainings WHERE user_id = $1);
+
+-- name: AnonymizeUserTrainingSeries :exec
+UPDATE trainings_series
+SET
+    user_name = $2,
+    updated_at = NOW()
+WHERE user_id = $1;
+
+-- name: AnonymizeUserWaitlistEntries :exec
+UPDATE trainings_waitlist
+SET
+    user_name = $2,
+    updated_at = NOW()
+WHERE user_id = $1;
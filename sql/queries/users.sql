@@ -14,10 +14,31 @@ INSERT INTO users_users (
     $1, $2, $3, $4, COALESCE($5, 0), NOW(), NOW()
 ) RETURNING *;
 
+-- name: CreateUserIfNotExists :execrows
+-- Provisions the user on first login, concurrent first requests of the same user insert a single row.
+-- Nothing is inserted also when the email is taken by another user, so the caller can retry without it.
+INSERT INTO users_users (
+    id,
+    user_type,
+    name,
+    email,
+    balance,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, 0, NOW(), NOW()
+)
+ON CONFLICT DO NOTHING;
+
 -- name: GetUser :one
 SELECT * FROM users_users
 WHERE id = $1;
 
+-- name: ListUsersByIDs :many
+SELECT * FROM users_users
+WHERE id = ANY(sqlc.arg(ids)::uuid[])
+ORDER BY id;
+
 -- name: GetUserByEmail :one
 SELECT * FROM users_users
 WHERE email = $1;
@@ -30,6 +51,65 @@ WHERE user_type = $1
 ORDER BY created_at, id
 LIMIT $4;
 
+-- name: ListUsers :many
+-- Keyset pagination by (created_at, id), all filters are optional
+SELECT * FROM users_users
+WHERE (sqlc.narg('user_type')::text IS NULL OR user_type = sqlc.narg('user_type'))
+  AND (sqlc.narg('deactivated')::boolean IS NULL OR (deactivated_at IS NOT NULL) = sqlc.narg('deactivated'))
+  AND (
+    sqlc.narg('search')::text IS NULL
+    OR name ILIKE '%' || sqlc.narg('search') || '%'
+    OR email ILIKE '%' || sqlc.narg('search') || '%'
+  )
+  AND (
+    sqlc.narg('after_id')::uuid IS NULL
+    OR (created_at, id) > (SELECT u.created_at, u.id FROM users_users u WHERE u.id = sqlc.narg('after_id'))
+  )
+ORDER BY created_at, id
+LIMIT sqlc.arg('limit');
+
+-- name: UpdateUserType :one
+UPDATE users_users
+SET
+    user_type = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeactivateUser :one
+-- Deactivating already deactivated user keeps the original deactivation time
+UPDATE users_users
+SET
+    deactivated_at = COALESCE(deactivated_at, NOW()),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: ReactivateUser :one
+-- Erased users can't be reactivated
+UPDATE users_users
+SET
+    deactivated_at = NULL,
+    updated_at = NOW()
+WHERE id = $1 AND erased_at IS NULL
+RETURNING *;
+
+-- name: EraseUser :one
+-- Erasing already erased user keeps the original erasure time
+UPDATE users_users
+SET
+    name = $2,
+    email = NULL,
+    avatar_url = NULL,
+    timezone = NULL,
+    locale = NULL,
+    last_ip = NULL,
+    deactivated_at = COALESCE(deactivated_at, NOW()),
+    erased_at = COALESCE(erased_at, NOW()),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
 -- name: UpdateUser :exec
 UPDATE users_users
 SET
@@ -38,20 +118,294 @@ SET
     updated_at = NOW()
 WHERE id = $1;
 
+-- name: UpdateUserProfile :one
+UPDATE users_users
+SET
+    name = $2,
+    email = $3,
+    avatar_url = $4,
+    timezone = $5,
+    locale = $6,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
 -- name: DeleteUser :exec
 DELETE FROM users_users
 WHERE id = $1;
 
+-- name: GetUserForUpdate :one
+SELECT * FROM users_users
+WHERE id = $1
+FOR UPDATE;
+
+-- name: CreateBalanceTransaction :one
+-- balance_after is derived from the ledger, the user row has to be locked by GetUserForUpdate
+INSERT INTO users_balance_transactions (
+    id,
+    user_id,
+    amount,
+    balance_after,
+    reason,
+    description,
+    training_id,
+    actor_id,
+    created_at
+) VALUES (
+    sqlc.arg('id'),
+    sqlc.arg('user_id'),
+    sqlc.arg('amount'),
+    (SELECT COALESCE(SUM(t.amount), 0) FROM users_balance_transactions t WHERE t.user_id = sqlc.arg('user_id'))::int + sqlc.arg('amount'),
+    sqlc.arg('reason'),
+    sqlc.narg('description'),
+    sqlc.narg('training_id'),
+    sqlc.narg('actor_id'),
+    NOW()
+) RETURNING *;
+
 -- name: UpdateBalance :exec
 UPDATE users_users
 SET
-    balance = balance + $2,
+    balance = $2,
     updated_at = NOW()
 WHERE id = $1;
 
+-- name: ListBalanceTransactions :many
+SELECT t.* FROM users_balance_transactions t
+WHERE t.user_id = sqlc.arg('user_id')
+  AND (
+    sqlc.narg('before_id')::uuid IS NULL
+    OR (t.created_at, t.id) < (
+        SELECT b.created_at, b.id FROM users_balance_transactions b
+        WHERE b.id = sqlc.narg('before_id') AND b.user_id = sqlc.arg('user_id')
+    )
+  )
+ORDER BY t.created_at DESC, t.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ListActiveCreditPackages :many
+SELECT * FROM users_credit_packages
+WHERE active
+ORDER BY credits, code;
+
+-- name: GetCreditPackage :one
+SELECT * FROM users_credit_packages
+WHERE code = $1;
+
+-- name: CreateCreditOrder :one
+INSERT INTO users_credit_orders (
+    id,
+    user_id,
+    package_code,
+    credits,
+    price_amount,
+    currency,
+    validity_days,
+    status,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, 'pending', NOW(), NOW()
+) RETURNING *;
+
+-- name: GetCreditOrder :one
+SELECT * FROM users_credit_orders
+WHERE id = $1;
+
+-- name: GetCreditOrderByPaymentIDForUpdate :one
+SELECT * FROM users_credit_orders
+WHERE payment_id = $1
+FOR UPDATE;
+
+-- name: ListCreditOrders :many
+SELECT * FROM users_credit_orders
+WHERE user_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT $2;
+
+-- name: SetCreditOrderPayment :one
+UPDATE users_credit_orders
+SET
+    payment_id = $2,
+    checkout_url = $3,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateCreditOrderStatus :one
+UPDATE users_credit_orders
+SET
+    status = sqlc.arg('status'),
+    failure_reason = COALESCE(sqlc.narg('failure_reason'), failure_reason),
+    balance_transaction_id = COALESCE(sqlc.narg('balance_transaction_id'), balance_transaction_id),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: CreateCreditLot :exec
+INSERT INTO users_credit_lots (
+    id,
+    user_id,
+    transaction_id,
+    credits,
+    remaining,
+    expires_at,
+    created_at
+) VALUES (
+    sqlc.arg('id'),
+    sqlc.arg('user_id'),
+    sqlc.arg('transaction_id'),
+    sqlc.arg('credits'),
+    sqlc.arg('credits'),
+    sqlc.narg('expires_at'),
+    NOW()
+);
+
+-- name: ListAvailableCreditLots :many
+-- oldest lots are consumed first
+SELECT * FROM users_credit_lots
+WHERE user_id = $1
+  AND remaining > 0
+  AND (expires_at IS NULL OR expires_at > sqlc.arg('now'))
+ORDER BY created_at, id
+FOR UPDATE;
+
+-- name: ListExpiredCreditLots :many
+SELECT * FROM users_credit_lots
+WHERE user_id = $1
+  AND remaining > 0
+  AND expires_at <= sqlc.arg('now')
+ORDER BY expires_at, id
+FOR UPDATE;
+
+-- name: ListUsersWithExpiredCreditLots :many
+SELECT DISTINCT user_id FROM users_credit_lots
+WHERE remaining > 0
+  AND expires_at <= sqlc.arg('now')
+LIMIT sqlc.arg('limit');
+
+-- name: UpdateCreditLotRemaining :exec
+UPDATE users_credit_lots
+SET remaining = remaining + sqlc.arg('amount')
+WHERE id = sqlc.arg('id');
+
+-- name: CreateCreditLotConsumption :exec
+INSERT INTO users_credit_lot_consumptions (
+    transaction_id,
+    lot_id,
+    amount
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: ListTrainingCreditLotConsumptions :many
+-- consumptions of the training's debits which were not returned to their lots yet
+SELECT c.* FROM users_credit_lot_consumptions c
+JOIN users_balance_transactions t ON t.id = c.transaction_id
+JOIN users_credit_lots l ON l.id = c.lot_id
+WHERE t.user_id = sqlc.arg('user_id')
+  AND t.training_id = sqlc.arg('training_id')
+  AND c.returned < c.amount
+ORDER BY t.created_at, l.created_at, l.id
+FOR UPDATE OF c;
+
+-- name: ReturnCreditLotConsumption :exec
+UPDATE users_credit_lot_consumptions
+SET returned = returned + sqlc.arg('amount')
+WHERE transaction_id = sqlc.arg('transaction_id')
+  AND lot_id = sqlc.arg('lot_id');
+
+-- name: ListUpcomingCreditExpirations :many
+SELECT
+    expires_at::timestamptz AS expires_at,
+    SUM(remaining)::int AS credits
+FROM users_credit_lots
+WHERE user_id = $1
+  AND remaining > 0
+  AND expires_at > sqlc.arg('now')
+GROUP BY expires_at
+ORDER BY expires_at;
+
 -- name: UpdateLastIP :exec
 UPDATE users_users
 SET
     last_ip = $2,
     updated_at = NOW()
 WHERE id = $1;
+
+-- name: RecordSession :one
+-- Creates the session on its first use, later uses only refresh where and when it was seen
+INSERT INTO users_sessions (
+    id,
+    user_id,
+    session_key,
+    auth_method,
+    ip,
+    user_agent,
+    first_seen_at,
+    last_seen_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, NOW(), NOW()
+)
+ON CONFLICT (user_id, session_key) DO UPDATE
+SET
+    ip = EXCLUDED.ip,
+    user_agent = EXCLUDED.user_agent,
+    last_seen_at = NOW()
+RETURNING *;
+
+-- name: ListUserSessions :many
+SELECT * FROM users_sessions
+WHERE user_id = $1
+ORDER BY last_seen_at DESC, id
+LIMIT $2;
+
+-- name: RevokeSession :one
+-- Revoking already revoked session keeps the original revocation time
+UPDATE users_sessions
+SET revoked_at = COALESCE(revoked_at, NOW())
+WHERE id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: DeleteUserSessions :exec
+DELETE FROM users_sessions
+WHERE user_id = $1;
+
+-- name: AssignTrainerClient :one
+-- Assigning already assigned client keeps the original assignment time
+INSERT INTO users_trainer_clients (
+    trainer_id,
+    client_id,
+    assigned_at
+) VALUES (
+    $1, $2, NOW()
+)
+ON CONFLICT (trainer_id, client_id) DO UPDATE
+SET assigned_at = users_trainer_clients.assigned_at
+RETURNING *;
+
+-- name: UnassignTrainerClient :execrows
+DELETE FROM users_trainer_clients
+WHERE trainer_id = $1 AND client_id = $2;
+
+-- name: ListTrainerClients :many
+SELECT sqlc.embed(users_users), users_trainer_clients.assigned_at
+FROM users_trainer_clients
+JOIN users_users ON users_users.id = users_trainer_clients.client_id
+WHERE users_trainer_clients.trainer_id = $1
+ORDER BY users_users.name, users_users.id;
+
+-- name: ListOtherTrainersClientIDs :many
+-- Attendees assigned only to other trainers, attendees which are also clients of the trainer are left out
+SELECT DISTINCT other.client_id
+FROM users_trainer_clients AS other
+WHERE other.trainer_id <> $1
+  AND other.client_id NOT IN (
+    SELECT own.client_id FROM users_trainer_clients AS own WHERE own.trainer_id = $1
+  )
+ORDER BY other.client_id;
+
+-- name: DeleteUserTrainerClients :exec
+-- Removes the user from rosters, both as a trainer and as a client
+DELETE FROM users_trainer_clients
+WHERE trainer_id = $1 OR client_id = $1;